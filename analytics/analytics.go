@@ -0,0 +1,263 @@
+// Package analytics aggregates the matches of a player into statistics per champion and role, e.g. for profile pages.
+// Matches are added to a Report one at a time, so they can be read from a match stream or a store:
+//
+//	report := analytics.NewReport(accountID)
+//	for value := range client.Riot.Match.ListStream(accountID, filter, riot.ListStreamWithMatches()) {
+//		if value.Error != nil {
+//			break
+//		}
+//		report.Add(value.Match)
+//	}
+//	fmt.Println(report.Overall.WinRate(), report.Form(10).Trend)
+package analytics
+
+import (
+	"sort"
+
+	"github.com/mjourard/golio/riot"
+)
+
+// Role is the position played by a participant
+type Role string
+
+// All possible roles
+const (
+	RoleTop     Role = "TOP"
+	RoleJungle  Role = "JUNGLE"
+	RoleMiddle  Role = "MIDDLE"
+	RoleBottom  Role = "BOTTOM"
+	RoleSupport Role = "SUPPORT"
+	RoleUnknown Role = "UNKNOWN"
+)
+
+// deltaFirstTenMinutes is the key of the per minute deltas of the first ten minutes of a participant timeline
+const deltaFirstTenMinutes = "0-10"
+
+// ParticipantRole returns the role of the participant as calculated by the Riot API
+func ParticipantRole(p *riot.Participant) Role {
+	if p == nil || p.Timeline == nil {
+		return RoleUnknown
+	}
+	switch p.Timeline.Lane {
+	case "TOP":
+		return RoleTop
+	case "JUNGLE":
+		return RoleJungle
+	case "MID", "MIDDLE":
+		return RoleMiddle
+	case "BOT", "BOTTOM":
+		if p.Timeline.Role == "DUO_SUPPORT" {
+			return RoleSupport
+		}
+		return RoleBottom
+	}
+	return RoleUnknown
+}
+
+// Aggregate contains the summed statistics of a number of games
+type Aggregate struct {
+	Games       int
+	Wins        int
+	Kills       int
+	Deaths      int
+	Assists     int
+	VisionScore int
+	// CSAt10 is the sum of the creep scores at ten minutes of the games in which it is known
+	CSAt10 float64
+	// CSAt10Games is the number of games in which the creep score at ten minutes is known
+	CSAt10Games int
+}
+
+func (a *Aggregate) add(p *riot.Participant) {
+	a.Games++
+	if p.Stats != nil {
+		if p.Stats.Win {
+			a.Wins++
+		}
+		a.Kills += p.Stats.Kills
+		a.Deaths += p.Stats.Deaths
+		a.Assists += p.Stats.Assists
+		a.VisionScore += p.Stats.VisionScore
+	}
+	if p.Timeline != nil {
+		if perMinute, ok := p.Timeline.CreepsPerMinDeltas[deltaFirstTenMinutes]; ok {
+			a.CSAt10 += perMinute * 10
+			a.CSAt10Games++
+		}
+	}
+}
+
+// Losses returns the number of lost games
+func (a Aggregate) Losses() int {
+	return a.Games - a.Wins
+}
+
+// WinRate returns the share of won games in [0, 1] or 0 if no game was played
+func (a Aggregate) WinRate() float64 {
+	return ratio(a.Wins, a.Games)
+}
+
+// KDA returns the ratio of kills and assists to deaths. Deaths are counted as at least one
+func (a Aggregate) KDA() float64 {
+	deaths := a.Deaths
+	if deaths < 1 {
+		deaths = 1
+	}
+	return float64(a.Kills+a.Assists) / float64(deaths)
+}
+
+// AverageCSAt10 returns the average creep score at ten minutes or 0 if it is unknown for all games
+func (a Aggregate) AverageCSAt10() float64 {
+	if a.CSAt10Games == 0 {
+		return 0
+	}
+	return a.CSAt10 / float64(a.CSAt10Games)
+}
+
+// AverageVisionScore returns the average vision score per game
+func (a Aggregate) AverageVisionScore() float64 {
+	return ratio(a.VisionScore, a.Games)
+}
+
+func ratio(a, b int) float64 {
+	if b == 0 {
+		return 0
+	}
+	return float64(a) / float64(b)
+}
+
+// ChampionStats are the statistics of the games played on a champion
+type ChampionStats struct {
+	Champion riot.Champion
+	Aggregate
+}
+
+// RoleStats are the statistics of the games played in a role
+type RoleStats struct {
+	Role Role
+	Aggregate
+}
+
+// Form describes the recent games compared to all games of a report
+type Form struct {
+	// Recent are the statistics of the most recent games
+	Recent Aggregate
+	// Trend is the win rate of the recent games minus the win rate of all games, positive if the player improves
+	Trend float64
+	// Streak is the number of the most recent games with the same result, positive for wins and negative for losses.
+	// It is not limited to the recent games
+	Streak int
+}
+
+// game is the result of the player in a single match
+type game struct {
+	creation    riot.Timestamp
+	participant *riot.Participant
+}
+
+// Report aggregates the matches of a single player. It is not safe for concurrent use
+type Report struct {
+	accountID string
+	// Overall are the statistics of all added games
+	Overall   Aggregate
+	champions map[riot.Champion]*Aggregate
+	roles     map[Role]*Aggregate
+	games     []game
+	seen      map[int]bool
+}
+
+// NewReport returns an empty report for the account with the given ID
+func NewReport(accountID string) *Report {
+	return &Report{
+		accountID: accountID,
+		champions: map[riot.Champion]*Aggregate{},
+		roles:     map[Role]*Aggregate{},
+		seen:      map[int]bool{},
+	}
+}
+
+// Add adds the match to the report. It returns false if the account did not play in the match or the match was
+// already added
+func (r *Report) Add(match *riot.Match) bool {
+	if match == nil || r.seen[match.GameID] {
+		return false
+	}
+	p := match.ParticipantByAccount(r.accountID)
+	if p == nil {
+		return false
+	}
+	r.seen[match.GameID] = true
+	r.Overall.add(p)
+	champion := riot.Champion(p.ChampionID)
+	if r.champions[champion] == nil {
+		r.champions[champion] = &Aggregate{}
+	}
+	r.champions[champion].add(p)
+	role := ParticipantRole(p)
+	if r.roles[role] == nil {
+		r.roles[role] = &Aggregate{}
+	}
+	r.roles[role].add(p)
+	r.games = append(r.games, game{creation: match.GameCreation, participant: p})
+	return true
+}
+
+// Champions returns the statistics per champion, most played first
+func (r *Report) Champions() []ChampionStats {
+	stats := make([]ChampionStats, 0, len(r.champions))
+	for champion, aggregate := range r.champions {
+		stats = append(stats, ChampionStats{Champion: champion, Aggregate: *aggregate})
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Games != stats[j].Games {
+			return stats[i].Games > stats[j].Games
+		}
+		return stats[i].Champion < stats[j].Champion
+	})
+	return stats
+}
+
+// Roles returns the statistics per role, most played first
+func (r *Report) Roles() []RoleStats {
+	stats := make([]RoleStats, 0, len(r.roles))
+	for role, aggregate := range r.roles {
+		stats = append(stats, RoleStats{Role: role, Aggregate: *aggregate})
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Games != stats[j].Games {
+			return stats[i].Games > stats[j].Games
+		}
+		return stats[i].Role < stats[j].Role
+	})
+	return stats
+}
+
+// Form returns the statistics of the n most recent games by creation time compared to all games
+func (r *Report) Form(n int) Form {
+	games := append([]game(nil), r.games...)
+	sort.SliceStable(games, func(i, j int) bool {
+		return games[i].creation.After(games[j].creation.Time)
+	})
+	var form Form
+	for i, g := range games {
+		won := g.participant.Stats != nil && g.participant.Stats.Win
+		if i > 0 && won != (form.Streak > 0) {
+			break
+		}
+		if won {
+			form.Streak++
+		} else {
+			form.Streak--
+		}
+	}
+	if n < len(games) {
+		games = games[:n]
+	}
+	for _, g := range games {
+		form.Recent.add(g.participant)
+	}
+	if form.Recent.Games > 0 {
+		form.Trend = form.Recent.WinRate() - r.Overall.WinRate()
+	}
+	return form
+}
@@ -0,0 +1,103 @@
+package analytics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mjourard/golio/riot"
+)
+
+func match(gameID int, created time.Time, champion riot.Champion, lane, role string, win bool, k, d, a int,
+	csPerMinute float64) *riot.Match {
+	timeline := &riot.ParticipantTimeline{Lane: lane, Role: role}
+	if csPerMinute > 0 {
+		timeline.CreepsPerMinDeltas = map[string]float64{"0-10": csPerMinute, "10-20": 10}
+	}
+	return &riot.Match{
+		GameID:       gameID,
+		GameCreation: riot.Timestamp{Time: created},
+		ParticipantIdentities: []*riot.ParticipantIdentity{
+			{ParticipantID: 1, Player: &riot.Player{AccountID: "other"}},
+			{ParticipantID: 2, Player: &riot.Player{AccountID: "id"}},
+		},
+		Participants: []*riot.Participant{
+			{ParticipantID: 1, ChampionID: int(riot.ChampionAnnie), Stats: &riot.ParticipantStats{Kills: 20}},
+			{
+				ParticipantID: 2,
+				ChampionID:    int(champion),
+				Timeline:      timeline,
+				Stats:         &riot.ParticipantStats{Win: win, Kills: k, Deaths: d, Assists: a, VisionScore: 10 * gameID},
+			},
+		},
+	}
+}
+
+func TestReport(t *testing.T) {
+	t.Parallel()
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	report := NewReport("id")
+	assert.True(t, report.Add(match(1, start, riot.ChampionAhri, "MID", "SOLO", false, 1, 5, 2, 6)))
+	assert.True(t, report.Add(match(2, start.Add(time.Hour), riot.ChampionAhri, "MIDDLE", "SOLO", true, 5, 0, 5, 8)))
+	assert.True(t, report.Add(match(4, start.Add(3*time.Hour), riot.ChampionAshe, "BOTTOM", "DUO_CARRY", true, 2, 2,
+		2, 0)))
+	assert.True(t, report.Add(match(3, start.Add(2*time.Hour), riot.ChampionAhri, "BOTTOM", "DUO_SUPPORT", true, 0, 1,
+		9, 1.5)))
+	assert.False(t, report.Add(match(3, start, riot.ChampionAhri, "TOP", "SOLO", true, 0, 0, 0, 0)))
+	assert.False(t, report.Add(nil))
+	other := match(5, start, riot.ChampionAhri, "TOP", "SOLO", true, 0, 0, 0, 0)
+	other.ParticipantIdentities[1].Player.AccountID = "unknown"
+	assert.False(t, report.Add(other))
+
+	assert.Equal(t, Aggregate{
+		Games: 4, Wins: 3, Kills: 8, Deaths: 8, Assists: 18, VisionScore: 100, CSAt10: 155, CSAt10Games: 3,
+	}, report.Overall)
+	assert.Equal(t, 1, report.Overall.Losses())
+	assert.Equal(t, 0.75, report.Overall.WinRate())
+	assert.Equal(t, 3.25, report.Overall.KDA())
+	assert.InDelta(t, 51.67, report.Overall.AverageCSAt10(), 0.01)
+	assert.Equal(t, 25.0, report.Overall.AverageVisionScore())
+
+	champions := report.Champions()
+	assert.Len(t, champions, 2)
+	assert.Equal(t, riot.ChampionAhri, champions[0].Champion)
+	assert.Equal(t, 3, champions[0].Games)
+	assert.InDelta(t, 2/3.0, champions[0].WinRate(), 0.001)
+	assert.Equal(t, riot.ChampionAshe, champions[1].Champion)
+	assert.Equal(t, 0.0, champions[1].AverageCSAt10())
+
+	roles := report.Roles()
+	assert.Equal(t, []Role{RoleMiddle, RoleBottom, RoleSupport}, []Role{roles[0].Role, roles[1].Role, roles[2].Role})
+	assert.Equal(t, 2, roles[0].Games)
+	assert.Equal(t, 70.0, roles[0].AverageCSAt10())
+
+	form := report.Form(2)
+	assert.Equal(t, 2, form.Recent.Games)
+	assert.Equal(t, 0.25, form.Trend)
+	assert.Equal(t, 3, form.Streak)
+	assert.Equal(t, 4, report.Form(10).Recent.Games)
+}
+
+func TestReport_losingStreak(t *testing.T) {
+	t.Parallel()
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	report := NewReport("id")
+	report.Add(match(1, start, riot.ChampionAhri, "TOP", "SOLO", true, 0, 0, 0, 0))
+	report.Add(match(2, start.Add(time.Hour), riot.ChampionAhri, "TOP", "SOLO", false, 0, 0, 0, 0))
+	report.Add(match(3, start.Add(2*time.Hour), riot.ChampionAhri, "TOP", "SOLO", false, 0, 0, 0, 0))
+	form := report.Form(3)
+	assert.Equal(t, -2, form.Streak)
+	assert.Equal(t, 0.0, form.Trend)
+	assert.Equal(t, Form{}, NewReport("id").Form(5))
+}
+
+func TestParticipantRole(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, RoleUnknown, ParticipantRole(nil))
+	assert.Equal(t, RoleUnknown, ParticipantRole(&riot.Participant{}))
+	assert.Equal(t, RoleTop, ParticipantRole(&riot.Participant{Timeline: &riot.ParticipantTimeline{Lane: "TOP"}}))
+	assert.Equal(t, RoleJungle, ParticipantRole(&riot.Participant{Timeline: &riot.ParticipantTimeline{Lane: "JUNGLE"}}))
+	assert.Equal(t, RoleBottom, ParticipantRole(&riot.Participant{Timeline: &riot.ParticipantTimeline{Lane: "BOT"}}))
+	assert.Equal(t, RoleUnknown, ParticipantRole(&riot.Participant{Timeline: &riot.ParticipantTimeline{Lane: "NONE"}}))
+}
@@ -0,0 +1,233 @@
+package analytics
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/mjourard/golio/riot"
+)
+
+// PartnerNode is a player of a PartnerGraph
+type PartnerNode struct {
+	AccountID    string `json:"accountId"`
+	SummonerName string `json:"summonerName"`
+	// Games is the number of added matches the player played in
+	Games int `json:"games"`
+}
+
+// PartnerEdge connects two players who played in the same team. From is always the smaller account ID
+type PartnerEdge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	// Games is the number of added matches in which the players were teammates
+	Games int `json:"games"`
+	// Wins is the number of these matches the team of the players won
+	Wins int `json:"wins"`
+}
+
+type edgeKey struct {
+	from, to string
+}
+
+// PartnerGraph is a weighted graph of players who played in the same team, e.g. to find duo partners of a player.
+// It is not safe for concurrent use
+type PartnerGraph struct {
+	nodes map[string]*PartnerNode
+	edges map[edgeKey]*PartnerEdge
+	seen  map[int]bool
+}
+
+// NewPartnerGraph returns an empty graph
+func NewPartnerGraph() *PartnerGraph {
+	return &PartnerGraph{
+		nodes: map[string]*PartnerNode{},
+		edges: map[edgeKey]*PartnerEdge{},
+		seen:  map[int]bool{},
+	}
+}
+
+// Add adds the players of the match and connects all teammates. It returns false if the match was already added
+func (g *PartnerGraph) Add(match *riot.Match) bool {
+	if match == nil || g.seen[match.GameID] {
+		return false
+	}
+	g.seen[match.GameID] = true
+	teams := map[int][]string{}
+	for _, identity := range match.ParticipantIdentities {
+		if identity == nil || identity.Player == nil {
+			continue
+		}
+		participant := match.Participant(identity.ParticipantID)
+		accountID := identity.Player.CurrentAccountID
+		if accountID == "" {
+			accountID = identity.Player.AccountID
+		}
+		if participant == nil || accountID == "" {
+			continue
+		}
+		node := g.nodes[accountID]
+		if node == nil {
+			node = &PartnerNode{AccountID: accountID}
+			g.nodes[accountID] = node
+		}
+		node.SummonerName = identity.Player.SummonerName
+		node.Games++
+		teams[participant.TeamID] = append(teams[participant.TeamID], accountID)
+	}
+	winner := match.WinningTeam()
+	for teamID, players := range teams {
+		sort.Strings(players)
+		for i := range players {
+			for _, to := range players[i+1:] {
+				key := edgeKey{from: players[i], to: to}
+				edge := g.edges[key]
+				if edge == nil {
+					edge = &PartnerEdge{From: key.from, To: key.to}
+					g.edges[key] = edge
+				}
+				edge.Games++
+				if winner != nil && winner.TeamID == teamID {
+					edge.Wins++
+				}
+			}
+		}
+	}
+	return true
+}
+
+// Nodes returns all players, most games first
+func (g *PartnerGraph) Nodes() []PartnerNode {
+	nodes := make([]PartnerNode, 0, len(g.nodes))
+	for _, node := range g.nodes {
+		nodes = append(nodes, *node)
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		if nodes[i].Games != nodes[j].Games {
+			return nodes[i].Games > nodes[j].Games
+		}
+		return nodes[i].AccountID < nodes[j].AccountID
+	})
+	return nodes
+}
+
+// Edges returns all connections of players who were teammates in at least minGames matches, most games first
+func (g *PartnerGraph) Edges(minGames int) []PartnerEdge {
+	var edges []PartnerEdge
+	for _, edge := range g.edges {
+		if edge.Games >= minGames {
+			edges = append(edges, *edge)
+		}
+	}
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i].Games != edges[j].Games {
+			return edges[i].Games > edges[j].Games
+		}
+		if edges[i].From != edges[j].From {
+			return edges[i].From < edges[j].From
+		}
+		return edges[i].To < edges[j].To
+	})
+	return edges
+}
+
+// Partners returns the connections of the player with at least minGames matches together, most games first
+func (g *PartnerGraph) Partners(accountID string, minGames int) []PartnerEdge {
+	var partners []PartnerEdge
+	for _, edge := range g.Edges(minGames) {
+		if edge.From == accountID || edge.To == accountID {
+			partners = append(partners, edge)
+		}
+	}
+	return partners
+}
+
+// connected returns the nodes of the edges
+func (g *PartnerGraph) connected(edges []PartnerEdge) []PartnerNode {
+	ids := map[string]bool{}
+	for _, edge := range edges {
+		ids[edge.From], ids[edge.To] = true, true
+	}
+	var nodes []PartnerNode
+	for _, node := range g.Nodes() {
+		if ids[node.AccountID] {
+			nodes = append(nodes, node)
+		}
+	}
+	return nodes
+}
+
+// WriteDOT writes the edges with at least minGames matches and their players as undirected graph in the DOT language
+// of Graphviz. Edges are labeled and weighted by the number of games
+func (g *PartnerGraph) WriteDOT(w io.Writer, minGames int) error {
+	edges := g.Edges(minGames)
+	var b strings.Builder
+	b.WriteString("graph partners {\n")
+	for _, node := range g.connected(edges) {
+		label := node.SummonerName
+		if label == "" {
+			label = node.AccountID
+		}
+		fmt.Fprintf(&b, "\t%s [label=%s];\n", dotID(node.AccountID), dotID(label))
+	}
+	for _, edge := range edges {
+		fmt.Fprintf(&b, "\t%s -- %s [weight=%d, label=\"%d\"];\n", dotID(edge.From), dotID(edge.To), edge.Games,
+			edge.Games)
+	}
+	b.WriteString("}\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// dotID quotes s as a DOT identifier
+func dotID(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
+
+// WriteJSON writes the edges with at least minGames matches and their players as a JSON object with the fields nodes
+// and edges
+func (g *PartnerGraph) WriteJSON(w io.Writer, minGames int) error {
+	edges := g.Edges(minGames)
+	nodes := g.connected(edges)
+	if edges == nil {
+		edges = []PartnerEdge{}
+		nodes = []PartnerNode{}
+	}
+	return json.NewEncoder(w).Encode(struct {
+		Nodes []PartnerNode `json:"nodes"`
+		Edges []PartnerEdge `json:"edges"`
+	}{Nodes: nodes, Edges: edges})
+}
+
+// BuildPartnerGraph adds the last matches, at most 100, of each of the accounts to a new graph. Matches shared by
+// several of the accounts are only fetched once
+func BuildPartnerGraph(ctx context.Context, client *riot.Client, accountIDs []string,
+	matches int) (*PartnerGraph, error) {
+	graph := NewPartnerGraph()
+	for _, accountID := range accountIDs {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		list, err := client.Match.List(accountID, riot.NewMatchFilter().Range(0, matches))
+		if err != nil {
+			return nil, err
+		}
+		for _, reference := range list.Matches {
+			if reference == nil || graph.seen[reference.GameID] {
+				continue
+			}
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			match, err := client.Match.Get(reference.GameID)
+			if err != nil {
+				return nil, err
+			}
+			graph.Add(match)
+		}
+	}
+	return graph, nil
+}
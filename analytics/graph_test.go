@@ -0,0 +1,103 @@
+package analytics
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mjourard/golio/api"
+	"github.com/mjourard/golio/mock"
+	"github.com/mjourard/golio/riot"
+)
+
+// teamMatch returns a match of the players, the first half playing for the blue team which wins if blueWins is set
+func teamMatch(gameID int, blueWins bool, players ...string) *riot.Match {
+	match := &riot.Match{GameID: gameID}
+	blue, red := "Win", "Fail"
+	if !blueWins {
+		blue, red = red, blue
+	}
+	match.Teams = []*riot.TeamStats{{TeamID: 100, Win: blue}, {TeamID: 200, Win: red}}
+	for i, player := range players {
+		teamID := 100
+		if i >= len(players)/2 {
+			teamID = 200
+		}
+		match.Participants = append(match.Participants, &riot.Participant{ParticipantID: i + 1, TeamID: teamID})
+		match.ParticipantIdentities = append(match.ParticipantIdentities, &riot.ParticipantIdentity{
+			ParticipantID: i + 1,
+			Player:        &riot.Player{AccountID: player, SummonerName: "name " + player},
+		})
+	}
+	return match
+}
+
+func TestPartnerGraph(t *testing.T) {
+	t.Parallel()
+	graph := NewPartnerGraph()
+	assert.True(t, graph.Add(teamMatch(1, true, "a", "b", "c", "d")))
+	assert.True(t, graph.Add(teamMatch(2, false, "b", "a", "e", "f")))
+	assert.True(t, graph.Add(teamMatch(3, true, "a", "c", "b", "d")))
+	assert.False(t, graph.Add(teamMatch(3, true, "a", "c", "b", "d")))
+	assert.False(t, graph.Add(nil))
+
+	assert.Equal(t, []PartnerEdge{{From: "a", To: "b", Games: 2, Wins: 1}}, graph.Edges(2))
+	assert.Len(t, graph.Edges(1), 5)
+	assert.Equal(t, []PartnerEdge{{From: "a", To: "b", Games: 2, Wins: 1}, {From: "a", To: "c", Games: 1, Wins: 1}},
+		graph.Partners("a", 1))
+	nodes := graph.Nodes()
+	assert.Equal(t, PartnerNode{AccountID: "a", SummonerName: "name a", Games: 3}, nodes[0])
+	assert.Len(t, nodes, 6)
+
+	var dot bytes.Buffer
+	require.Nil(t, graph.WriteDOT(&dot, 2))
+	assert.Equal(t, "graph partners {\n"+
+		"\t\"a\" [label=\"name a\"];\n"+
+		"\t\"b\" [label=\"name b\"];\n"+
+		"\t\"a\" -- \"b\" [weight=2, label=\"2\"];\n"+
+		"}\n", dot.String())
+
+	var data bytes.Buffer
+	require.Nil(t, graph.WriteJSON(&data, 2))
+	var got struct {
+		Nodes []PartnerNode `json:"nodes"`
+		Edges []PartnerEdge `json:"edges"`
+	}
+	require.Nil(t, json.Unmarshal(data.Bytes(), &got))
+	assert.Len(t, got.Nodes, 2)
+	assert.Equal(t, graph.Edges(2), got.Edges)
+	data.Reset()
+	require.Nil(t, graph.WriteJSON(&data, 10))
+	assert.JSONEq(t, `{"nodes":[],"edges":[]}`, data.String())
+}
+
+func TestDotID(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, `"a \"b\" \\"`, dotID(`a "b" \`))
+}
+
+func TestBuildPartnerGraph(t *testing.T) {
+	t.Parallel()
+	doer := mock.NewSequenceDoer(
+		mock.OK(riot.Matchlist{Matches: []*riot.MatchReference{{GameID: 1}, {GameID: 2}}}),
+		mock.OK(teamMatch(1, true, "a", "b", "c", "d")),
+		mock.OK(teamMatch(2, true, "a", "b", "e", "f")),
+		mock.OK(riot.Matchlist{Matches: []*riot.MatchReference{{GameID: 2}, {GameID: 3}}}),
+		mock.OK(teamMatch(3, true, "b", "c", "a", "d")),
+	)
+	client := riot.NewClient(api.RegionKorea, "API_KEY", doer, logrus.StandardLogger())
+	graph, err := BuildPartnerGraph(context.Background(), client, []string{"a", "b"}, 20)
+	require.Nil(t, err)
+	assert.Equal(t, 5, doer.Calls())
+	assert.Equal(t, []PartnerEdge{{From: "a", To: "b", Games: 2, Wins: 2}}, graph.Edges(2))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = BuildPartnerGraph(ctx, client, []string{"a"}, 20)
+	assert.Equal(t, context.Canceled, err)
+}
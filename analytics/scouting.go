@@ -0,0 +1,170 @@
+package analytics
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/mjourard/golio/riot"
+)
+
+const (
+	// DefaultScoutedMatches is the number of recent matches of each player fetched by a Scout
+	DefaultScoutedMatches = 20
+	// DefaultScoutedMasteries is the number of champion masteries of each player kept by a Scout
+	DefaultScoutedMasteries = 5
+)
+
+// Scout gathers scouting reports of teams, e.g. of Clash or tournament opponents. The zero value of the options uses
+// the defaults
+type Scout struct {
+	Client *riot.Client
+	// Matches is the number of recent matches of each player aggregated in the report, at most 100
+	Matches int
+	// Masteries is the number of champion masteries with the most points kept per player
+	Masteries int
+}
+
+// ScoutingReport is the report of a team
+type ScoutingReport struct {
+	// Players are in the order of the requested Riot IDs
+	Players []*PlayerReport
+}
+
+// PlayerReport is the report of a single player of a team
+type PlayerReport struct {
+	// RiotID is the requested Riot ID, e.g. Name#EUW
+	RiotID   string
+	Account  *riot.Account
+	Summoner *riot.Summoner
+	// Ranks are the league entries of the player in all ranked queues
+	Ranks []*riot.LeagueItem
+	// Masteries are the champion masteries with the most points
+	Masteries []*riot.ChampionMastery
+	// Champions are the statistics of the champions played in the recent matches, most played first
+	Champions []ChampionStats
+	// Roles are the statistics of the roles played in the recent matches, most played first
+	Roles []RoleStats
+	// Overall are the statistics of all recent matches
+	Overall Aggregate
+}
+
+// Rank returns the league entry of the player in the queue or nil if the player is unranked
+func (p *PlayerReport) Rank(queue riot.Queue) *riot.LeagueItem {
+	for _, entry := range p.Ranks {
+		if entry != nil && entry.QueueType == string(queue) {
+			return entry
+		}
+	}
+	return nil
+}
+
+// Report gathers the reports of the players with the given Riot IDs, e.g. Name#EUW, concurrently. The first error
+// stops the other players and is returned
+func (s *Scout) Report(ctx context.Context, riotIDs []string) (*ScoutingReport, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	report := &ScoutingReport{Players: make([]*PlayerReport, len(riotIDs))}
+	errs := make([]error, len(riotIDs))
+	var wg sync.WaitGroup
+	for i, riotID := range riotIDs {
+		wg.Add(1)
+		go func(i int, riotID string) {
+			defer wg.Done()
+			report.Players[i], errs[i] = s.player(ctx, riotID)
+			if errs[i] != nil {
+				cancel()
+			}
+		}(i, riotID)
+	}
+	wg.Wait()
+	for i, err := range errs {
+		if err != nil && err != context.Canceled {
+			return nil, fmt.Errorf("analytics: scouting %s: %w", riotIDs[i], err)
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// player gathers the report of a single player, sending the requests sequentially
+func (s *Scout) player(ctx context.Context, riotID string) (*PlayerReport, error) {
+	gameName, tagLine, err := splitRiotID(riotID)
+	if err != nil {
+		return nil, err
+	}
+	player := &PlayerReport{RiotID: riotID}
+	if player.Account, err = s.Client.Account.GetByRiotID(gameName, tagLine); err != nil {
+		return nil, err
+	}
+	if player.Summoner, err = s.Client.Summoner.GetByPUUID(player.Account.PUUID); err != nil {
+		return nil, err
+	}
+	if player.Ranks, err = s.Client.League.ListBySummoner(player.Summoner.ID); err != nil {
+		return nil, err
+	}
+	masteries, err := s.Client.ChampionMastery.List(player.Summoner.ID)
+	if err != nil {
+		return nil, err
+	}
+	player.Masteries = topMasteries(masteries, s.masteries())
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	games := NewReport(player.Summoner.AccountID)
+	list, err := s.Client.Match.List(player.Summoner.AccountID, riot.NewMatchFilter().Range(0, s.matches()))
+	if err != nil {
+		return nil, err
+	}
+	for _, reference := range list.Matches {
+		if reference == nil {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		match, err := s.Client.Match.Get(reference.GameID)
+		if err != nil {
+			return nil, err
+		}
+		games.Add(match)
+	}
+	player.Champions = games.Champions()
+	player.Roles = games.Roles()
+	player.Overall = games.Overall
+	return player, nil
+}
+
+func (s *Scout) matches() int {
+	if s.Matches > 0 {
+		return s.Matches
+	}
+	return DefaultScoutedMatches
+}
+
+func (s *Scout) masteries() int {
+	if s.Masteries > 0 {
+		return s.Masteries
+	}
+	return DefaultScoutedMasteries
+}
+
+// topMasteries returns the first n masteries, which the API sorts by champion points
+func topMasteries(masteries []*riot.ChampionMastery, n int) []*riot.ChampionMastery {
+	if n < len(masteries) {
+		return masteries[:n]
+	}
+	return masteries
+}
+
+// splitRiotID splits a Riot ID into the game name and the tag line at the last #
+func splitRiotID(riotID string) (string, string, error) {
+	i := strings.LastIndex(riotID, "#")
+	if i <= 0 || i == len(riotID)-1 {
+		return "", "", fmt.Errorf("invalid riot id %q, want game name and tag line separated by #", riotID)
+	}
+	return riotID[:i], riotID[i+1:], nil
+}
@@ -0,0 +1,95 @@
+package analytics
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mjourard/golio/api"
+	"github.com/mjourard/golio/mock"
+	"github.com/mjourard/golio/riot"
+)
+
+// scoutingDoer answers the requests of a Scout for the players a and b
+func scoutingDoer(r *http.Request) (*http.Response, error) {
+	path := r.URL.Path
+	last := path[strings.LastIndex(path, "/")+1:]
+	var object interface{}
+	switch {
+	case strings.HasPrefix(path, "/riot/account/v1/accounts/by-riot-id/"):
+		if strings.Contains(path, "Missing") {
+			return &http.Response{StatusCode: http.StatusNotFound, Body: io.NopCloser(&bytes.Buffer{})}, nil
+		}
+		object = riot.Account{PUUID: strings.Repeat(strings.ToLower(path[len(path)-5:len(path)-4]), 78)}
+	case strings.HasPrefix(path, "/lol/summoner/v4/summoners/by-puuid/"):
+		object = riot.Summoner{ID: "summoner " + last[:1], AccountID: last[:1], PUUID: last}
+	case strings.HasPrefix(path, "/lol/league/v4/entries/by-summoner/"):
+		object = []*riot.LeagueItem{{QueueType: "RANKED_FLEX_SR"}, {QueueType: "RANKED_SOLO_5x5", Tier: "GOLD"}}
+	case strings.HasPrefix(path, "/lol/champion-mastery/v4/champion-masteries/by-summoner/"):
+		object = []*riot.ChampionMastery{{ChampionID: 1}, {ChampionID: 2}, {ChampionID: 3}}
+	case strings.HasPrefix(path, "/lol/match/v4/matchlists/by-account/"):
+		object = riot.Matchlist{Matches: []*riot.MatchReference{{GameID: 1}, {GameID: 2}}}
+	default:
+		match := teamMatch(1, true, "a", "b")
+		if last == "2" {
+			match = teamMatch(2, false, "a", "b")
+		}
+		for _, p := range match.Participants {
+			p.Stats = &riot.ParticipantStats{Win: match.WinningTeam().TeamID == p.TeamID}
+		}
+		object = match
+	}
+	body, _ := json.Marshal(object)
+	return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(body))}, nil
+}
+
+func TestScout_Report(t *testing.T) {
+	t.Parallel()
+	doer := &mock.Doer{Custom: scoutingDoer}
+	scout := &Scout{
+		Client:    riot.NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger()),
+		Masteries: 2,
+	}
+	report, err := scout.Report(context.Background(), []string{"Player A#EUW", "Player B#EUW"})
+	require.Nil(t, err)
+	require.Len(t, report.Players, 2)
+	a, b := report.Players[0], report.Players[1]
+	assert.Equal(t, "Player A#EUW", a.RiotID)
+	assert.Equal(t, "a", a.Summoner.AccountID)
+	assert.Equal(t, "b", b.Summoner.AccountID)
+	assert.Equal(t, "GOLD", a.Rank(riot.QueueRankedSolo).Tier)
+	assert.Nil(t, a.Rank(riot.QueueRankedTwistedTreeline))
+	assert.Len(t, a.Masteries, 2)
+	assert.Equal(t, 2, a.Overall.Games)
+	assert.Equal(t, 1, a.Overall.Wins)
+	assert.Equal(t, 1, b.Overall.Wins)
+	assert.Len(t, a.Champions, 1)
+	assert.Equal(t, 14, doer.Calls())
+
+	_, err = scout.Report(context.Background(), []string{"Player A#EUW", "Missing#EUW"})
+	assert.True(t, errors.Is(err, api.ErrNotFound))
+	assert.Contains(t, err.Error(), "Missing#EUW")
+	_, err = scout.Report(context.Background(), []string{"no tag line"})
+	assert.NotNil(t, err)
+}
+
+func TestSplitRiotID(t *testing.T) {
+	t.Parallel()
+	gameName, tagLine, err := splitRiotID("Name #with# hash#EUW")
+	require.Nil(t, err)
+	assert.Equal(t, "Name #with# hash", gameName)
+	assert.Equal(t, "EUW", tagLine)
+	for _, id := range []string{"", "Name", "#EUW", "Name#"} {
+		_, _, err := splitRiotID(id)
+		assert.NotNil(t, err, id)
+	}
+}
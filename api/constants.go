@@ -6,17 +6,17 @@ type Region string
 // All existing regions
 const (
 	RegionBrasil            Region = "br1"
-	RegionEuropeNorthEast          = "eun1"
-	RegionEuropeWest               = "euw1"
-	RegionJapan                    = "jp1"
-	RegionKorea                    = "kr"
-	RegionLatinAmericaNorth        = "la1"
-	RegionLatinAmericaSouth        = "la2"
-	RegionNorthAmerica             = "na1"
-	RegionOceania                  = "oc1"
-	RegionTurkey                   = "tr1"
-	RegionRussia                   = "ru"
-	RegionPBE                      = "pbe1"
+	RegionEuropeNorthEast   Region = "eun1"
+	RegionEuropeWest        Region = "euw1"
+	RegionJapan             Region = "jp1"
+	RegionKorea             Region = "kr"
+	RegionLatinAmericaNorth Region = "la1"
+	RegionLatinAmericaSouth Region = "la2"
+	RegionNorthAmerica      Region = "na1"
+	RegionOceania           Region = "oc1"
+	RegionTurkey            Region = "tr1"
+	RegionRussia            Region = "ru"
+	RegionPBE               Region = "pbe1"
 )
 
 var (
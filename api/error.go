@@ -1,7 +1,15 @@
 package api
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
 )
 
 // Error is a custom error type used by the API to signal http error responses
@@ -14,6 +22,318 @@ func (e Error) Error() string {
 	return e.Message
 }
 
+// Is returns true if target is an Error with the same status code, so that errors.Is(err, api.ErrNotFound) matches
+// regardless of the message
+func (e Error) Is(target error) bool {
+	t, ok := target.(Error)
+	return ok && t.StatusCode == e.StatusCode
+}
+
+// Retryable returns true if a request failing with this error can succeed when it is sent again later, i.e. for
+// rate limits and errors of the server
+func (e Error) Retryable() bool {
+	switch e.StatusCode {
+	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// Temporary is the same as Retryable, following the convention of net.Error
+func (e Error) Temporary() bool {
+	return e.Retryable()
+}
+
+// IsRetryable returns true if err or an error wrapped by it implements a Retryable method which returns true, e.g.
+// an Error or a ResponseError for the status 503
+func IsRetryable(err error) bool {
+	var retryable interface {
+		Retryable() bool
+	}
+	return errors.As(err, &retryable) && retryable.Retryable()
+}
+
+// MaxErrorBodySize is the maximum number of bytes of a response body included in a ResponseError
+const MaxErrorBodySize = 256
+
+// Status is the error object sent by the Riot API in the body of error responses, e.g.
+// {"status": {"message": "Data not found", "status_code": 404}}
+type Status struct {
+	Message    string `json:"message"`
+	StatusCode int    `json:"status_code"`
+}
+
+// ResponseError is returned for error responses. It contains the request and the beginning of the response body to
+// make the error debuggable and wraps the Error for the status code, so that errors.Is(err, api.ErrNotFound) can be
+// used to check for specific errors
+type ResponseError struct {
+	Method string
+	// URL of the request, API keys given as query parameters are redacted
+	URL string
+	// Endpoint is the path of the request including query parameters, e.g. /lol/summoner/v4/summoners/by-name/name
+	Endpoint   string
+	StatusCode int
+	// Body contains up to the first 256 bytes of the response body
+	Body []byte
+	// Status is the decoded error object of the Riot API or nil if the body contains none
+	Status *Status
+	// Header contains the ErrorHeaders of the response
+	Header http.Header
+	Err    Error
+}
+
+// ErrorHeaders are the headers of error responses kept in ResponseError.Header
+var ErrorHeaders = []string{
+	"Retry-After",
+	"X-Rate-Limit-Type",
+	"X-App-Rate-Limit",
+	"X-App-Rate-Limit-Count",
+	"X-Method-Rate-Limit",
+	"X-Method-Rate-Limit-Count",
+}
+
+// NewResponseError returns a ResponseError for a response with the given status code and body. The body is
+// truncated and API keys are redacted from the URL
+func NewResponseError(method string, u *url.URL, statusCode int, body []byte) *ResponseError {
+	err, ok := StatusToError[statusCode]
+	if !ok {
+		err = Error{
+			Message:    "unknown error reason",
+			StatusCode: statusCode,
+		}
+	}
+	var riotBody struct {
+		Status *Status `json:"status"`
+	}
+	_ = json.Unmarshal(body, &riotBody)
+	if len(body) > MaxErrorBodySize {
+		body = body[:MaxErrorBodySize]
+	}
+	return &ResponseError{
+		Method:     method,
+		URL:        sanitizeURL(u),
+		StatusCode: statusCode,
+		Body:       body,
+		Status:     riotBody.Status,
+		Err:        err,
+	}
+}
+
+// ErrorHeader returns the ErrorHeaders of the header of a response or nil if it contains none
+func ErrorHeader(header http.Header) http.Header {
+	var kept http.Header
+	for _, key := range ErrorHeaders {
+		if values := header.Values(key); len(values) > 0 {
+			if kept == nil {
+				kept = http.Header{}
+			}
+			kept[key] = values
+		}
+	}
+	return kept
+}
+
+// RetryAfter returns the duration from the Retry-After header of the response, see ParseRetryAfter. The returned bool
+// is false if the response has no valid header
+func (e *ResponseError) RetryAfter() (time.Duration, bool) {
+	return ParseRetryAfter(e.Header.Get("Retry-After"), time.Now())
+}
+
+// ParseRetryAfter parses the value of a Retry-After header, which is either a number of seconds or an HTTP date, and
+// returns the duration to wait from now. A date in the past results in 0. The returned bool is false if the value is
+// empty or malformed
+func ParseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	date, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+	if wait := date.Sub(now); wait > 0 {
+		return wait, true
+	}
+	return 0, true
+}
+
+func (e *ResponseError) Error() string {
+	res := fmt.Sprintf("%s %s: %s (%d)", e.Method, e.URL, e.Err.Message, e.StatusCode)
+	if len(e.Body) > 0 {
+		res += ": " + string(e.Body)
+	}
+	return res
+}
+
+// Unwrap returns the Error for the status code of the response
+func (e *ResponseError) Unwrap() error {
+	return e.Err
+}
+
+// Retryable returns true if the request can succeed when it is sent again later, see Error.Retryable
+func (e *ResponseError) Retryable() bool {
+	return e.Err.Retryable()
+}
+
+// Temporary is the same as Retryable, following the convention of net.Error
+func (e *ResponseError) Temporary() bool {
+	return e.Retryable()
+}
+
+// Types of rate limits as sent in the X-Rate-Limit-Type header of responses with the status 429
+const (
+	// RateLimitTypeApplication is the limit of all requests of the API key
+	RateLimitTypeApplication = "application"
+	// RateLimitTypeMethod is the limit of the requests of the API key to an endpoint
+	RateLimitTypeMethod = "method"
+	// RateLimitTypeService is the limit of an overloaded service shared by all API keys
+	RateLimitTypeService = "service"
+)
+
+// RateLimitError is returned for a response with the status 429 which is not retried. It wraps the ResponseError of
+// the response, so errors.Is(err, api.ErrRateLimitExceeded) matches
+type RateLimitError struct {
+	// Type is the rate limit which was exceeded as sent in the X-Rate-Limit-Type header, i.e. RateLimitTypeApplication,
+	// RateLimitTypeMethod or RateLimitTypeService, or empty if the header is missing
+	Type string
+	// RetryAfter is the duration from the Retry-After header or 0 if the header is missing
+	RetryAfter time.Duration
+	Response   *ResponseError
+}
+
+// NewRateLimitError returns a RateLimitError for the error of a response with the status 429
+func NewRateLimitError(response *ResponseError) *RateLimitError {
+	retryAfter, _ := response.RetryAfter()
+	return &RateLimitError{
+		Type:       response.Header.Get("X-Rate-Limit-Type"),
+		RetryAfter: retryAfter,
+		Response:   response,
+	}
+}
+
+func (e *RateLimitError) Error() string {
+	res := "rate limit exceeded"
+	if e.Type != "" {
+		res += " (" + e.Type + ")"
+	}
+	if e.RetryAfter > 0 {
+		res += fmt.Sprintf(", retry after %s", e.RetryAfter)
+	}
+	return res + ": " + e.Response.Error()
+}
+
+// Unwrap returns the ResponseError of the response
+func (e *RateLimitError) Unwrap() error {
+	return e.Response
+}
+
+// Retryable returns true, the request can succeed once the rate limit resets
+func (e *RateLimitError) Retryable() bool {
+	return true
+}
+
+// TransportError is returned if a request could not be sent or no response was received, e.g. for timeouts or
+// refused connections. It wraps the error of the http client
+type TransportError struct {
+	Method   string
+	Endpoint string
+	Err      error
+}
+
+func (e *TransportError) Error() string {
+	return fmt.Sprintf("%s %s: %v", e.Method, e.Endpoint, e.Err)
+}
+
+// Unwrap returns the error of the http client
+func (e *TransportError) Unwrap() error {
+	return e.Err
+}
+
+// Retryable returns true unless the request was cancelled
+func (e *TransportError) Retryable() bool {
+	return !errors.Is(e.Err, context.Canceled)
+}
+
+// DecodeError is returned if the body of a successful response can not be decoded. It wraps the error of the decoder
+type DecodeError struct {
+	Endpoint string
+	Err      error
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("decoding response of %s: %v", e.Endpoint, e.Err)
+}
+
+// Unwrap returns the error of the decoder
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// ErrCircuitOpen is matched by a CircuitOpenError using errors.Is
+var ErrCircuitOpen = errors.New("circuit open")
+
+// CircuitOpenError is returned without sending a request while the circuit breaker of the region and endpoint family
+// is open after consecutive server errors
+type CircuitOpenError struct {
+	Region Region
+	// Family is the endpoint family, e.g. match-v5
+	Family string
+	// Until is the time at which the next request is sent to test whether the service recovered
+	Until time.Time
+}
+
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("circuit open for %s in %s until %s", e.Family, e.Region, e.Until.Format(time.RFC3339))
+}
+
+// Is returns true for ErrCircuitOpen
+func (e *CircuitOpenError) Is(target error) bool {
+	return target == ErrCircuitOpen
+}
+
+// Retryable returns true, the request can succeed once the service recovered
+func (e *CircuitOpenError) Retryable() bool {
+	return true
+}
+
+// RedactURLError returns err with the api_key query parameter and the user information redacted from its URL if it is
+// a *url.Error, as returned by http clients, so that the error can be logged without leaking the API key
+func RedactURLError(err error) error {
+	urlErr, ok := err.(*url.Error)
+	if !ok {
+		return err
+	}
+	redacted := *urlErr
+	if u, parseErr := url.Parse(urlErr.URL); parseErr == nil {
+		redacted.URL = sanitizeURL(u)
+	} else if i := strings.IndexByte(urlErr.URL, '?'); i >= 0 {
+		redacted.URL = urlErr.URL[:i]
+	}
+	return &redacted
+}
+
+// sanitizeURL returns u without the api_key query parameter
+func sanitizeURL(u *url.URL) string {
+	if u == nil {
+		return ""
+	}
+	sanitized := *u
+	query := sanitized.Query()
+	if query.Has("api_key") {
+		query.Set("api_key", "REDACTED")
+		sanitized.RawQuery = query.Encode()
+	}
+	sanitized.User = nil
+	return sanitized.String()
+}
+
 // All regularly returned errors by the Riot API
 var (
 	ErrBadRequest = Error{
@@ -0,0 +1,134 @@
+package api
+
+import (
+	"fmt"
+	"strings"
+)
+
+// regionNames are the names of the regions as used by the game client and on the website, e.g. EUW
+var regionNames = map[Region]string{
+	RegionBrasil:            "BR",
+	RegionEuropeNorthEast:   "EUNE",
+	RegionEuropeWest:        "EUW",
+	RegionJapan:             "JP",
+	RegionKorea:             "KR",
+	RegionLatinAmericaNorth: "LAN",
+	RegionLatinAmericaSouth: "LAS",
+	RegionNorthAmerica:      "NA",
+	RegionOceania:           "OCE",
+	RegionTurkey:            "TR",
+	RegionRussia:            "RU",
+	RegionPBE:               "PBE",
+}
+
+// UnknownRegionError is returned for strings which are no known region
+type UnknownRegionError struct {
+	Region string
+}
+
+func (e *UnknownRegionError) Error() string {
+	return fmt.Sprintf("unknown region %q", e.Region)
+}
+
+// AllRegions returns all known regions. The returned slice may be modified by the caller
+func AllRegions() []Region {
+	return append([]Region(nil), Regions...)
+}
+
+// ParseRegion returns the region for either the platform ID (e.g. "euw1") or the name (e.g. "EUW") of a region,
+// ignoring case. The platform ID without the trailing number (e.g. "eun") is accepted if it is unambiguous
+func ParseRegion(s string) (Region, error) {
+	value := strings.ToLower(strings.TrimSpace(s))
+	var prefixed []Region
+	for _, region := range Regions {
+		if string(region) == value || strings.ToLower(regionNames[region]) == value {
+			return region, nil
+		}
+		if strings.TrimRight(string(region), "0123456789") == value {
+			prefixed = append(prefixed, region)
+		}
+	}
+	if len(prefixed) == 1 {
+		return prefixed[0], nil
+	}
+	return "", &UnknownRegionError{Region: s}
+}
+
+// Validate returns an *UnknownRegionError if r is no known region
+func (r Region) Validate() error {
+	if _, ok := regionNames[r]; !ok {
+		return &UnknownRegionError{Region: string(r)}
+	}
+	return nil
+}
+
+// String returns the platform ID of the region, e.g. euw1
+func (r Region) String() string {
+	return string(r)
+}
+
+// MarshalText returns the platform ID of the region
+func (r Region) MarshalText() ([]byte, error) {
+	return []byte(r), nil
+}
+
+// UnmarshalText parses a platform ID or a name of a region using ParseRegion
+func (r *Region) UnmarshalText(text []byte) error {
+	region, err := ParseRegion(string(text))
+	if err != nil {
+		return err
+	}
+	*r = region
+	return nil
+}
+
+// Name returns the name of the region as used by the game client, e.g. EUW for RegionEuropeWest, or an empty string
+// for unknown regions
+func (r Region) Name() string {
+	return regionNames[r]
+}
+
+// Continent is a regional routing value. Endpoints which are shared by all regions of a continent, e.g. the account
+// endpoints, are served by the host of the continent instead of the host of the region
+type Continent string
+
+// All existing continents
+const (
+	ContinentAmericas Continent = "americas"
+	ContinentAsia     Continent = "asia"
+	ContinentEurope   Continent = "europe"
+	ContinentSEA      Continent = "sea"
+)
+
+// Continents is a list of all available continents
+var Continents = []Continent{ContinentAmericas, ContinentAsia, ContinentEurope, ContinentSEA}
+
+var regionContinents = map[Region]Continent{
+	RegionBrasil:            ContinentAmericas,
+	RegionEuropeNorthEast:   ContinentEurope,
+	RegionEuropeWest:        ContinentEurope,
+	RegionJapan:             ContinentAsia,
+	RegionKorea:             ContinentAsia,
+	RegionLatinAmericaNorth: ContinentAmericas,
+	RegionLatinAmericaSouth: ContinentAmericas,
+	RegionNorthAmerica:      ContinentAmericas,
+	RegionOceania:           ContinentSEA,
+	RegionTurkey:            ContinentEurope,
+	RegionRussia:            ContinentEurope,
+	RegionPBE:               ContinentAmericas,
+}
+
+// Continent returns the continent serving the regional routing endpoints of the region, or an empty string for
+// unknown regions
+func (r Region) Continent() Continent {
+	return regionContinents[r]
+}
+
+// AccountContinent returns the continent serving the account endpoints for the region. The account endpoints are not
+// served by ContinentSEA, so its regions use ContinentAsia
+func (r Region) AccountContinent() Continent {
+	if continent := r.Continent(); continent != ContinentSEA {
+		return continent
+	}
+	return ContinentAsia
+}
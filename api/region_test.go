@@ -0,0 +1,96 @@
+package api
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRegion(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		input   string
+		want    Region
+		wantErr bool
+	}{
+		{input: "euw1", want: RegionEuropeWest},
+		{input: "EUW", want: RegionEuropeWest},
+		{input: "euw", want: RegionEuropeWest},
+		{input: " EUNE ", want: RegionEuropeNorthEast},
+		{input: "eun", want: RegionEuropeNorthEast},
+		{input: "kr", want: RegionKorea},
+		{input: "LAS", want: RegionLatinAmericaSouth},
+		{input: "oc", want: RegionOceania},
+		{input: "la", wantErr: true},
+		{input: "", wantErr: true},
+		{input: "mars1", wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			got, err := ParseRegion(test.input)
+			if test.wantErr {
+				var regionErr *UnknownRegionError
+				require.True(t, errors.As(err, &regionErr))
+				assert.Equal(t, test.input, regionErr.Region)
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestRegion_Validate(t *testing.T) {
+	t.Parallel()
+	for _, region := range AllRegions() {
+		assert.Nil(t, region.Validate())
+		assert.NotEmpty(t, region.Name())
+		parsed, err := ParseRegion(region.Name())
+		require.Nil(t, err)
+		assert.Equal(t, region, parsed)
+	}
+	assert.NotNil(t, Region("EUW").Validate())
+	assert.Empty(t, Region("mars1").Name())
+}
+
+func TestAllRegions(t *testing.T) {
+	t.Parallel()
+	regions := AllRegions()
+	assert.Equal(t, Regions, regions)
+	regions[0] = "modified"
+	assert.Equal(t, RegionBrasil, Regions[0])
+}
+
+func TestRegion_Continent(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, ContinentEurope, RegionEuropeWest.Continent())
+	assert.Equal(t, ContinentAmericas, RegionNorthAmerica.Continent())
+	assert.Equal(t, ContinentAsia, RegionKorea.Continent())
+	assert.Equal(t, ContinentSEA, RegionOceania.Continent())
+	assert.Equal(t, Continent(""), Region("mars1").Continent())
+	for _, region := range Regions {
+		assert.Contains(t, Continents, region.Continent())
+	}
+}
+
+func TestRegion_AccountContinent(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, ContinentEurope, RegionEuropeWest.AccountContinent())
+	assert.Equal(t, ContinentAsia, RegionOceania.AccountContinent())
+	assert.Equal(t, Continent(""), Region("mars1").AccountContinent())
+}
+
+func TestRegion_Text(t *testing.T) {
+	t.Parallel()
+	text, err := RegionKorea.MarshalText()
+	require.Nil(t, err)
+	assert.Equal(t, "kr", string(text))
+	assert.Equal(t, "kr", RegionKorea.String())
+	var region Region
+	require.Nil(t, region.UnmarshalText([]byte("EUW")))
+	assert.Equal(t, RegionEuropeWest, region)
+	var unknown *UnknownRegionError
+	assert.True(t, errors.As(region.UnmarshalText([]byte("xx")), &unknown))
+}
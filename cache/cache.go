@@ -0,0 +1,60 @@
+// Package cache stores responses of the Riot API, e.g. to archive large immutable payloads like matches and
+// timelines off-box:
+//
+//	store, err := cache.NewS3("golio-archive")
+//	client := golio.NewClient("API KEY", golio.WithRawCapture(cache.Archiver(store, api.RegionEuropeWest, 0, nil)))
+//	body, ok, err := store.Get(ctx, cache.Key(api.RegionEuropeWest, "/lol/match/v4/matches/1"))
+//
+// Responses are stored by the region and the endpoint of the request, see Key.
+package cache
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"github.com/mjourard/golio/api"
+	"github.com/mjourard/golio/internal"
+	"github.com/mjourard/golio/riot"
+)
+
+// Cache stores values by key with a time to live. It is the same interface as riot.Cache and datadragon.Cache, so
+// every cache of this package can be passed to both. Implementations must be safe for concurrent use
+type Cache = internal.Cache
+
+// Key returns the key of the response to a request of the endpoint in the region, e.g. euw1/lol/match/v4/matches/1.
+// The endpoint may contain query parameters. The keys are the ones of riot.WithCache, so a cache filled by Archiver
+// serves the requests of a client using it
+func Key(region api.Region, endpoint string) string {
+	return riot.CacheKey(region, endpoint)
+}
+
+// immutableEndpoints are the endpoints whose responses never change
+var immutableEndpoints = []*regexp.Regexp{
+	regexp.MustCompile(`^/lol/match/v4/matches/\d+$`),
+	regexp.MustCompile(`^/lol/match/v4/timelines/by-match/\d+$`),
+}
+
+// Archiver returns a hook for riot.WithRawCapture storing the responses of the match and timeline endpoints of the
+// region, whose responses never change, in the cache using the ttl. Errors of the cache are passed to onError if it is
+// not nil. The hook blocks the request until the response is stored
+func Archiver(c Cache, region api.Region, ttl time.Duration, onError func(error)) func(riot.RawResponse) {
+	return func(raw riot.RawResponse) {
+		if !isImmutable(raw.Endpoint) {
+			return
+		}
+		body := append([]byte(nil), raw.Body...)
+		if err := c.Set(context.Background(), Key(region, raw.Endpoint), body, ttl); err != nil && onError != nil {
+			onError(err)
+		}
+	}
+}
+
+func isImmutable(endpoint string) bool {
+	for _, pattern := range immutableEndpoints {
+		if pattern.MatchString(endpoint) {
+			return true
+		}
+	}
+	return false
+}
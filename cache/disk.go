@@ -0,0 +1,207 @@
+package cache
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+const (
+	diskKeysDir  = "keys"
+	diskBlobsDir = "blobs"
+	// diskPruneGrace protects blobs written by a concurrent Set which did not write the file of its key yet
+	diskPruneGrace = time.Minute
+)
+
+// Disk is a Cache storing the values in files of a directory, e.g. the large payloads of Data Dragon, so that they
+// survive restarts. Values are content-addressed: a value is stored once in a blob named by the SHA-256 hash of its
+// content, and the file of a key, named by the SHA-256 hash of the key, references the blob. Keys storing the same
+// payload share the blob, and a blob which was corrupted on disk no longer matches its name and is treated as missing.
+// Files are written to a temporary file which is renamed once it is complete, so readers never see a partially written
+// file, even if the process crashes. It is safe for concurrent use, also by multiple processes sharing the directory.
+// Blobs no longer referenced by a key are removed by Prune
+type Disk struct {
+	dir string
+	now func() time.Time
+}
+
+// NewDisk returns a cache storing the values in the directory, which is created if it does not exist
+func NewDisk(dir string) (*Disk, error) {
+	for _, sub := range []string{diskKeysDir, diskBlobsDir} {
+		if err := os.MkdirAll(filepath.Join(dir, sub), 0o755); err != nil {
+			return nil, err
+		}
+	}
+	return &Disk{dir: dir, now: time.Now}, nil
+}
+
+// Get returns the value of the key. Expired keys and corrupted blobs are removed
+func (d *Disk) Get(_ context.Context, key string) ([]byte, bool, error) {
+	path := d.keyPath(key)
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	// the file of a key is the expiration in Unix milliseconds or 0 and the hash of the blob, separated by a newline
+	header, hash, ok := bytes.Cut(data, []byte("\n"))
+	if !ok || len(hash) != hex.EncodedLen(sha256.Size) {
+		return nil, false, fmt.Errorf("cache: malformed file %s", path)
+	}
+	expires, err := strconv.ParseInt(string(header), 10, 64)
+	if err != nil {
+		return nil, false, fmt.Errorf("cache: malformed file %s: %w", path, err)
+	}
+	if expires > 0 && d.now().UnixMilli() >= expires {
+		return nil, false, remove(path)
+	}
+	blob := d.blobPath(string(hash))
+	value, err := os.ReadFile(blob)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	if contentHash(value) != string(hash) {
+		return nil, false, remove(blob)
+	}
+	return value, true, nil
+}
+
+// Set stores the value in its blob, unless it exists already, and atomically replaces the file of the key
+func (d *Disk) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	var expires int64
+	if ttl > 0 {
+		expires = d.now().Add(ttl).UnixMilli()
+	}
+	hash := contentHash(value)
+	blob := d.blobPath(hash)
+	// touching an existing blob keeps Prune from removing it before the file of the key references it
+	now := time.Now()
+	err := os.Chtimes(blob, now, now)
+	if errors.Is(err, os.ErrNotExist) {
+		err = d.writeFile(blob, value)
+	}
+	if err != nil {
+		return err
+	}
+	return d.writeFile(d.keyPath(key), []byte(strconv.FormatInt(expires, 10)+"\n"+hash))
+}
+
+// Delete removes the file of the key. Its blob is removed by Prune once no key references it
+func (d *Disk) Delete(_ context.Context, key string) error {
+	return remove(d.keyPath(key))
+}
+
+// Prune removes the files of expired keys and the blobs no longer referenced by any key, e.g. after values were
+// replaced or deleted. Blobs written within the last minute are kept, as a concurrent Set might not have written the
+// file of its key yet
+func (d *Disk) Prune(ctx context.Context) error {
+	keys, err := os.ReadDir(filepath.Join(d.dir, diskKeysDir))
+	if err != nil {
+		return err
+	}
+	referenced := make(map[string]bool, len(keys))
+	for _, entry := range keys {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		path := filepath.Join(d.dir, diskKeysDir, entry.Name())
+		data, err := os.ReadFile(path)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		header, hash, _ := bytes.Cut(data, []byte("\n"))
+		expires, err := strconv.ParseInt(string(header), 10, 64)
+		if err == nil && expires > 0 && d.now().UnixMilli() >= expires {
+			if err := remove(path); err != nil {
+				return err
+			}
+			continue
+		}
+		referenced[string(hash)] = true
+	}
+	blobs, err := os.ReadDir(filepath.Join(d.dir, diskBlobsDir))
+	if err != nil {
+		return err
+	}
+	for _, entry := range blobs {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if referenced[entry.Name()] {
+			continue
+		}
+		info, err := entry.Info()
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if time.Since(info.ModTime()) < diskPruneGrace {
+			continue
+		}
+		if err := remove(filepath.Join(d.dir, diskBlobsDir, entry.Name())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeFile atomically replaces the file at path with data
+func (d *Disk) writeFile(path string, data []byte) error {
+	file, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+	_, err = file.Write(data)
+	if err == nil {
+		err = file.Sync()
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(file.Name(), path)
+}
+
+// keyPath returns the path of the file of the key
+func (d *Disk) keyPath(key string) string {
+	return filepath.Join(d.dir, diskKeysDir, contentHash([]byte(key)))
+}
+
+// blobPath returns the path of the blob with the hash
+func (d *Disk) blobPath(hash string) string {
+	return filepath.Join(d.dir, diskBlobsDir, hash)
+}
+
+// contentHash returns the hex encoded SHA-256 hash of data
+func contentHash(data []byte) string {
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:])
+}
+
+// remove removes the file at path. Removing a file which does not exist is no error
+func remove(path string) error {
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
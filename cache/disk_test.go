@@ -0,0 +1,128 @@
+package cache
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDisk(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	dir := filepath.Join(t.TempDir(), "golio")
+	d, err := NewDisk(dir)
+	require.Nil(t, err)
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	d.now = func() time.Time { return now }
+
+	_, ok, err := d.Get(ctx, "missing")
+	require.Nil(t, err)
+	assert.False(t, ok)
+
+	key := "ddragon.leagueoflegends.com/cdn/10.1.1/data/en_US/champion.json"
+	require.Nil(t, d.Set(ctx, key, []byte(`{"data":{}}`), 0))
+	require.Nil(t, d.Set(ctx, key, []byte(`{"data":{"Ahri":{}}}`), 0))
+	value, ok, err := d.Get(ctx, key)
+	require.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, `{"data":{"Ahri":{}}}`, string(value))
+	// the blob is named by the hash of its content and no temporary files are left behind
+	_, err = os.Stat(d.blobPath(contentHash(value)))
+	assert.Nil(t, err)
+	entries, err := os.ReadDir(filepath.Join(dir, diskKeysDir))
+	require.Nil(t, err)
+	require.Len(t, entries, 1)
+	assert.Len(t, entries[0].Name(), 64)
+	// keys with the same content share the blob
+	require.Nil(t, d.Set(ctx, "copy", value, 0))
+	entries, err = os.ReadDir(filepath.Join(dir, diskBlobsDir))
+	require.Nil(t, err)
+	assert.Len(t, entries, 2)
+
+	// a new cache of the directory reads the values, e.g. after a restart
+	restarted, err := NewDisk(dir)
+	require.Nil(t, err)
+	_, ok, err = restarted.Get(ctx, key)
+	require.Nil(t, err)
+	assert.True(t, ok)
+
+	require.Nil(t, d.Set(ctx, "expiring", []byte("value"), time.Minute))
+	now = now.Add(time.Minute)
+	_, ok, err = d.Get(ctx, "expiring")
+	require.Nil(t, err)
+	assert.False(t, ok)
+	_, err = os.Stat(d.keyPath("expiring"))
+	assert.True(t, os.IsNotExist(err))
+
+	require.Nil(t, d.Delete(ctx, key))
+	require.Nil(t, d.Delete(ctx, key))
+	_, ok, err = d.Get(ctx, key)
+	require.Nil(t, err)
+	assert.False(t, ok)
+
+	require.Nil(t, os.WriteFile(d.keyPath("malformed"), []byte("value"), 0o644))
+	_, _, err = d.Get(ctx, "malformed")
+	assert.NotNil(t, err)
+}
+
+func TestDisk_corruptedBlob(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	d, err := NewDisk(t.TempDir())
+	require.Nil(t, err)
+	value := []byte(`{"data":{}}`)
+	require.Nil(t, d.Set(ctx, "key", value, 0))
+	blob := d.blobPath(contentHash(value))
+	require.Nil(t, os.WriteFile(blob, []byte(`{"da`), 0o644))
+	_, ok, err := d.Get(ctx, "key")
+	require.Nil(t, err)
+	assert.False(t, ok)
+	_, err = os.Stat(blob)
+	assert.True(t, os.IsNotExist(err))
+
+	// setting the value again restores the blob
+	require.Nil(t, d.Set(ctx, "key", value, 0))
+	got, ok, err := d.Get(ctx, "key")
+	require.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, value, got)
+}
+
+func TestDisk_Prune(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	d, err := NewDisk(t.TempDir())
+	require.Nil(t, err)
+	require.Nil(t, d.Set(ctx, "kept", []byte("kept"), 0))
+	require.Nil(t, d.Set(ctx, "replaced", []byte("old"), 0))
+	require.Nil(t, d.Set(ctx, "replaced", []byte("new"), 0))
+	require.Nil(t, d.Set(ctx, "deleted", []byte("deleted"), 0))
+	require.Nil(t, d.Delete(ctx, "deleted"))
+	require.Nil(t, d.Set(ctx, "expired", []byte("expired"), time.Minute))
+	now := time.Now().Add(time.Minute)
+	d.now = func() time.Time { return now }
+	require.Nil(t, d.Set(ctx, "recent", []byte("recent"), 0))
+	require.Nil(t, d.Delete(ctx, "recent"))
+
+	old := time.Now().Add(-time.Hour)
+	for _, value := range []string{"kept", "old", "new", "deleted", "expired"} {
+		require.Nil(t, os.Chtimes(d.blobPath(contentHash([]byte(value))), old, old))
+	}
+	require.Nil(t, d.Prune(ctx))
+	for value, exists := range map[string]bool{"kept": true, "old": false, "new": true, "deleted": false,
+		"expired": false, "recent": true} {
+		_, err := os.Stat(d.blobPath(contentHash([]byte(value))))
+		assert.Equal(t, exists, err == nil, value)
+	}
+	_, err = os.Stat(d.keyPath("expired"))
+	assert.True(t, os.IsNotExist(err))
+	got, ok, err := d.Get(ctx, "replaced")
+	require.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "new", string(got))
+}
@@ -0,0 +1,44 @@
+package cache
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/mjourard/golio/internal/cloud"
+)
+
+const gcsBaseURL = "https://storage.googleapis.com"
+
+// GCS is a Cache storing values as objects of a Google Cloud Storage bucket. It is safe for concurrent use
+type GCS struct {
+	objectStore
+}
+
+// NewGCS returns a cache using the bucket
+func NewGCS(bucket string, options ...ObjectStoreOption) *GCS {
+	cfg := newObjectStoreConfig(options)
+	base := gcsBaseURL + "/" + bucket
+	if cfg.endpoint != "" {
+		base = cfg.endpoint + "/" + bucket
+	}
+	token := cfg.token
+	if token == nil {
+		token = (&cloud.MetadataToken{Client: cfg.client}).Get
+	}
+	return &GCS{objectStore{
+		client: cfg.client,
+		prefix: cfg.prefix,
+		url: func(escapedKey string) string {
+			return base + "/" + escapedKey
+		},
+		expiresHeader: "X-Goog-Meta-Golio-Expires",
+		authorize: func(ctx context.Context, request *http.Request, _ []byte) error {
+			accessToken, err := token(ctx)
+			if err != nil {
+				return err
+			}
+			request.Header.Set("Authorization", "Bearer "+accessToken)
+			return nil
+		},
+	}}
+}
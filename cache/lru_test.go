@@ -0,0 +1,44 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLRU(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := NewLRU(2)
+	c.now = func() time.Time { return now }
+
+	require.Nil(t, c.Set(ctx, "a", []byte("a"), 0))
+	require.Nil(t, c.Set(ctx, "b", []byte("b"), time.Minute))
+	value, ok, err := c.Get(ctx, "a")
+	require.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "a", string(value))
+
+	// b is the least recently used value
+	require.Nil(t, c.Set(ctx, "c", []byte("c"), 0))
+	assert.Equal(t, 2, c.Len())
+	_, ok, _ = c.Get(ctx, "b")
+	assert.False(t, ok)
+
+	require.Nil(t, c.Set(ctx, "c", []byte("new"), time.Minute))
+	now = now.Add(time.Minute)
+	_, ok, _ = c.Get(ctx, "c")
+	assert.False(t, ok)
+	assert.Equal(t, 1, c.Len())
+
+	require.Nil(t, c.Delete(ctx, "a"))
+	require.Nil(t, c.Delete(ctx, "missing"))
+	_, ok, _ = c.Get(ctx, "a")
+	assert.False(t, ok)
+	assert.Equal(t, 0, c.Len())
+	assert.Equal(t, DefaultLRUSize, NewLRU(0).size)
+}
@@ -0,0 +1,196 @@
+package cache
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mjourard/golio/internal"
+	"github.com/mjourard/golio/internal/cloud"
+)
+
+// maxErrorBodySize is the number of bytes of an error response included in an *Error
+const maxErrorBodySize = 512
+
+// AWSCredentials are used to sign the requests to S3
+type AWSCredentials = cloud.AWSCredentials
+
+// TokenSource returns an OAuth 2 access token used to authenticate the requests to Google Cloud Storage
+type TokenSource func(ctx context.Context) (string, error)
+
+// Error is returned if an object store rejected a request
+type Error struct {
+	Method     string
+	Key        string
+	StatusCode int
+	// Message is the beginning of the body of the response
+	Message string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("cache: %s %s failed with status %d: %s", e.Method, e.Key, e.StatusCode, e.Message)
+}
+
+// ObjectStoreOption is used to configure the S3 and GCS caches
+type ObjectStoreOption func(*objectStoreConfig)
+
+type objectStoreConfig struct {
+	client      internal.Doer
+	prefix      string
+	endpoint    string
+	region      string
+	credentials AWSCredentials
+	token       TokenSource
+}
+
+// WithClient sets the http client used to send the requests
+func WithClient(c internal.Doer) ObjectStoreOption {
+	return func(o *objectStoreConfig) {
+		o.client = c
+	}
+}
+
+// WithPrefix stores all objects below the prefix, e.g. golio/
+func WithPrefix(prefix string) ObjectStoreOption {
+	return func(o *objectStoreConfig) {
+		o.prefix = prefix
+	}
+}
+
+// WithEndpoint sets the URL of the object store, e.g. of MinIO or a storage emulator. Objects are addressed as
+// {endpoint}/{bucket}/{key}
+func WithEndpoint(endpoint string) ObjectStoreOption {
+	return func(o *objectStoreConfig) {
+		o.endpoint = strings.TrimRight(endpoint, "/")
+	}
+}
+
+// WithAWSRegion sets the region of the S3 bucket. Defaults to the environment variable AWS_REGION
+func WithAWSRegion(region string) ObjectStoreOption {
+	return func(o *objectStoreConfig) {
+		o.region = region
+	}
+}
+
+// WithAWSCredentials sets the credentials for S3. Defaults to the environment variables AWS_ACCESS_KEY_ID,
+// AWS_SECRET_ACCESS_KEY and AWS_SESSION_TOKEN
+func WithAWSCredentials(credentials AWSCredentials) ObjectStoreOption {
+	return func(o *objectStoreConfig) {
+		o.credentials = credentials
+	}
+}
+
+// WithTokenSource sets the source of the access tokens for Google Cloud Storage. Defaults to the tokens of the default
+// service account provided by the metadata server
+func WithTokenSource(token TokenSource) ObjectStoreOption {
+	return func(o *objectStoreConfig) {
+		o.token = token
+	}
+}
+
+func newObjectStoreConfig(options []ObjectStoreOption) *objectStoreConfig {
+	o := &objectStoreConfig{
+		client:      http.DefaultClient,
+		region:      os.Getenv("AWS_REGION"),
+		credentials: cloud.AWSCredentialsFromEnv(),
+	}
+	for _, opt := range options {
+		opt(o)
+	}
+	return o
+}
+
+// objectStore is a Cache storing values as objects using the XML APIs of S3 and Google Cloud Storage. The expiration
+// is stored as metadata, expired objects are treated as missing and should be deleted by a lifecycle rule
+type objectStore struct {
+	client internal.Doer
+	prefix string
+	// url returns the URL of the object with the escaped key
+	url func(escapedKey string) string
+	// expiresHeader is the metadata header containing the expiration as Unix time
+	expiresHeader string
+	authorize     func(ctx context.Context, request *http.Request, body []byte) error
+}
+
+// Get downloads the object of the key
+func (s *objectStore) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	response, err := s.do(ctx, http.MethodGet, key, nil, nil)
+	if err != nil {
+		return nil, false, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode == http.StatusNotFound {
+		return nil, false, nil
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, false, responseError(http.MethodGet, key, response)
+	}
+	if expires, err := strconv.ParseInt(response.Header.Get(s.expiresHeader), 10, 64); err == nil &&
+		time.Now().After(time.Unix(expires, 0)) {
+		return nil, false, nil
+	}
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, false, err
+	}
+	return body, true, nil
+}
+
+// Set uploads the value as the object of the key
+func (s *objectStore) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	header := http.Header{}
+	header.Set("Content-Type", "application/octet-stream")
+	if ttl > 0 {
+		header.Set(s.expiresHeader, strconv.FormatInt(time.Now().Add(ttl).Unix(), 10))
+	}
+	response, err := s.do(ctx, http.MethodPut, key, header, value)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return responseError(http.MethodPut, key, response)
+	}
+	return nil
+}
+
+// Delete deletes the object of the key
+func (s *objectStore) Delete(ctx context.Context, key string) error {
+	response, err := s.do(ctx, http.MethodDelete, key, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	switch response.StatusCode {
+	case http.StatusOK, http.StatusNoContent, http.StatusNotFound:
+		return nil
+	}
+	return responseError(http.MethodDelete, key, response)
+}
+
+func (s *objectStore) do(ctx context.Context, method, key string, header http.Header,
+	body []byte) (*http.Response, error) {
+	request, err := http.NewRequestWithContext(ctx, method, s.url(cloud.EscapeAWSPath(s.prefix+key)),
+		bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	for k, values := range header {
+		request.Header[k] = values
+	}
+	if err := s.authorize(ctx, request, body); err != nil {
+		return nil, err
+	}
+	return s.client.Do(request)
+}
+
+func responseError(method, key string, response *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(response.Body, maxErrorBodySize))
+	return &Error{Method: method, Key: key, StatusCode: response.StatusCode, Message: string(body)}
+}
@@ -0,0 +1,167 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mjourard/golio/api"
+	"github.com/mjourard/golio/riot"
+)
+
+// bucket is an object store keeping objects and their headers in memory
+type bucket struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+	headers map[string]http.Header
+	auth    []string
+	paths   []string
+}
+
+func newBucket() *bucket {
+	return &bucket{objects: map[string][]byte{}, headers: map[string]http.Header{}}
+}
+
+func (b *bucket) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.auth = append(b.auth, r.Header.Get("Authorization"))
+	b.paths = append(b.paths, r.URL.EscapedPath())
+	key := r.URL.Path
+	switch r.Method {
+	case http.MethodPut:
+		body, _ := io.ReadAll(r.Body)
+		if string(body) == "denied" {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte("<Error><Code>AccessDenied</Code></Error>"))
+			return
+		}
+		b.objects[key] = body
+		b.headers[key] = r.Header.Clone()
+	case http.MethodGet:
+		body, ok := b.objects[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		for k, values := range b.headers[key] {
+			w.Header()[k] = values
+		}
+		_, _ = w.Write(body)
+	case http.MethodDelete:
+		delete(b.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func testCache(t *testing.T, c Cache, b *bucket, expiresHeader string) {
+	ctx := context.Background()
+	_, ok, err := c.Get(ctx, "euw1/missing")
+	require.Nil(t, err)
+	assert.False(t, ok)
+
+	require.Nil(t, c.Set(ctx, "euw1/lol/match/v4/matches/1", []byte(`{"gameId":1}`), 0))
+	value, ok, err := c.Get(ctx, "euw1/lol/match/v4/matches/1")
+	require.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, `{"gameId":1}`, string(value))
+
+	require.Nil(t, c.Set(ctx, "euw1/fresh", []byte("fresh"), time.Hour))
+	expires, err := strconv.ParseInt(b.headers["/bucket/golio/euw1/fresh"].Get(expiresHeader), 10, 64)
+	require.Nil(t, err)
+	assert.InDelta(t, time.Now().Add(time.Hour).Unix(), expires, 5)
+	_, ok, err = c.Get(ctx, "euw1/fresh")
+	require.Nil(t, err)
+	assert.True(t, ok)
+
+	require.Nil(t, c.Set(ctx, "euw1/expired", []byte("expired"), time.Hour))
+	b.headers["/bucket/golio/euw1/expired"].Set(expiresHeader, strconv.FormatInt(time.Now().Add(-time.Second).Unix(), 10))
+	_, ok, err = c.Get(ctx, "euw1/expired")
+	require.Nil(t, err)
+	assert.False(t, ok)
+
+	require.Nil(t, c.Set(ctx, "euw1/lol/match/v4/matchlists/by-account/a?beginIndex=0", []byte("{}"), 0))
+	assert.Contains(t, b.paths, "/bucket/golio/euw1/lol/match/v4/matchlists/by-account/a%3FbeginIndex%3D0")
+
+	require.Nil(t, c.Delete(ctx, "euw1/lol/match/v4/matches/1"))
+	_, ok, err = c.Get(ctx, "euw1/lol/match/v4/matches/1")
+	require.Nil(t, err)
+	assert.False(t, ok)
+
+	err = c.Set(ctx, "euw1/denied", []byte("denied"), 0)
+	var cacheErr *Error
+	require.True(t, errors.As(err, &cacheErr))
+	assert.Equal(t, http.StatusForbidden, cacheErr.StatusCode)
+	assert.Contains(t, cacheErr.Message, "AccessDenied")
+}
+
+func TestS3(t *testing.T) {
+	t.Parallel()
+	b := newBucket()
+	server := httptest.NewServer(b)
+	defer server.Close()
+	_, err := NewS3("bucket", WithAWSRegion(""), WithAWSCredentials(AWSCredentials{}))
+	assert.NotNil(t, err)
+	c, err := NewS3("bucket", WithEndpoint(server.URL+"/"), WithPrefix("golio/"), WithAWSRegion("eu-west-1"),
+		WithAWSCredentials(AWSCredentials{AccessKeyID: "id", SecretAccessKey: "secret"}))
+	require.Nil(t, err)
+	testCache(t, c, b, "X-Amz-Meta-Golio-Expires")
+	assert.Contains(t, b.auth[0], "AWS4-HMAC-SHA256 Credential=id/")
+	assert.Contains(t, b.auth[0], "/eu-west-1/s3/aws4_request")
+}
+
+func TestGCS(t *testing.T) {
+	t.Parallel()
+	b := newBucket()
+	server := httptest.NewServer(b)
+	defer server.Close()
+	c := NewGCS("bucket", WithEndpoint(server.URL), WithPrefix("golio/"),
+		WithTokenSource(func(context.Context) (string, error) {
+			return "token", nil
+		}))
+	testCache(t, c, b, "X-Goog-Meta-Golio-Expires")
+	assert.Equal(t, "Bearer token", b.auth[0])
+
+	failing := NewGCS("bucket", WithEndpoint(server.URL), WithTokenSource(func(context.Context) (string, error) {
+		return "", errors.New("no token")
+	}))
+	_, _, err := failing.Get(context.Background(), "key")
+	assert.EqualError(t, err, "no token")
+}
+
+func TestKey(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "euw1/lol/match/v4/matches/1", Key(api.RegionEuropeWest, "/lol/match/v4/matches/1"))
+	assert.Equal(t, "na1/lol/status/v3/shard-data", Key(api.RegionNorthAmerica, "lol/status/v3/shard-data"))
+}
+
+func TestArchiver(t *testing.T) {
+	t.Parallel()
+	b := newBucket()
+	server := httptest.NewServer(b)
+	defer server.Close()
+	c := NewGCS("bucket", WithEndpoint(server.URL), WithTokenSource(func(context.Context) (string, error) {
+		return "token", nil
+	}))
+	var errs []error
+	archive := Archiver(c, api.RegionKorea, 0, func(err error) {
+		errs = append(errs, err)
+	})
+	archive(riot.RawResponse{Method: http.MethodGet, Endpoint: "/lol/match/v4/matches/1", Body: []byte(`{"a":1}`)})
+	archive(riot.RawResponse{Method: http.MethodGet, Endpoint: "/lol/match/v4/timelines/by-match/1", Body: []byte("{}")})
+	archive(riot.RawResponse{Method: http.MethodGet, Endpoint: "/lol/status/v3/shard-data", Body: []byte("{}")})
+	archive(riot.RawResponse{Method: http.MethodGet, Endpoint: "/lol/match/v4/matches/2", Body: []byte("denied")})
+	assert.Equal(t, 3, len(b.paths))
+	assert.Equal(t, `{"a":1}`, string(b.objects["/bucket/kr/lol/match/v4/matches/1"]))
+	assert.Contains(t, b.objects, "/bucket/kr/lol/match/v4/timelines/by-match/1")
+	assert.Equal(t, 1, len(errs))
+}
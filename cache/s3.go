@@ -0,0 +1,43 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/mjourard/golio/internal/cloud"
+)
+
+// S3 is a Cache storing values as objects of an S3 bucket. It is safe for concurrent use
+type S3 struct {
+	objectStore
+}
+
+// NewS3 returns a cache using the bucket
+func NewS3(bucket string, options ...ObjectStoreOption) (*S3, error) {
+	cfg := newObjectStoreConfig(options)
+	if cfg.region == "" {
+		return nil, errors.New("cache: missing aws region")
+	}
+	if cfg.credentials.AccessKeyID == "" || cfg.credentials.SecretAccessKey == "" {
+		return nil, errors.New("cache: missing aws credentials")
+	}
+	base := fmt.Sprintf("https://%s.s3.%s.amazonaws.com", bucket, cfg.region)
+	if cfg.endpoint != "" {
+		base = cfg.endpoint + "/" + bucket
+	}
+	return &S3{objectStore{
+		client: cfg.client,
+		prefix: cfg.prefix,
+		url: func(escapedKey string) string {
+			return base + "/" + escapedKey
+		},
+		expiresHeader: "X-Amz-Meta-Golio-Expires",
+		authorize: func(_ context.Context, request *http.Request, body []byte) error {
+			cloud.SignAWS(request, body, cfg.credentials, cfg.region, "s3", time.Now())
+			return nil
+		},
+	}}, nil
+}
@@ -0,0 +1,43 @@
+// Command golio-champions generates the Champion constants of the riot package from the champion list of
+// Data Dragon. It is run for every patch using go generate in the riot package.
+//
+//	go run ./cmd/golio-champions -o riot/champion_gen.go
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/mjourard/golio/internal/champions"
+)
+
+func main() {
+	source := flag.String("source", "", "URL or path of champion.json, defaults to the latest Data Dragon version")
+	output := flag.String("o", "champion_gen.go", "path of the generated file")
+	pkg := flag.String("package", "riot", "package of the generated file")
+	flag.Parse()
+	if *source == "" {
+		version, err := champions.LatestVersion()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		*source = fmt.Sprintf(champions.ChampionsURLFormat, version)
+	}
+	data, err := champions.Load(*source)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	var buf bytes.Buffer
+	if err := champions.Generate(&buf, data, *pkg); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if err := os.WriteFile(*output, buf.Bytes(), 0644); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,31 @@
+// Command golio-schema validates the types of golio against the community maintained OpenAPI schema of the Riot API
+// and lists all fields which are missing, unknown or mistyped. It exits with status 1 if any problem was found.
+//
+//	go run ./cmd/golio-schema -schema openapi-3.0.0.json
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/mjourard/golio/internal/schema"
+)
+
+func main() {
+	source := flag.String("schema", schema.DefaultSource, "URL or path of the OpenAPI schema")
+	flag.Parse()
+	spec, err := schema.Load(*source)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	problems := schema.Validate(spec, schema.RiotTypes)
+	for _, problem := range problems {
+		fmt.Println(problem)
+	}
+	if len(problems) > 0 {
+		fmt.Fprintf(os.Stderr, "%d problems found\n", len(problems))
+		os.Exit(1)
+	}
+}
@@ -3,13 +3,14 @@
 package datadragon
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"strconv"
 	"strings"
 	"sync"
-	"sync/atomic"
 
 	log "github.com/sirupsen/logrus"
 
@@ -40,34 +41,55 @@ var (
 	}
 )
 
-// Client provides access to all data provided by the Data Dragon service
+// Client provides access to all data provided by the Data Dragon service. The data is cached after the first request
+// and a client is safe for concurrent use
 type Client struct {
-	logger             log.FieldLogger
-	Version            string
-	Language           languageCode
-	client             internal.Doer
-	championsMu        sync.RWMutex
-	championsByName    map[string]ChampionDataExtended
-	getChampionsToggle uint32
-	profileIconsMu     sync.RWMutex
-	profileIcons       []ProfileIcon
-	itemsMu            sync.RWMutex
-	items              []Item
-	masteriesMu        sync.RWMutex
-	masteries          []Mastery
-	runesMu            sync.RWMutex
-	runes              []Item
-	summonersMu        sync.RWMutex
-	summoners          []SummonerSpell
+	logger          log.FieldLogger
+	Version         string
+	Language        languageCode
+	client          internal.Doer
+	cache           Cache
+	championsMu     sync.RWMutex
+	championsByName map[string]ChampionDataExtended
+	championsLoaded bool
+	profileIconsMu  sync.RWMutex
+	profileIcons    []ProfileIcon
+	itemsMu         sync.RWMutex
+	items           []Item
+	masteriesMu     sync.RWMutex
+	masteries       []Mastery
+	runesMu         sync.RWMutex
+	runes           []Item
+	summonersMu     sync.RWMutex
+	summoners       []SummonerSpell
+}
+
+// Cache stores the payloads of Data Dragon by key with a time to live. The caches of package cache, e.g.
+// cache.NewDisk, implement it. It is the same interface as cache.Cache and riot.Cache
+type Cache = internal.Cache
+
+// Option is used to alter the attributes of a client
+type Option func(*Client)
+
+// WithCache stores the payloads of the data endpoints in the cache, e.g. on disk so that a restarted application does
+// not fetch all champions and items again. The payloads are keyed by their URL, which contains the version and the
+// language, so they never expire. Errors of the cache are logged and never fail a request
+func WithCache(cache Cache) Option {
+	return func(c *Client) {
+		c.cache = cache
+	}
 }
 
 // NewClient returns a new client for the Data Dragon service.
-func NewClient(client internal.Doer, region api.Region, logger log.FieldLogger) *Client {
+func NewClient(client internal.Doer, region api.Region, logger log.FieldLogger, options ...Option) *Client {
 	c := &Client{
 		client:          client,
 		logger:          logger.WithField("client", "data dragon"),
 		championsByName: map[string]ChampionDataExtended{},
 	}
+	for _, opt := range options {
+		opt(c)
+	}
 	if err := c.init(regionToRealmRegion[region]); err != nil {
 		c.Version = fallbackVersion
 		c.Language = fallbackLanguage
@@ -75,6 +97,26 @@ func NewClient(client internal.Doer, region api.Region, logger log.FieldLogger)
 	return c
 }
 
+// NewClientWithVersion returns a new client for the Data Dragon service using the version and the language of the
+// United States instead of requesting the current version of the realm of a region, e.g. to avoid the request on cold
+// starts of serverless functions. An empty version uses the version golio was tested with
+func NewClientWithVersion(client internal.Doer, version string, logger log.FieldLogger, options ...Option) *Client {
+	if version == "" {
+		version = fallbackVersion
+	}
+	c := &Client{
+		client:          client,
+		logger:          logger.WithField("client", "data dragon"),
+		championsByName: map[string]ChampionDataExtended{},
+		Version:         version,
+		Language:        fallbackLanguage,
+	}
+	for _, opt := range options {
+		opt(c)
+	}
+	return c
+}
+
 func (c *Client) init(region string) error {
 	var res struct {
 		Version  string `json:"v"`
@@ -87,6 +129,7 @@ func (c *Client) init(region string) error {
 	if response.Body == nil {
 		return fmt.Errorf("no response body")
 	}
+	defer response.Body.Close()
 	if err := json.NewDecoder(response.Body).Decode(&res); err != nil {
 		return err
 	}
@@ -99,16 +142,22 @@ func (c *Client) init(region string) error {
 func (c *Client) GetChampions() ([]ChampionData, error) {
 	unlock, toggle := internal.RWLockToggle(&c.championsMu)
 	defer unlock()
-	if atomic.CompareAndSwapUint32(&c.getChampionsToggle, 0, 1) {
+	if !c.championsLoaded {
 		toggle()
+	}
+	// another caller may have loaded the champions while the lock was switched
+	if !c.championsLoaded {
 		var champions map[string]ChampionData
 		if err := c.getInto("/champion.json", &champions); err != nil {
 			return nil, err
 		}
 		for _, champion := range champions {
-			data := ChampionDataExtended{ChampionData: champion}
-			c.championsByName[champion.Name] = data
+			// champions requested by GetChampion before are kept with their extended data
+			if _, ok := c.championsByName[champion.Name]; !ok {
+				c.championsByName[champion.Name] = ChampionDataExtended{ChampionData: champion}
+			}
 		}
+		c.championsLoaded = true
 	}
 	res := make([]ChampionData, 0, len(c.championsByName))
 	for _, champion := range c.championsByName {
@@ -329,7 +378,7 @@ func (c *Client) GetSummonerSpell(id string) (SummonerSpell, error) {
 func (c *Client) ClearCaches() {
 	c.championsMu.Lock()
 	c.championsByName = map[string]ChampionDataExtended{}
-	atomic.StoreUint32(&c.getChampionsToggle, 0)
+	c.championsLoaded = false
 	c.championsMu.Unlock()
 	c.masteriesMu.Lock()
 	c.masteries = []Mastery{}
@@ -349,15 +398,70 @@ func (c *Client) ClearCaches() {
 }
 
 func (c *Client) getInto(endpoint string, target interface{}) error {
+	if c.cache != nil {
+		return c.getIntoCached(endpoint, target)
+	}
 	response, err := c.doRequest(dataDragonDataURLFormat, endpoint)
 	if err != nil {
 		return err
 	}
+	if response.Body != nil {
+		defer response.Body.Close()
+	}
 	var ddResponse dataDragonResponse
 	if err = json.NewDecoder(response.Body).Decode(&ddResponse); err != nil {
 		return err
 	}
-	// this can not return an error. the error would have been returned during the above decode already
+	return decodeData(ddResponse, target)
+}
+
+// getIntoCached decodes the payload cached for the endpoint into target or, if it is not cached, requests it and
+// caches it once it was decoded. A cached payload which can not be decoded is deleted and requested again
+func (c *Client) getIntoCached(endpoint string, target interface{}) error {
+	request, err := c.newRequest(dataDragonDataURLFormat, endpoint)
+	if err != nil {
+		return err
+	}
+	key := request.URL.Host + request.URL.Path
+	body, ok, err := c.cache.Get(context.Background(), key)
+	if err != nil {
+		c.logger.Warnf("reading cache: %v", err)
+	}
+	if ok {
+		var ddResponse dataDragonResponse
+		err := json.Unmarshal(body, &ddResponse)
+		if err == nil {
+			return decodeData(ddResponse, target)
+		}
+		c.logger.Warnf("decoding cached %s: %v", key, err)
+		if err := c.cache.Delete(context.Background(), key); err != nil {
+			c.logger.Warnf("deleting cache: %v", err)
+		}
+	}
+	response, err := c.doRequest(dataDragonDataURLFormat, endpoint)
+	if err != nil {
+		return err
+	}
+	body = nil
+	if response.Body != nil {
+		defer response.Body.Close()
+		if body, err = io.ReadAll(response.Body); err != nil {
+			return err
+		}
+	}
+	var ddResponse dataDragonResponse
+	if err := json.Unmarshal(body, &ddResponse); err != nil {
+		return err
+	}
+	if err := c.cache.Set(context.Background(), key, body, 0); err != nil {
+		c.logger.Warnf("writing cache: %v", err)
+	}
+	return decodeData(ddResponse, target)
+}
+
+// decodeData decodes the data of the response into target
+func decodeData(ddResponse dataDragonResponse, target interface{}) error {
+	// this can not return an error. the error would have been returned during the decoding of the response already
 	data, _ := json.Marshal(ddResponse.Data)
 	return json.Unmarshal(data, &target)
 }
@@ -372,6 +476,9 @@ func (c *Client) doRequest(format dataDragonURL, endpoint string) (*http.Respons
 		return nil, err
 	}
 	if response.StatusCode < 200 || response.StatusCode > 299 {
+		if response.Body != nil {
+			_ = response.Body.Close()
+		}
 		var err error
 		err, ok := api.StatusToError[response.StatusCode]
 		if !ok {
@@ -1,9 +1,16 @@
 package datadragon
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
@@ -11,7 +18,7 @@ import (
 
 	"github.com/mjourard/golio/api"
 	"github.com/mjourard/golio/internal"
-	"github.com/mjourard/golio/internal/mock"
+	"github.com/mjourard/golio/mock"
 )
 
 func TestNewClient(t *testing.T) {
@@ -20,6 +27,16 @@ func TestNewClient(t *testing.T) {
 	require.NotNil(t, ddClient)
 }
 
+func TestNewClientWithVersion(t *testing.T) {
+	t.Parallel()
+	doer := mock.NewSequenceDoer()
+	ddClient := NewClientWithVersion(doer, "10.1.1", log.StandardLogger())
+	assert.Equal(t, "10.1.1", ddClient.Version)
+	assert.Equal(t, languageCode(fallbackLanguage), ddClient.Language)
+	assert.Equal(t, fallbackVersion, NewClientWithVersion(doer, "", log.StandardLogger()).Version)
+	assert.Equal(t, 0, doer.Calls())
+}
+
 func TestClient_GetChampions(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -64,6 +81,33 @@ func TestClient_GetChampions(t *testing.T) {
 	}
 }
 
+func TestClient_GetChampionsConcurrent(t *testing.T) {
+	t.Parallel()
+	var calls int32
+	doer := &mock.Doer{Custom: func(r *http.Request) (*http.Response, error) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			return &http.Response{StatusCode: http.StatusServiceUnavailable}, nil
+		}
+		body, _ := json.Marshal(dataDragonResponse{Data: map[string]ChampionData{"Ahri": {Name: "Ahri"}}})
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(body))}, nil
+	}}
+	c := NewClientWithVersion(doer, "", log.StandardLogger())
+	_, err := c.GetChampions()
+	require.NotNil(t, err)
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			got, err := c.GetChampions()
+			assert.Nil(t, err)
+			assert.Equal(t, []ChampionData{{Name: "Ahri"}}, got)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
+
 func TestClient_GetChampion(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -643,7 +687,7 @@ func TestClient_init(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			c := NewClient(tt.doer, api.RegionOceania, log.StandardLogger())
-			if err := c.init(api.RegionOceania); (err != nil) != tt.wantErr {
+			if err := c.init(string(api.RegionOceania)); (err != nil) != tt.wantErr {
 				t.Errorf("Client.init() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
@@ -724,3 +768,73 @@ func (e errorReadCloser) Read(p []byte) (n int, err error) {
 func (e errorReadCloser) Close() error {
 	return fmt.Errorf("error")
 }
+
+// mapCache is a Cache keeping the values in a map
+type mapCache struct {
+	mu     sync.Mutex
+	values map[string][]byte
+}
+
+func (c *mapCache) Get(_ context.Context, key string) ([]byte, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	value, ok := c.values[key]
+	return value, ok, nil
+}
+
+func (c *mapCache) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.values[key] = value
+	return nil
+}
+
+func (c *mapCache) Delete(_ context.Context, key string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.values, key)
+	return nil
+}
+
+func TestWithCache(t *testing.T) {
+	t.Parallel()
+	cache := &mapCache{values: map[string][]byte{}}
+	doer := mock.NewSequenceDoer(mock.OK(dataDragonResponse{Data: map[string]Item{"1001": {Name: "Boots"}}}))
+	client := NewClientWithVersion(doer, "10.1.1", log.StandardLogger(), WithCache(cache))
+	items, err := client.GetItems()
+	require.Nil(t, err)
+	require.Len(t, items, 1)
+	assert.Contains(t, cache.values, "ddragon.leagueoflegends.com/cdn/10.1.1/data/en_US/item.json")
+
+	// a new client, e.g. after a restart, reads the items from the cache
+	client = NewClientWithVersion(doer, "10.1.1", log.StandardLogger(), WithCache(cache))
+	items, err = client.GetItems()
+	require.Nil(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, "Boots", items[0].Name)
+	assert.Equal(t, 1, doer.Calls())
+}
+
+func TestWithCache_corrupted(t *testing.T) {
+	t.Parallel()
+	key := "ddragon.leagueoflegends.com/cdn/10.1.1/data/en_US/item.json"
+	cache := &mapCache{values: map[string][]byte{key: []byte(`{"data":`)}}
+	doer := mock.NewSequenceDoer(mock.OK(dataDragonResponse{Data: map[string]Item{"1001": {Name: "Boots"}}}))
+	client := NewClientWithVersion(doer, "10.1.1", log.StandardLogger(), WithCache(cache))
+	items, err := client.GetItems()
+	require.Nil(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, "Boots", items[0].Name)
+	assert.Equal(t, 1, doer.Calls())
+	assert.Contains(t, string(cache.values[key]), "Boots")
+
+	// a response without body is an error
+	empty := &mock.Doer{Custom: func(*http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK}, nil
+	}}
+	cache = &mapCache{values: map[string][]byte{}}
+	client = NewClientWithVersion(empty, "10.1.1", log.StandardLogger(), WithCache(cache))
+	_, err = client.GetItems()
+	assert.NotNil(t, err)
+	assert.Empty(t, cache.values)
+}
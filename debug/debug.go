@@ -0,0 +1,178 @@
+// Package debug logs the requests sent to the Riot API and their responses for debugging, e.g. during an incident in
+// production. The Doer wraps the http client of a golio client and can be switched on and off at runtime:
+//
+//	doer := debug.NewDoer(http.DefaultClient, logger)
+//	client := golio.NewClient("API KEY", golio.WithClient(doer))
+//	doer.Enable()
+//	defer doer.Disable()
+//
+// The API key is never logged, see Redacted.
+package debug
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/url"
+	"sync/atomic"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/mjourard/golio/internal"
+)
+
+// Redacted replaces the values of secret headers and query parameters in the logs
+const Redacted = "[REDACTED]"
+
+// maxLoggedBody is the number of bytes of a body included in the logs, longer bodies are truncated
+const maxLoggedBody = 4096
+
+// secretHeaders are the headers containing the API key
+var secretHeaders = []string{"X-Riot-Token", "Authorization"}
+
+// secretParameters are the query parameters containing the API key
+var secretParameters = []string{"api_key"}
+
+// Doer is an implementation of the Doer interface logging the requests sent to another Doer and their responses,
+// including headers, bodies and timing, while it is enabled. It is safe for concurrent use if the wrapped Doer is
+type Doer struct {
+	next    internal.Doer
+	logger  log.FieldLogger
+	enabled int32
+}
+
+// NewDoer constructs a new Doer sending requests to next and logging them to logger at debug level. The Doer is
+// disabled until Enable is called
+func NewDoer(next internal.Doer, logger log.FieldLogger) *Doer {
+	return &Doer{
+		next:   next,
+		logger: logger.WithField("client", "debug"),
+	}
+}
+
+// Enable starts logging requests
+func (d *Doer) Enable() {
+	atomic.StoreInt32(&d.enabled, 1)
+}
+
+// Disable stops logging requests
+func (d *Doer) Disable() {
+	atomic.StoreInt32(&d.enabled, 0)
+}
+
+// Enabled returns whether requests are logged
+func (d *Doer) Enabled() bool {
+	return atomic.LoadInt32(&d.enabled) == 1
+}
+
+// Do sends the request to the wrapped Doer and logs the request and its response if the Doer is enabled
+func (d *Doer) Do(r *http.Request) (*http.Response, error) {
+	if !d.Enabled() {
+		return d.next.Do(r)
+	}
+	requestBody, err := copyBody(&r.Body)
+	if err != nil {
+		return nil, err
+	}
+	logger := d.logger.WithFields(log.Fields{
+		"method":  r.Method,
+		"url":     RedactURL(r.URL),
+		"headers": RedactHeader(r.Header),
+	})
+	if len(requestBody) > 0 {
+		logger = logger.WithField("requestBody", truncate(requestBody))
+	}
+	start := time.Now()
+	response, err := d.next.Do(r)
+	logger = logger.WithField("duration", time.Since(start))
+	if err != nil {
+		logger.WithError(redactError(err)).Debug("request failed")
+		return response, err
+	}
+	if response == nil {
+		logger.Debug("request returned no response")
+		return response, err
+	}
+	responseBody, err := copyBody(&response.Body)
+	if err != nil {
+		logger.WithError(err).Debug("reading response body failed")
+		return nil, err
+	}
+	logger.WithFields(log.Fields{
+		"status":          response.StatusCode,
+		"responseHeaders": RedactHeader(response.Header),
+		"responseBody":    truncate(responseBody),
+	}).Debug("request sent")
+	return response, nil
+}
+
+// RedactHeader returns a copy of the header with the values of headers containing the API key replaced by Redacted
+func RedactHeader(header http.Header) http.Header {
+	redacted := header.Clone()
+	for _, key := range secretHeaders {
+		if _, ok := redacted[http.CanonicalHeaderKey(key)]; ok {
+			redacted.Set(key, Redacted)
+		}
+	}
+	return redacted
+}
+
+// RedactURL returns the URL with the values of query parameters containing the API key replaced by Redacted
+func RedactURL(u *url.URL) string {
+	if u == nil {
+		return ""
+	}
+	query := u.Query()
+	changed := false
+	for _, key := range secretParameters {
+		if query.Has(key) {
+			query.Set(key, Redacted)
+			changed = true
+		}
+	}
+	if !changed {
+		return u.String()
+	}
+	redacted := *u
+	redacted.RawQuery = query.Encode()
+	return redacted.String()
+}
+
+// redactError returns the error of the http client with its URL redacted by RedactURL if it is a *url.Error, as it
+// contains the API key if the key is sent as query parameter
+func redactError(err error) error {
+	urlErr, ok := err.(*url.Error)
+	if !ok {
+		return err
+	}
+	redacted := *urlErr
+	u, parseErr := url.Parse(urlErr.URL)
+	if parseErr != nil {
+		redacted.URL = Redacted
+	} else {
+		redacted.URL = RedactURL(u)
+	}
+	return &redacted
+}
+
+// copyBody reads the body and replaces it with a new reader of the same content
+func copyBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+	content, err := io.ReadAll(*body)
+	_ = (*body).Close()
+	if err != nil {
+		return nil, err
+	}
+	*body = io.NopCloser(bytes.NewReader(content))
+	return content, nil
+}
+
+func truncate(body []byte) string {
+	if len(body) > maxLoggedBody {
+		return string(body[:maxLoggedBody]) + "..."
+	}
+	return string(body)
+}
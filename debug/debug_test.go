@@ -0,0 +1,105 @@
+package debug
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mjourard/golio/mock"
+)
+
+func TestDoer(t *testing.T) {
+	t.Parallel()
+	logger, hook := test.NewNullLogger()
+	logger.SetLevel(log.DebugLevel)
+	doer := NewDoer(mock.NewJSONMockDoer(map[string]string{"name": "name"}, http.StatusOK), logger)
+
+	request, err := http.NewRequest(http.MethodPost, "https://euw1.api.riotgames.com/path?api_key=secret&a=b",
+		strings.NewReader("body"))
+	require.Nil(t, err)
+	request.Header.Set("X-Riot-Token", "secret")
+	_, err = doer.Do(request)
+	require.Nil(t, err)
+	assert.Empty(t, hook.AllEntries())
+
+	doer.Enable()
+	assert.True(t, doer.Enabled())
+	response, err := doer.Do(request)
+	require.Nil(t, err)
+	body, err := io.ReadAll(response.Body)
+	require.Nil(t, err)
+	assert.JSONEq(t, `{"name": "name"}`, string(body))
+	entry := hook.LastEntry()
+	require.NotNil(t, entry)
+	assert.Equal(t, log.DebugLevel, entry.Level)
+	assert.Equal(t, http.StatusOK, entry.Data["status"])
+	assert.Equal(t, "body", entry.Data["requestBody"])
+	assert.JSONEq(t, `{"name": "name"}`, entry.Data["responseBody"].(string))
+	assert.Equal(t, Redacted, entry.Data["headers"].(http.Header).Get("X-Riot-Token"))
+	assert.NotContains(t, entry.Data["url"], "secret")
+	assert.Equal(t, "secret", request.Header.Get("X-Riot-Token"))
+
+	doer.Disable()
+	hook.Reset()
+	_, err = doer.Do(request)
+	require.Nil(t, err)
+	assert.Empty(t, hook.AllEntries())
+}
+
+func TestDoer_Error(t *testing.T) {
+	t.Parallel()
+	logger, hook := test.NewNullLogger()
+	logger.SetLevel(log.DebugLevel)
+	doer := NewDoer(&mock.Doer{Custom: func(*http.Request) (*http.Response, error) {
+		return nil, io.ErrUnexpectedEOF
+	}}, logger)
+	doer.Enable()
+	request, err := http.NewRequest(http.MethodGet, "https://euw1.api.riotgames.com/path", nil)
+	require.Nil(t, err)
+	_, err = doer.Do(request)
+	assert.Equal(t, io.ErrUnexpectedEOF, err)
+	require.NotNil(t, hook.LastEntry())
+	assert.Equal(t, "request failed", hook.LastEntry().Message)
+}
+
+func TestDoer_ErrorRedacted(t *testing.T) {
+	t.Parallel()
+	logger, hook := test.NewNullLogger()
+	logger.SetLevel(log.DebugLevel)
+	doer := NewDoer(http.DefaultClient, logger)
+	doer.Enable()
+	request, err := http.NewRequest(http.MethodGet, "http://127.0.0.1:1/path?api_key=SECRETKEY", nil)
+	require.Nil(t, err)
+	_, err = doer.Do(request)
+	require.NotNil(t, err)
+	entry := hook.LastEntry()
+	require.NotNil(t, entry)
+	logged, ok := entry.Data[log.ErrorKey].(error)
+	require.True(t, ok)
+	assert.NotContains(t, logged.Error(), "SECRETKEY")
+	assert.Contains(t, logged.Error(), "api_key=%5BREDACTED%5D")
+}
+
+func TestRedactURL(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{url: "https://host/path?a=b", want: "https://host/path?a=b"},
+		{url: "https://host/path?api_key=secret&a=b", want: "https://host/path?a=b&api_key=%5BREDACTED%5D"},
+	}
+	for _, test := range tests {
+		u, err := url.Parse(test.url)
+		require.Nil(t, err)
+		assert.Equal(t, test.want, RedactURL(u))
+	}
+	assert.Equal(t, "", RedactURL(nil))
+}
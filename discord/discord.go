@@ -0,0 +1,275 @@
+// Package discord turns results of the Riot API into embeds of Discord messages, e.g. for bots. The embeds can be
+// encoded as JSON and sent as part of a message using the Discord API or any Discord library:
+//
+//	embed := discord.Builder{}.PostGame(match, participantID)
+//	payload, err := json.Marshal(map[string]interface{}{"embeds": []discord.Embed{embed}})
+package discord
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/mjourard/golio/riot"
+)
+
+// Limits of the Discord API for embeds
+const (
+	maxTitle      = 256
+	maxFieldName  = 256
+	maxFieldValue = 1024
+	maxFields     = 25
+)
+
+// Colors of the embeds
+const (
+	ColorWin      = 0x2ecc71
+	ColorLoss     = 0xe74c3c
+	ColorLiveGame = 0x3498db
+	ColorUnranked = 0x95a5a6
+)
+
+// tierColors are the colors of the embeds of profiles by the highest tier of the summoner
+var tierColors = map[riot.Tier]int{
+	riot.TierIron:        0x51484a,
+	riot.TierBronze:      0x8c5a3c,
+	riot.TierSilver:      0x80989d,
+	riot.TierGold:        0xcd8837,
+	riot.TierPlatinum:    0x4e9996,
+	riot.TierDiamond:     0x576bce,
+	riot.TierMaster:      0x9d48e0,
+	riot.TierGrandmaster: 0xcd4545,
+	riot.TierChallenger:  0xf4c874,
+}
+
+const dataDragonURL = "https://ddragon.leagueoflegends.com/cdn/"
+
+// teamNames are the names of the teams by their ID
+var teamNames = map[int]string{100: "Blue team", 200: "Red team"}
+
+// Embed is an embed of a Discord message
+type Embed struct {
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+	URL         string `json:"url,omitempty"`
+	// Timestamp in ISO 8601 format
+	Timestamp string       `json:"timestamp,omitempty"`
+	Color     int          `json:"color,omitempty"`
+	Footer    *EmbedFooter `json:"footer,omitempty"`
+	Thumbnail *EmbedImage  `json:"thumbnail,omitempty"`
+	Fields    []EmbedField `json:"fields,omitempty"`
+}
+
+// EmbedFooter is the footer of an embed
+type EmbedFooter struct {
+	Text string `json:"text"`
+}
+
+// EmbedImage is an image of an embed
+type EmbedImage struct {
+	URL string `json:"url"`
+}
+
+// EmbedField is a field of an embed
+type EmbedField struct {
+	Name   string `json:"name"`
+	Value  string `json:"value"`
+	Inline bool   `json:"inline,omitempty"`
+}
+
+// Builder creates embeds. The zero value can be used, but has no images
+type Builder struct {
+	// DataDragonVersion is the version of Data Dragon used for the images of champions and profile icons, e.g.
+	// 10.1.1. Images are omitted if it is empty
+	DataDragonVersion string
+	// RankFormat is used to format ranks. Defaults to riot.DefaultRankFormat
+	RankFormat *riot.RankFormat
+}
+
+// ProfileCard returns an embed with the level, the ranks and the champions with the highest mastery of the summoner.
+// At most three masteries are shown
+func (b Builder) ProfileCard(summoner *riot.Summoner, entries []*riot.LeagueItem,
+	masteries []*riot.ChampionMastery) Embed {
+	embed := Embed{
+		Title:       truncate(summoner.Name, maxTitle),
+		Description: fmt.Sprintf("Level %d", summoner.SummonerLevel),
+		Color:       ColorUnranked,
+	}
+	if b.DataDragonVersion != "" {
+		embed.Thumbnail = &EmbedImage{
+			URL: fmt.Sprintf("%s%s/img/profileicon/%d.png", dataDragonURL, b.DataDragonVersion, summoner.ProfileIconID),
+		}
+	}
+	var highest *riot.LeagueItem
+	for _, entry := range entries {
+		if entry == nil {
+			continue
+		}
+		embed.addField(queueName(riot.Queue(entry.QueueType)), b.rankFormat().Format(entry), true)
+		if highest == nil || highest.Less(entry) {
+			highest = entry
+		}
+	}
+	if highest != nil {
+		if color, ok := tierColors[riot.Tier(highest.Tier)]; ok {
+			embed.Color = color
+		}
+	}
+	if len(entries) == 0 {
+		embed.addField("Rank", b.rankFormat().Unranked, true)
+	}
+	sorted := make([]*riot.ChampionMastery, 0, len(masteries))
+	for _, mastery := range masteries {
+		if mastery != nil {
+			sorted = append(sorted, mastery)
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].ChampionPoints > sorted[j].ChampionPoints
+	})
+	if len(sorted) > 3 {
+		sorted = sorted[:3]
+	}
+	lines := make([]string, 0, len(sorted))
+	for _, mastery := range sorted {
+		lines = append(lines, fmt.Sprintf("%s · level %d · %s points", riot.Champion(mastery.ChampionID),
+			mastery.ChampionLevel, formatThousands(mastery.ChampionPoints)))
+	}
+	if len(lines) > 0 {
+		embed.addField("Top champions", strings.Join(lines, "\n"), false)
+	}
+	return embed
+}
+
+// LiveGame returns an embed with the queue, the duration, the participants by team and the bans of a current game.
+// The duration is the time since the start of the game at now
+func (b Builder) LiveGame(game *riot.GameInfo, now time.Time) Embed {
+	embed := Embed{
+		Title: "Live game · " + game.QueueName(),
+		Color: ColorLiveGame,
+	}
+	if elapsed := game.ElapsedAt(now); elapsed > 0 {
+		embed.Description = "In game for " + formatDuration(elapsed)
+		embed.Timestamp = game.GameStartTime.UTC().Format(time.RFC3339)
+	} else {
+		embed.Description = "Loading"
+	}
+	teams := game.Teams()
+	teamIDs := make([]int, 0, len(teams))
+	for id := range teams {
+		teamIDs = append(teamIDs, id)
+	}
+	sort.Ints(teamIDs)
+	for _, id := range teamIDs {
+		lines := make([]string, 0, len(teams[id]))
+		for _, p := range teams[id] {
+			lines = append(lines, fmt.Sprintf("%s · %s", riot.Champion(p.ChampionID), p.SummonerName))
+		}
+		embed.addField(teamName(id), strings.Join(lines, "\n"), true)
+	}
+	if bans := game.BannedChampionNames(); len(bans) > 0 {
+		embed.addField("Bans", strings.Join(bans, ", "), false)
+	}
+	return embed
+}
+
+// PostGame returns an embed with the result, the KDA, the farm and the damage of the participant of a finished match
+func (b Builder) PostGame(match *riot.Match, participantID int) Embed {
+	p := match.Participant(participantID)
+	if p == nil {
+		return Embed{Title: fmt.Sprintf("Match %d", match.GameID), Description: "Participant not found"}
+	}
+	champion := riot.Champion(p.ChampionID)
+	embed := Embed{
+		Title:       "Defeat · " + champion.String(),
+		Color:       ColorLoss,
+		Description: riot.QueueID(match.QueueID).String() + " · " + formatDuration(match.GameDuration.Duration),
+	}
+	if !match.GameCreation.IsZero() {
+		embed.Timestamp = match.GameCreation.UTC().Format(time.RFC3339)
+	}
+	if p.Stats != nil && p.Stats.Win {
+		embed.Title = "Victory · " + champion.String()
+		embed.Color = ColorWin
+	}
+	if b.DataDragonVersion != "" && champion.Key() != "" {
+		embed.Thumbnail = &EmbedImage{
+			URL: fmt.Sprintf("%s%s/img/champion/%s.png", dataDragonURL, b.DataDragonVersion, champion.Key()),
+		}
+	}
+	if s := p.Stats; s != nil {
+		embed.addField("KDA", fmt.Sprintf("%d / %d / %d (%.2f)", s.Kills, s.Deaths, s.Assists, p.KDA()), true)
+		embed.addField("CS", fmt.Sprintf("%d (%.1f/min)", s.TotalMinionsKilled+s.NeutralMinionsKilled,
+			match.CSPerMinute(p)), true)
+		embed.addField("Damage", fmt.Sprintf("%s (%.0f%% of team)", formatThousands(s.TotalDamageDealtToChampions),
+			match.DamageShare(p)*100), true)
+		embed.addField("Vision score", fmt.Sprint(s.VisionScore), true)
+	}
+	embed.Footer = &EmbedFooter{Text: fmt.Sprintf("Match %d", match.GameID)}
+	return embed
+}
+
+func (b Builder) rankFormat() riot.RankFormat {
+	if b.RankFormat != nil {
+		return *b.RankFormat
+	}
+	return riot.DefaultRankFormat
+}
+
+// addField adds a field within the limits of Discord. Fields exceeding the maximum number of fields are dropped
+func (e *Embed) addField(name, value string, inline bool) {
+	if len(e.Fields) >= maxFields {
+		return
+	}
+	e.Fields = append(e.Fields, EmbedField{
+		Name:   truncate(name, maxFieldName),
+		Value:  truncate(value, maxFieldValue),
+		Inline: inline,
+	})
+}
+
+// queueName returns the name of the ranked queue, e.g. "Ranked Solo/Duo"
+func queueName(queue riot.Queue) string {
+	for _, id := range riot.QueueIDs {
+		if q, ok := id.Queue(); ok && q == queue {
+			return id.String()
+		}
+	}
+	return string(queue)
+}
+
+func teamName(id int) string {
+	if name, ok := teamNames[id]; ok {
+		return name
+	}
+	return fmt.Sprintf("Team %d", id)
+}
+
+// formatDuration returns the duration as minutes and seconds, e.g. 32:05
+func formatDuration(d time.Duration) string {
+	seconds := int(d / time.Second)
+	return fmt.Sprintf("%d:%02d", seconds/60, seconds%60)
+}
+
+// formatThousands returns the number with commas separating the thousands, e.g. 123,456
+func formatThousands(n int) string {
+	if n < 0 {
+		return "-" + formatThousands(-n)
+	}
+	s := fmt.Sprint(n)
+	for i := len(s) - 3; i > 0; i -= 3 {
+		s = s[:i] + "," + s[i:]
+	}
+	return s
+}
+
+// truncate cuts s to at most n characters, ending with an ellipsis if it was cut
+func truncate(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	runes := []rune(s)
+	return string(runes[:n-1]) + "…"
+}
@@ -0,0 +1,120 @@
+package discord
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mjourard/golio/riot"
+)
+
+func TestBuilder_ProfileCard(t *testing.T) {
+	t.Parallel()
+	summoner := &riot.Summoner{Name: "name", SummonerLevel: 120, ProfileIconID: 7}
+	entries := []*riot.LeagueItem{
+		{QueueType: string(riot.QueueRankedFlex), Tier: string(riot.TierSilver), Rank: "I", LeaguePoints: 10},
+		{QueueType: string(riot.QueueRankedSolo), Tier: string(riot.TierGold), Rank: "IV", LeaguePoints: 56, Wins: 1,
+			Losses: 1},
+	}
+	masteries := []*riot.ChampionMastery{
+		{ChampionID: int(riot.ChampionAhri), ChampionLevel: 7, ChampionPoints: 123456},
+		{ChampionID: int(riot.ChampionAnnie), ChampionLevel: 5, ChampionPoints: 1000},
+		{ChampionID: int(riot.ChampionAshe), ChampionLevel: 6, ChampionPoints: 50000},
+		{ChampionID: int(riot.ChampionAkali), ChampionLevel: 1, ChampionPoints: 10},
+	}
+	got := Builder{DataDragonVersion: "10.1.1"}.ProfileCard(summoner, entries, masteries)
+	assert.Equal(t, Embed{
+		Title:       "name",
+		Description: "Level 120",
+		Color:       tierColors[riot.TierGold],
+		Thumbnail:   &EmbedImage{URL: "https://ddragon.leagueoflegends.com/cdn/10.1.1/img/profileicon/7.png"},
+		Fields: []EmbedField{
+			{Name: "Ranked Flex", Value: "Silver I · 10 LP", Inline: true},
+			{Name: "Ranked Solo/Duo", Value: "Gold IV · 56 LP (W 1 / L 1, 50%)", Inline: true},
+			{Name: "Top champions", Value: "Ahri · level 7 · 123,456 points\nAshe · level 6 · 50,000 points\n" +
+				"Annie · level 5 · 1,000 points"},
+		},
+	}, got)
+
+	unranked := Builder{}.ProfileCard(summoner, nil, nil)
+	assert.Nil(t, unranked.Thumbnail)
+	assert.Equal(t, ColorUnranked, unranked.Color)
+	assert.Equal(t, []EmbedField{{Name: "Rank", Value: "Unranked", Inline: true}}, unranked.Fields)
+}
+
+func TestBuilder_LiveGame(t *testing.T) {
+	t.Parallel()
+	start := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	game := &riot.GameInfo{
+		GameQueueConfigID: int(riot.QueueIDRankedSolo),
+		GameStartTime:     riot.Timestamp{Time: start},
+		Participants: []*riot.CurrentGameParticipant{
+			{TeamID: 200, ChampionID: int(riot.ChampionAshe), SummonerName: "red"},
+			{TeamID: 100, ChampionID: int(riot.ChampionAhri), SummonerName: "blue"},
+		},
+		BannedChampions: []*riot.BannedChampion{{ChampionID: int(riot.ChampionAnnie)}, {ChampionID: -1}},
+	}
+	got := Builder{}.LiveGame(game, start.Add(12*time.Minute+5*time.Second))
+	assert.Equal(t, Embed{
+		Title:       "Live game · Ranked Solo/Duo",
+		Description: "In game for 12:05",
+		Timestamp:   "2020-01-01T12:00:00Z",
+		Color:       ColorLiveGame,
+		Fields: []EmbedField{
+			{Name: "Blue team", Value: "Ahri · blue", Inline: true},
+			{Name: "Red team", Value: "Ashe · red", Inline: true},
+			{Name: "Bans", Value: "Annie"},
+		},
+	}, got)
+	assert.Equal(t, "Loading", Builder{}.LiveGame(&riot.GameInfo{}, start).Description)
+}
+
+func TestBuilder_PostGame(t *testing.T) {
+	t.Parallel()
+	match := &riot.Match{
+		GameID:       1,
+		QueueID:      int(riot.QueueIDARAM),
+		GameDuration: riot.DurationSeconds{Duration: 20 * time.Minute},
+		Participants: []*riot.Participant{
+			{ParticipantID: 1, TeamID: 100, ChampionID: int(riot.ChampionAhri), Stats: &riot.ParticipantStats{
+				Win: true, Kills: 5, Deaths: 2, Assists: 7, TotalMinionsKilled: 100, TotalDamageDealtToChampions: 30000,
+				VisionScore: 12,
+			}},
+			{ParticipantID: 2, TeamID: 100, Stats: &riot.ParticipantStats{TotalDamageDealtToChampions: 10000}},
+		},
+	}
+	got := Builder{DataDragonVersion: "10.1.1"}.PostGame(match, 1)
+	assert.Equal(t, Embed{
+		Title:       "Victory · Ahri",
+		Description: "ARAM · 20:00",
+		Color:       ColorWin,
+		Thumbnail:   &EmbedImage{URL: "https://ddragon.leagueoflegends.com/cdn/10.1.1/img/champion/Ahri.png"},
+		Footer:      &EmbedFooter{Text: "Match 1"},
+		Fields: []EmbedField{
+			{Name: "KDA", Value: "5 / 2 / 7 (6.00)", Inline: true},
+			{Name: "CS", Value: "100 (5.0/min)", Inline: true},
+			{Name: "Damage", Value: "30,000 (75% of team)", Inline: true},
+			{Name: "Vision score", Value: "12", Inline: true},
+		},
+	}, got)
+	assert.Equal(t, "Participant not found", Builder{}.PostGame(match, 3).Description)
+}
+
+func TestEmbed_limits(t *testing.T) {
+	t.Parallel()
+	embed := Embed{}
+	for i := 0; i < maxFields+1; i++ {
+		embed.addField("name", strings.Repeat("ä", maxFieldValue+1), false)
+	}
+	require.Len(t, embed.Fields, maxFields)
+	assert.Equal(t, maxFieldValue, len([]rune(embed.Fields[0].Value)))
+	payload, err := json.Marshal(embed)
+	require.Nil(t, err)
+	assert.True(t, json.Valid(payload))
+	assert.Equal(t, "1,000,000", formatThousands(1000000))
+	assert.Equal(t, "-1,000", formatThousands(-1000))
+}
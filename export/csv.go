@@ -0,0 +1,197 @@
+package export
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"strconv"
+
+	"github.com/mjourard/golio/datadragon"
+	"github.com/mjourard/golio/riot"
+)
+
+// Column is a single column of a CSV export. Value returns the content of the column for the given participant of
+// the given match
+type Column struct {
+	Name  string
+	Value func(match *riot.Match, participant *riot.Participant) string
+}
+
+// All predefined columns
+var (
+	ColumnGameID = Column{
+		Name: "game_id",
+		Value: func(match *riot.Match, _ *riot.Participant) string {
+			return strconv.Itoa(match.GameID)
+		},
+	}
+	ColumnSummonerName = Column{
+		Name: "summoner_name",
+		Value: func(match *riot.Match, participant *riot.Participant) string {
+			for _, identity := range match.ParticipantIdentities {
+				if identity != nil && identity.Player != nil && identity.ParticipantID == participant.ParticipantID {
+					return identity.Player.SummonerName
+				}
+			}
+			return ""
+		},
+	}
+	ColumnChampion = Column{
+		Name: "champion_id",
+		Value: func(_ *riot.Match, participant *riot.Participant) string {
+			return strconv.Itoa(participant.ChampionID)
+		},
+	}
+	ColumnQueue = Column{
+		Name: "queue_id",
+		Value: func(match *riot.Match, _ *riot.Participant) string {
+			return strconv.Itoa(match.QueueID)
+		},
+	}
+	ColumnResult = Column{
+		Name: "result",
+		Value: func(_ *riot.Match, participant *riot.Participant) string {
+			if participant.Stats == nil {
+				return ""
+			}
+			if participant.Stats.Win {
+				return "win"
+			}
+			return "loss"
+		},
+	}
+	ColumnKills = statsColumn("kills", func(s *riot.ParticipantStats) string {
+		return strconv.Itoa(s.Kills)
+	})
+	ColumnDeaths = statsColumn("deaths", func(s *riot.ParticipantStats) string {
+		return strconv.Itoa(s.Deaths)
+	})
+	ColumnAssists = statsColumn("assists", func(s *riot.ParticipantStats) string {
+		return strconv.Itoa(s.Assists)
+	})
+	// KDA is calculated as (kills + assists) / deaths, counting zero deaths as one death
+	ColumnKDA = statsColumn("kda", func(s *riot.ParticipantStats) string {
+		deaths := s.Deaths
+		if deaths == 0 {
+			deaths = 1
+		}
+		return strconv.FormatFloat(float64(s.Kills+s.Assists)/float64(deaths), 'f', 2, 64)
+	})
+	// CS is the sum of killed minions and neutral monsters
+	ColumnCS = statsColumn("cs", func(s *riot.ParticipantStats) string {
+		return strconv.Itoa(s.TotalMinionsKilled + s.NeutralMinionsKilled)
+	})
+	// Duration of the match in seconds
+	ColumnDuration = Column{
+		Name: "duration",
+		Value: func(match *riot.Match, _ *riot.Participant) string {
+			return strconv.FormatInt(match.GameDuration.Raw(), 10)
+		},
+	}
+
+	// DefaultColumns is the list of columns used if no columns are specified
+	DefaultColumns = []Column{
+		ColumnGameID,
+		ColumnChampion,
+		ColumnQueue,
+		ColumnResult,
+		ColumnKills,
+		ColumnDeaths,
+		ColumnAssists,
+		ColumnKDA,
+		ColumnCS,
+		ColumnDuration,
+	}
+)
+
+// ChampionNameColumn returns a column containing the name of the played champion, resolved using the given Data
+// Dragon client. The column is empty if the champion can not be resolved
+func ChampionNameColumn(client *datadragon.Client) Column {
+	return Column{
+		Name: "champion",
+		Value: func(_ *riot.Match, participant *riot.Participant) string {
+			champion, err := participant.GetChampion(client)
+			if err != nil {
+				return ""
+			}
+			return champion.Name
+		},
+	}
+}
+
+// WithColumns sets the columns written by CSV. Defaults to DefaultColumns
+func WithColumns(columns ...Column) Option {
+	return func(c *config) {
+		c.columns = columns
+	}
+}
+
+// WithAllParticipants writes a row for every participant of a match to the CSV export instead of only the row for
+// the exported account
+func WithAllParticipants() Option {
+	return func(c *config) {
+		c.allParticipants = true
+	}
+}
+
+// CSV writes every match played on the account with the given ID to w as CSV with a header row followed by one row
+// per match. Matches in which the account can not be found as participant are skipped. The export stops at the first
+// error or once ctx is done.
+func CSV(ctx context.Context, client *riot.Client, w io.Writer, accountID string, options ...Option) error {
+	cfg := newConfig(options)
+	columns := cfg.columns
+	if len(columns) == 0 {
+		columns = DefaultColumns
+	}
+	writer := csv.NewWriter(w)
+	header := make([]string, len(columns))
+	for i, column := range columns {
+		header[i] = column.Name
+	}
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+	err := forEachMatch(ctx, client, accountID, cfg, func(match *riot.Match, _ *riot.MatchTimeline) error {
+		for _, participant := range match.Participants {
+			if participant == nil || !cfg.allParticipants && !isAccount(match, participant, accountID) {
+				continue
+			}
+			row := make([]string, len(columns))
+			for i, column := range columns {
+				row[i] = column.Value(match, participant)
+			}
+			if err := writer.Write(row); err != nil {
+				return err
+			}
+		}
+		writer.Flush()
+		return writer.Error()
+	})
+	if err != nil {
+		return err
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+func statsColumn(name string, value func(*riot.ParticipantStats) string) Column {
+	return Column{
+		Name: name,
+		Value: func(_ *riot.Match, participant *riot.Participant) string {
+			if participant.Stats == nil {
+				return ""
+			}
+			return value(participant.Stats)
+		},
+	}
+}
+
+func isAccount(match *riot.Match, participant *riot.Participant, accountID string) bool {
+	for _, identity := range match.ParticipantIdentities {
+		if identity == nil || identity.Player == nil || identity.ParticipantID != participant.ParticipantID {
+			continue
+		}
+		return identity.Player.AccountID == accountID || identity.Player.CurrentAccountID == accountID
+	}
+	return false
+}
@@ -0,0 +1,102 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mjourard/golio/api"
+	"github.com/mjourard/golio/datadragon"
+	"github.com/mjourard/golio/mock"
+	"github.com/mjourard/golio/riot"
+)
+
+func TestCSV(t *testing.T) {
+	t.Parallel()
+	match := riot.Match{
+		GameID:       1,
+		QueueID:      420,
+		GameDuration: riot.DurationSeconds{Duration: 30 * time.Minute},
+		ParticipantIdentities: []*riot.ParticipantIdentity{
+			{ParticipantID: 1, Player: &riot.Player{AccountID: "account", SummonerName: "player"}},
+			{ParticipantID: 2, Player: &riot.Player{AccountID: "other", SummonerName: "other player"}},
+		},
+		Participants: []*riot.Participant{
+			{
+				ParticipantID: 1,
+				ChampionID:    103,
+				Stats: &riot.ParticipantStats{
+					Win: true, Kills: 5, Deaths: 2, Assists: 7, TotalMinionsKilled: 180, NeutralMinionsKilled: 12,
+				},
+			},
+			{
+				ParticipantID: 2,
+				ChampionID:    22,
+				Stats:         &riot.ParticipantStats{Kills: 1, Assists: 2},
+			},
+		},
+	}
+	routes := map[string]interface{}{
+		"/lol/match/v4/matchlists/by-account/account": riot.Matchlist{
+			Matches: []*riot.MatchReference{{GameID: 1}},
+		},
+		"/lol/match/v4/matches/1": match,
+	}
+	dd := datadragon.NewClient(mock.NewJSONMockDoer(struct {
+		Data map[string]datadragon.ChampionData
+	}{
+		Data: map[string]datadragon.ChampionData{"Ahri": {ID: "103", Name: "Ahri"}},
+	}, 200), api.RegionEuropeWest, logrus.StandardLogger())
+	tests := []struct {
+		name    string
+		routes  map[string]interface{}
+		options []Option
+		want    string
+		wantErr error
+	}{
+		{
+			name:   "default columns",
+			routes: routes,
+			want: "game_id,champion_id,queue_id,result,kills,deaths,assists,kda,cs,duration\n" +
+				"1,103,420,win,5,2,7,6.00,192,1800\n",
+		},
+		{
+			name:    "all participants",
+			routes:  routes,
+			options: []Option{WithAllParticipants(), WithColumns(ColumnSummonerName, ColumnResult, ColumnKDA)},
+			want:    "summoner_name,result,kda\nplayer,win,6.00\nother player,loss,3.00\n",
+		},
+		{
+			name:    "champion names",
+			routes:  routes,
+			options: []Option{WithColumns(ChampionNameColumn(dd))},
+			want:    "champion\nAhri\n",
+		},
+		{
+			name: "error",
+			routes: map[string]interface{}{
+				"/lol/match/v4/matchlists/by-account/account": routes["/lol/match/v4/matchlists/by-account/account"],
+				"/lol/match/v4/matches/1":                     http.StatusForbidden,
+			},
+			wantErr: api.ErrForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := riot.NewClient(api.RegionEuropeWest, "API_KEY", routeDoer(tt.routes), logrus.StandardLogger())
+			buf := &bytes.Buffer{}
+			err := CSV(context.Background(), client, buf, "account", tt.options...)
+			require.True(t, errors.Is(err, tt.wantErr))
+			if tt.wantErr == nil {
+				assert.Equal(t, tt.want, buf.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+// Package export provides methods for exporting the match history of an account into common file formats.
+// The exporters page through the complete match history using the Riot API client, fetch every match and write the
+// results to a caller-provided io.Writer.
+package export
+
+import (
+	"context"
+	"io"
+
+	"github.com/mjourard/golio/riot"
+)
+
+// Progress contains information about the state of a running export
+type Progress struct {
+	// Number of matches exported so far, including the current match
+	Exported int
+	// Reference to the match that was just exported
+	Match *riot.MatchReference
+}
+
+// Option is used to alter the behaviour of an export
+type Option func(*config)
+
+type config struct {
+	filter          *riot.MatchFilter
+	timelines       bool
+	progress        func(Progress)
+	columns         []Column
+	allParticipants bool
+}
+
+// WithFilter restricts the export to matches matching the given filter. Index values of the filter are ignored
+func WithFilter(filter *riot.MatchFilter) Option {
+	return func(c *config) {
+		c.filter = filter
+	}
+}
+
+// WithTimelines additionally exports the timeline of every match. Matches without an available timeline are
+// exported without one
+func WithTimelines() Option {
+	return func(c *config) {
+		c.timelines = true
+	}
+}
+
+// WithProgress sets a callback which is called after every exported match
+func WithProgress(f func(Progress)) Option {
+	return func(c *config) {
+		c.progress = f
+	}
+}
+
+func newConfig(options []Option) *config {
+	c := &config{}
+	for _, opt := range options {
+		opt(c)
+	}
+	if c.filter == nil {
+		c.filter = riot.NewMatchFilter()
+	}
+	return c
+}
+
+// forEachMatch fetches every match in the history of the account and calls f with the match and, if enabled, its
+// timeline
+func forEachMatch(ctx context.Context, client *riot.Client, accountID string, cfg *config,
+	f func(*riot.Match, *riot.MatchTimeline) error) error {
+	option := riot.ListStreamWithMatches()
+	if cfg.timelines {
+		option = riot.ListStreamWithTimelines()
+	}
+	// the stream pages and fetches the matches using ctx, so cancelling it when returning early stops the requests
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	values := client.WithContext(ctx).Match.ListStream(accountID, cfg.filter, option)
+	// the stream ends with an error value, which has to be received to release the producer when returning early
+	done := false
+	defer func() {
+		if !done {
+			go drain(values)
+		}
+	}()
+	exported := 0
+	for value := range values {
+		if value.Error != nil {
+			done = true
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if value.Error == io.EOF {
+			return nil
+		} else if value.Error != nil {
+			return value.Error
+		}
+		if err := f(value.Match, value.Timeline); err != nil {
+			return err
+		}
+		exported++
+		if cfg.progress != nil {
+			cfg.progress(Progress{Exported: exported, Match: value.MatchReference})
+		}
+	}
+	return nil
+}
+
+func drain(values <-chan riot.MatchStreamValue) {
+	for value := range values {
+		if value.Error != nil {
+			return
+		}
+	}
+}
@@ -0,0 +1,24 @@
+package export
+
+import (
+	"net/http"
+
+	"github.com/mjourard/golio/internal"
+	"github.com/mjourard/golio/mock"
+)
+
+// routeDoer responds with the object registered for the path of the request and 404 for all unknown paths
+func routeDoer(routes map[string]interface{}) internal.Doer {
+	return &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			object, ok := routes[r.URL.Path]
+			if !ok {
+				return mock.NewStatusMockDoer(http.StatusNotFound).Do(r)
+			}
+			if code, ok := object.(int); ok {
+				return mock.NewStatusMockDoer(code).Do(r)
+			}
+			return mock.NewJSONMockDoer(object, http.StatusOK).Do(r)
+		},
+	}
+}
@@ -0,0 +1,25 @@
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+
+	"github.com/mjourard/golio/riot"
+)
+
+// Record is a single line written by JSONL
+type Record struct {
+	Match    *riot.Match         `json:"match"`
+	Timeline *riot.MatchTimeline `json:"timeline,omitempty"`
+}
+
+// JSONL writes every match played on the account with the given ID to w as newline delimited JSON, one Record per
+// line. The export stops at the first error or once ctx is done.
+func JSONL(ctx context.Context, client *riot.Client, w io.Writer, accountID string, options ...Option) error {
+	encoder := json.NewEncoder(w)
+	return forEachMatch(ctx, client, accountID, newConfig(options), func(match *riot.Match,
+		timeline *riot.MatchTimeline) error {
+		return encoder.Encode(Record{Match: match, Timeline: timeline})
+	})
+}
@@ -0,0 +1,158 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mjourard/golio/api"
+	"github.com/mjourard/golio/mock"
+	"github.com/mjourard/golio/riot"
+)
+
+func TestJSONL(t *testing.T) {
+	t.Parallel()
+	history := map[string]interface{}{
+		"/lol/match/v4/matchlists/by-account/account": riot.Matchlist{
+			Matches: []*riot.MatchReference{{GameID: 1}, {GameID: 2}},
+		},
+		"/lol/match/v4/matches/1":            riot.Match{GameID: 1},
+		"/lol/match/v4/matches/2":            riot.Match{GameID: 2},
+		"/lol/match/v4/timelines/by-match/1": riot.MatchTimeline{Interval: riot.DurationMillis{Duration: time.Minute}},
+	}
+	tests := []struct {
+		name    string
+		routes  map[string]interface{}
+		options []Option
+		want    []Record
+		wantErr error
+	}{
+		{
+			name:   "matches",
+			routes: history,
+			want: []Record{
+				{Match: &riot.Match{GameID: 1}},
+				{Match: &riot.Match{GameID: 2}},
+			},
+		},
+		{
+			name:    "matches with timelines",
+			routes:  history,
+			options: []Option{WithTimelines()},
+			want: []Record{
+				{
+					Match:    &riot.Match{GameID: 1},
+					Timeline: &riot.MatchTimeline{Interval: riot.DurationMillis{Duration: time.Minute}},
+				},
+				{Match: &riot.Match{GameID: 2}},
+			},
+		},
+		{
+			name: "match error",
+			routes: map[string]interface{}{
+				"/lol/match/v4/matchlists/by-account/account": history["/lol/match/v4/matchlists/by-account/account"],
+				"/lol/match/v4/matches/1":                     http.StatusForbidden,
+			},
+			wantErr: api.ErrForbidden,
+		},
+		{
+			name: "timeline error",
+			routes: map[string]interface{}{
+				"/lol/match/v4/matchlists/by-account/account": history["/lol/match/v4/matchlists/by-account/account"],
+				"/lol/match/v4/matches/1":                     riot.Match{GameID: 1},
+				"/lol/match/v4/timelines/by-match/1":          http.StatusForbidden,
+			},
+			options: []Option{WithTimelines()},
+			wantErr: api.ErrForbidden,
+		},
+		{
+			name:    "matchlist error",
+			routes:  map[string]interface{}{},
+			wantErr: api.ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := riot.NewClient(api.RegionEuropeWest, "API_KEY", routeDoer(tt.routes), logrus.StandardLogger())
+			var progress []int
+			options := append(tt.options, WithProgress(func(p Progress) {
+				progress = append(progress, p.Match.GameID)
+			}))
+			buf := &bytes.Buffer{}
+			err := JSONL(context.Background(), client, buf, "account", options...)
+			require.True(t, errors.Is(err, tt.wantErr))
+			if tt.wantErr != nil {
+				return
+			}
+			var got []Record
+			decoder := json.NewDecoder(buf)
+			for decoder.More() {
+				var record Record
+				require.Nil(t, decoder.Decode(&record))
+				got = append(got, record)
+			}
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, []int{1, 2}, progress)
+		})
+	}
+}
+
+func TestJSONL_Cancelled(t *testing.T) {
+	t.Parallel()
+	client := riot.NewClient(api.RegionEuropeWest, "API_KEY", routeDoer(map[string]interface{}{
+		"/lol/match/v4/matchlists/by-account/account": riot.Matchlist{Matches: []*riot.MatchReference{{GameID: 1}}},
+	}), logrus.StandardLogger())
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := JSONL(ctx, client, &bytes.Buffer{}, "account")
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestJSONL_stopsRequests(t *testing.T) {
+	t.Parallel()
+	routes := map[string]interface{}{}
+	var references []*riot.MatchReference
+	for i := 1; i <= 100; i++ {
+		references = append(references, &riot.MatchReference{GameID: i})
+		routes[fmt.Sprintf("/lol/match/v4/matches/%d", i)] = riot.Match{GameID: i}
+	}
+	routes["/lol/match/v4/matchlists/by-account/account"] = riot.Matchlist{Matches: references}
+	doer := routeDoer(routes)
+	var requests int32
+	counting := &mock.Doer{Custom: func(r *http.Request) (*http.Response, error) {
+		// like http.Client, requests of a cancelled context fail
+		if err := r.Context().Err(); err != nil {
+			return nil, err
+		}
+		atomic.AddInt32(&requests, 1)
+		if r.URL.Query().Get("beginIndex") == "100" {
+			return mock.NewJSONMockDoer(riot.Matchlist{}, http.StatusOK).Do(r)
+		}
+		time.Sleep(time.Millisecond)
+		return doer.Do(r)
+	}}
+	client := riot.NewClient(api.RegionEuropeWest, "API_KEY", counting, logrus.StandardLogger())
+	err := JSONL(context.Background(), client, failingWriter{}, "account")
+	assert.Equal(t, errWrite, err)
+	// the stream is cancelled, so the remaining matches of the history are not fetched after returning
+	time.Sleep(50 * time.Millisecond)
+	assert.Less(t, atomic.LoadInt32(&requests), int32(10))
+}
+
+var errWrite = errors.New("write failed")
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errWrite
+}
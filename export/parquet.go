@@ -0,0 +1,167 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/mjourard/golio/riot"
+)
+
+// ParticipantRow is the flattened record of a participant of a match written by Parquet
+type ParticipantRow struct {
+	GameID     int64
+	PlatformID string
+	QueueID    int64
+	// Patch is the major and minor version of the game, e.g. 10.1
+	Patch string
+	// GameCreation in milliseconds since the Unix epoch
+	GameCreation int64
+	// Duration of the match in seconds
+	Duration          int64
+	ParticipantID     int64
+	TeamID            int64
+	ChampionID        int64
+	SummonerName      string
+	Win               bool
+	Kills             int64
+	Deaths            int64
+	Assists           int64
+	KDA               float64
+	CS                int64
+	GoldEarned        int64
+	DamageToChampions int64
+	VisionScore       int64
+}
+
+// parquetColumns are the columns of the Parquet files in the order of the fields of ParticipantRow
+var parquetColumns = []parquetColumn{
+	{name: "game_id", kind: parquetInt64, converted: -1},
+	{name: "platform_id", kind: parquetByteArray, converted: parquetUTF8},
+	{name: "queue_id", kind: parquetInt64, converted: -1},
+	{name: "patch", kind: parquetByteArray, converted: parquetUTF8},
+	{name: "game_creation", kind: parquetInt64, converted: parquetTimestampMillis},
+	{name: "duration", kind: parquetInt64, converted: -1},
+	{name: "participant_id", kind: parquetInt64, converted: -1},
+	{name: "team_id", kind: parquetInt64, converted: -1},
+	{name: "champion_id", kind: parquetInt64, converted: -1},
+	{name: "summoner_name", kind: parquetByteArray, converted: parquetUTF8},
+	{name: "win", kind: parquetBoolean, converted: -1},
+	{name: "kills", kind: parquetInt64, converted: -1},
+	{name: "deaths", kind: parquetInt64, converted: -1},
+	{name: "assists", kind: parquetInt64, converted: -1},
+	{name: "kda", kind: parquetDouble, converted: -1},
+	{name: "cs", kind: parquetInt64, converted: -1},
+	{name: "gold_earned", kind: parquetInt64, converted: -1},
+	{name: "damage_to_champions", kind: parquetInt64, converted: -1},
+	{name: "vision_score", kind: parquetInt64, converted: -1},
+}
+
+// NewParticipantRow returns the flattened record of the participant of the match
+func NewParticipantRow(match *riot.Match, participant *riot.Participant) ParticipantRow {
+	row := ParticipantRow{
+		GameID:        int64(match.GameID),
+		PlatformID:    match.PlatformID,
+		QueueID:       int64(match.QueueID),
+		Patch:         Patch(match.GameVersion),
+		GameCreation:  match.GameCreation.Millis(),
+		Duration:      match.GameDuration.Raw(),
+		ParticipantID: int64(participant.ParticipantID),
+		TeamID:        int64(participant.TeamID),
+		ChampionID:    int64(participant.ChampionID),
+		SummonerName:  ColumnSummonerName.Value(match, participant),
+		KDA:           participant.KDA(),
+	}
+	if s := participant.Stats; s != nil {
+		row.Win = s.Win
+		row.Kills = int64(s.Kills)
+		row.Deaths = int64(s.Deaths)
+		row.Assists = int64(s.Assists)
+		row.CS = int64(s.TotalMinionsKilled + s.NeutralMinionsKilled)
+		row.GoldEarned = int64(s.GoldEarned)
+		row.DamageToChampions = int64(s.TotalDamageDealtToChampions)
+		row.VisionScore = int64(s.VisionScore)
+	}
+	return row
+}
+
+// Patch returns the major and minor version of a game version, e.g. 10.1 for 10.1.305.4546
+func Patch(gameVersion string) string {
+	parts := strings.SplitN(gameVersion, ".", 3)
+	if len(parts) < 2 {
+		return gameVersion
+	}
+	return parts[0] + "." + parts[1]
+}
+
+// WriteParquet writes the rows to w as a single Parquet file
+func WriteParquet(w io.Writer, rows []ParticipantRow) error {
+	table := newParquetTable(parquetColumns)
+	for _, r := range rows {
+		table.append(r.GameID, r.PlatformID, r.QueueID, r.Patch, r.GameCreation, r.Duration, r.ParticipantID,
+			r.TeamID, r.ChampionID, r.SummonerName, r.Win, r.Kills, r.Deaths, r.Assists, r.KDA, r.CS, r.GoldEarned,
+			r.DamageToChampions, r.VisionScore)
+	}
+	return table.writeTo(w)
+}
+
+// Parquet writes every match played on the account with the given ID to Parquet files in dir, which can be read by
+// DuckDB, Spark or pandas. The files are partitioned by patch and queue in the layout of Hive, e.g.
+// dir/patch=10.1/queue=420/matches.parquet, and contain one ParticipantRow per participant. Only the participant of
+// the account is written unless WithAllParticipants is used. The files are written once all matches were fetched.
+// The export stops at the first error or once ctx is done.
+func Parquet(ctx context.Context, client *riot.Client, dir, accountID string, options ...Option) error {
+	cfg := newConfig(options)
+	type partition struct {
+		patch string
+		queue int64
+	}
+	partitions := map[partition][]ParticipantRow{}
+	err := forEachMatch(ctx, client, accountID, cfg, func(match *riot.Match, _ *riot.MatchTimeline) error {
+		for _, participant := range match.Participants {
+			if participant == nil || !cfg.allParticipants && !isAccount(match, participant, accountID) {
+				continue
+			}
+			row := NewParticipantRow(match, participant)
+			key := partition{patch: row.Patch, queue: row.QueueID}
+			partitions[key] = append(partitions[key], row)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	keys := make([]partition, 0, len(partitions))
+	for key := range partitions {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].patch < keys[j].patch || keys[i].patch == keys[j].patch && keys[i].queue < keys[j].queue
+	})
+	for _, key := range keys {
+		path := filepath.Join(dir, "patch="+key.patch, fmt.Sprintf("queue=%d", key.queue), "matches.parquet")
+		if err := writeParquetFile(path, partitions[key]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeParquetFile(path string, rows []ParticipantRow) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := WriteParquet(file, rows); err != nil {
+		_ = file.Close()
+		return err
+	}
+	return file.Close()
+}
@@ -0,0 +1,290 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mjourard/golio/api"
+	"github.com/mjourard/golio/riot"
+)
+
+func TestWriteParquet(t *testing.T) {
+	t.Parallel()
+	buf := &bytes.Buffer{}
+	require.Nil(t, WriteParquet(buf, []ParticipantRow{{GameID: 1, Patch: "10.1", Win: true}, {GameID: 2}}))
+	file := buf.Bytes()
+	require.True(t, len(file) > 12)
+	assert.Equal(t, parquetMagic, string(file[:4]))
+	assert.Equal(t, parquetMagic, string(file[len(file)-4:]))
+	metaLength := int(binary.LittleEndian.Uint32(file[len(file)-8:]))
+	require.True(t, metaLength < len(file)-12)
+	meta := file[len(file)-8-metaLength : len(file)-8]
+	for _, column := range parquetColumns {
+		assert.Contains(t, string(meta), column.name)
+	}
+}
+
+func TestEncodePlain(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, []byte{0x05, 0x01}, encodePlain(parquetBoolean, []interface{}{
+		true, false, true, false, false, false, false, false, true,
+	}))
+	assert.Equal(t, []byte{2, 0, 0, 0, 'a', 'b'}, encodePlain(parquetByteArray, []interface{}{"ab"}))
+	assert.Equal(t, []byte{1, 0, 0, 0, 0, 0, 0, 0}, encodePlain(parquetInt64, []interface{}{int64(1)}))
+}
+
+func TestThriftWriter(t *testing.T) {
+	t.Parallel()
+	w := &thriftWriter{}
+	w.i32(1, -1)
+	w.i64(20, 2)
+	w.structBegin(21)
+	w.binary(1, "a")
+	w.structEnd()
+	w.listBegin(22, thriftI32, 16)
+	w.stop()
+	assert.Equal(t, []byte{
+		0x15, 0x01, // field 1, i32 -1
+		0x06, 0x28, 0x04, // field 20 with long form, i64 2
+		0x1c, 0x18, 0x01, 'a', 0x00, // struct field 21 containing field 1
+		0x19, 0xf5, 0x10, // list field 22 of 16 i32
+		0x00,
+	}, w.buf.Bytes())
+}
+
+func TestPatch(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "10.1", Patch("10.1.305.4546"))
+	assert.Equal(t, "10", Patch("10"))
+}
+
+func TestParquet(t *testing.T) {
+	t.Parallel()
+	match := func(id, queue int, version string) riot.Match {
+		return riot.Match{
+			GameID:      id,
+			QueueID:     queue,
+			GameVersion: version,
+			ParticipantIdentities: []*riot.ParticipantIdentity{
+				{ParticipantID: 1, Player: &riot.Player{AccountID: "account", SummonerName: "player"}},
+				{ParticipantID: 2, Player: &riot.Player{AccountID: "other"}},
+			},
+			Participants: []*riot.Participant{
+				{ParticipantID: 1, Stats: &riot.ParticipantStats{Kills: 3, Win: true}},
+				{ParticipantID: 2, Stats: &riot.ParticipantStats{}},
+			},
+		}
+	}
+	client := riot.NewClient(api.RegionEuropeWest, "API_KEY", routeDoer(map[string]interface{}{
+		"/lol/match/v4/matchlists/by-account/account": riot.Matchlist{
+			Matches: []*riot.MatchReference{{GameID: 1}, {GameID: 2}, {GameID: 3}},
+		},
+		"/lol/match/v4/matches/1": match(1, 420, "10.1.305.4546"),
+		"/lol/match/v4/matches/2": match(2, 420, "10.2.1"),
+		"/lol/match/v4/matches/3": match(3, 450, "10.2.3"),
+	}), logrus.StandardLogger())
+	dir := t.TempDir()
+	require.Nil(t, Parquet(context.Background(), client, dir, "account"))
+	for _, path := range []string{"patch=10.1/queue=420", "patch=10.2/queue=420", "patch=10.2/queue=450"} {
+		info, err := os.Stat(filepath.Join(dir, path, "matches.parquet"))
+		require.Nil(t, err, path)
+		assert.True(t, info.Size() > 0)
+	}
+	row := NewParticipantRow(&riot.Match{GameID: 1, GameVersion: "10.1.1"}, &riot.Participant{
+		ParticipantID: 1,
+		Stats:         &riot.ParticipantStats{Kills: 3, Win: true},
+	})
+	assert.Equal(t, ParticipantRow{GameID: 1, Patch: "10.1", ParticipantID: 1, Kills: 3, Win: true, KDA: 3}, row)
+}
+
+func TestWriteParquet_roundTrip(t *testing.T) {
+	t.Parallel()
+	var rows []ParticipantRow
+	for i := int64(0); i < 10; i++ {
+		rows = append(rows, ParticipantRow{
+			GameID: 4000000000 + i, PlatformID: "EUW1", QueueID: 420, Patch: "10.1", GameCreation: 1580000000000 + i,
+			Duration: 1800 + i, ParticipantID: i + 1, TeamID: 100 + 100*(i/5), ChampionID: 10 * i,
+			SummonerName: []string{"player", "", "Sömé Näme"}[i%3], Win: i%3 == 0, Kills: i, Deaths: i % 4,
+			Assists: 2 * i, KDA: float64(i) / 4, CS: -i, GoldEarned: 10000 + i, DamageToChampions: 20000 + i,
+			VisionScore: i * i,
+		})
+	}
+	buf := &bytes.Buffer{}
+	require.Nil(t, WriteParquet(buf, rows))
+
+	columns, numRows := readParquet(t, buf.Bytes())
+	assert.Equal(t, int64(len(rows)), numRows)
+	require.Len(t, columns, len(parquetColumns))
+	for i, column := range parquetColumns {
+		want := make([]interface{}, len(rows))
+		for j, row := range rows {
+			// the columns are in the order of the fields of ParticipantRow
+			want[j] = reflect.ValueOf(row).Field(i).Interface()
+		}
+		assert.Equal(t, want, columns[column.name], column.name)
+	}
+}
+
+// readParquet decodes the footer of a Parquet file written by WriteParquet and the data pages of its columns. It
+// returns the values by column name and the number of rows
+func readParquet(t *testing.T, file []byte) (map[string][]interface{}, int64) {
+	require.True(t, len(file) > 12)
+	require.Equal(t, parquetMagic, string(file[:4]))
+	require.Equal(t, parquetMagic, string(file[len(file)-4:]))
+	metaLength := int(binary.LittleEndian.Uint32(file[len(file)-8:]))
+	require.True(t, metaLength < len(file)-12)
+	meta := (&thriftReader{buf: file[len(file)-8-metaLength : len(file)-8]}).readStruct(t)
+
+	schema := meta[2].([]interface{})
+	require.Len(t, schema, len(parquetColumns)+1)
+	assert.Equal(t, int64(len(parquetColumns)), schema[0].(map[int16]interface{})[5])
+	kinds := map[string]int64{}
+	for i, element := range schema[1:] {
+		element := element.(map[int16]interface{})
+		name := string(element[4].([]byte))
+		assert.Equal(t, parquetColumns[i].name, name)
+		assert.Equal(t, int64(parquetColumns[i].kind), element[1])
+		assert.Equal(t, int64(parquetRequired), element[3])
+		kinds[name] = element[1].(int64)
+	}
+	numRows := meta[3].(int64)
+
+	rowGroups := meta[4].([]interface{})
+	require.Len(t, rowGroups, 1)
+	columns := map[string][]interface{}{}
+	for _, chunk := range rowGroups[0].(map[int16]interface{})[1].([]interface{}) {
+		chunkMeta := chunk.(map[int16]interface{})[3].(map[int16]interface{})
+		path := chunkMeta[3].([]interface{})
+		require.Len(t, path, 1)
+		name := string(path[0].([]byte))
+		assert.Equal(t, kinds[name], chunkMeta[1], name)
+		assert.Equal(t, int64(parquetUncompressed), chunkMeta[4], name)
+		assert.Equal(t, numRows, chunkMeta[5], name)
+
+		offset := chunkMeta[9].(int64)
+		reader := &thriftReader{buf: file[offset:]}
+		header := reader.readStruct(t)
+		assert.Equal(t, int64(parquetDataPage), header[1], name)
+		pageHeader := header[5].(map[int16]interface{})
+		assert.Equal(t, int64(parquetPlain), pageHeader[2], name)
+		values := int(pageHeader[1].(int64))
+		size := int(header[3].(int64))
+		require.True(t, reader.pos+size <= len(reader.buf), name)
+		assert.Equal(t, chunkMeta[7], int64(reader.pos+size), name)
+		columns[name] = decodePlain(t, kinds[name], values, reader.buf[reader.pos:reader.pos+size])
+	}
+	return columns, numRows
+}
+
+// decodePlain decodes n values of the physical type using the plain encoding of Parquet
+func decodePlain(t *testing.T, kind int64, n int, data []byte) []interface{} {
+	values := make([]interface{}, 0, n)
+	for i := 0; i < n; i++ {
+		switch kind {
+		case parquetBoolean:
+			require.True(t, i/8 < len(data))
+			values = append(values, data[i/8]&(1<<(i%8)) != 0)
+		case parquetInt64:
+			require.True(t, len(data) >= 8)
+			values = append(values, int64(binary.LittleEndian.Uint64(data)))
+			data = data[8:]
+		case parquetDouble:
+			require.True(t, len(data) >= 8)
+			values = append(values, math.Float64frombits(binary.LittleEndian.Uint64(data)))
+			data = data[8:]
+		case parquetByteArray:
+			require.True(t, len(data) >= 4)
+			length := int(binary.LittleEndian.Uint32(data))
+			require.True(t, len(data) >= 4+length)
+			values = append(values, string(data[4:4+length]))
+			data = data[4+length:]
+		default:
+			t.Fatalf("unknown physical type %d", kind)
+		}
+	}
+	if kind != parquetBoolean {
+		assert.Empty(t, data)
+	}
+	return values
+}
+
+// thriftReader decodes structs encoded using the Thrift compact protocol. Structs are decoded to maps by field ID,
+// integers to int64, binary fields to []byte and lists to []interface{}
+type thriftReader struct {
+	buf []byte
+	pos int
+}
+
+func (r *thriftReader) readStruct(t *testing.T) map[int16]interface{} {
+	fields := map[int16]interface{}{}
+	var id int16
+	for {
+		require.True(t, r.pos < len(r.buf), "truncated struct")
+		header := r.buf[r.pos]
+		r.pos++
+		if header == 0 {
+			return fields
+		}
+		kind := header & 0x0f
+		if delta := int16(header >> 4); delta != 0 {
+			id += delta
+		} else {
+			id = int16(unzigzag(r.varint(t)))
+		}
+		fields[id] = r.readValue(t, kind)
+	}
+}
+
+func (r *thriftReader) readValue(t *testing.T, kind byte) interface{} {
+	switch kind {
+	case 1, 2:
+		// booleans are encoded in the type of the field
+		return kind == 1
+	case thriftI32, thriftI64:
+		return unzigzag(r.varint(t))
+	case thriftBinary:
+		length := int(r.varint(t))
+		require.True(t, r.pos+length <= len(r.buf), "truncated binary")
+		value := r.buf[r.pos : r.pos+length]
+		r.pos += length
+		return value
+	case thriftList:
+		require.True(t, r.pos < len(r.buf), "truncated list")
+		header := r.buf[r.pos]
+		r.pos++
+		n := int(header >> 4)
+		if n == 15 {
+			n = int(r.varint(t))
+		}
+		list := make([]interface{}, n)
+		for i := range list {
+			list[i] = r.readValue(t, header&0x0f)
+		}
+		return list
+	case thriftStruct:
+		return r.readStruct(t)
+	}
+	t.Fatalf("unsupported Thrift type %d", kind)
+	return nil
+}
+
+func (r *thriftReader) varint(t *testing.T) uint64 {
+	value, n := binary.Uvarint(r.buf[r.pos:])
+	require.True(t, n > 0, "malformed varint")
+	r.pos += n
+	return value
+}
+
+func unzigzag(value uint64) int64 {
+	return int64(value>>1) ^ -int64(value&1)
+}
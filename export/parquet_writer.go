@@ -0,0 +1,266 @@
+package export
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math"
+)
+
+// This file contains a minimal writer of Parquet files: a flat schema of required columns, written as a single row
+// group with one uncompressed, plain encoded data page per column. The metadata is encoded using the Thrift compact
+// protocol, see https://github.com/apache/parquet-format
+
+const parquetMagic = "PAR1"
+
+// Physical types of Parquet
+const (
+	parquetBoolean   = 0
+	parquetInt64     = 2
+	parquetDouble    = 5
+	parquetByteArray = 6
+)
+
+// Converted types of Parquet
+const (
+	parquetUTF8            = 0
+	parquetTimestampMillis = 9
+)
+
+const (
+	parquetRequired     = 0
+	parquetPlain        = 0
+	parquetRLE          = 3
+	parquetUncompressed = 0
+	parquetDataPage     = 0
+)
+
+// parquetColumn is a column of a Parquet file
+type parquetColumn struct {
+	name string
+	// kind is the physical type of the column
+	kind int32
+	// converted is the converted type of the column or -1
+	converted int32
+}
+
+// parquetTable contains the values of the rows of a file by column. The values of a column are bool, int64, float64
+// or string depending on the type of the column
+type parquetTable struct {
+	columns []parquetColumn
+	values  [][]interface{}
+	rows    int
+}
+
+func newParquetTable(columns []parquetColumn) *parquetTable {
+	return &parquetTable{columns: columns, values: make([][]interface{}, len(columns))}
+}
+
+// append adds a row containing a value for every column
+func (t *parquetTable) append(row ...interface{}) {
+	for i, value := range row {
+		t.values[i] = append(t.values[i], value)
+	}
+	t.rows++
+}
+
+// writeTo writes the table as Parquet file to w
+func (t *parquetTable) writeTo(w io.Writer) error {
+	var file bytes.Buffer
+	file.WriteString(parquetMagic)
+	type chunk struct {
+		offset int64
+		size   int64
+	}
+	chunks := make([]chunk, len(t.columns))
+	var totalSize int64
+	for i, column := range t.columns {
+		data := encodePlain(column.kind, t.values[i])
+		header := &thriftWriter{}
+		header.i32(1, parquetDataPage)
+		header.i32(2, int32(len(data)))
+		header.i32(3, int32(len(data)))
+		header.structBegin(5)
+		header.i32(1, int32(t.rows))
+		header.i32(2, parquetPlain)
+		header.i32(3, parquetRLE)
+		header.i32(4, parquetRLE)
+		header.structEnd()
+		header.stop()
+		chunks[i] = chunk{offset: int64(file.Len()), size: int64(header.buf.Len() + len(data))}
+		totalSize += chunks[i].size
+		file.Write(header.buf.Bytes())
+		file.Write(data)
+	}
+	meta := &thriftWriter{}
+	meta.i32(1, 1)
+	meta.listBegin(2, thriftStruct, len(t.columns)+1)
+	meta.elementBegin()
+	meta.binary(4, "schema")
+	meta.i32(5, int32(len(t.columns)))
+	meta.elementEnd()
+	for _, column := range t.columns {
+		meta.elementBegin()
+		meta.i32(1, column.kind)
+		meta.i32(3, parquetRequired)
+		meta.binary(4, column.name)
+		if column.converted >= 0 {
+			meta.i32(6, column.converted)
+		}
+		meta.elementEnd()
+	}
+	meta.i64(3, int64(t.rows))
+	meta.listBegin(4, thriftStruct, 1)
+	meta.elementBegin()
+	meta.listBegin(1, thriftStruct, len(t.columns))
+	for i, column := range t.columns {
+		meta.elementBegin()
+		meta.i64(2, chunks[i].offset)
+		meta.structBegin(3)
+		meta.i32(1, column.kind)
+		meta.listBegin(2, thriftI32, 2)
+		meta.varint(zigzag(parquetPlain))
+		meta.varint(zigzag(parquetRLE))
+		meta.listBegin(3, thriftBinary, 1)
+		meta.varint(uint64(len(column.name)))
+		meta.buf.WriteString(column.name)
+		meta.i32(4, parquetUncompressed)
+		meta.i64(5, int64(t.rows))
+		meta.i64(6, chunks[i].size)
+		meta.i64(7, chunks[i].size)
+		meta.i64(9, chunks[i].offset)
+		meta.structEnd()
+		meta.elementEnd()
+	}
+	meta.i64(2, totalSize)
+	meta.i64(3, int64(t.rows))
+	meta.elementEnd()
+	meta.binary(6, "golio")
+	meta.stop()
+	file.Write(meta.buf.Bytes())
+	_ = binary.Write(&file, binary.LittleEndian, uint32(meta.buf.Len()))
+	file.WriteString(parquetMagic)
+	_, err := w.Write(file.Bytes())
+	return err
+}
+
+// encodePlain encodes the values using the plain encoding of Parquet
+func encodePlain(kind int32, values []interface{}) []byte {
+	var buf bytes.Buffer
+	switch kind {
+	case parquetBoolean:
+		packed := make([]byte, (len(values)+7)/8)
+		for i, value := range values {
+			if value.(bool) {
+				packed[i/8] |= 1 << (i % 8)
+			}
+		}
+		buf.Write(packed)
+	case parquetInt64:
+		for _, value := range values {
+			_ = binary.Write(&buf, binary.LittleEndian, value.(int64))
+		}
+	case parquetDouble:
+		for _, value := range values {
+			_ = binary.Write(&buf, binary.LittleEndian, math.Float64bits(value.(float64)))
+		}
+	case parquetByteArray:
+		for _, value := range values {
+			s := value.(string)
+			_ = binary.Write(&buf, binary.LittleEndian, uint32(len(s)))
+			buf.WriteString(s)
+		}
+	}
+	return buf.Bytes()
+}
+
+// Types of the Thrift compact protocol
+const (
+	thriftI32    = 5
+	thriftI64    = 6
+	thriftBinary = 8
+	thriftList   = 9
+	thriftStruct = 12
+)
+
+// thriftWriter encodes structs using the Thrift compact protocol
+type thriftWriter struct {
+	buf bytes.Buffer
+	// last contains the ID of the last written field of every open struct
+	last []int16
+	id   int16
+}
+
+func (w *thriftWriter) field(id int16, kind byte) {
+	delta := id - w.id
+	if delta > 0 && delta <= 15 {
+		w.buf.WriteByte(byte(delta)<<4 | kind)
+	} else {
+		w.buf.WriteByte(kind)
+		w.varint(zigzag(int64(id)))
+	}
+	w.id = id
+}
+
+func (w *thriftWriter) i32(id int16, value int32) {
+	w.field(id, thriftI32)
+	w.varint(zigzag(int64(value)))
+}
+
+func (w *thriftWriter) i64(id int16, value int64) {
+	w.field(id, thriftI64)
+	w.varint(zigzag(value))
+}
+
+func (w *thriftWriter) binary(id int16, value string) {
+	w.field(id, thriftBinary)
+	w.varint(uint64(len(value)))
+	w.buf.WriteString(value)
+}
+
+// structBegin starts a struct field, which is ended by structEnd
+func (w *thriftWriter) structBegin(id int16) {
+	w.field(id, thriftStruct)
+	w.elementBegin()
+}
+
+func (w *thriftWriter) structEnd() {
+	w.elementEnd()
+}
+
+// listBegin starts a list field with n elements of the type, which are written directly after it
+func (w *thriftWriter) listBegin(id int16, kind byte, n int) {
+	w.field(id, thriftList)
+	if n < 15 {
+		w.buf.WriteByte(byte(n)<<4 | kind)
+		return
+	}
+	w.buf.WriteByte(0xf0 | kind)
+	w.varint(uint64(n))
+}
+
+// elementBegin starts a struct without a field header, e.g. an element of a list
+func (w *thriftWriter) elementBegin() {
+	w.last = append(w.last, w.id)
+	w.id = 0
+}
+
+func (w *thriftWriter) elementEnd() {
+	w.stop()
+	w.id = w.last[len(w.last)-1]
+	w.last = w.last[:len(w.last)-1]
+}
+
+// stop ends the current struct
+func (w *thriftWriter) stop() {
+	w.buf.WriteByte(0)
+}
+
+func (w *thriftWriter) varint(value uint64) {
+	var buf [binary.MaxVarintLen64]byte
+	w.buf.Write(buf[:binary.PutUvarint(buf[:], value)])
+}
+
+func zigzag(value int64) uint64 {
+	return uint64(value<<1) ^ uint64(value>>63)
+}
@@ -0,0 +1,81 @@
+package fake
+
+import (
+	"sort"
+	"time"
+
+	"github.com/mjourard/golio/riot"
+)
+
+const (
+	freeChampions          = 15
+	freeChampionsNewPlayer = 10
+	maxNewPlayerLevel      = 10
+)
+
+// masteryLevelPoints are the champion points required for mastery levels 1 to 5. Higher levels require tokens
+var masteryLevelPoints = []int{0, 1800, 6000, 12600, 21600}
+
+// ChampionMasteries returns random masteries of the given summoner for count different champions, sorted by points
+// in descending order like the Riot API
+func (g *Generator) ChampionMasteries(summoner *riot.Summoner, count int) []*riot.ChampionMastery {
+	count = min(count, len(championIDs))
+	masteries := make([]*riot.ChampionMastery, 0, count)
+	for _, i := range g.rand.Perm(len(championIDs))[:count] {
+		masteries = append(masteries, g.championMastery(summoner, championIDs[i]))
+	}
+	sort.SliceStable(masteries, func(i, j int) bool {
+		return masteries[i].ChampionPoints > masteries[j].ChampionPoints
+	})
+	return masteries
+}
+
+func (g *Generator) championMastery(summoner *riot.Summoner, championID int) *riot.ChampionMastery {
+	// most champions are only played a few times, so points are skewed towards low values
+	points := int(float64(g.between(100, 700)) * float64(g.between(1, 600)) / 2)
+	level := 1
+	for i, required := range masteryLevelPoints {
+		if points >= required {
+			level = i + 1
+		}
+	}
+	mastery := &riot.ChampionMastery{
+		ChampionID:                   championID,
+		ChampionPoints:               points,
+		ChampionPointsSinceLastLevel: points - masteryLevelPoints[level-1],
+		LastPlayTime:                 g.timestamp(180 * 24 * time.Hour),
+		ChestGranted:                 g.chance(0.3),
+		SummonerID:                   summoner.ID,
+	}
+	if level < len(masteryLevelPoints) {
+		mastery.ChampionPointsUntilNextLevel = masteryLevelPoints[level] - points
+	}
+	switch {
+	case level == 5 && points > 50000 && g.chance(0.5):
+		mastery.ChampionLevel = 6 + g.rand.Intn(2)
+		if mastery.ChampionLevel == 6 {
+			mastery.TokensEarned = g.rand.Intn(4)
+		}
+	case level == 5:
+		mastery.ChampionLevel = level
+		mastery.TokensEarned = g.rand.Intn(3)
+	default:
+		mastery.ChampionLevel = level
+	}
+	return mastery
+}
+
+// ChampionInfo returns a random free champion rotation
+func (g *Generator) ChampionInfo() *riot.ChampionInfo {
+	champions := g.rand.Perm(len(championIDs))
+	info := &riot.ChampionInfo{MaxNewPlayerLevel: maxNewPlayerLevel}
+	for _, i := range champions[:freeChampions] {
+		info.FreeChampionIDs = append(info.FreeChampionIDs, championIDs[i])
+	}
+	for _, i := range champions[freeChampions : freeChampions+freeChampionsNewPlayer] {
+		info.FreeChampionIDsForNewPlayers = append(info.FreeChampionIDsForNewPlayers, championIDs[i])
+	}
+	sort.Ints(info.FreeChampionIDs)
+	sort.Ints(info.FreeChampionIDsForNewPlayers)
+	return info
+}
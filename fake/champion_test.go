@@ -0,0 +1,42 @@
+package fake
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerator_ChampionMasteries(t *testing.T) {
+	t.Parallel()
+	g := New(1)
+	summoner := g.Summoner()
+	masteries := g.ChampionMasteries(summoner, 20)
+	require.Len(t, masteries, 20)
+	champions := map[int]bool{}
+	for i, mastery := range masteries {
+		assert.Equal(t, summoner.ID, mastery.SummonerID)
+		assert.False(t, champions[mastery.ChampionID], "duplicate champion %d", mastery.ChampionID)
+		champions[mastery.ChampionID] = true
+		assert.True(t, mastery.ChampionLevel >= 1 && mastery.ChampionLevel <= 7)
+		if mastery.ChampionLevel < 5 {
+			assert.Equal(t, masteryLevelPoints[mastery.ChampionLevel],
+				mastery.ChampionPoints+mastery.ChampionPointsUntilNextLevel)
+		}
+		if i > 0 {
+			assert.True(t, masteries[i-1].ChampionPoints >= mastery.ChampionPoints)
+		}
+	}
+	assert.Len(t, g.ChampionMasteries(summoner, 1000), len(championIDs))
+}
+
+func TestGenerator_ChampionInfo(t *testing.T) {
+	t.Parallel()
+	info := New(1).ChampionInfo()
+	assert.Len(t, info.FreeChampionIDs, freeChampions)
+	assert.Len(t, info.FreeChampionIDsForNewPlayers, freeChampionsNewPlayer)
+	for _, id := range info.FreeChampionIDsForNewPlayers {
+		assert.NotContains(t, info.FreeChampionIDs, id)
+	}
+	assert.Equal(t, maxNewPlayerLevel, info.MaxNewPlayerLevel)
+}
@@ -0,0 +1,215 @@
+// Package fake generates realistic random values of the Riot API types for seeding tests and demos.
+// Generated values stay within the ranges seen in real responses and reference each other consistently, e.g. the
+// participants of a match are the given summoners and the kills of a timeline match the stats of its match.
+package fake
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/mjourard/golio/riot"
+)
+
+const (
+	idAlphabet      = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789-_"
+	summonerIDSize  = 47
+	accountIDSize   = 56
+	puuidSize       = 78
+	maxProfileIcon  = 4800
+	minRankedLevel  = 30
+	maxLevel        = 500
+	defaultPlatform = "EUW1"
+)
+
+// epoch is the point in time all generated timestamps are based on, so that a seed always generates the same values
+var epoch = time.Date(2020, time.March, 26, 0, 0, 0, 0, time.UTC)
+
+var (
+	nameAdjectives = []string{"Silent", "Crimson", "Lucky", "Frozen", "Wild", "Ashen", "Golden", "Hidden", "Iron",
+		"Velvet", "Solar", "Quiet", "Brave", "Lunar", "Rapid"}
+	nameNouns = []string{"Fox", "Blade", "Storm", "Lynx", "Quill", "Tide", "Ember", "Raven", "Wolf", "Comet", "Moss",
+		"Spark", "Drake", "Owl", "Viper"}
+	tiers = []riot.Tier{riot.TierIron, riot.TierBronze, riot.TierSilver, riot.TierGold, riot.TierPlatinum,
+		riot.TierDiamond}
+	// tierWeights roughly follows the distribution of the ranked ladder
+	tierWeights = []int{5, 20, 35, 25, 11, 4}
+	divisions   = []riot.Division{riot.DivisionOne, riot.DivisionTwo, riot.DivisionThree, riot.DivisionFour}
+	// apexTiers are the tiers without divisions, which consist of a single league per queue
+	apexTiers   = []riot.Tier{riot.TierMaster, riot.TierGrandmaster, riot.TierChallenger}
+	leagueNames = []string{"Ashe's Marksmen", "Taric's Enforcers", "Nasus's Warlords", "Sona's Maestros",
+		"Orianna's Tacticians", "Jax's Duelists", "Leona's Vanguard", "Riven's Blademasters"}
+)
+
+// Generator generates random values. Generators created with the same seed generate the same values.
+// A Generator is not safe for concurrent use
+type Generator struct {
+	rand       *rand.Rand
+	platform   string
+	nextGameID int
+}
+
+// New returns a new generator using the given seed
+func New(seed int64) *Generator {
+	r := rand.New(rand.NewSource(seed))
+	return &Generator{
+		rand:       r,
+		platform:   defaultPlatform,
+		nextGameID: 4000000000 + r.Intn(500000000),
+	}
+}
+
+// SetPlatform sets the platform ID used for generated matches, e.g. NA1. Defaults to EUW1
+func (g *Generator) SetPlatform(platform string) {
+	g.platform = platform
+}
+
+// Summoner returns a random level 30+ summoner
+func (g *Generator) Summoner() *riot.Summoner {
+	return &riot.Summoner{
+		ID:            g.id(summonerIDSize),
+		AccountID:     g.id(accountIDSize),
+		PUUID:         g.id(puuidSize),
+		Name:          g.name(),
+		ProfileIconID: g.rand.Intn(maxProfileIcon + 1),
+		SummonerLevel: g.between(minRankedLevel, maxLevel),
+		RevisionDate:  g.timestamp(30 * 24 * time.Hour),
+	}
+}
+
+// LeagueItem returns a random solo queue league entry of the given summoner. A new summoner is generated if summoner
+// is nil
+func (g *Generator) LeagueItem(summoner *riot.Summoner) *riot.LeagueItem {
+	if summoner == nil {
+		summoner = g.Summoner()
+	}
+	item := &riot.LeagueItem{
+		QueueType:    string(riot.QueueRankedSolo),
+		SummonerID:   summoner.ID,
+		SummonerName: summoner.Name,
+		Tier:         string(tiers[g.weighted(tierWeights)]),
+		Rank:         string(divisions[g.rand.Intn(len(divisions))]),
+		LeaguePoints: g.rand.Intn(100),
+		Wins:         g.between(10, 400),
+		Veteran:      g.chance(0.1),
+		Inactive:     g.chance(0.02),
+		FreshBlood:   g.chance(0.1),
+		HotStreak:    g.chance(0.15),
+	}
+	item.Losses = max(1, item.Wins+g.between(-item.Wins/10, item.Wins/10))
+	if item.Rank == string(riot.DivisionOne) && g.chance(0.2) {
+		// promotion series to the next tier, which is a best of five
+		item.LeaguePoints = 100
+		wins, losses := g.rand.Intn(3), g.rand.Intn(3)
+		progress := []byte("NNNNN")
+		for i := 0; i < wins+losses; i++ {
+			progress[i] = 'L'
+			if i < wins {
+				progress[i] = 'W'
+			}
+		}
+		item.MiniSeries = &riot.MiniSeries{
+			Target:   3,
+			Wins:     wins,
+			Losses:   losses,
+			Progress: string(progress),
+		}
+	}
+	return item
+}
+
+// LeagueList returns a random league of the given tier with size entries of new summoners.
+// Entries of the apex tiers (master, grandmaster and challenger) are all in division I
+func (g *Generator) LeagueList(queue riot.Queue, tier riot.Tier, size int) *riot.LeagueList {
+	list := &riot.LeagueList{
+		LeagueID: g.uuid(),
+		Tier:     string(tier),
+		Queue:    string(queue),
+		Name:     leagueNames[g.rand.Intn(len(leagueNames))],
+	}
+	apex := false
+	for _, t := range apexTiers {
+		apex = apex || t == tier
+	}
+	for i := 0; i < size; i++ {
+		item := g.LeagueItem(nil)
+		item.LeagueID = list.LeagueID
+		item.QueueType = list.Queue
+		item.Tier = list.Tier
+		if apex {
+			item.Rank = string(riot.DivisionOne)
+			item.LeaguePoints = g.between(0, 1500)
+			item.MiniSeries = nil
+		}
+		list.Entries = append(list.Entries, item)
+	}
+	return list
+}
+
+// uuid returns a random string formatted like a UUID, as used for league IDs
+func (g *Generator) uuid() string {
+	const hex = "0123456789abcdef"
+	res := []byte("xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx")
+	for i := range res {
+		if res[i] == 'x' {
+			res[i] = hex[g.rand.Intn(len(hex))]
+		}
+	}
+	return string(res)
+}
+
+// id returns a random string looking like an encrypted ID of the given size
+func (g *Generator) id(size int) string {
+	res := make([]byte, size)
+	for i := range res {
+		res[i] = idAlphabet[g.rand.Intn(len(idAlphabet))]
+	}
+	return string(res)
+}
+
+func (g *Generator) name() string {
+	name := nameAdjectives[g.rand.Intn(len(nameAdjectives))] + " " + nameNouns[g.rand.Intn(len(nameNouns))]
+	if g.chance(0.5) {
+		name += string(rune('0' + g.rand.Intn(10)))
+	}
+	return name
+}
+
+// timestamp returns a random timestamp within the given duration before epoch
+func (g *Generator) timestamp(within time.Duration) riot.Timestamp {
+	return riot.TimestampFromMillis(epoch.Add(-time.Duration(g.rand.Int63n(int64(within)))).UnixNano() / int64(time.Millisecond))
+}
+
+// between returns a random number in [min, max]
+func (g *Generator) between(min, max int) int {
+	if max <= min {
+		return min
+	}
+	return min + g.rand.Intn(max-min+1)
+}
+
+func (g *Generator) chance(p float64) bool {
+	return g.rand.Float64() < p
+}
+
+// weighted returns a random index of weights, with each index being picked proportionally to its weight
+func (g *Generator) weighted(weights []int) int {
+	total := 0
+	for _, w := range weights {
+		total += w
+	}
+	n := g.rand.Intn(total)
+	for i, w := range weights {
+		if n < w {
+			return i
+		}
+		n -= w
+	}
+	return len(weights) - 1
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
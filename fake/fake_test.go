@@ -0,0 +1,83 @@
+package fake
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mjourard/golio/riot"
+)
+
+func TestGenerator_Seed(t *testing.T) {
+	t.Parallel()
+	a, b := New(42), New(42)
+	assert.Equal(t, a.Summoner(), b.Summoner())
+	match := a.Match()
+	assert.Equal(t, match, b.Match())
+	assert.Equal(t, a.Timeline(match), b.Timeline(match))
+	assert.NotEqual(t, New(1).Summoner(), New(2).Summoner())
+}
+
+func TestGenerator_Summoner(t *testing.T) {
+	t.Parallel()
+	g := New(1)
+	for i := 0; i < 100; i++ {
+		summoner := g.Summoner()
+		assert.Len(t, summoner.ID, summonerIDSize)
+		assert.Len(t, summoner.AccountID, accountIDSize)
+		assert.Len(t, summoner.PUUID, puuidSize)
+		assert.NotEmpty(t, summoner.Name)
+		assert.True(t, summoner.SummonerLevel >= minRankedLevel && summoner.SummonerLevel <= maxLevel)
+		assert.True(t, summoner.ProfileIconID >= 0 && summoner.ProfileIconID <= maxProfileIcon)
+		assert.True(t, summoner.RevisionDate.Millis() > 0)
+	}
+}
+
+func TestGenerator_LeagueItem(t *testing.T) {
+	t.Parallel()
+	g := New(1)
+	summoner := g.Summoner()
+	series := 0
+	for i := 0; i < 200; i++ {
+		item := g.LeagueItem(summoner)
+		require.Equal(t, summoner.ID, item.SummonerID)
+		require.Equal(t, summoner.Name, item.SummonerName)
+		assert.Equal(t, string(riot.QueueRankedSolo), item.QueueType)
+		assert.Contains(t, tiers, riot.Tier(item.Tier))
+		assert.Contains(t, divisions, riot.Division(item.Rank))
+		assert.True(t, item.Wins > 0 && item.Losses > 0)
+		if item.MiniSeries == nil {
+			assert.True(t, item.LeaguePoints >= 0 && item.LeaguePoints < 100)
+			continue
+		}
+		series++
+		assert.Equal(t, 100, item.LeaguePoints)
+		assert.Equal(t, string(riot.DivisionOne), item.Rank)
+		assert.Len(t, item.MiniSeries.Progress, 5)
+	}
+	assert.True(t, series > 0)
+	assert.NotEqual(t, g.LeagueItem(nil).SummonerID, summoner.ID)
+}
+
+func TestGenerator_LeagueList(t *testing.T) {
+	t.Parallel()
+	g := New(1)
+	g.SetPlatform("NA1")
+	assert.Equal(t, "NA1", g.Match().PlatformID)
+	for _, tier := range []riot.Tier{riot.TierGold, "CHALLENGER"} {
+		list := g.LeagueList(riot.QueueRankedFlex, tier, 50)
+		require.Len(t, list.Entries, 50)
+		assert.Len(t, list.LeagueID, 36)
+		assert.Equal(t, string(tier), list.Tier)
+		for _, entry := range list.Entries {
+			assert.Equal(t, list.LeagueID, entry.LeagueID)
+			assert.Equal(t, string(riot.QueueRankedFlex), entry.QueueType)
+			assert.Equal(t, string(tier), entry.Tier)
+			if tier == "CHALLENGER" {
+				assert.Equal(t, string(riot.DivisionOne), entry.Rank)
+				assert.Nil(t, entry.MiniSeries)
+			}
+		}
+	}
+}
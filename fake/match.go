@@ -0,0 +1,329 @@
+package fake
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/mjourard/golio/riot"
+)
+
+const (
+	teamBlue          = 100
+	teamRed           = 200
+	participantsCount = 10
+	spellFlash        = 4
+	spellSmite        = 11
+)
+
+var (
+	// a selection of champion IDs, of which each match uses ten different ones
+	championIDs = []int{1, 7, 11, 18, 22, 25, 39, 51, 53, 59, 63, 64, 67, 81, 84, 86, 89, 92, 103, 104, 111, 117,
+		119, 121, 122, 157, 222, 236, 238, 245, 412, 497, 498, 516, 517, 523, 555, 777, 875, 887}
+	items = []int{3006, 3020, 3031, 3036, 3047, 3065, 3071, 3078, 3089, 3111, 3135, 3153, 3157, 3814, 6653, 1058,
+		1037, 3133}
+	trinkets = []int{3340, 3363, 3364}
+	tierIDs  = []string{"UNRANKED", "BRONZE", "SILVER", "GOLD", "PLATINUM", "DIAMOND"}
+)
+
+type role struct {
+	lane       string
+	role       string
+	spell2     []int
+	csPerMin   [2]int
+	killWeight int
+}
+
+// roles of the participants of a team in participant order
+var roles = []role{
+	{lane: "TOP", role: "SOLO", spell2: []int{12, 14}, csPerMin: [2]int{5, 8}, killWeight: 3},
+	{lane: "JUNGLE", role: "NONE", spell2: []int{spellSmite}, csPerMin: [2]int{4, 6}, killWeight: 3},
+	{lane: "MIDDLE", role: "SOLO", spell2: []int{14, 12, 21}, csPerMin: [2]int{6, 9}, killWeight: 4},
+	{lane: "BOTTOM", role: "DUO_CARRY", spell2: []int{7, 21}, csPerMin: [2]int{6, 9}, killWeight: 4},
+	{lane: "BOTTOM", role: "DUO_SUPPORT", spell2: []int{14, 3}, csPerMin: [2]int{0, 2}, killWeight: 1},
+}
+
+// Match returns a random ranked solo queue match on Summoner's Rift. The given summoners, at most ten, are used as the
+// first participants and new summoners are generated for the remaining ones.
+// Kills, deaths and assists are consistent across teams, e.g. the kills of a team equal the deaths of the other team
+func (g *Generator) Match(summoners ...*riot.Summoner) *riot.Match {
+	gameID := g.nextGameID
+	g.nextGameID += g.between(1000, 50000)
+	duration := g.between(15*60, 45*60)
+	m := &riot.Match{
+		GameID:       gameID,
+		PlatformID:   g.platform,
+		SeasonID:     13,
+		QueueID:      int(riot.QueueIDRankedSolo),
+		MapID:        11,
+		GameMode:     "CLASSIC",
+		GameType:     "MATCHED_GAME",
+		GameVersion:  fmt.Sprintf("10.%d.%d.%d", g.between(1, 8), g.between(300, 330), g.between(1000, 9999)),
+		GameDuration: riot.DurationSeconds{Duration: time.Duration(duration) * time.Second},
+		GameCreation: g.timestamp(14 * 24 * time.Hour),
+	}
+	winner := teamBlue
+	if g.chance(0.5) {
+		winner = teamRed
+	}
+	champions := g.rand.Perm(len(championIDs))
+	for i := 0; i < participantsCount; i++ {
+		var summoner *riot.Summoner
+		if i < len(summoners) && summoners[i] != nil {
+			summoner = summoners[i]
+		} else {
+			summoner = g.Summoner()
+		}
+		teamID := teamBlue
+		if i >= participantsCount/2 {
+			teamID = teamRed
+		}
+		m.Participants = append(m.Participants, g.participant(i+1, teamID, teamID == winner,
+			championIDs[champions[i]], duration))
+		m.ParticipantIdentities = append(m.ParticipantIdentities, &riot.ParticipantIdentity{
+			ParticipantID: i + 1,
+			Player: &riot.Player{
+				PlatformID:        g.platform,
+				CurrentPlatformID: g.platform,
+				AccountID:         summoner.AccountID,
+				CurrentAccountID:  summoner.AccountID,
+				SummonerID:        summoner.ID,
+				SummonerName:      summoner.Name,
+				ProfileIcon:       summoner.ProfileIconID,
+				MatchHistoryURI:   fmt.Sprintf("/v1/stats/player_history/%s/%d", g.platform, g.between(2e8, 3e8)),
+			},
+		})
+	}
+	g.distributeKills(m, winner)
+	bans := champions[participantsCount : 2*participantsCount]
+	for _, teamID := range []int{teamBlue, teamRed} {
+		m.Teams = append(m.Teams, g.teamStats(m, teamID, winner, bans))
+	}
+	g.setFirstObjectives(m.Teams)
+	return m
+}
+
+func (g *Generator) participant(id, teamID int, win bool, championID, duration int) *riot.Participant {
+	r := roles[(id-1)%len(roles)]
+	minutes := float64(duration) / 60
+	cs := int(float64(g.between(r.csPerMin[0]*10, r.csPerMin[1]*10)) / 10 * minutes)
+	stats := &riot.ParticipantStats{
+		ParticipantID:               id,
+		Win:                         win,
+		ChampLevel:                  min(18, 6+duration/(3*60)+g.between(-2, 1)),
+		GoldEarned:                  int(float64(g.between(250, 480)) * minutes),
+		VisionScore:                 int(float64(g.between(5, 30)) / 10 * minutes),
+		WardsPlaced:                 int(float64(g.between(3, 15)) / 10 * minutes),
+		WardsKilled:                 g.between(0, duration/240),
+		VisionWardsBoughtInGame:     g.between(0, duration/300),
+		TotalDamageDealt:            int(float64(g.between(2500, 7000)) * minutes),
+		TotalDamageDealtToChampions: int(float64(g.between(300, 1200)) * minutes),
+		TotalDamageTaken:            int(float64(g.between(400, 1300)) * minutes),
+		DamageSelfMitigated:         int(float64(g.between(200, 1500)) * minutes),
+		DamageDealtToObjectives:     int(float64(g.between(50, 800)) * minutes),
+		DamageDealtToTurrets:        int(float64(g.between(0, 300)) * minutes),
+		TotalHeal:                   int(float64(g.between(30, 400)) * minutes),
+		TotalUnitsHealed:            g.between(1, 5),
+		TimeCCingOthers:             g.between(0, duration/30),
+		TotalTimeCrowdControlDealt:  g.between(0, duration/3),
+		Item0:                       items[g.rand.Intn(len(items))],
+		Item1:                       items[g.rand.Intn(len(items))],
+		Item2:                       items[g.rand.Intn(len(items))],
+		Item3:                       items[g.rand.Intn(len(items))],
+		Item6:                       trinkets[g.rand.Intn(len(trinkets))],
+		PerkPrimaryStyle:            8100,
+		PerkSubStyle:                8200,
+		Perk0:                       8112,
+		Perk1:                       8126,
+		Perk2:                       8138,
+		Perk3:                       8135,
+		Perk4:                       8210,
+		Perk5:                       8237,
+	}
+	stats.GoldSpent = stats.GoldEarned - g.between(0, min(stats.GoldEarned, 1500))
+	stats.MagicDamageDealtToChampions = stats.TotalDamageDealtToChampions * g.between(0, 100) / 100
+	stats.TrueDamageDealtToChampions = stats.TotalDamageDealtToChampions * g.between(0, 10) / 100
+	stats.PhysicalDamageDealtToChampions = stats.TotalDamageDealtToChampions - stats.MagicDamageDealtToChampions -
+		stats.TrueDamageDealtToChampions
+	stats.MagicDamageDealt = stats.TotalDamageDealt * g.between(0, 100) / 100
+	stats.TrueDamageDealt = stats.TotalDamageDealt * g.between(0, 10) / 100
+	stats.PhysicalDamageDealt = stats.TotalDamageDealt - stats.MagicDamageDealt - stats.TrueDamageDealt
+	stats.MagicalDamageTaken = stats.TotalDamageTaken * g.between(20, 60) / 100
+	stats.TrueDamageTaken = stats.TotalDamageTaken * g.between(0, 10) / 100
+	stats.PhysicalDamageTaken = stats.TotalDamageTaken - stats.MagicalDamageTaken - stats.TrueDamageTaken
+	if r.lane == "JUNGLE" {
+		stats.NeutralMinionsKilled = cs
+		stats.NeutralMinionsKilledTeamJungle = cs * 8 / 10
+		stats.NeutralMinionsKilledEnemyJungle = cs - stats.NeutralMinionsKilledTeamJungle
+		stats.TotalMinionsKilled = g.between(0, cs/5)
+	} else {
+		stats.TotalMinionsKilled = cs
+		stats.NeutralMinionsKilled = g.between(0, cs/10)
+		stats.NeutralMinionsKilledTeamJungle = stats.NeutralMinionsKilled
+	}
+	return &riot.Participant{
+		ParticipantID:             id,
+		TeamID:                    teamID,
+		ChampionID:                championID,
+		Spell1ID:                  spellFlash,
+		Spell2ID:                  r.spell2[g.rand.Intn(len(r.spell2))],
+		HighestAchievedSeasonTier: tierIDs[g.rand.Intn(len(tierIDs))],
+		Stats:                     stats,
+		Timeline: &riot.ParticipantTimeline{
+			ParticipantID:      id,
+			Lane:               r.lane,
+			Role:               r.role,
+			CreepsPerMinDeltas: g.deltas(duration, float64(r.csPerMin[0]), float64(r.csPerMin[1])),
+			GoldPerMinDeltas:   g.deltas(duration, 200, 500),
+			XpPerMinDeltas:     g.deltas(duration, 250, 600),
+		},
+	}
+}
+
+// deltas returns per minute values for every full ten minute period of the game
+func (g *Generator) deltas(duration int, min, max float64) map[string]float64 {
+	res := map[string]float64{}
+	for start := 0; start+10 <= duration/60 && start < 30; start += 10 {
+		res[fmt.Sprintf("%d-%d", start, start+10)] = float64(int((min+g.rand.Float64()*(max-min))*10)) / 10
+	}
+	return res
+}
+
+// distributeKills sets the kills, deaths and assists of all participants. The winning team tends to have more kills
+func (g *Generator) distributeKills(m *riot.Match, winner int) {
+	minutes := int(m.GameDuration.Minutes())
+	for _, teamID := range []int{teamBlue, teamRed} {
+		kills := g.between(minutes/4, minutes+5)
+		if teamID == winner {
+			kills += g.between(0, minutes/2)
+		}
+		team, enemies := teamParticipants(m, teamID)
+		weights := make([]int, len(team))
+		for i, p := range team {
+			weights[i] = roles[(p.ParticipantID-1)%len(roles)].killWeight
+		}
+		for i := 0; i < kills; i++ {
+			team[g.weighted(weights)].Stats.Kills++
+			enemies[g.rand.Intn(len(enemies))].Stats.Deaths++
+		}
+		for _, p := range team {
+			// a participant can assist at most in every kill of the team not made by themselves
+			p.Stats.Assists = (kills - p.Stats.Kills) * g.between(30, 80) / 100
+		}
+	}
+	firstBlood := g.firstBloodKiller(m)
+	for _, p := range m.Participants {
+		s := p.Stats
+		s.FirstBloodKill = p == firstBlood
+		s.LargestKillingSpree = g.between(0, s.Kills)
+		if s.LargestKillingSpree < 2 {
+			s.LargestKillingSpree = 0
+		}
+		if s.Kills > 0 {
+			s.LargestMultiKill = 1
+			s.KillingSprees = g.between(0, s.Kills/2)
+		}
+		if s.Kills > 3 && g.chance(0.4) {
+			s.DoubleKills = g.between(1, s.Kills/3)
+			s.LargestMultiKill = 2
+		}
+		if s.Kills > 6 && g.chance(0.2) {
+			s.TripleKills = 1
+			s.LargestMultiKill = 3
+		}
+		s.LongestTimeSpentLiving = int(m.GameDuration.Raw()) / (s.Deaths + 1) * g.between(80, 120) / 100
+		if s.Deaths == 0 {
+			s.LongestTimeSpentLiving = 0
+		}
+	}
+}
+
+// firstBloodKiller returns a random participant with at least one kill
+func (g *Generator) firstBloodKiller(m *riot.Match) *riot.Participant {
+	var weights []int
+	for _, p := range m.Participants {
+		weights = append(weights, p.Stats.Kills)
+	}
+	for _, w := range weights {
+		if w > 0 {
+			return m.Participants[g.weighted(weights)]
+		}
+	}
+	return nil
+}
+
+func (g *Generator) teamStats(m *riot.Match, teamID, winner int, bans []int) *riot.TeamStats {
+	team, _ := teamParticipants(m, teamID)
+	stats := &riot.TeamStats{
+		TeamID:         teamID,
+		Win:            "Fail",
+		TowerKills:     g.between(0, 7),
+		InhibitorKills: 0,
+		DragonKills:    g.between(0, 3),
+		BaronKills:     g.between(0, 1),
+	}
+	if teamID == winner {
+		stats.Win = "Win"
+		stats.TowerKills = g.between(5, 11)
+		stats.InhibitorKills = g.between(1, 3)
+		stats.DragonKills = g.between(1, 4)
+	}
+	if m.GameDuration.Duration < 20*time.Minute {
+		stats.BaronKills = 0
+	}
+	for _, p := range team {
+		if p.Stats.FirstBloodKill {
+			stats.FirstBlood = true
+		}
+	}
+	offset := 0
+	if teamID == teamRed {
+		offset = participantsCount / 2
+	}
+	for i := 0; i < participantsCount/2; i++ {
+		stats.Bans = append(stats.Bans, &riot.TeamBan{
+			ChampionID: championIDs[bans[offset+i]],
+			PickTurn:   offset + i + 1,
+		})
+	}
+	return stats
+}
+
+// setFirstObjectives marks for every objective the team which took it first, which is only possible for teams which
+// took the objective at least once
+func (g *Generator) setFirstObjectives(teams []*riot.TeamStats) {
+	first := func(count func(*riot.TeamStats) int) *riot.TeamStats {
+		var candidates []*riot.TeamStats
+		for _, t := range teams {
+			if count(t) > 0 {
+				candidates = append(candidates, t)
+			}
+		}
+		if len(candidates) == 0 {
+			return &riot.TeamStats{}
+		}
+		return candidates[g.rand.Intn(len(candidates))]
+	}
+	first(func(t *riot.TeamStats) int { return t.TowerKills }).FirstTower = true
+	first(func(t *riot.TeamStats) int { return t.InhibitorKills }).FirstInhibitor = true
+	first(func(t *riot.TeamStats) int { return t.DragonKills }).FirstDragon = true
+	first(func(t *riot.TeamStats) int { return t.BaronKills }).FirstBaron = true
+}
+
+// teamParticipants returns the participants of the given team and of the enemy team
+func teamParticipants(m *riot.Match, teamID int) (team, enemies []*riot.Participant) {
+	for _, p := range m.Participants {
+		if p.TeamID == teamID {
+			team = append(team, p)
+		} else {
+			enemies = append(enemies, p)
+		}
+	}
+	return team, enemies
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,66 @@
+package fake
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mjourard/golio/riot"
+)
+
+func TestGenerator_Match(t *testing.T) {
+	t.Parallel()
+	g := New(1)
+	summoners := []*riot.Summoner{g.Summoner(), g.Summoner()}
+	previous := 0
+	for i := 0; i < 100; i++ {
+		m := g.Match(summoners...)
+		assert.True(t, m.GameID > previous)
+		previous = m.GameID
+		require.Len(t, m.Participants, participantsCount)
+		require.Len(t, m.ParticipantIdentities, participantsCount)
+		require.Len(t, m.Teams, 2)
+		assert.Equal(t, summoners[0].ID, m.ParticipantIdentities[0].Player.SummonerID)
+		assert.Equal(t, summoners[1].AccountID, m.ParticipantIdentities[1].Player.AccountID)
+
+		champions := map[int]bool{}
+		kills := map[int]int{}
+		deaths := map[int]int{}
+		firstBloods := 0
+		for j, p := range m.Participants {
+			assert.Equal(t, j+1, p.ParticipantID)
+			assert.Equal(t, j+1, m.ParticipantIdentities[j].ParticipantID)
+			assert.False(t, champions[p.ChampionID], "champion picked twice")
+			champions[p.ChampionID] = true
+			kills[p.TeamID] += p.Stats.Kills
+			deaths[p.TeamID] += p.Stats.Deaths
+			if p.Stats.FirstBloodKill {
+				firstBloods++
+			}
+			assert.True(t, p.Stats.ChampLevel >= 1 && p.Stats.ChampLevel <= 18)
+		}
+		assert.Equal(t, kills[teamBlue], deaths[teamRed])
+		assert.Equal(t, kills[teamRed], deaths[teamBlue])
+		for _, p := range m.Participants {
+			assert.True(t, p.Stats.Assists <= kills[p.TeamID]-p.Stats.Kills)
+		}
+		if kills[teamBlue]+kills[teamRed] > 0 {
+			assert.Equal(t, 1, firstBloods)
+		}
+
+		winners := 0
+		for _, team := range m.Teams {
+			if team.Win == "Win" {
+				winners++
+			}
+			for _, ban := range team.Bans {
+				assert.False(t, champions[ban.ChampionID], "banned champion picked")
+			}
+		}
+		assert.Equal(t, 1, winners)
+		assert.False(t, m.Teams[0].FirstTower && m.Teams[1].FirstTower)
+		assert.False(t, m.Teams[0].FirstDragon && m.Teams[1].FirstDragon)
+		assert.False(t, m.Teams[0].FirstBlood && m.Teams[1].FirstBlood)
+	}
+}
@@ -0,0 +1,248 @@
+package fake
+
+import (
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/mjourard/golio/riot"
+)
+
+const (
+	frameInterval = 60000
+	startingGold  = 500
+	// no champion dies before this point in time (in milliseconds)
+	firstKillAfter = 90000
+)
+
+var (
+	starterItems   = []int{1054, 1055, 1056, 1039, 3850}
+	towerLanes     = []string{"TOP_LANE", "MID_LANE", "BOT_LANE"}
+	towerTypes     = []string{"OUTER_TURRET", "INNER_TURRET", "BASE_TURRET"}
+	dragonSubTypes = []string{"AIR_DRAGON", "EARTH_DRAGON", "FIRE_DRAGON", "WATER_DRAGON"}
+)
+
+// Timeline returns a random timeline for the given match, which should be generated using Match.
+// The champion kills of the timeline match the kills, deaths and assists of the participants and the first blood,
+// the building kills match the tower and inhibitor kills of the teams and the elite monster kills match the dragon
+// and baron kills of the teams
+func (g *Generator) Timeline(match *riot.Match) *riot.MatchTimeline {
+	duration := int(match.GameDuration.Milliseconds())
+	var events []*riot.MatchEvent
+	events = append(events, g.itemPurchases(match)...)
+	events = append(events, g.championKills(match, duration)...)
+	for _, team := range match.Teams {
+		events = append(events, g.objectiveKills(match, team, duration)...)
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Timestamp.Duration < events[j].Timestamp.Duration
+	})
+	timeline := &riot.MatchTimeline{Interval: millis(frameInterval)}
+	for timestamp := 0; ; timestamp += frameInterval {
+		if timestamp > duration {
+			timestamp = duration
+		}
+		timeline.Frames = append(timeline.Frames, g.frame(match, timestamp, duration))
+		if timestamp == duration {
+			break
+		}
+	}
+	// events are part of the first frame at or after the event
+	for _, event := range events {
+		index := (int(event.Timestamp.Raw()) + frameInterval - 1) / frameInterval
+		if index >= len(timeline.Frames) {
+			index = len(timeline.Frames) - 1
+		}
+		frame := timeline.Frames[index]
+		frame.Events = append(frame.Events, event)
+	}
+	return timeline
+}
+
+func (g *Generator) frame(match *riot.Match, timestamp, duration int) *riot.MatchFrame {
+	progress := float64(timestamp) / float64(duration)
+	frame := &riot.MatchFrame{
+		Timestamp:         millis(timestamp),
+		ParticipantFrames: map[string]*riot.ParticipantFrame{},
+	}
+	for _, p := range match.Participants {
+		totalGold := startingGold + int(float64(p.Stats.GoldEarned-startingGold)*progress)
+		level := 1 + int(float64(p.Stats.ChampLevel-1)*progress)
+		frame.ParticipantFrames[strconv.Itoa(p.ParticipantID)] = &riot.ParticipantFrame{
+			ParticipantID:       p.ParticipantID,
+			TotalGold:           totalGold,
+			CurrentGold:         g.between(0, min(totalGold, 1500)),
+			Level:               level,
+			XP:                  (level - 1) * (280 + 50*level),
+			MinionsKilled:       int(float64(p.Stats.TotalMinionsKilled) * progress),
+			JungleMinionsKilled: int(float64(p.Stats.NeutralMinionsKilled) * progress),
+			Position:            g.position(),
+		}
+	}
+	return frame
+}
+
+func (g *Generator) itemPurchases(match *riot.Match) []*riot.MatchEvent {
+	var events []*riot.MatchEvent
+	for _, p := range match.Participants {
+		events = append(events, &riot.MatchEvent{
+			EventType:     string(riot.MatchEventTypeItemPurchased),
+			Timestamp:     millis(g.between(1000, 15000)),
+			ParticipantID: p.ParticipantID,
+			ItemID:        starterItems[g.rand.Intn(len(starterItems))],
+		})
+	}
+	return events
+}
+
+// championKills returns a kill event for every kill of the participants with victims and assists matching their
+// deaths and assists. The first kill is made by the participant with first blood
+func (g *Generator) championKills(match *riot.Match, duration int) []*riot.MatchEvent {
+	var kills []*riot.MatchEvent
+	for _, teamID := range []int{teamBlue, teamRed} {
+		team, enemies := teamParticipants(match, teamID)
+		var killers, victims []int
+		for _, p := range team {
+			for i := 0; i < p.Stats.Kills; i++ {
+				killers = append(killers, p.ParticipantID)
+			}
+		}
+		for _, p := range enemies {
+			for i := 0; i < p.Stats.Deaths; i++ {
+				victims = append(victims, p.ParticipantID)
+			}
+		}
+		g.rand.Shuffle(len(victims), func(i, j int) {
+			victims[i], victims[j] = victims[j], victims[i]
+		})
+		teamKills := make([]*riot.MatchEvent, 0, len(killers))
+		for i := 0; i < len(killers) && i < len(victims); i++ {
+			teamKills = append(teamKills, &riot.MatchEvent{
+				EventType: string(riot.MatchEventTypeChampionKill),
+				Timestamp: millis(g.between(firstKillAfter, duration-1)),
+				KillerID:  killers[i],
+				VictimID:  victims[i],
+				Position:  g.position(),
+			})
+		}
+		for _, p := range team {
+			var eligible []*riot.MatchEvent
+			for _, kill := range teamKills {
+				if kill.KillerID != p.ParticipantID {
+					eligible = append(eligible, kill)
+				}
+			}
+			for _, i := range g.rand.Perm(len(eligible))[:min(p.Stats.Assists, len(eligible))] {
+				eligible[i].AssistingParticipantIDs = append(eligible[i].AssistingParticipantIDs, p.ParticipantID)
+			}
+		}
+		kills = append(kills, teamKills...)
+	}
+	for _, kill := range kills {
+		sort.Ints(kill.AssistingParticipantIDs)
+	}
+	sort.Slice(kills, func(i, j int) bool {
+		return kills[i].Timestamp.Duration < kills[j].Timestamp.Duration
+	})
+	// move the first kill of the participant with first blood to the front by swapping the timestamps
+	for _, kill := range kills {
+		if match.Participants[kill.KillerID-1].Stats.FirstBloodKill {
+			kills[0].Timestamp, kill.Timestamp = kill.Timestamp, kills[0].Timestamp
+			break
+		}
+	}
+	return kills
+}
+
+// objectiveKills returns the building and elite monster kills of the given team
+func (g *Generator) objectiveKills(match *riot.Match, team *riot.TeamStats, duration int) []*riot.MatchEvent {
+	members, _ := teamParticipants(match, team.TeamID)
+	enemyTeamID := teamBlue
+	if team.TeamID == teamBlue {
+		enemyTeamID = teamRed
+	}
+	killer := func() int {
+		return members[g.rand.Intn(len(members))].ParticipantID
+	}
+	var buildings []*riot.MatchEvent
+	destroyed := map[string]int{}
+	for i := 0; i < team.TowerKills; i++ {
+		event := &riot.MatchEvent{
+			EventType:    string(riot.MatchEventTypeBuildingKill),
+			KillerID:     killer(),
+			TeamID:       enemyTeamID,
+			BuildingType: "TOWER_BUILDING",
+			TowerType:    "NEXUS_TURRET",
+			LaneType:     "MID_LANE",
+			Position:     g.position(),
+		}
+		for _, lane := range g.rand.Perm(len(towerLanes)) {
+			if destroyed[towerLanes[lane]] < len(towerTypes) {
+				event.LaneType = towerLanes[lane]
+				event.TowerType = towerTypes[destroyed[towerLanes[lane]]]
+				destroyed[towerLanes[lane]]++
+				break
+			}
+		}
+		buildings = append(buildings, event)
+	}
+	for i := 0; i < team.InhibitorKills; i++ {
+		buildings = append(buildings, &riot.MatchEvent{
+			EventType:    string(riot.MatchEventTypeBuildingKill),
+			KillerID:     killer(),
+			TeamID:       enemyTeamID,
+			BuildingType: "INHIBITOR_BUILDING",
+			LaneType:     towerLanes[i%len(towerLanes)],
+			Position:     g.position(),
+		})
+	}
+	// buildings are destroyed from the outside in, so their order is kept and only the times are random
+	times := g.times(len(buildings), 8*frameInterval, duration)
+	for i, building := range buildings {
+		building.Timestamp = millis(times[i])
+	}
+	events := buildings
+	for _, timestamp := range g.times(team.DragonKills, 5*frameInterval, duration) {
+		events = append(events, &riot.MatchEvent{
+			EventType:      string(riot.MatchEventTypeEliteMonsterKill),
+			Timestamp:      millis(timestamp),
+			KillerID:       killer(),
+			MonsterType:    "DRAGON",
+			MonsterSubType: dragonSubTypes[g.rand.Intn(len(dragonSubTypes))],
+			Position:       &riot.MatchPosition{X: 9866, Y: 4414},
+		})
+	}
+	for _, timestamp := range g.times(team.BaronKills, 20*frameInterval, duration) {
+		events = append(events, &riot.MatchEvent{
+			EventType:   string(riot.MatchEventTypeEliteMonsterKill),
+			Timestamp:   millis(timestamp),
+			KillerID:    killer(),
+			MonsterType: "BARON_NASHOR",
+			Position:    &riot.MatchPosition{X: 4993, Y: 10280},
+		})
+	}
+	return events
+}
+
+// times returns n sorted random timestamps between from and to. If from is not before to, the second half of the
+// game before to is used instead
+func (g *Generator) times(n, from, to int) []int {
+	if from >= to {
+		from = to / 2
+	}
+	res := make([]int, n)
+	for i := range res {
+		res[i] = g.between(from, to-1)
+	}
+	sort.Ints(res)
+	return res
+}
+
+// millis returns the given milliseconds as a duration of the timeline
+func millis(ms int) riot.DurationMillis {
+	return riot.DurationMillis{Duration: time.Duration(ms) * time.Millisecond}
+}
+
+func (g *Generator) position() *riot.MatchPosition {
+	return &riot.MatchPosition{X: g.between(500, 14300), Y: g.between(500, 14300)}
+}
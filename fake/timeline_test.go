@@ -0,0 +1,68 @@
+package fake
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mjourard/golio/riot"
+)
+
+func TestGenerator_Timeline(t *testing.T) {
+	t.Parallel()
+	g := New(1)
+	for i := 0; i < 50; i++ {
+		m := g.Match()
+		timeline := g.Timeline(m)
+		require.NotEmpty(t, timeline.Frames)
+		last := timeline.Frames[len(timeline.Frames)-1]
+		assert.Equal(t, m.GameDuration.Duration, last.Timestamp.Duration)
+		for _, frame := range timeline.Frames {
+			assert.Len(t, frame.ParticipantFrames, participantsCount)
+			for _, event := range frame.Events {
+				assert.True(t, event.Timestamp.Duration <= frame.Timestamp.Duration)
+			}
+		}
+
+		kills := map[int]int{}
+		deaths := map[int]int{}
+		assists := map[int]int{}
+		buildings := map[int]int{}
+		dragons := map[int]int{}
+		var first *riot.ChampionKillEvent
+		for _, event := range timeline.Events() {
+			switch e := event.(type) {
+			case *riot.ChampionKillEvent:
+				if first == nil {
+					first = e
+				}
+				kills[e.KillerID]++
+				deaths[e.VictimID]++
+				for _, id := range e.AssistingParticipantIDs {
+					assists[id]++
+				}
+			case *riot.ObjectiveKillEvent:
+				team := m.Participants[e.KillerID-1].TeamID
+				if e.IsBuilding() {
+					assert.NotEqual(t, team, e.TeamID)
+					buildings[team]++
+				} else if e.ObjectiveType == "DRAGON" {
+					dragons[team]++
+				}
+			}
+		}
+		for _, p := range m.Participants {
+			assert.Equal(t, p.Stats.Kills, kills[p.ParticipantID])
+			assert.Equal(t, p.Stats.Deaths, deaths[p.ParticipantID])
+			assert.Equal(t, p.Stats.Assists, assists[p.ParticipantID])
+		}
+		if first != nil {
+			assert.True(t, m.Participants[first.KillerID-1].Stats.FirstBloodKill)
+		}
+		for _, team := range m.Teams {
+			assert.Equal(t, team.TowerKills+team.InhibitorKills, buildings[team.TeamID])
+			assert.Equal(t, team.DragonKills, dragons[team.TeamID])
+		}
+	}
+}
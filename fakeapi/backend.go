@@ -0,0 +1,325 @@
+// Package fakeapi provides a stateful in-memory implementation of the Riot API for end-to-end tests of applications
+// chaining multiple golio calls. Values added to the backend are consistent across all endpoints, e.g. a created
+// summoner can be looked up by all of its IDs, its league entries, matches and current game are returned for it and
+// tournament codes created using the client can be retrieved and updated.
+//
+//	backend := fakeapi.New(1)
+//	summoner := backend.CreateSummoner("Some Name")
+//	game := backend.StartGame(summoner)
+//	client := golio.NewClient("API_KEY", golio.WithClient(backend))
+//	current, _ := client.Riot.Spectator.GetCurrent(summoner.ID) // returns game
+//	backend.EndGame(game.GameID)
+//	matches, _ := client.Riot.Match.List(summoner.AccountID, riot.NewMatchFilter()) // contains the finished game
+//
+// The backend does not distinguish regions, all platforms share the same data.
+package fakeapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/mjourard/golio/fake"
+	"github.com/mjourard/golio/internal/fakeriot"
+	"github.com/mjourard/golio/riot"
+)
+
+const (
+	riotHostSuffix = ".api.riotgames.com"
+	// leagueSize is the number of other entries in leagues created by CreateLeagueEntry
+	leagueSize = 9
+)
+
+// apexTiers are the tiers without divisions
+var apexTiers = map[string]bool{"MASTER": true, "GRANDMASTER": true, "CHALLENGER": true}
+
+// Backend is an in-memory Riot API and an implementation of the Doer interface. It is safe for concurrent use
+type Backend struct {
+	mu        sync.Mutex
+	generator *fake.Generator
+	summoners []*riot.Summoner
+	leagues   map[string]*riot.LeagueList
+	masteries map[string][]*riot.ChampionMastery
+	matches   map[int]*riot.Match
+	timelines map[int]*riot.MatchTimeline
+	// games contains the games in progress and pendingMatches the matches they become once they end
+	games           map[int]*riot.GameInfo
+	pendingMatches  map[int]*riot.Match
+	thirdPartyCodes map[string]string
+	rotation        *riot.ChampionInfo
+	status          *riot.Status
+
+	nextID      int
+	providers   map[int]*riot.ProviderRegistrationParameters
+	tournaments map[int]*riot.TournamentRegistrationParameters
+	codes       map[string]*riot.Tournament
+	lobbyEvents map[string][]*riot.LobbyEvent
+	// tournamentMatches contains the IDs of the matches played with a tournament code
+	tournamentMatches map[string][]int
+}
+
+// New returns a new empty backend. The seed is used to generate the values of the Create methods
+func New(seed int64) *Backend {
+	return &Backend{
+		generator:         fake.New(seed),
+		leagues:           map[string]*riot.LeagueList{},
+		masteries:         map[string][]*riot.ChampionMastery{},
+		matches:           map[int]*riot.Match{},
+		timelines:         map[int]*riot.MatchTimeline{},
+		games:             map[int]*riot.GameInfo{},
+		pendingMatches:    map[int]*riot.Match{},
+		thirdPartyCodes:   map[string]string{},
+		nextID:            1,
+		providers:         map[int]*riot.ProviderRegistrationParameters{},
+		tournaments:       map[int]*riot.TournamentRegistrationParameters{},
+		codes:             map[string]*riot.Tournament{},
+		lobbyEvents:       map[string][]*riot.LobbyEvent{},
+		tournamentMatches: map[string][]int{},
+	}
+}
+
+// AddSummoner adds the summoner, replacing any summoner with the same ID
+func (b *Backend) AddSummoner(summoner *riot.Summoner) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for i, s := range b.summoners {
+		if s.ID == summoner.ID {
+			b.summoners[i] = summoner
+			return
+		}
+	}
+	b.summoners = append(b.summoners, summoner)
+}
+
+// CreateSummoner adds and returns a new random summoner with the given name
+func (b *Backend) CreateSummoner(name string) *riot.Summoner {
+	b.mu.Lock()
+	summoner := b.generator.Summoner()
+	b.mu.Unlock()
+	summoner.Name = name
+	b.AddSummoner(summoner)
+	return summoner
+}
+
+// AddLeagueEntry adds the entry to the league with its LeagueID, which is created if it does not exist yet.
+// An existing entry of the same summoner in the league is replaced
+func (b *Backend) AddLeagueEntry(entry *riot.LeagueItem) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	league, ok := b.leagues[entry.LeagueID]
+	if !ok {
+		league = &riot.LeagueList{
+			LeagueID: entry.LeagueID,
+			Tier:     entry.Tier,
+			Queue:    entry.QueueType,
+			Name:     "League " + entry.LeagueID,
+		}
+		b.leagues[entry.LeagueID] = league
+	}
+	for i, e := range league.Entries {
+		if e.SummonerID == entry.SummonerID {
+			league.Entries[i] = entry
+			return
+		}
+	}
+	league.Entries = append(league.Entries, entry)
+}
+
+// CreateLeagueEntry adds and returns a random solo queue entry of the summoner. The entry is added to a new league
+// with entries of new random summoners, which are added as well
+func (b *Backend) CreateLeagueEntry(summoner *riot.Summoner) *riot.LeagueItem {
+	b.mu.Lock()
+	entry := b.generator.LeagueItem(summoner)
+	league := b.generator.LeagueList(riot.Queue(entry.QueueType), riot.Tier(entry.Tier), leagueSize)
+	b.mu.Unlock()
+	entry.LeagueID = league.LeagueID
+	for _, e := range league.Entries {
+		// the generated entries belong to summoners that are not known yet
+		b.AddSummoner(&riot.Summoner{
+			ID:            e.SummonerID,
+			AccountID:     "account-" + e.SummonerID,
+			PUUID:         "puuid-" + e.SummonerID,
+			Name:          e.SummonerName,
+			ProfileIconID: 1,
+			SummonerLevel: 30,
+		})
+		b.AddLeagueEntry(e)
+	}
+	if apexTiers[entry.Tier] {
+		entry.Rank = string(riot.DivisionOne)
+		entry.MiniSeries = nil
+	}
+	b.AddLeagueEntry(entry)
+	return entry
+}
+
+// AddChampionMastery adds the mastery of its summoner, replacing an existing mastery of the same champion
+func (b *Backend) AddChampionMastery(mastery *riot.ChampionMastery) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	masteries := b.masteries[mastery.SummonerID]
+	for i, m := range masteries {
+		if m.ChampionID == mastery.ChampionID {
+			masteries[i] = mastery
+			return
+		}
+	}
+	masteries = append(masteries, mastery)
+	sort.SliceStable(masteries, func(i, j int) bool {
+		return masteries[i].ChampionPoints > masteries[j].ChampionPoints
+	})
+	b.masteries[mastery.SummonerID] = masteries
+}
+
+// AddMatch adds the finished match and its timeline, which may be nil. The match is listed for all participants
+func (b *Backend) AddMatch(match *riot.Match, timeline *riot.MatchTimeline) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.matches[match.GameID] = match
+	if timeline != nil {
+		b.timelines[match.GameID] = timeline
+	}
+}
+
+// CreateMatch adds and returns a random finished match of the given summoners, at most ten, including a timeline
+func (b *Backend) CreateMatch(summoners ...*riot.Summoner) *riot.Match {
+	b.mu.Lock()
+	match := b.generator.Match(summoners...)
+	timeline := b.generator.Timeline(match)
+	b.mu.Unlock()
+	b.AddMatch(match, timeline)
+	return match
+}
+
+// StartGame starts and returns a random game in progress of the given summoners, at most ten. The game is returned
+// as the current game of the summoners and as featured game until it is ended using EndGame
+func (b *Backend) StartGame(summoners ...*riot.Summoner) *riot.GameInfo {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	match := b.generator.Match(summoners...)
+	game := &riot.GameInfo{
+		GameID:            match.GameID,
+		GameStartTime:     match.GameCreation,
+		PlatformID:        match.PlatformID,
+		GameMode:          match.GameMode,
+		MapID:             match.MapID,
+		GameType:          match.GameType,
+		GameQueueConfigID: match.QueueID,
+		Observers:         &riot.Observer{EncryptionKey: "fakeapi"},
+	}
+	for _, team := range match.Teams {
+		for _, ban := range team.Bans {
+			game.BannedChampions = append(game.BannedChampions, &riot.BannedChampion{
+				PickTurn:   ban.PickTurn,
+				ChampionID: ban.ChampionID,
+				TeamID:     team.TeamID,
+			})
+		}
+	}
+	for i, p := range match.Participants {
+		player := match.ParticipantIdentities[i].Player
+		game.Participants = append(game.Participants, &riot.CurrentGameParticipant{
+			ProfileIconID: player.ProfileIcon,
+			ChampionID:    p.ChampionID,
+			SummonerName:  player.SummonerName,
+			Spell1ID:      p.Spell1ID,
+			Spell2ID:      p.Spell2ID,
+			TeamID:        p.TeamID,
+			SummonerID:    player.SummonerID,
+		})
+	}
+	b.games[game.GameID] = game
+	b.pendingMatches[game.GameID] = match
+	return game
+}
+
+// EndGame ends the game in progress with the given ID and adds the finished match with a timeline. It returns the
+// match or nil if there is no such game
+func (b *Backend) EndGame(gameID int) *riot.Match {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	match, ok := b.pendingMatches[gameID]
+	if !ok {
+		return nil
+	}
+	delete(b.games, gameID)
+	delete(b.pendingMatches, gameID)
+	b.matches[gameID] = match
+	b.timelines[gameID] = b.generator.Timeline(match)
+	return match
+}
+
+// SetThirdPartyCode sets the third party code of the summoner
+func (b *Backend) SetThirdPartyCode(summonerID, code string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.thirdPartyCodes[summonerID] = code
+}
+
+// SetChampionRotation sets the free champion rotation. A random rotation is used if none is set
+func (b *Backend) SetChampionRotation(rotation *riot.ChampionInfo) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.rotation = rotation
+}
+
+// SetStatus sets the status of the shard. A status with all services online is used if none is set
+func (b *Backend) SetStatus(status *riot.Status) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.status = status
+}
+
+// AddLobbyEvent adds an event to the lobby of the tournament code
+func (b *Backend) AddLobbyEvent(code string, event *riot.LobbyEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.lobbyEvents[code] = append(b.lobbyEvents[code], event)
+}
+
+// AddTournamentMatch adds the finished match as played with the tournament code
+func (b *Backend) AddTournamentMatch(code string, match *riot.Match) {
+	b.AddMatch(match, nil)
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.tournamentMatches[code] = append(b.tournamentMatches[code], match.GameID)
+}
+
+// summonerBy returns the summoner with the given value of the given field. The caller must hold b.mu
+func (b *Backend) summonerBy(field, value string) *riot.Summoner {
+	for _, s := range b.summoners {
+		switch {
+		case field == fieldName && fakeriot.NormalizeName(s.Name) == fakeriot.NormalizeName(value),
+			field == fieldID && s.ID == value,
+			field == fieldAccountID && s.AccountID == value,
+			field == fieldPUUID && s.PUUID == value:
+			return s
+		}
+	}
+	return nil
+}
+
+// Do answers the request using the data of the backend
+func (b *Backend) Do(r *http.Request) (*http.Response, error) {
+	if !strings.HasSuffix(r.URL.Host, riotHostSuffix) {
+		return fakeriot.ErrorResponse(http.StatusNotFound), nil
+	}
+	b.mu.Lock()
+	payload, code := b.handle(r)
+	var body []byte
+	var err error
+	if code == http.StatusOK {
+		// marshal while holding the lock as the payload may be modified by later requests
+		body, err = json.Marshal(payload)
+	}
+	b.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	if code != http.StatusOK {
+		return fakeriot.ErrorResponse(code), nil
+	}
+	return fakeriot.Response(http.StatusOK, body), nil
+}
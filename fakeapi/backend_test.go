@@ -0,0 +1,213 @@
+package fakeapi
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mjourard/golio/api"
+	"github.com/mjourard/golio/riot"
+)
+
+func newClient(b *Backend) *riot.Client {
+	return riot.NewClient(api.RegionEuropeWest, "", b, log.StandardLogger())
+}
+
+func TestBackend_Summoner(t *testing.T) {
+	t.Parallel()
+	b := New(1)
+	client := newClient(b)
+	summoner := b.CreateSummoner("Silent Fox")
+	assert.Equal(t, "Silent Fox", summoner.Name)
+	for _, get := range []func() (*riot.Summoner, error){
+		func() (*riot.Summoner, error) { return client.Summoner.GetByName("silentfox") },
+		func() (*riot.Summoner, error) { return client.Summoner.GetByID(summoner.ID) },
+		func() (*riot.Summoner, error) { return client.Summoner.GetByAccountID(summoner.AccountID) },
+		func() (*riot.Summoner, error) { return client.Summoner.GetByPUUID(summoner.PUUID) },
+	} {
+		got, err := get()
+		require.Nil(t, err)
+		assert.Equal(t, summoner, got)
+	}
+	_, err := client.Summoner.GetByName("Crimson Owl")
+	assert.True(t, errors.Is(err, api.ErrNotFound))
+
+	renamed := *summoner
+	renamed.Name = "Crimson Owl"
+	b.AddSummoner(&renamed)
+	got, err := client.Summoner.GetByName("Crimson Owl")
+	require.Nil(t, err)
+	assert.Equal(t, summoner.ID, got.ID)
+	_, err = client.Summoner.GetByName("Silent Fox")
+	assert.True(t, errors.Is(err, api.ErrNotFound))
+}
+
+func TestBackend_League(t *testing.T) {
+	t.Parallel()
+	b := New(1)
+	client := newClient(b)
+	summoner := b.CreateSummoner("Silent Fox")
+	entries, err := client.League.ListBySummoner(summoner.ID)
+	require.Nil(t, err)
+	assert.Empty(t, entries)
+
+	entry := b.CreateLeagueEntry(summoner)
+	entries, err = client.League.ListBySummoner(summoner.ID)
+	require.Nil(t, err)
+	assert.Equal(t, []*riot.LeagueItem{entry}, entries)
+	league, err := client.League.Get(entry.LeagueID)
+	require.Nil(t, err)
+	assert.Len(t, league.Entries, leagueSize+1)
+	assert.Contains(t, league.Entries, entry)
+	for _, e := range league.Entries {
+		other, err := client.Summoner.GetByID(e.SummonerID)
+		require.Nil(t, err)
+		assert.Equal(t, e.SummonerName, other.Name)
+	}
+
+	b.AddLeagueEntry(&riot.LeagueItem{
+		LeagueID:   "apex",
+		QueueType:  string(riot.QueueRankedSolo),
+		Tier:       "CHALLENGER",
+		Rank:       "I",
+		SummonerID: summoner.ID,
+	})
+	challenger, err := client.League.GetChallenger(riot.QueueRankedSolo)
+	require.Nil(t, err)
+	assert.Equal(t, "apex", challenger.LeagueID)
+	_, err = client.League.GetMaster(riot.QueueRankedSolo)
+	assert.True(t, errors.Is(err, api.ErrNotFound))
+	entries, err = client.League.ListBySummoner(summoner.ID)
+	require.Nil(t, err)
+	assert.Len(t, entries, 2)
+}
+
+func TestBackend_ChampionMastery(t *testing.T) {
+	t.Parallel()
+	b := New(1)
+	client := newClient(b)
+	summoner := b.CreateSummoner("Silent Fox")
+	b.AddChampionMastery(&riot.ChampionMastery{SummonerID: summoner.ID, ChampionID: 1, ChampionPoints: 100,
+		ChampionLevel: 1})
+	b.AddChampionMastery(&riot.ChampionMastery{SummonerID: summoner.ID, ChampionID: 2, ChampionPoints: 9000,
+		ChampionLevel: 3})
+	masteries, err := client.ChampionMastery.List(summoner.ID)
+	require.Nil(t, err)
+	require.Len(t, masteries, 2)
+	assert.Equal(t, 2, masteries[0].ChampionID)
+	mastery, err := client.ChampionMastery.Get(summoner.ID, "1")
+	require.Nil(t, err)
+	assert.Equal(t, 100, mastery.ChampionPoints)
+	score, err := client.ChampionMastery.GetTotal(summoner.ID)
+	require.Nil(t, err)
+	assert.Equal(t, 4, score)
+	_, err = client.ChampionMastery.List("unknown")
+	assert.True(t, errors.Is(err, api.ErrNotFound))
+}
+
+func TestBackend_Game(t *testing.T) {
+	t.Parallel()
+	b := New(1)
+	client := newClient(b)
+	summoner := b.CreateSummoner("Silent Fox")
+	_, err := client.Spectator.GetCurrent(summoner.ID)
+	assert.True(t, errors.Is(err, api.ErrNotFound))
+
+	game := b.StartGame(summoner)
+	require.Len(t, game.Participants, 10)
+	current, err := client.Spectator.GetCurrent(summoner.ID)
+	require.Nil(t, err)
+	assert.Equal(t, game, current)
+	featured, err := client.Spectator.ListFeatured()
+	require.Nil(t, err)
+	assert.Equal(t, []*riot.GameInfo{game}, featured.GameList)
+	_, err = client.Match.Get(game.GameID)
+	assert.True(t, errors.Is(err, api.ErrNotFound))
+
+	match := b.EndGame(game.GameID)
+	require.NotNil(t, match)
+	assert.Nil(t, b.EndGame(game.GameID))
+	_, err = client.Spectator.GetCurrent(summoner.ID)
+	assert.True(t, errors.Is(err, api.ErrNotFound))
+	got, err := client.Match.Get(game.GameID)
+	require.Nil(t, err)
+	assert.Equal(t, match, got)
+	timeline, err := client.Match.GetTimeline(game.GameID)
+	require.Nil(t, err)
+	assert.NotEmpty(t, timeline.Frames)
+	list, err := client.Match.List(summoner.AccountID, riot.NewMatchFilter())
+	require.Nil(t, err)
+	require.Len(t, list.Matches, 1)
+	assert.Equal(t, game.GameID, list.Matches[0].GameID)
+	for i, p := range game.Participants {
+		assert.Equal(t, match.Participants[i].ChampionID, p.ChampionID)
+		assert.Equal(t, match.ParticipantIdentities[i].Player.SummonerID, p.SummonerID)
+	}
+}
+
+func TestBackend_Other(t *testing.T) {
+	t.Parallel()
+	b := New(1)
+	client := newClient(b)
+	b.SetThirdPartyCode("summoner", "code")
+	code, err := client.ThirdPartyCode.Get("summoner")
+	require.Nil(t, err)
+	assert.Equal(t, "code", code)
+	_, err = client.ThirdPartyCode.Get("other")
+	assert.True(t, errors.Is(err, api.ErrNotFound))
+
+	rotation, err := client.Champion.GetFreeRotation()
+	require.Nil(t, err)
+	assert.NotEmpty(t, rotation.FreeChampionIDs)
+	b.SetChampionRotation(&riot.ChampionInfo{FreeChampionIDs: []int{1}})
+	rotation, err = client.Champion.GetFreeRotation()
+	require.Nil(t, err)
+	assert.Equal(t, []int{1}, rotation.FreeChampionIDs)
+
+	status, err := client.Status.Get()
+	require.Nil(t, err)
+	assert.Equal(t, "EUW1", status.Name)
+	b.SetStatus(&riot.Status{Name: "custom"})
+	status, err = client.Status.Get()
+	require.Nil(t, err)
+	assert.Equal(t, "custom", status.Name)
+}
+
+func TestBackend_Do(t *testing.T) {
+	t.Parallel()
+	b := New(1)
+	req, err := http.NewRequest(http.MethodGet, "https://ddragon.leagueoflegends.com/realms/na.json", nil)
+	require.Nil(t, err)
+	res, err := b.Do(req)
+	require.Nil(t, err)
+	assert.Equal(t, http.StatusNotFound, res.StatusCode)
+	req, err = http.NewRequest(http.MethodGet, "https://euw1.api.riotgames.com/lol/unknown", nil)
+	require.Nil(t, err)
+	res, err = b.Do(req)
+	require.Nil(t, err)
+	assert.Equal(t, http.StatusNotFound, res.StatusCode)
+}
+
+func TestBackend_Concurrent(t *testing.T) {
+	t.Parallel()
+	b := New(1)
+	client := newClient(b)
+	done := make(chan struct{})
+	for i := 0; i < 4; i++ {
+		go func(i int) {
+			defer func() { done <- struct{}{} }()
+			summoner := b.CreateSummoner("Summoner " + strconv.Itoa(i))
+			b.CreateMatch(summoner)
+			_, _ = client.Match.List(summoner.AccountID, riot.NewMatchFilter())
+		}(i)
+	}
+	for i := 0; i < 4; i++ {
+		<-done
+	}
+	assert.Len(t, b.matches, 4)
+}
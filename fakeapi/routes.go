@@ -0,0 +1,500 @@
+package fakeapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mjourard/golio/riot"
+)
+
+const (
+	fieldName      = "name"
+	fieldID        = "id"
+	fieldAccountID = "account"
+	fieldPUUID     = "puuid"
+
+	defaultEndIndex  = 100
+	maxMatchlistPage = 100
+	leaguePageSize   = 205
+	maxCodes         = 1000
+	maxTeamSize      = 5
+)
+
+type route struct {
+	method  string
+	pattern *regexp.Regexp
+	// handler returns the payload and status code for the request given the submatches of pattern. It is called
+	// while holding b.mu
+	handler func(b *Backend, r *http.Request, params []string) (interface{}, int)
+}
+
+// tournamentBase matches the paths of both the tournament and the tournament stub API, which share their data
+const tournamentBase = `^/lol/tournament(?:-stub)?/v4`
+
+var routes = []route{
+	{http.MethodGet, regexp.MustCompile(`^/lol/summoner/v4/summoners/by-name/([^/]+)$`), summonerBy(fieldName)},
+	{http.MethodGet, regexp.MustCompile(`^/lol/summoner/v4/summoners/by-account/([^/]+)$`), summonerBy(fieldAccountID)},
+	{http.MethodGet, regexp.MustCompile(`^/lol/summoner/v4/summoners/by-puuid/([^/]+)$`), summonerBy(fieldPUUID)},
+	{http.MethodGet, regexp.MustCompile(`^/lol/summoner/v4/summoners/([^/]+)$`), summonerBy(fieldID)},
+	{http.MethodGet, regexp.MustCompile(`^/lol/champion-mastery/v4/champion-masteries/by-summoner/([^/]+)$`),
+		masteries},
+	{http.MethodGet,
+		regexp.MustCompile(`^/lol/champion-mastery/v4/champion-masteries/by-summoner/([^/]+)/by-champion/(\d+)$`),
+		mastery},
+	{http.MethodGet, regexp.MustCompile(`^/lol/champion-mastery/v4/scores/by-summoner/([^/]+)$`), masteryScore},
+	{http.MethodGet, regexp.MustCompile(`^/lol/platform/v3/champion-rotations$`), rotation},
+	{http.MethodGet, regexp.MustCompile(`^/lol/platform/v3/third-party-code/by-summoner/([^/]+)$`), thirdPartyCode},
+	{http.MethodGet, regexp.MustCompile(`^/lol/league/v4/(challenger|grandmaster|master)leagues/by-queue/([^/]+)$`),
+		apexLeague},
+	{http.MethodGet, regexp.MustCompile(`^/lol/league/v4/leagues/([^/]+)$`), league},
+	{http.MethodGet, regexp.MustCompile(`^/lol/league/v4/entries/by-summoner/([^/]+)$`), leagueEntriesBySummoner},
+	{http.MethodGet, regexp.MustCompile(`^/lol/league/v4/entries/([^/]+)/([^/]+)/([^/]+)$`), leagueEntries},
+	{http.MethodGet, regexp.MustCompile(`^/lol/status/v3/shard-data$`), status},
+	{http.MethodGet, regexp.MustCompile(`^/lol/match/v4/matches/by-tournament-code/([^/]+)/ids$`),
+		tournamentMatchIDs},
+	{http.MethodGet, regexp.MustCompile(`^/lol/match/v4/matches/(\d+)/by-tournament-code/([^/]+)$`),
+		tournamentMatch},
+	{http.MethodGet, regexp.MustCompile(`^/lol/match/v4/matches/(\d+)$`), match},
+	{http.MethodGet, regexp.MustCompile(`^/lol/match/v4/matchlists/by-account/([^/]+)$`), matchlist},
+	{http.MethodGet, regexp.MustCompile(`^/lol/match/v4/timelines/by-match/(\d+)$`), timeline},
+	{http.MethodGet, regexp.MustCompile(`^/lol/spectator/v4/active-games/by-summoner/([^/]+)$`), currentGame},
+	{http.MethodGet, regexp.MustCompile(`^/lol/spectator/v4/featured-games$`), featuredGames},
+	{http.MethodPost, regexp.MustCompile(tournamentBase + `/providers$`), createProvider},
+	{http.MethodPost, regexp.MustCompile(tournamentBase + `/tournaments$`), createTournament},
+	{http.MethodPost, regexp.MustCompile(tournamentBase + `/codes$`), createCodes},
+	{http.MethodGet, regexp.MustCompile(`^/lol/tournament/v4/codes/([^/]+)$`), tournament},
+	{http.MethodPut, regexp.MustCompile(`^/lol/tournament/v4/codes/([^/]+)$`), updateTournament},
+	{http.MethodGet, regexp.MustCompile(tournamentBase + `/lobby-events/by-code/([^/]+)$`), lobbyEvents},
+}
+
+// handle returns the payload and status code for the given request. The caller must hold b.mu
+func (b *Backend) handle(r *http.Request) (interface{}, int) {
+	for _, route := range routes {
+		params := route.pattern.FindStringSubmatch(r.URL.Path)
+		if params == nil {
+			continue
+		}
+		if r.Method != route.method {
+			continue
+		}
+		for i, param := range params {
+			if unescaped, err := url.PathUnescape(param); err == nil {
+				params[i] = unescaped
+			}
+		}
+		return route.handler(b, r, params[1:])
+	}
+	return nil, http.StatusNotFound
+}
+
+// found returns the payload with status code 200 if ok is true and 404 otherwise
+func found(payload interface{}, ok bool) (interface{}, int) {
+	if !ok {
+		return nil, http.StatusNotFound
+	}
+	return payload, http.StatusOK
+}
+
+func summonerBy(field string) func(*Backend, *http.Request, []string) (interface{}, int) {
+	return func(b *Backend, _ *http.Request, params []string) (interface{}, int) {
+		summoner := b.summonerBy(field, params[0])
+		return found(summoner, summoner != nil)
+	}
+}
+
+func masteries(b *Backend, _ *http.Request, params []string) (interface{}, int) {
+	if b.summonerBy(fieldID, params[0]) == nil {
+		return nil, http.StatusNotFound
+	}
+	return append([]*riot.ChampionMastery{}, b.masteries[params[0]]...), http.StatusOK
+}
+
+func mastery(b *Backend, _ *http.Request, params []string) (interface{}, int) {
+	championID, _ := strconv.Atoi(params[1])
+	for _, m := range b.masteries[params[0]] {
+		if m.ChampionID == championID {
+			return m, http.StatusOK
+		}
+	}
+	return nil, http.StatusNotFound
+}
+
+func masteryScore(b *Backend, _ *http.Request, params []string) (interface{}, int) {
+	if b.summonerBy(fieldID, params[0]) == nil {
+		return nil, http.StatusNotFound
+	}
+	score := 0
+	for _, m := range b.masteries[params[0]] {
+		score += m.ChampionLevel
+	}
+	return score, http.StatusOK
+}
+
+func rotation(b *Backend, _ *http.Request, _ []string) (interface{}, int) {
+	if b.rotation == nil {
+		b.rotation = b.generator.ChampionInfo()
+	}
+	return b.rotation, http.StatusOK
+}
+
+func thirdPartyCode(b *Backend, _ *http.Request, params []string) (interface{}, int) {
+	code, ok := b.thirdPartyCodes[params[0]]
+	return found(code, ok)
+}
+
+// apexLeague returns the league of the apex tier and queue. Unlike the Riot API, a league is returned only if
+// entries with the tier and queue were added
+func apexLeague(b *Backend, _ *http.Request, params []string) (interface{}, int) {
+	tier := strings.ToUpper(params[0])
+	for _, list := range b.sortedLeagues() {
+		if list.Tier == tier && list.Queue == params[1] {
+			return list, http.StatusOK
+		}
+	}
+	return nil, http.StatusNotFound
+}
+
+func league(b *Backend, _ *http.Request, params []string) (interface{}, int) {
+	list, ok := b.leagues[params[0]]
+	return found(list, ok)
+}
+
+func leagueEntriesBySummoner(b *Backend, _ *http.Request, params []string) (interface{}, int) {
+	entries := []*riot.LeagueItem{}
+	for _, list := range b.sortedLeagues() {
+		for _, entry := range list.Entries {
+			if entry.SummonerID == params[0] {
+				entries = append(entries, entry)
+			}
+		}
+	}
+	return entries, http.StatusOK
+}
+
+func leagueEntries(b *Backend, r *http.Request, params []string) (interface{}, int) {
+	page, err := strconv.Atoi(r.URL.Query().Get("page"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+	var entries []*riot.LeagueItem
+	for _, list := range b.sortedLeagues() {
+		for _, entry := range list.Entries {
+			if entry.QueueType == params[0] && entry.Tier == params[1] && entry.Rank == params[2] {
+				entries = append(entries, entry)
+			}
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].LeaguePoints > entries[j].LeaguePoints
+	})
+	begin := (page - 1) * leaguePageSize
+	if begin >= len(entries) {
+		return []*riot.LeagueItem{}, http.StatusOK
+	}
+	return entries[begin:min(begin+leaguePageSize, len(entries))], http.StatusOK
+}
+
+// sortedLeagues returns all leagues sorted by ID, so that responses do not depend on the order of the map
+func (b *Backend) sortedLeagues() []*riot.LeagueList {
+	lists := make([]*riot.LeagueList, 0, len(b.leagues))
+	for _, list := range b.leagues {
+		lists = append(lists, list)
+	}
+	sort.Slice(lists, func(i, j int) bool {
+		return lists[i].LeagueID < lists[j].LeagueID
+	})
+	return lists
+}
+
+func status(b *Backend, r *http.Request, _ []string) (interface{}, int) {
+	if b.status != nil {
+		return b.status, http.StatusOK
+	}
+	platform := strings.TrimSuffix(r.URL.Host, riotHostSuffix)
+	res := &riot.Status{
+		Name:      strings.ToUpper(platform),
+		RegionTag: platform,
+		Hostname:  r.URL.Host,
+		Slug:      platform,
+		Locales:   []string{"en_US"},
+	}
+	for _, service := range []string{"Game", "Store", "Website", "Client"} {
+		res.Services = append(res.Services, &riot.Service{
+			Name:      service,
+			Slug:      strings.ToLower(service),
+			Status:    "online",
+			Incidents: []*riot.Incident{},
+		})
+	}
+	return res, http.StatusOK
+}
+
+func match(b *Backend, _ *http.Request, params []string) (interface{}, int) {
+	gameID, _ := strconv.Atoi(params[0])
+	m, ok := b.matches[gameID]
+	return found(m, ok)
+}
+
+func timeline(b *Backend, _ *http.Request, params []string) (interface{}, int) {
+	gameID, _ := strconv.Atoi(params[0])
+	t, ok := b.timelines[gameID]
+	return found(t, ok)
+}
+
+// matchlist returns the matches the summoner participated in, the most recent first. All filters of MatchFilter
+// are applied
+func matchlist(b *Backend, r *http.Request, params []string) (interface{}, int) {
+	summoner := b.summonerBy(fieldAccountID, params[0])
+	if summoner == nil {
+		return nil, http.StatusNotFound
+	}
+	query := r.URL.Query()
+	champions, queues, seasons := newIntSet(query["champion"]), newIntSet(query["queue"]), newIntSet(query["season"])
+	beginTime, _ := strconv.ParseInt(query.Get("beginTime"), 10, 64)
+	endTime, _ := strconv.ParseInt(query.Get("endTime"), 10, 64)
+	var references []*riot.MatchReference
+	for _, m := range b.matches {
+		for i, identity := range m.ParticipantIdentities {
+			if identity.Player == nil || identity.Player.AccountID != summoner.AccountID {
+				continue
+			}
+			p := m.Participants[i]
+			switch {
+			case !champions.contains(p.ChampionID), !queues.contains(m.QueueID), !seasons.contains(m.SeasonID),
+				beginTime > 0 && m.GameCreation.Millis() < beginTime, endTime > 0 && m.GameCreation.Millis() > endTime:
+				continue
+			}
+			reference := &riot.MatchReference{
+				GameID:     m.GameID,
+				PlatformID: m.PlatformID,
+				Champion:   p.ChampionID,
+				Queue:      m.QueueID,
+				Season:     m.SeasonID,
+				Timestamp:  m.GameCreation,
+			}
+			if p.Timeline != nil {
+				reference.Lane = p.Timeline.Lane
+				reference.Role = p.Timeline.Role
+			}
+			references = append(references, reference)
+		}
+	}
+	sort.Slice(references, func(i, j int) bool {
+		if !references[i].Timestamp.Equal(references[j].Timestamp.Time) {
+			return references[i].Timestamp.After(references[j].Timestamp.Time)
+		}
+		return references[i].GameID > references[j].GameID
+	})
+	begin, err := strconv.Atoi(query.Get("beginIndex"))
+	if err != nil || begin < 0 {
+		begin = 0
+	}
+	end, err := strconv.Atoi(query.Get("endIndex"))
+	if err != nil || end > begin+maxMatchlistPage {
+		end = begin + defaultEndIndex
+	}
+	if end < begin {
+		return nil, http.StatusBadRequest
+	}
+	res := &riot.Matchlist{
+		Matches:    []*riot.MatchReference{},
+		TotalGames: len(references),
+		StartIndex: begin,
+	}
+	for i := begin; i < end && i < len(references); i++ {
+		res.Matches = append(res.Matches, references[i])
+	}
+	res.EndIndex = begin + len(res.Matches)
+	return res, http.StatusOK
+}
+
+// intSet is a set of integers in which every value is contained if it is empty
+type intSet map[int]bool
+
+func newIntSet(values []string) intSet {
+	set := intSet{}
+	for _, value := range values {
+		if i, err := strconv.Atoi(value); err == nil {
+			set[i] = true
+		}
+	}
+	return set
+}
+
+func (s intSet) contains(i int) bool {
+	return len(s) == 0 || s[i]
+}
+
+func tournamentMatchIDs(b *Backend, _ *http.Request, params []string) (interface{}, int) {
+	if _, ok := b.codes[params[0]]; !ok {
+		return nil, http.StatusNotFound
+	}
+	return append([]int{}, b.tournamentMatches[params[0]]...), http.StatusOK
+}
+
+func tournamentMatch(b *Backend, _ *http.Request, params []string) (interface{}, int) {
+	gameID, _ := strconv.Atoi(params[0])
+	for _, id := range b.tournamentMatches[params[1]] {
+		if id == gameID {
+			return found(b.matches[gameID], b.matches[gameID] != nil)
+		}
+	}
+	return nil, http.StatusNotFound
+}
+
+func currentGame(b *Backend, _ *http.Request, params []string) (interface{}, int) {
+	for _, game := range b.sortedGames() {
+		for _, p := range game.Participants {
+			if p.SummonerID == params[0] {
+				return game, http.StatusOK
+			}
+		}
+	}
+	return nil, http.StatusNotFound
+}
+
+func featuredGames(b *Backend, _ *http.Request, _ []string) (interface{}, int) {
+	return &riot.FeaturedGames{
+		ClientRefreshInterval: riot.DurationSeconds{Duration: 5 * time.Minute},
+		GameList:              b.sortedGames(),
+	}, http.StatusOK
+}
+
+// sortedGames returns all games in progress sorted by ID
+func (b *Backend) sortedGames() []*riot.GameInfo {
+	games := make([]*riot.GameInfo, 0, len(b.games))
+	for _, game := range b.games {
+		games = append(games, game)
+	}
+	sort.Slice(games, func(i, j int) bool {
+		return games[i].GameID < games[j].GameID
+	})
+	return games
+}
+
+// id returns a new ID for a provider or tournament
+func (b *Backend) id() int {
+	id := b.nextID
+	b.nextID++
+	return id
+}
+
+func createProvider(b *Backend, r *http.Request, _ []string) (interface{}, int) {
+	params := &riot.ProviderRegistrationParameters{}
+	if err := decodeBody(r, params); err != nil || params.URL == "" || params.Region == "" {
+		return nil, http.StatusBadRequest
+	}
+	id := b.id()
+	b.providers[id] = params
+	return id, http.StatusOK
+}
+
+func createTournament(b *Backend, r *http.Request, _ []string) (interface{}, int) {
+	params := &riot.TournamentRegistrationParameters{}
+	if err := decodeBody(r, params); err != nil {
+		return nil, http.StatusBadRequest
+	}
+	if _, ok := b.providers[params.ProviderID]; !ok {
+		return nil, http.StatusBadRequest
+	}
+	id := b.id()
+	b.tournaments[id] = params
+	return id, http.StatusOK
+}
+
+// createCodes creates tournament codes, which can be retrieved and updated afterwards
+func createCodes(b *Backend, r *http.Request, _ []string) (interface{}, int) {
+	count, err := strconv.Atoi(r.URL.Query().Get("count"))
+	if err != nil || count < 1 || count > maxCodes {
+		return nil, http.StatusBadRequest
+	}
+	tournamentID, _ := strconv.Atoi(r.URL.Query().Get("tournamentId"))
+	registration, ok := b.tournaments[tournamentID]
+	if !ok {
+		return nil, http.StatusBadRequest
+	}
+	params := &riot.TournamentCodeParameters{}
+	if err := decodeBody(r, params); err != nil || params.TeamSize < 1 || params.TeamSize > maxTeamSize {
+		return nil, http.StatusBadRequest
+	}
+	region := b.providers[registration.ProviderID].Region
+	codes := make([]string, count)
+	for i := range codes {
+		id := b.id()
+		codes[i] = fmt.Sprintf("%s%04d-%08d", region, tournamentID, id)
+		b.codes[codes[i]] = &riot.Tournament{
+			Map:          params.MapType,
+			Code:         codes[i],
+			Spectators:   params.SpectatorType,
+			Region:       region,
+			ProviderID:   registration.ProviderID,
+			TeamSize:     params.TeamSize,
+			Participants: append([]string{}, params.AllowedSummonerIDs...),
+			PickType:     params.PickType,
+			TournamentID: tournamentID,
+			LobbyName:    codes[i],
+			Password:     fmt.Sprintf("%08d", id*7919%100000000),
+			ID:           id,
+			MetaData:     params.Metadata,
+		}
+	}
+	return codes, http.StatusOK
+}
+
+func tournament(b *Backend, _ *http.Request, params []string) (interface{}, int) {
+	t, ok := b.codes[params[0]]
+	return found(t, ok)
+}
+
+func updateTournament(b *Backend, r *http.Request, params []string) (interface{}, int) {
+	t, ok := b.codes[params[0]]
+	if !ok {
+		return nil, http.StatusNotFound
+	}
+	update := &riot.TournamentUpdateParameters{}
+	if err := decodeBody(r, update); err != nil {
+		return nil, http.StatusBadRequest
+	}
+	if update.SpectatorType != "" {
+		t.Spectators = update.SpectatorType
+	}
+	if update.PickType != "" {
+		t.PickType = update.PickType
+	}
+	if update.MapType != "" {
+		t.Map = update.MapType
+	}
+	if update.AllowedSummonerIDs != nil {
+		t.Participants = append([]string{}, update.AllowedSummonerIDs...)
+	}
+	return nil, http.StatusOK
+}
+
+func lobbyEvents(b *Backend, _ *http.Request, params []string) (interface{}, int) {
+	if _, ok := b.codes[params[0]]; !ok {
+		return nil, http.StatusNotFound
+	}
+	return &riot.LobbyEventList{EventList: append([]*riot.LobbyEvent{}, b.lobbyEvents[params[0]]...)}, http.StatusOK
+}
+
+func decodeBody(r *http.Request, target interface{}) error {
+	if r.Body == nil {
+		return fmt.Errorf("empty body")
+	}
+	defer r.Body.Close()
+	return json.NewDecoder(r.Body).Decode(target)
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,227 @@
+package fakeapi
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mjourard/golio/api"
+	"github.com/mjourard/golio/riot"
+)
+
+func TestMatchlist(t *testing.T) {
+	t.Parallel()
+	b := New(1)
+	client := newClient(b)
+	summoner := b.CreateSummoner("Silent Fox")
+	other := b.CreateSummoner("Crimson Owl")
+	var matches []*riot.Match
+	for i := 0; i < 5; i++ {
+		matches = append(matches, b.CreateMatch(summoner))
+	}
+	b.CreateMatch(other)
+	ownChampion := func(m *riot.Match) int {
+		for i, identity := range m.ParticipantIdentities {
+			if identity.Player.AccountID == summoner.AccountID {
+				return m.Participants[i].ChampionID
+			}
+		}
+		return 0
+	}
+	index := func(i int) *int { return &i }
+	tests := []struct {
+		name   string
+		filter *riot.MatchFilter
+		want   func(m *riot.Match) bool
+		total  int
+	}{
+		{
+			name:   "all",
+			filter: riot.NewMatchFilter(),
+			want:   func(*riot.Match) bool { return true },
+			total:  5,
+		},
+		{
+			name:   "champion",
+			filter: &riot.MatchFilter{ChampionIds: []int{ownChampion(matches[2])}},
+			want:   func(m *riot.Match) bool { return ownChampion(m) == ownChampion(matches[2]) },
+		},
+		{
+			name:   "queue",
+			filter: &riot.MatchFilter{QueueIds: []int{matches[0].QueueID}},
+			want:   func(m *riot.Match) bool { return m.QueueID == matches[0].QueueID },
+		},
+		{
+			name: "time",
+			filter: &riot.MatchFilter{
+				BeginTime: timePtr(time.Unix(matches[1].GameCreation.Unix(), 0)),
+			},
+			want: func(m *riot.Match) bool { return m.GameCreation.Unix() >= matches[1].GameCreation.Unix() },
+		},
+		{
+			name:   "index",
+			filter: &riot.MatchFilter{BeginIndex: index(1), EndIndex: index(3)},
+			total:  5,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			list, err := client.Match.List(summoner.AccountID, test.filter)
+			require.Nil(t, err)
+			for i := 1; i < len(list.Matches); i++ {
+				assert.False(t, list.Matches[i-1].Timestamp.Before(list.Matches[i].Timestamp.Time))
+			}
+			if test.total != 0 {
+				assert.Equal(t, test.total, list.TotalGames)
+			}
+			if test.want == nil {
+				assert.Len(t, list.Matches, 2)
+				assert.Equal(t, 1, list.StartIndex)
+				assert.Equal(t, 3, list.EndIndex)
+				return
+			}
+			count := 0
+			for _, m := range matches {
+				if test.want(m) {
+					count++
+				}
+			}
+			assert.Len(t, list.Matches, count)
+			for _, reference := range list.Matches {
+				assert.True(t, test.want(b.matches[reference.GameID]))
+			}
+		})
+	}
+	_, err := client.Match.List("unknown", riot.NewMatchFilter())
+	assert.True(t, errors.Is(err, api.ErrNotFound))
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
+
+func TestLeagueEntries(t *testing.T) {
+	t.Parallel()
+	b := New(1)
+	client := newClient(b)
+	for i := 0; i < leaguePageSize+5; i++ {
+		b.AddLeagueEntry(&riot.LeagueItem{
+			LeagueID:     "league",
+			QueueType:    string(riot.QueueRankedSolo),
+			Tier:         string(riot.TierGold),
+			Rank:         string(riot.DivisionTwo),
+			SummonerID:   string(rune('a'+i%26)) + string(rune('a'+i/26)),
+			LeaguePoints: i % 100,
+		})
+	}
+	first, err := client.League.ListPlayers(riot.QueueRankedSolo, riot.TierGold, riot.DivisionTwo, 1)
+	require.Nil(t, err)
+	assert.Len(t, first, leaguePageSize)
+	assert.Equal(t, 99, first[0].LeaguePoints)
+	second, err := client.League.ListPlayers(riot.QueueRankedSolo, riot.TierGold, riot.DivisionTwo, 2)
+	require.Nil(t, err)
+	assert.Len(t, second, 5)
+	none, err := client.League.ListPlayers(riot.QueueRankedSolo, riot.TierGold, riot.DivisionOne, 1)
+	require.Nil(t, err)
+	assert.Empty(t, none)
+}
+
+func TestTournament(t *testing.T) {
+	t.Parallel()
+	for _, stub := range []bool{false, true} {
+		stub := stub
+		t.Run(map[bool]string{false: "tournament", true: "stub"}[stub], func(t *testing.T) {
+			t.Parallel()
+			b := New(1)
+			client := newClient(b)
+			_, err := client.Tournament.Create(&riot.TournamentRegistrationParameters{ProviderID: 1}, stub)
+			assert.True(t, errors.Is(err, api.ErrBadRequest))
+			provider, err := client.Tournament.CreateProvider(&riot.ProviderRegistrationParameters{
+				URL:    "https://example.com",
+				Region: "EUW",
+			}, stub)
+			require.Nil(t, err)
+			id, err := client.Tournament.Create(&riot.TournamentRegistrationParameters{ProviderID: provider}, stub)
+			require.Nil(t, err)
+			_, err = client.Tournament.CreateCodes(id, 1, &riot.TournamentCodeParameters{TeamSize: 6}, stub)
+			assert.True(t, errors.Is(err, api.ErrBadRequest))
+			_, err = client.Tournament.CreateCodes(id+1, 1, &riot.TournamentCodeParameters{TeamSize: 5}, stub)
+			assert.True(t, errors.Is(err, api.ErrBadRequest))
+			params := &riot.TournamentCodeParameters{
+				TeamSize:           5,
+				PickType:           "TOURNAMENT_DRAFT",
+				MapType:            "SUMMONERS_RIFT",
+				SpectatorType:      "ALL",
+				AllowedSummonerIDs: []string{"a", "b"},
+				Metadata:           "meta",
+			}
+			codes, err := client.Tournament.CreateCodes(id, 3, params, stub)
+			require.Nil(t, err)
+			require.Len(t, codes, 3)
+			assert.NotEqual(t, codes[0], codes[1])
+
+			events, err := client.Tournament.ListLobbyEvents(codes[0], stub)
+			require.Nil(t, err)
+			assert.Empty(t, events.EventList)
+			b.AddLobbyEvent(codes[0], &riot.LobbyEvent{EventType: "PracticeGameCreatedEvent", SummonerID: "a"})
+			events, err = client.Tournament.ListLobbyEvents(codes[0], stub)
+			require.Nil(t, err)
+			assert.Len(t, events.EventList, 1)
+			_, err = client.Tournament.ListLobbyEvents("unknown", stub)
+			assert.True(t, errors.Is(err, api.ErrNotFound))
+
+			tournament, err := client.Tournament.Get(codes[0])
+			require.Nil(t, err)
+			assert.Equal(t, &riot.Tournament{
+				Map:          "SUMMONERS_RIFT",
+				Code:         codes[0],
+				Spectators:   "ALL",
+				Region:       "EUW",
+				ProviderID:   provider,
+				TeamSize:     5,
+				Participants: []string{"a", "b"},
+				PickType:     "TOURNAMENT_DRAFT",
+				TournamentID: id,
+				LobbyName:    codes[0],
+				Password:     tournament.Password,
+				ID:           tournament.ID,
+				MetaData:     "meta",
+			}, tournament)
+			require.Nil(t, client.Tournament.Update(codes[0], riot.TournamentUpdateParameters{PickType: "BLIND_PICK"}))
+			tournament, err = client.Tournament.Get(codes[0])
+			require.Nil(t, err)
+			assert.Equal(t, "BLIND_PICK", tournament.PickType)
+			assert.Equal(t, "ALL", tournament.Spectators)
+			_, err = client.Tournament.Get("unknown")
+			assert.True(t, errors.Is(err, api.ErrNotFound))
+
+			ids, err := client.Match.ListIDsByTournamentCode(codes[0])
+			require.Nil(t, err)
+			assert.Empty(t, ids)
+			match := b.generator.Match()
+			b.AddTournamentMatch(codes[0], match)
+			ids, err = client.Match.ListIDsByTournamentCode(codes[0])
+			require.Nil(t, err)
+			assert.Equal(t, []int{match.GameID}, ids)
+			got, err := client.Match.GetForTournament(match.GameID, codes[0])
+			require.Nil(t, err)
+			assert.Equal(t, match, got)
+			_, err = client.Match.GetForTournament(match.GameID, codes[1])
+			assert.True(t, errors.Is(err, api.ErrNotFound))
+		})
+	}
+}
+
+func TestHandle_Method(t *testing.T) {
+	t.Parallel()
+	b := New(1)
+	req, err := http.NewRequest(http.MethodDelete, "https://euw1.api.riotgames.com/lol/tournament/v4/codes/x", nil)
+	require.Nil(t, err)
+	res, err := b.Do(req)
+	require.Nil(t, err)
+	assert.Equal(t, http.StatusNotFound, res.StatusCode)
+}
@@ -0,0 +1,233 @@
+// Package features converts match timelines into flat feature vectors for training machine learning models, e.g. to
+// predict the winner of a game from its first minutes:
+//
+//	extractor := features.Extractor{Minutes: []int{10, 15}}
+//	writer := features.NewCSVWriter(file, extractor)
+//	err := extractor.ExtractStream(client.Riot.Match.ListStream(accountID, nil, riot.ListStreamWithTimelines()),
+//		writer.Write)
+//
+// All features are from the perspective of the blue team (team ID 100): diffs are blue minus red and indicators are
+// 1 if the blue team achieved the objective first. Features which are not available, e.g. the gold diff at minute 30
+// of a game lasting 25 minutes, are NaN and written as empty values or null.
+package features
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"time"
+
+	"github.com/mjourard/golio/riot"
+)
+
+// Teams of a match
+const (
+	teamBlue = 100
+	teamRed  = 200
+)
+
+// DefaultMinutes are the minute marks used if no minutes are specified
+var DefaultMinutes = []int{5, 10, 15, 20}
+
+// ErrNoTimeline is returned for matches without a timeline
+var ErrNoTimeline = errors.New("features: match has no timeline")
+
+// objectives are the objectives whose first kill is extracted, by the prefix of their feature names
+var objectives = []struct {
+	name  string
+	event riot.MatchEventType
+}{
+	{name: "first_blood", event: riot.MatchEventTypeChampionKill},
+	{name: "first_tower", event: riot.MatchEventTypeBuildingKill},
+	{name: "first_dragon", event: riot.MatchEventTypeDragonKill},
+	{name: "first_herald", event: riot.MatchEventTypeRiftHeraldKill},
+	{name: "first_baron", event: riot.MatchEventTypeBaronKill},
+}
+
+// Extractor converts a match and its timeline into a feature vector. The zero value uses DefaultMinutes
+type Extractor struct {
+	// Minutes are the minute marks at which the gold, experience and creep score diffs are extracted
+	Minutes []int
+}
+
+// Vector are the features of a single match
+type Vector struct {
+	GameID int
+	// Values of the features in the order of Extractor.Names
+	Values []float64
+}
+
+func (e Extractor) minutes() []int {
+	if len(e.Minutes) == 0 {
+		return DefaultMinutes
+	}
+	return e.Minutes
+}
+
+// Names returns the names of the features in the order of Vector.Values
+func (e Extractor) Names() []string {
+	names := []string{"queue_id", "duration_seconds", "blue_win"}
+	for _, minute := range e.minutes() {
+		names = append(names, fmt.Sprintf("gold_diff_%d", minute), fmt.Sprintf("xp_diff_%d", minute),
+			fmt.Sprintf("cs_diff_%d", minute))
+	}
+	for _, objective := range objectives {
+		names = append(names, objective.name+"_blue", objective.name+"_seconds")
+	}
+	return names
+}
+
+// Extract returns the features of the match. The timeline must belong to the match
+func (e Extractor) Extract(match *riot.Match, timeline *riot.MatchTimeline) (Vector, error) {
+	if match == nil {
+		return Vector{}, errors.New("features: match is nil")
+	}
+	if timeline == nil {
+		return Vector{}, ErrNoTimeline
+	}
+	values := []float64{float64(match.QueueID), match.GameDuration.Seconds(), blueWin(match)}
+	for _, minute := range e.minutes() {
+		gold, xp, cs := diffsAt(match, timeline, time.Duration(minute)*time.Minute)
+		values = append(values, gold, xp, cs)
+	}
+	firsts := firstObjectives(match, timeline)
+	for _, objective := range objectives {
+		first, ok := firsts[objective.event]
+		if !ok {
+			values = append(values, math.NaN(), math.NaN())
+			continue
+		}
+		values = append(values, indicator(first.team == teamBlue), first.time.Seconds())
+	}
+	return Vector{GameID: match.GameID, Values: values}, nil
+}
+
+// ExtractStream extracts the features of every match of the stream returned by riot.Client.Match.ListStream with
+// riot.ListStreamWithTimelines and passes them to write, e.g. CSVWriter.Write. Matches without a timeline are
+// skipped. It stops at the end of the stream or the first error of the stream or write
+func (e Extractor) ExtractStream(stream <-chan riot.MatchStreamValue, write func(Vector) error) error {
+	for value := range stream {
+		if value.Error == io.EOF {
+			return nil
+		}
+		if value.Error != nil {
+			return value.Error
+		}
+		if value.Match == nil || value.Timeline == nil {
+			continue
+		}
+		vector, err := e.Extract(value.Match, value.Timeline)
+		if err != nil {
+			return err
+		}
+		if err := write(vector); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func blueWin(match *riot.Match) float64 {
+	winner := match.WinningTeam()
+	if winner == nil {
+		return math.NaN()
+	}
+	return indicator(winner.TeamID == teamBlue)
+}
+
+func indicator(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// frameAt returns the frame closest to the time if it is less than half a minute away
+func frameAt(timeline *riot.MatchTimeline, at time.Duration) *riot.MatchFrame {
+	var closest *riot.MatchFrame
+	best := 30 * time.Second
+	for _, frame := range timeline.Frames {
+		if frame == nil {
+			continue
+		}
+		diff := frame.Timestamp.Duration - at
+		if diff < 0 {
+			diff = -diff
+		}
+		if diff < best {
+			closest, best = frame, diff
+		}
+	}
+	return closest
+}
+
+// diffsAt returns the total gold, experience and creep score of the blue team minus the ones of the red team
+func diffsAt(match *riot.Match, timeline *riot.MatchTimeline, at time.Duration) (float64, float64, float64) {
+	frame := frameAt(timeline, at)
+	if frame == nil {
+		return math.NaN(), math.NaN(), math.NaN()
+	}
+	var gold, xp, cs float64
+	for _, pf := range frame.ParticipantFrames {
+		if pf == nil {
+			continue
+		}
+		sign := teamSign(match, pf.ParticipantID)
+		gold += sign * float64(pf.TotalGold)
+		xp += sign * float64(pf.XP)
+		cs += sign * float64(pf.MinionsKilled+pf.JungleMinionsKilled)
+	}
+	return gold, xp, cs
+}
+
+// teamSign returns 1 for participants of the blue team, -1 for the red team and 0 for unknown participants
+func teamSign(match *riot.Match, participantID int) float64 {
+	switch participantTeam(match, participantID) {
+	case teamBlue:
+		return 1
+	case teamRed:
+		return -1
+	}
+	return 0
+}
+
+func participantTeam(match *riot.Match, participantID int) int {
+	if p := match.Participant(participantID); p != nil {
+		return p.TeamID
+	}
+	return 0
+}
+
+type first struct {
+	team int
+	time time.Duration
+}
+
+// firstObjectives returns the team and time of the first kill of every objective by its event type
+func firstObjectives(match *riot.Match, timeline *riot.MatchTimeline) map[riot.MatchEventType]first {
+	firsts := map[riot.MatchEventType]first{}
+	for _, event := range timeline.Events() {
+		var team int
+		switch e := event.(type) {
+		case *riot.ChampionKillEvent:
+			team = participantTeam(match, e.KillerID)
+			if team == 0 {
+				// executions by minions, turrets or monsters count for the team of the opponents of the victim
+				team = teamBlue + teamRed - participantTeam(match, e.VictimID)
+			}
+		case *riot.ObjectiveKillEvent:
+			if e.IsBuilding() {
+				team = teamBlue + teamRed - e.TeamID
+			} else {
+				team = participantTeam(match, e.KillerID)
+			}
+		default:
+			continue
+		}
+		if _, ok := firsts[event.Type()]; !ok && (team == teamBlue || team == teamRed) {
+			firsts[event.Type()] = first{team: team, time: event.Time()}
+		}
+	}
+	return firsts
+}
@@ -0,0 +1,114 @@
+package features
+
+import (
+	"errors"
+	"io"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mjourard/golio/riot"
+)
+
+func millis(d time.Duration) riot.DurationMillis {
+	return riot.DurationMillis{Duration: d}
+}
+
+func frame(at time.Duration, blueGold, redGold int, events ...*riot.MatchEvent) *riot.MatchFrame {
+	return &riot.MatchFrame{
+		Timestamp: millis(at),
+		ParticipantFrames: map[string]*riot.ParticipantFrame{
+			"1": {ParticipantID: 1, TotalGold: blueGold, XP: blueGold / 2, MinionsKilled: blueGold / 100},
+			"6": {ParticipantID: 6, TotalGold: redGold, XP: redGold / 2, JungleMinionsKilled: redGold / 100},
+		},
+		Events: events,
+	}
+}
+
+func testMatch() (*riot.Match, *riot.MatchTimeline) {
+	match := &riot.Match{
+		GameID:       1,
+		QueueID:      420,
+		GameDuration: riot.DurationSeconds{Duration: 12 * time.Minute},
+		Participants: []*riot.Participant{{ParticipantID: 1, TeamID: 100}, {ParticipantID: 6, TeamID: 200}},
+		Teams:        []*riot.TeamStats{{TeamID: 100, Win: "Fail"}, {TeamID: 200, Win: "Win"}},
+	}
+	timeline := &riot.MatchTimeline{Frames: []*riot.MatchFrame{
+		frame(0, 500, 500),
+		frame(5*time.Minute+100*time.Millisecond, 2000, 1500,
+			&riot.MatchEvent{Type: riot.MatchEventTypeChampionKill, KillerID: 0, VictimID: 1,
+				Timestamp: millis(3 * time.Minute)},
+			&riot.MatchEvent{Type: riot.MatchEventTypeEliteMonsterKill, MonsterType: "DRAGON", KillerID: 1,
+				Timestamp: millis(4 * time.Minute)}),
+		frame(10*time.Minute+45*time.Millisecond, 4000, 4600,
+			&riot.MatchEvent{Type: riot.MatchEventTypeBuildingKill, TeamID: 100, KillerID: 6,
+				Timestamp: millis(9*time.Minute + 30*time.Second)},
+			&riot.MatchEvent{Type: riot.MatchEventTypeEliteMonsterKill, MonsterType: "DRAGON", KillerID: 6,
+				Timestamp: millis(9*time.Minute + 40*time.Second)}),
+		frame(12*time.Minute, 5000, 6000),
+	}}
+	return match, timeline
+}
+
+func TestExtractor_Extract(t *testing.T) {
+	t.Parallel()
+	extractor := Extractor{Minutes: []int{5, 10, 20}}
+	match, timeline := testMatch()
+	vector, err := extractor.Extract(match, timeline)
+	require.Nil(t, err)
+	assert.Equal(t, 1, vector.GameID)
+	names := extractor.Names()
+	require.Len(t, vector.Values, len(names))
+	got := map[string]float64{}
+	for i, name := range names {
+		got[name] = vector.Values[i]
+	}
+	assert.Equal(t, 420.0, got["queue_id"])
+	assert.Equal(t, 720.0, got["duration_seconds"])
+	assert.Equal(t, 0.0, got["blue_win"])
+	assert.Equal(t, 500.0, got["gold_diff_5"])
+	assert.Equal(t, 250.0, got["xp_diff_5"])
+	assert.Equal(t, 5.0, got["cs_diff_5"])
+	assert.Equal(t, -600.0, got["gold_diff_10"])
+	assert.True(t, math.IsNaN(got["gold_diff_20"]))
+	assert.Equal(t, 0.0, got["first_blood_blue"])
+	assert.Equal(t, 180.0, got["first_blood_seconds"])
+	assert.Equal(t, 0.0, got["first_tower_blue"])
+	assert.Equal(t, 570.0, got["first_tower_seconds"])
+	assert.Equal(t, 1.0, got["first_dragon_blue"])
+	assert.Equal(t, 240.0, got["first_dragon_seconds"])
+	assert.True(t, math.IsNaN(got["first_baron_blue"]))
+	assert.True(t, math.IsNaN(got["first_herald_seconds"]))
+
+	_, err = extractor.Extract(match, nil)
+	assert.Equal(t, ErrNoTimeline, err)
+	assert.Len(t, Extractor{}.Names(), 3+3*len(DefaultMinutes)+2*len(objectives))
+}
+
+func TestExtractor_ExtractStream(t *testing.T) {
+	t.Parallel()
+	match, timeline := testMatch()
+	stream := make(chan riot.MatchStreamValue, 3)
+	stream <- riot.MatchStreamValue{Match: match, Timeline: timeline}
+	stream <- riot.MatchStreamValue{Match: match}
+	stream <- riot.MatchStreamValue{Error: errors.New("error")}
+	close(stream)
+	var vectors []Vector
+	err := Extractor{}.ExtractStream(stream, func(v Vector) error {
+		vectors = append(vectors, v)
+		return nil
+	})
+	assert.Equal(t, errors.New("error"), err)
+	assert.Len(t, vectors, 1)
+
+	stream = make(chan riot.MatchStreamValue, 2)
+	stream <- riot.MatchStreamValue{Match: match, Timeline: timeline}
+	stream <- riot.MatchStreamValue{Error: io.EOF}
+	close(stream)
+	assert.Nil(t, Extractor{}.ExtractStream(stream, func(v Vector) error {
+		return nil
+	}))
+}
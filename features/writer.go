@@ -0,0 +1,89 @@
+package features
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+)
+
+// CSVWriter writes feature vectors as CSV with a header row of the feature names, preceded by the game ID
+type CSVWriter struct {
+	writer *csv.Writer
+	names  []string
+	header bool
+}
+
+// NewCSVWriter returns a writer for the vectors of the extractor
+func NewCSVWriter(w io.Writer, extractor Extractor) *CSVWriter {
+	return &CSVWriter{writer: csv.NewWriter(w), names: extractor.Names()}
+}
+
+// Write writes the vector as a single row, writing the header row first if necessary. Values are flushed after
+// every row
+func (w *CSVWriter) Write(vector Vector) error {
+	if len(vector.Values) != len(w.names) {
+		return fmt.Errorf("features: vector has %d values, want %d", len(vector.Values), len(w.names))
+	}
+	if !w.header {
+		if err := w.writer.Write(append([]string{"game_id"}, w.names...)); err != nil {
+			return err
+		}
+		w.header = true
+	}
+	row := make([]string, 0, len(vector.Values)+1)
+	row = append(row, strconv.Itoa(vector.GameID))
+	for _, value := range vector.Values {
+		if math.IsNaN(value) {
+			row = append(row, "")
+			continue
+		}
+		row = append(row, strconv.FormatFloat(value, 'f', -1, 64))
+	}
+	if err := w.writer.Write(row); err != nil {
+		return err
+	}
+	w.writer.Flush()
+	return w.writer.Error()
+}
+
+// JSONLWriter writes feature vectors as newline delimited JSON objects keyed by the feature names, with the game ID
+// as game_id
+type JSONLWriter struct {
+	writer io.Writer
+	names  []string
+}
+
+// NewJSONLWriter returns a writer for the vectors of the extractor
+func NewJSONLWriter(w io.Writer, extractor Extractor) *JSONLWriter {
+	return &JSONLWriter{writer: w, names: extractor.Names()}
+}
+
+// Write writes the vector as a single line. The keys keep the order of the feature names
+func (w *JSONLWriter) Write(vector Vector) error {
+	if len(vector.Values) != len(w.names) {
+		return fmt.Errorf("features: vector has %d values, want %d", len(vector.Values), len(w.names))
+	}
+	var line bytes.Buffer
+	line.WriteString(`{"game_id":` + strconv.Itoa(vector.GameID))
+	for i, value := range vector.Values {
+		name, err := json.Marshal(w.names[i])
+		if err != nil {
+			return err
+		}
+		line.WriteByte(',')
+		line.Write(name)
+		line.WriteByte(':')
+		if math.IsNaN(value) || math.IsInf(value, 0) {
+			line.WriteString("null")
+			continue
+		}
+		line.WriteString(strconv.FormatFloat(value, 'f', -1, 64))
+	}
+	line.WriteString("}\n")
+	_, err := w.writer.Write(line.Bytes())
+	return err
+}
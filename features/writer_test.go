@@ -0,0 +1,44 @@
+package features
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCSVWriter(t *testing.T) {
+	t.Parallel()
+	extractor := Extractor{Minutes: []int{10}}
+	values := make([]float64, len(extractor.Names()))
+	values[0], values[1] = 420, math.NaN()
+	var buf bytes.Buffer
+	writer := NewCSVWriter(&buf, extractor)
+	require.Nil(t, writer.Write(Vector{GameID: 1, Values: values}))
+	require.Nil(t, writer.Write(Vector{GameID: 2, Values: values}))
+	assert.NotNil(t, writer.Write(Vector{GameID: 3}))
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 3)
+	assert.True(t, strings.HasPrefix(lines[0], "game_id,queue_id,duration_seconds,blue_win,gold_diff_10,"))
+	assert.True(t, strings.HasPrefix(lines[1], "1,420,,0,"))
+}
+
+func TestJSONLWriter(t *testing.T) {
+	t.Parallel()
+	extractor := Extractor{Minutes: []int{10}}
+	values := make([]float64, len(extractor.Names()))
+	values[0], values[1], values[3] = 420, math.NaN(), -1.5
+	var buf bytes.Buffer
+	writer := NewJSONLWriter(&buf, extractor)
+	require.Nil(t, writer.Write(Vector{GameID: 1, Values: values}))
+	assert.NotNil(t, writer.Write(Vector{GameID: 2}))
+	assert.True(t, strings.HasPrefix(buf.String(),
+		`{"game_id":1,"queue_id":420,"duration_seconds":null,"blue_win":0,"gold_diff_10":-1.5,`))
+	var got map[string]interface{}
+	require.Nil(t, json.Unmarshal(buf.Bytes(), &got))
+	assert.Len(t, got, len(values)+1)
+}
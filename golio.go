@@ -21,23 +21,35 @@
 package golio
 
 import (
+	"context"
 	"net/http"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 
 	"github.com/mjourard/golio/api"
+	"github.com/mjourard/golio/cache"
 	"github.com/mjourard/golio/datadragon"
 	"github.com/mjourard/golio/internal"
+	"github.com/mjourard/golio/logging"
 	"github.com/mjourard/golio/riot"
+	"github.com/mjourard/golio/sandbox"
 	"github.com/mjourard/golio/static"
 )
 
-// Client is a client for both the Riot API and the Data Dragon service
+// Client is a client for both the Riot API and the Data Dragon service. It is safe for concurrent use, so a single
+// client should be shared by all goroutines of an application
 type Client struct {
 	client     internal.Doer
 	logger     log.FieldLogger
 	region     api.Region
 	apiKey     string
+	riot       []riot.Option
+	dataDragon []datadragon.Option
+	// dataDragonVersion is used instead of requesting the current version if set, see WithDataDragonVersion
+	dataDragonVersion string
+	// serverless skips all requests when the client is created, see WithServerless
+	serverless bool
 	Riot       *riot.Client
 	DataDragon *datadragon.Client
 	Static     *static.Client
@@ -60,6 +72,13 @@ func WithLogger(l log.FieldLogger) Option {
 	}
 }
 
+// WithLogging sets a logger which does not depend on logrus, e.g. logging.Slog(slog.Default()) or logging.Discard()
+func WithLogging(l logging.Logger) Option {
+	return func(client *Client) {
+		client.logger = logging.FieldLogger(l)
+	}
+}
+
 // WithRegion sets the given region for the golio client
 func WithRegion(r api.Region) Option {
 	return func(client *Client) {
@@ -67,6 +86,218 @@ func WithRegion(r api.Region) Option {
 	}
 }
 
+// WithSandbox answers all Riot API requests with deterministic generated data instead of accessing the network,
+// see package sandbox. Data Dragon and static data are not available in the sandbox
+func WithSandbox(options ...sandbox.Option) Option {
+	return func(client *Client) {
+		client.client = sandbox.New(options...)
+	}
+}
+
+// WithStrictDecoding reports fields in Riot API responses which do not exist in the types of golio,
+// see riot.WithStrictDecoding
+func WithStrictDecoding(hook func(*riot.UnknownFieldError)) Option {
+	return func(client *Client) {
+		client.riot = append(client.riot, riot.WithStrictDecoding(hook))
+	}
+}
+
+// WithRawCapture passes the body of every successful Riot API response to hook before decoding,
+// see riot.WithRawCapture
+func WithRawCapture(hook func(riot.RawResponse)) Option {
+	return func(client *Client) {
+		client.riot = append(client.riot, riot.WithRawCapture(hook))
+	}
+}
+
+// WithRequestObserver notifies the observer about every request sent to the Riot API, e.g. to collect metrics,
+// see riot.WithRequestObserver and package metrics
+func WithRequestObserver(o riot.RequestObserver) Option {
+	return func(client *Client) {
+		client.riot = append(client.riot, riot.WithRequestObserver(o))
+	}
+}
+
+// WithAuditSink records every call to the Riot API to the sink, e.g. for quota usage reports, see riot.WithAuditSink
+func WithAuditSink(sink riot.AuditSink) Option {
+	return func(client *Client) {
+		client.riot = append(client.riot, riot.WithAuditSink(sink))
+	}
+}
+
+// WithLogLevel sets the level of the logs of a category of the Riot API client, see riot.WithLogLevel
+func WithLogLevel(category riot.LogCategory, level logging.Level) Option {
+	return func(client *Client) {
+		client.riot = append(client.riot, riot.WithLogLevel(category, level))
+	}
+}
+
+// WithRetryLogSampling logs only every n-th message about retries of the Riot API client,
+// see riot.WithRetryLogSampling
+func WithRetryLogSampling(n int) Option {
+	return func(client *Client) {
+		client.riot = append(client.riot, riot.WithRetryLogSampling(n))
+	}
+}
+
+// WithBaseURL sends the requests of the Riot API client to the base URL, e.g. a proxy, see riot.WithBaseURL
+func WithBaseURL(baseURL string) Option {
+	return func(client *Client) {
+		client.riot = append(client.riot, riot.WithBaseURL(baseURL))
+	}
+}
+
+// WithKeyPlacement sets the way the API key is sent to the Riot API, see riot.WithKeyPlacement
+func WithKeyPlacement(placement riot.KeyPlacement) Option {
+	return func(client *Client) {
+		client.riot = append(client.riot, riot.WithKeyPlacement(placement))
+	}
+}
+
+// WithKeyProvider replaces the API key by the provider, e.g. a riot.RotatingKey, see riot.WithKeyProvider
+func WithKeyProvider(provider riot.KeyProvider) Option {
+	return func(client *Client) {
+		client.riot = append(client.riot, riot.WithKeyProvider(provider))
+	}
+}
+
+// WithRouteKey uses the provider for all endpoints starting with the prefix, e.g. a separate tournament key for
+// /lol/tournament/v4, see riot.WithRouteKey
+func WithRouteKey(prefix string, provider riot.KeyProvider) Option {
+	return func(client *Client) {
+		client.riot = append(client.riot, riot.WithRouteKey(prefix, provider))
+	}
+}
+
+// WithBaseContext sets the context of all requests to the Riot API, e.g. a context cancelled on shutdown, see
+// riot.WithBaseContext
+func WithBaseContext(ctx context.Context) Option {
+	return func(client *Client) {
+		client.riot = append(client.riot, riot.WithBaseContext(ctx))
+	}
+}
+
+// WithRateLimiter waits before requests which would exceed the rate limits of the API key instead of only after 429
+// responses, see riot.WithRateLimiter
+func WithRateLimiter() Option {
+	return func(client *Client) {
+		client.riot = append(client.riot, riot.WithRateLimiter())
+	}
+}
+
+// WithBackoff sets the policy for retrying requests answered with status 503 or 429, see riot.WithBackoff
+func WithBackoff(backoff riot.Backoff) Option {
+	return func(client *Client) {
+		client.riot = append(client.riot, riot.WithBackoff(backoff))
+	}
+}
+
+// WithRetryPolicy sets the policy deciding which failed requests are sent again, see riot.WithRetryPolicy
+func WithRetryPolicy(policy riot.RetryPolicy) Option {
+	return func(client *Client) {
+		client.riot = append(client.riot, riot.WithRetryPolicy(policy))
+	}
+}
+
+// WithRateLimitErrors returns an *api.RateLimitError for responses with status 429 instead of waiting and retrying,
+// see riot.WithRateLimitErrors
+func WithRateLimitErrors() Option {
+	return func(client *Client) {
+		client.riot = append(client.riot, riot.WithRateLimitErrors())
+	}
+}
+
+// WithCircuitBreaker fails requests with api.ErrCircuitOpen for the cooldown after consecutive server errors of a
+// region and endpoint family, see riot.WithCircuitBreaker
+func WithCircuitBreaker(failures int, cooldown time.Duration) Option {
+	return func(client *Client) {
+		client.riot = append(client.riot, riot.WithCircuitBreaker(failures, cooldown))
+	}
+}
+
+// WithBulkReserve reserves the fraction of the rate limits for interactive requests, deferring requests tagged with
+// riot.PriorityBulk, see riot.WithBulkReserve
+func WithBulkReserve(fraction float64) Option {
+	return func(client *Client) {
+		client.riot = append(client.riot, riot.WithBulkReserve(fraction))
+	}
+}
+
+// WithScheduler admits the requests to the Riot API through the scheduler, interleaving the requests of its lanes in
+// weighted round-robin order, see riot.WithScheduler
+func WithScheduler(s *riot.Scheduler) Option {
+	return func(client *Client) {
+		client.riot = append(client.riot, riot.WithScheduler(s))
+	}
+}
+
+// WithCache serves identical GET requests to the Riot API within the ttl from the cache, see riot.WithCache. If c is
+// nil, an in-memory cache keeping the cache.DefaultLRUSize most recently used responses is used
+func WithCache(c riot.Cache, ttl time.Duration) Option {
+	if c == nil {
+		c = cache.NewLRU(cache.DefaultLRUSize)
+	}
+	return func(client *Client) {
+		client.riot = append(client.riot, riot.WithCache(c, ttl))
+	}
+}
+
+// WithUserAgent sets the User-Agent header of all requests to the Riot API, see riot.WithUserAgent
+func WithUserAgent(userAgent string) Option {
+	return func(client *Client) {
+		client.riot = append(client.riot, riot.WithUserAgent(userAgent))
+	}
+}
+
+// WithHeader adds a header to all requests to the Riot API, see riot.WithHeader
+func WithHeader(key, value string) Option {
+	return func(client *Client) {
+		client.riot = append(client.riot, riot.WithHeader(key, value))
+	}
+}
+
+// WithMiddleware wraps the Doer of the Riot API client with the middleware, see riot.WithMiddleware
+func WithMiddleware(middleware ...riot.Middleware) Option {
+	return func(client *Client) {
+		client.riot = append(client.riot, riot.WithMiddleware(middleware...))
+	}
+}
+
+// WithDataDragonVersion sets the version of Data Dragon instead of requesting the current version of the region when
+// the client is created, see datadragon.NewClientWithVersion
+func WithDataDragonVersion(version string) Option {
+	return func(client *Client) {
+		client.dataDragonVersion = version
+	}
+}
+
+// WithDataDragonCache stores the payloads of Data Dragon in the cache, e.g. cache.NewDisk, so that restarted
+// applications do not fetch them again, see datadragon.WithCache
+func WithDataDragonCache(c datadragon.Cache) Option {
+	return func(client *Client) {
+		client.dataDragon = append(client.dataDragon, datadragon.WithCache(c))
+	}
+}
+
+// WithRateLimitStore coordinates the application rate limits of all clients using the API key through the store, e.g.
+// of the instances of a horizontally scaled service, see riot.WithRateLimitStore and package redis
+func WithRateLimitStore(store riot.RateLimitStore, limits ...riot.RateLimit) Option {
+	return func(client *Client) {
+		client.riot = append(client.riot, riot.WithRateLimitStore(store, limits...))
+	}
+}
+
+// WithServerless configures the client for short-lived invocations of serverless functions, e.g. AWS Lambda or
+// Cloud Functions. No requests are sent when the client is created, so Data Dragon uses the version set by
+// WithDataDragonVersion or the version golio was tested with. The application rate limits are coordinated between
+// all invocations using the store, see riot.WithRateLimitStore and package serverless
+func WithServerless(store riot.RateLimitStore, limits ...riot.RateLimit) Option {
+	return func(client *Client) {
+		client.riot = append(client.riot, riot.WithRateLimitStore(store, limits...))
+		client.serverless = true
+	}
+}
+
 // NewClient returns a new client for both the Riot API and the Data Dragon service
 func NewClient(apiKey string, options ...Option) *Client {
 	c := &Client{
@@ -78,8 +309,31 @@ func NewClient(apiKey string, options ...Option) *Client {
 	for _, opt := range options {
 		opt(c)
 	}
-	c.Riot = riot.NewClient(c.region, c.apiKey, c.client, c.logger)
-	c.DataDragon = datadragon.NewClient(c.client, c.region, c.logger)
+	c.Riot = riot.NewClient(c.region, c.apiKey, c.client, c.logger, c.riot...)
+	if c.serverless || c.dataDragonVersion != "" {
+		c.DataDragon = datadragon.NewClientWithVersion(c.client, c.dataDragonVersion, c.logger, c.dataDragon...)
+	} else {
+		c.DataDragon = datadragon.NewClient(c.client, c.region, c.logger, c.dataDragon...)
+	}
 	c.Static = static.NewClient(c.client, c.logger)
 	return c
 }
+
+// Get sends a GET request of the path to the Riot API and decodes the response into a new value of type T, e.g. for
+// endpoints not supported by golio yet. The path is the path of the URL including query parameters,
+// e.g. /lol/summoner/v4/summoners/by-name/name. Authentication, rate limits and errors are handled like for all other
+// requests of the client, see riot.Client.GetInto
+func Get[T any](ctx context.Context, client *Client, path string) (T, error) {
+	var result T
+	if err := client.Riot.GetInto(ctx, path, &result); err != nil {
+		var zero T
+		return zero, err
+	}
+	return result, nil
+}
+
+// Post sends a POST request of the path to the Riot API with the body encoded as json and decodes the response into a
+// new value of type T, see Get and riot.Post
+func Post[T any](ctx context.Context, client *Client, path string, body interface{}) (T, error) {
+	return riot.Post[T](client.Riot.WithContext(ctx), path, body)
+}
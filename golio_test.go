@@ -1,13 +1,19 @@
 package golio
 
 import (
+	"context"
+	"errors"
 	"net/http"
 	"testing"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/require"
 
 	"github.com/mjourard/golio/api"
+	"github.com/mjourard/golio/logging"
+	"github.com/mjourard/golio/mock"
+	"github.com/mjourard/golio/riot"
 )
 
 func TestNewClient(t *testing.T) {
@@ -17,3 +23,144 @@ func TestNewClient(t *testing.T) {
 		WithClient(http.DefaultClient))
 	require.NotNil(t, client)
 }
+
+func TestNewClient_Logging(t *testing.T) {
+	var messages []string
+	client := NewClient("", WithClient(mock.NewStatusMockDoer(http.StatusNotFound)),
+		WithLogging(recordingLogger(func(msg string) {
+			messages = append(messages, msg)
+		})))
+	_, err := client.Riot.Summoner.GetByName("missing")
+	require.NotNil(t, err)
+	require.NotEmpty(t, messages)
+}
+
+// recordingLogger is a logging.Logger passing all messages to the function
+type recordingLogger func(msg string)
+
+func (recordingLogger) Enabled(logging.Level) bool {
+	return true
+}
+
+func (l recordingLogger) Log(_ logging.Level, msg string, _ map[string]interface{}) {
+	l(msg)
+}
+
+func TestNewClient_Sandbox(t *testing.T) {
+	client := NewClient("", WithSandbox())
+	summoner, err := client.Riot.Summoner.GetByName("SK Jenax")
+	require.Nil(t, err)
+	require.Equal(t, "SK Jenax", summoner.Name)
+}
+
+func TestNewClient_Serverless(t *testing.T) {
+	doer := mock.NewSequenceDoer(mock.OK(map[string]interface{}{"name": "name"}))
+	client := NewClient("", WithClient(doer), WithServerless(riot.NewMemoryRateLimitStore()))
+	require.Equal(t, 0, doer.Calls())
+	summoner, err := client.Riot.Summoner.GetByName("name")
+	require.Nil(t, err)
+	require.Equal(t, "name", summoner.Name)
+
+	client = NewClient("", WithClient(doer), WithDataDragonVersion("10.1.1"))
+	require.Equal(t, "10.1.1", client.DataDragon.Version)
+	require.Equal(t, 1, doer.Calls())
+}
+
+func TestNewClient_StrictDecoding(t *testing.T) {
+	var unknown []string
+	client := NewClient("", WithClient(mock.NewJSONMockDoer(map[string]interface{}{"name": "name", "new": 1}, 200)),
+		WithStrictDecoding(func(err *riot.UnknownFieldError) {
+			unknown = append(unknown, err.Field)
+		}))
+	summoner, err := client.Riot.Summoner.GetByName("name")
+	require.Nil(t, err)
+	require.Equal(t, "name", summoner.Name)
+	require.Equal(t, []string{"new"}, unknown)
+}
+
+func TestNewClient_RawCapture(t *testing.T) {
+	var captured []riot.RawResponse
+	client := NewClient("", WithClient(mock.NewJSONMockDoer(map[string]interface{}{"name": "name", "new": 1}, 200)),
+		WithRawCapture(func(raw riot.RawResponse) {
+			captured = append(captured, raw)
+		}))
+	_, err := client.Riot.Summoner.GetByName("name")
+	require.Nil(t, err)
+	require.Len(t, captured, 1)
+	require.JSONEq(t, `{"name":"name","new":1}`, string(captured[0].Body))
+}
+
+func TestGet(t *testing.T) {
+	type status struct {
+		Name string `json:"name"`
+	}
+	client := NewClient("", WithClient(mock.NewJSONMockDoer(map[string]string{"name": "EU West"}, 200)))
+	got, err := Get[status](context.Background(), client, "/lol/status/v4/platform-data")
+	require.Nil(t, err)
+	require.Equal(t, "EU West", got.Name)
+
+	client = NewClient("", WithClient(mock.NewStatusMockDoer(http.StatusNotFound)))
+	_, err = Get[*status](context.Background(), client, "/lol/status/v4/platform-data")
+	require.True(t, errors.Is(err, api.ErrNotFound))
+}
+
+func TestPost(t *testing.T) {
+	client := NewClient("", WithClient(mock.NewJSONMockDoer([]string{"code"}, 200)))
+	got, err := Post[[]string](context.Background(), client, "/lol/tournament/v4/codes", map[string]int{"count": 1})
+	require.Nil(t, err)
+	require.Equal(t, []string{"code"}, got)
+}
+
+type countingObserver struct {
+	requests int
+}
+
+func (o *countingObserver) ObserveRequest(riot.RequestStats) {
+	o.requests++
+}
+
+func (o *countingObserver) ObserveRetry(riot.RetryStats) {}
+
+func TestNewClient_RequestObserver(t *testing.T) {
+	observer := &countingObserver{}
+	client := NewClient("", WithClient(mock.NewJSONMockDoer(map[string]interface{}{"name": "name"}, 200)),
+		WithRequestObserver(observer))
+	_, err := client.Riot.Summoner.GetByName("name")
+	require.Nil(t, err)
+	require.Equal(t, 1, observer.requests)
+}
+
+func TestNewClient_Cache(t *testing.T) {
+	doer := mock.NewSequenceDoer(mock.OK(riot.Summoner{Name: "name"}))
+	client := NewClient("", WithClient(doer), WithDataDragonVersion("10.1.1"), WithCache(nil, time.Minute))
+	for i := 0; i < 2; i++ {
+		summoner, err := client.Riot.Summoner.GetByName("name")
+		require.Nil(t, err)
+		require.Equal(t, "name", summoner.Name)
+	}
+	require.Len(t, doer.Requests(), 1)
+}
+
+func TestNewClient_RouteKey(t *testing.T) {
+	doer := mock.NewSequenceDoer(mock.OK(riot.Summoner{}))
+	client := NewClient("api_key", WithClient(doer), WithDataDragonVersion("10.1.1"),
+		WithRouteKey("/lol/summoner/v4", riot.KeyProviderFunc(func(string) string { return "summoner_key" })))
+	_, err := client.Riot.Summoner.GetByName("name")
+	require.Nil(t, err)
+	_, err = client.Riot.Status.Get()
+	require.Nil(t, err)
+	requests := doer.Requests()
+	require.Len(t, requests, 2)
+	require.Equal(t, "summoner_key", requests[0].Header.Get("X-Riot-Token"))
+	require.Equal(t, "api_key", requests[1].Header.Get("X-Riot-Token"))
+}
+
+func TestNewClient_Scheduler(t *testing.T) {
+	doer := mock.NewSequenceDoer(mock.OK(riot.Summoner{Name: "name"}))
+	scheduler := riot.NewScheduler(1)
+	client := NewClient("", WithClient(doer), WithDataDragonVersion("10.1.1"), WithScheduler(scheduler))
+	ctx := riot.WithLane(context.Background(), scheduler.Lane(3))
+	summoner, err := client.Riot.WithContext(ctx).Summoner.GetByName("name")
+	require.Nil(t, err)
+	require.Equal(t, "name", summoner.Name)
+}
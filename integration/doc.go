@@ -0,0 +1,12 @@
+// Package integration contains tests exercising every Riot API endpoint supported by golio against the live API.
+// The tests are skipped unless the following environment variables are set:
+//
+//	GOLIO_API_KEY   a development or production API key
+//	GOLIO_SUMMONER  the name of an existing summoner with ranked games in the region
+//	GOLIO_REGION    the region of the summoner, e.g. euw1 (defaults to na1)
+//
+// Requests are spaced by GOLIO_REQUEST_INTERVAL (a duration, defaults to 1.5s), which keeps a development key below
+// its limit of 100 requests every two minutes. Run the tests using
+//
+//	GOLIO_API_KEY=... GOLIO_SUMMONER=... go test -v -count=1 ./integration
+package integration
@@ -0,0 +1,218 @@
+package integration
+
+import (
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mjourard/golio"
+	"github.com/mjourard/golio/api"
+	"github.com/mjourard/golio/internal"
+	"github.com/mjourard/golio/riot"
+)
+
+const defaultRequestInterval = 1500 * time.Millisecond
+
+// tournamentRegions maps the platform regions to the regions used by the tournament endpoints
+var tournamentRegions = map[api.Region]string{
+	api.RegionBrasil:            "BR",
+	api.RegionEuropeNorthEast:   "EUNE",
+	api.RegionEuropeWest:        "EUW",
+	api.RegionJapan:             "JP",
+	api.RegionKorea:             "KR",
+	api.RegionLatinAmericaNorth: "LAN",
+	api.RegionLatinAmericaSouth: "LAS",
+	api.RegionNorthAmerica:      "NA",
+	api.RegionOceania:           "OCE",
+	api.RegionTurkey:            "TR",
+	api.RegionRussia:            "RU",
+	api.RegionPBE:               "PBE",
+}
+
+// throttledDoer spaces all requests by at least interval
+type throttledDoer struct {
+	next     internal.Doer
+	interval time.Duration
+	mu       sync.Mutex
+	last     time.Time
+}
+
+func (d *throttledDoer) Do(r *http.Request) (*http.Response, error) {
+	d.mu.Lock()
+	if wait := d.interval - time.Since(d.last); wait > 0 {
+		time.Sleep(wait)
+	}
+	d.last = time.Now()
+	d.mu.Unlock()
+	return d.next.Do(r)
+}
+
+type config struct {
+	client   *golio.Client
+	region   api.Region
+	summoner string
+}
+
+// setup returns the configuration of the live tests or skips the test if no API key is set
+func setup(t *testing.T) *config {
+	apiKey := os.Getenv("GOLIO_API_KEY")
+	summoner := os.Getenv("GOLIO_SUMMONER")
+	if apiKey == "" || summoner == "" {
+		t.Skip("GOLIO_API_KEY and GOLIO_SUMMONER need to be set to run integration tests")
+	}
+	region := api.RegionNorthAmerica
+	if value := os.Getenv("GOLIO_REGION"); value != "" {
+		var err error
+		region, err = api.ParseRegion(value)
+		require.Nil(t, err, "invalid GOLIO_REGION")
+	}
+	interval := defaultRequestInterval
+	if value := os.Getenv("GOLIO_REQUEST_INTERVAL"); value != "" {
+		var err error
+		interval, err = time.ParseDuration(value)
+		require.Nil(t, err, "invalid GOLIO_REQUEST_INTERVAL")
+	}
+	logger := log.New()
+	logger.SetLevel(log.WarnLevel)
+	client := golio.NewClient(apiKey,
+		golio.WithRegion(region),
+		golio.WithLogger(logger),
+		golio.WithClient(&throttledDoer{next: http.DefaultClient, interval: interval}))
+	return &config{client: client, region: region, summoner: summoner}
+}
+
+// allowNotFound fails the test for all errors except api.ErrNotFound, which is returned by some endpoints for valid
+// requests, e.g. for a summoner not in a game
+func allowNotFound(t *testing.T, err error) {
+	if err != nil && err != api.ErrNotFound {
+		t.Error(err)
+	}
+}
+
+// TestLive runs all tests sequentially, so that the requests share one throttled client
+func TestLive(t *testing.T) {
+	cfg := setup(t)
+	client := cfg.client.Riot
+
+	summoner, err := client.Summoner.GetByName(cfg.summoner)
+	require.Nil(t, err)
+	require.NotNil(t, summoner)
+
+	t.Run("summoner", func(t *testing.T) {
+		byAccount, err := client.Summoner.GetByAccountID(summoner.AccountID)
+		require.Nil(t, err)
+		assert.Equal(t, summoner.ID, byAccount.ID)
+		byPUUID, err := client.Summoner.GetByPUUID(summoner.PUUID)
+		require.Nil(t, err)
+		assert.Equal(t, summoner.ID, byPUUID.ID)
+		byID, err := client.Summoner.GetByID(summoner.ID)
+		require.Nil(t, err)
+		assert.Equal(t, summoner.Name, byID.Name)
+	})
+
+	t.Run("champion mastery", func(t *testing.T) {
+		masteries, err := client.ChampionMastery.List(summoner.ID)
+		require.Nil(t, err)
+		total, err := client.ChampionMastery.GetTotal(summoner.ID)
+		require.Nil(t, err)
+		if len(masteries) == 0 {
+			return
+		}
+		assert.True(t, total > 0)
+		mastery, err := client.ChampionMastery.Get(summoner.ID, strconv.Itoa(masteries[0].ChampionID))
+		require.Nil(t, err)
+		assert.Equal(t, masteries[0].ChampionPoints, mastery.ChampionPoints)
+	})
+
+	t.Run("champion", func(t *testing.T) {
+		rotation, err := client.Champion.GetFreeRotation()
+		require.Nil(t, err)
+		assert.NotEmpty(t, rotation.FreeChampionIDs)
+	})
+
+	t.Run("league", func(t *testing.T) {
+		challenger, err := client.League.GetChallenger(riot.QueueRankedSolo)
+		require.Nil(t, err)
+		assert.NotEmpty(t, challenger.Entries)
+		_, err = client.League.GetGrandmaster(riot.QueueRankedSolo)
+		require.Nil(t, err)
+		_, err = client.League.GetMaster(riot.QueueRankedSolo)
+		require.Nil(t, err)
+		league, err := client.League.Get(challenger.LeagueID)
+		require.Nil(t, err)
+		assert.Equal(t, challenger.Tier, league.Tier)
+		_, err = client.League.ListBySummoner(summoner.ID)
+		require.Nil(t, err)
+		players, err := client.League.ListPlayers(riot.QueueRankedSolo, riot.TierGold, riot.DivisionTwo, 1)
+		require.Nil(t, err)
+		assert.NotEmpty(t, players)
+	})
+
+	t.Run("status", func(t *testing.T) {
+		status, err := client.Status.Get()
+		require.Nil(t, err)
+		assert.NotEmpty(t, status.Services)
+	})
+
+	t.Run("match", func(t *testing.T) {
+		end := 5
+		matches, err := client.Match.List(summoner.AccountID, &riot.MatchFilter{EndIndex: &end})
+		require.Nil(t, err)
+		require.NotEmpty(t, matches.Matches, "the summoner needs to have played at least one game")
+		match, err := client.Match.Get(matches.Matches[0].GameID)
+		require.Nil(t, err)
+		assert.Equal(t, matches.Matches[0].GameID, match.GameID)
+		_, err = client.Match.GetTimeline(match.GameID)
+		allowNotFound(t, err)
+	})
+
+	t.Run("spectator", func(t *testing.T) {
+		featured, err := client.Spectator.ListFeatured()
+		require.Nil(t, err)
+		_, err = client.Spectator.GetCurrent(summoner.ID)
+		allowNotFound(t, err)
+		if len(featured.GameList) == 0 || len(featured.GameList[0].Participants) == 0 {
+			return
+		}
+		// featured games usually last long enough to still be running
+		player, err := client.Summoner.GetByName(featured.GameList[0].Participants[0].SummonerName)
+		require.Nil(t, err)
+		_, err = client.Spectator.GetCurrent(player.ID)
+		allowNotFound(t, err)
+	})
+
+	t.Run("third party code", func(t *testing.T) {
+		_, err := client.ThirdPartyCode.Get(summoner.ID)
+		allowNotFound(t, err)
+	})
+
+	t.Run("tournament stub", func(t *testing.T) {
+		providerID, err := client.Tournament.CreateProvider(&riot.ProviderRegistrationParameters{
+			URL:    "https://example.com/callback",
+			Region: tournamentRegions[cfg.region],
+		}, true)
+		require.Nil(t, err)
+		tournamentID, err := client.Tournament.Create(&riot.TournamentRegistrationParameters{
+			ProviderID: providerID,
+			Name:       "golio integration test",
+		}, true)
+		require.Nil(t, err)
+		codes, err := client.Tournament.CreateCodes(tournamentID, 1, &riot.TournamentCodeParameters{
+			MapType:       "SUMMONERS_RIFT",
+			PickType:      "TOURNAMENT_DRAFT",
+			SpectatorType: "ALL",
+			TeamSize:      5,
+		}, true)
+		require.Nil(t, err)
+		require.Len(t, codes, 1)
+		_, err = client.Tournament.ListLobbyEvents(codes[0], true)
+		require.Nil(t, err)
+	})
+}
@@ -0,0 +1,18 @@
+package internal
+
+import (
+	"context"
+	"time"
+)
+
+// Cache stores values by key with a time to live. It is defined once here, as package cache imports package riot,
+// which imports package datadragon, and all of them accept the same caches. Implementations must be safe for
+// concurrent use
+type Cache interface {
+	// Get returns the value of the key. The returned bool is false if the key does not exist or is expired
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	// Set stores the value for the key. A ttl of 0 stores the value without expiration
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	// Delete removes the key. Deleting a key which does not exist is no error
+	Delete(ctx context.Context, key string) error
+}
@@ -0,0 +1,177 @@
+// Package champions generates Go source code for the champions listed by Data Dragon, so that code using golio can
+// refer to champions by constants instead of their numeric IDs. The code is regenerated for every patch using
+// cmd/golio-champions.
+package champions
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+const (
+	// VersionsURL is the location of the list of all Data Dragon versions, the latest first
+	VersionsURL = "https://ddragon.leagueoflegends.com/api/versions.json"
+	// ChampionsURLFormat is the location of the champion list of a Data Dragon version
+	ChampionsURLFormat = "https://ddragon.leagueoflegends.com/cdn/%s/data/en_US/champion.json"
+)
+
+// Champion is a champion as listed by Data Dragon
+type Champion struct {
+	// ID is the numeric ID used by the Riot API
+	ID int
+	// Key is the textual ID used by Data Dragon, e.g. MonkeyKing
+	Key   string
+	Name  string
+	Title string
+}
+
+// Identifier returns the name of the constant for the champion, i.e. its name without any characters not allowed
+// in identifiers and each word starting with an upper case letter, e.g. NunuWillump for "Nunu & Willump"
+func (c Champion) Identifier() string {
+	var b strings.Builder
+	for _, word := range strings.Fields(c.Name) {
+		runes := []rune(word)
+		runes[0] = unicode.ToUpper(runes[0])
+		for _, r := range runes {
+			if unicode.IsLetter(r) || unicode.IsDigit(r) {
+				b.WriteRune(r)
+			}
+		}
+	}
+	return b.String()
+}
+
+// Data is the list of champions of a Data Dragon version
+type Data struct {
+	Version string
+	// Champions sorted by ID
+	Champions []Champion
+}
+
+// Load reads the champion list from source, which is either an HTTP(S) URL or a file path, in the format of
+// champion.json of Data Dragon
+func Load(source string) (*Data, error) {
+	r, err := open(source)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	var file struct {
+		Version string `json:"version"`
+		Data    map[string]struct {
+			ID    string `json:"id"`
+			Key   string `json:"key"`
+			Name  string `json:"name"`
+			Title string `json:"title"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(r).Decode(&file); err != nil {
+		return nil, fmt.Errorf("decoding champions from %s: %v", source, err)
+	}
+	data := &Data{Version: file.Version}
+	for _, c := range file.Data {
+		id, err := strconv.Atoi(c.Key)
+		if err != nil {
+			return nil, fmt.Errorf("champion %s has invalid key %q", c.ID, c.Key)
+		}
+		data.Champions = append(data.Champions, Champion{ID: id, Key: c.ID, Name: c.Name, Title: c.Title})
+	}
+	sort.Slice(data.Champions, func(i, j int) bool {
+		return data.Champions[i].ID < data.Champions[j].ID
+	})
+	return data, nil
+}
+
+// LatestVersion returns the latest version of Data Dragon
+func LatestVersion() (string, error) {
+	r, err := open(VersionsURL)
+	if err != nil {
+		return "", err
+	}
+	defer r.Close()
+	var versions []string
+	if err := json.NewDecoder(r).Decode(&versions); err != nil {
+		return "", fmt.Errorf("decoding versions: %v", err)
+	}
+	if len(versions) == 0 {
+		return "", fmt.Errorf("no versions found")
+	}
+	return versions[0], nil
+}
+
+func open(source string) (io.ReadCloser, error) {
+	if !strings.HasPrefix(source, "http://") && !strings.HasPrefix(source, "https://") {
+		return os.Open(source)
+	}
+	response, err := http.Get(source)
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode != http.StatusOK {
+		response.Body.Close()
+		return nil, fmt.Errorf("loading %s: %s", source, response.Status)
+	}
+	return response.Body, nil
+}
+
+var source = template.Must(template.New("champions").Parse(`// Code generated by golio-champions from Data Dragon {{.Version}}. DO NOT EDIT.
+
+package {{.Package}}
+
+// All champions of Data Dragon {{.Version}} by their ID
+const (
+{{- range .Champions}}
+	Champion{{.Identifier}} Champion = {{.ID}}
+{{- end}}
+)
+
+// Champions is a list of all champions sorted by ID
+var Champions = []Champion{
+{{- range .Champions}}
+	Champion{{.Identifier}},
+{{- end}}
+}
+
+var championData = map[Champion]championInfo{
+{{- range .Champions}}
+	Champion{{.Identifier}}: { {{- printf "%q" .Key}}, {{printf "%q" .Name}}, {{printf "%q" .Title -}} },
+{{- end}}
+}
+`))
+
+// Generate writes the Go source code for the champions to w. The code declares a constant of type Champion for
+// every champion, a list of all champions and a map from each champion to a championInfo with its key, name and
+// title, which are expected to be declared in the package
+func Generate(w io.Writer, data *Data, pkg string) error {
+	var buf bytes.Buffer
+	err := source.Execute(&buf, struct {
+		*Data
+		Package string
+	}{data, pkg})
+	if err != nil {
+		return err
+	}
+	seen := map[string]int{}
+	for _, c := range data.Champions {
+		if id, ok := seen[c.Identifier()]; ok {
+			return fmt.Errorf("champions %d and %d have the same identifier %s", id, c.ID, c.Identifier())
+		}
+		seen[c.Identifier()] = c.ID
+	}
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("formatting generated code: %v", err)
+	}
+	_, err = w.Write(formatted)
+	return err
+}
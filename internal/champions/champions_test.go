@@ -0,0 +1,74 @@
+package champions
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+	data, err := Load("testdata/champion.json")
+	require.Nil(t, err)
+	assert.Equal(t, "10.6.1", data.Version)
+	require.Len(t, data.Champions, 6)
+	assert.Equal(t, Champion{ID: 1, Key: "Annie", Name: "Annie", Title: "the Dark Child"}, data.Champions[0])
+	assert.Equal(t, 222, data.Champions[5].ID)
+
+	server := httptest.NewServer(http.FileServer(http.Dir("testdata")))
+	defer server.Close()
+	remote, err := Load(server.URL + "/champion.json")
+	require.Nil(t, err)
+	assert.Equal(t, data, remote)
+	_, err = Load(server.URL + "/missing.json")
+	assert.NotNil(t, err)
+	_, err = Load("testdata/missing.json")
+	assert.NotNil(t, err)
+
+	invalid := filepath.Join(t.TempDir(), "champion.json")
+	require.Nil(t, os.WriteFile(invalid, []byte(`{"data":{"Annie":{"id":"Annie","key":"one"}}}`), 0644))
+	_, err = Load(invalid)
+	assert.NotNil(t, err)
+}
+
+func TestChampion_Identifier(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "Ahri", want: "Ahri"},
+		{name: "Kai'Sa", want: "KaiSa"},
+		{name: "Dr. Mundo", want: "DrMundo"},
+		{name: "Nunu & Willump", want: "NunuWillump"},
+		{name: "Jarvan IV", want: "JarvanIV"},
+		{name: "LeBlanc", want: "LeBlanc"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Champion{Name: tt.name}.Identifier())
+		})
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+	data, err := Load("testdata/champion.json")
+	require.Nil(t, err)
+	var buf bytes.Buffer
+	require.Nil(t, Generate(&buf, data, "riot"))
+	code := buf.String()
+	assert.Contains(t, code, "// Code generated by golio-champions from Data Dragon 10.6.1. DO NOT EDIT.")
+	assert.Contains(t, code, "package riot")
+	assert.Contains(t, code, "ChampionNunuWillump Champion = 20")
+	assert.Contains(t, code, `ChampionNunuWillump: {"Nunu", "Nunu & Willump", "the Boy and His Yeti"},`)
+
+	data.Champions = append(data.Champions, Champion{ID: 999, Key: "Jinx2", Name: "Jinx"})
+	assert.NotNil(t, Generate(&buf, data, "riot"))
+}
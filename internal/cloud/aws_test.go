@@ -0,0 +1,43 @@
+package cloud
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignAWS(t *testing.T) {
+	t.Parallel()
+	// example of the AWS documentation of Signature Version 4
+	request, err := http.NewRequest(http.MethodGet, "https://iam.amazonaws.com/?Action=ListUsers&Version=2010-05-08",
+		nil)
+	require.Nil(t, err)
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+	credentials := AWSCredentials{AccessKeyID: "AKIDEXAMPLE", SecretAccessKey: "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY"}
+	SignAWS(request, nil, credentials, "us-east-1", "iam", time.Date(2015, 8, 30, 12, 36, 0, 0, time.UTC))
+	assert.Equal(t, "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/iam/aws4_request, "+
+		"SignedHeaders=content-type;host;x-amz-date, "+
+		"Signature=5d672d79c15b13162d9279b0855cfba6789a8edb4c82c400e06b5924a6f2b5d7",
+		request.Header.Get("Authorization"))
+}
+
+func TestSignAWS_s3(t *testing.T) {
+	t.Parallel()
+	request, err := http.NewRequest(http.MethodGet, "https://bucket.s3.eu-west-1.amazonaws.com/key", nil)
+	require.Nil(t, err)
+	SignAWS(request, nil, AWSCredentials{AccessKeyID: "id", SecretAccessKey: "secret", SessionToken: "token"},
+		"eu-west-1", "s3", time.Now())
+	assert.Equal(t, "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+		request.Header.Get("X-Amz-Content-Sha256"))
+	assert.Equal(t, "token", request.Header.Get("X-Amz-Security-Token"))
+	assert.Contains(t, request.Header.Get("Authorization"),
+		"SignedHeaders=host;x-amz-content-sha256;x-amz-date;x-amz-security-token")
+}
+
+func TestEscapeAWSPath(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "/euw1/lol/path%3Fa%3Db%20c~_-.", EscapeAWSPath("/euw1/lol/path?a=b c~_-."))
+}
@@ -0,0 +1,62 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/mjourard/golio/internal"
+)
+
+// metadataTokenURL returns an access token of the service account of a Cloud Function or other Google Cloud services
+const metadataTokenURL = "http://metadata.google.internal/computeMetadata/v1/instance/service-accounts/default/token"
+
+// MetadataToken returns the access tokens of the default service account provided by the metadata server of Google
+// Cloud, caching them until shortly before they expire. It is safe for concurrent use
+type MetadataToken struct {
+	Client internal.Doer
+	// URL of the token endpoint, defaults to the endpoint of the metadata server
+	URL     string
+	mu      sync.Mutex
+	token   string
+	expires time.Time
+}
+
+// Get returns a valid access token
+func (m *MetadataToken) Get(ctx context.Context) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.token != "" && time.Now().Before(m.expires) {
+		return m.token, nil
+	}
+	url := m.URL
+	if url == "" {
+		url = metadataTokenURL
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+	request.Header.Set("Metadata-Flavor", "Google")
+	response, err := m.Client.Do(request)
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("cloud: metadata token request failed with status %d", response.StatusCode)
+	}
+	var token struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int    `json:"expires_in"`
+	}
+	if err := json.NewDecoder(response.Body).Decode(&token); err != nil {
+		return "", err
+	}
+	m.token = token.AccessToken
+	m.expires = time.Now().Add(time.Duration(token.ExpiresIn)*time.Second - time.Minute)
+	return m.token, nil
+}
@@ -0,0 +1,29 @@
+package cloud
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetadataToken(t *testing.T) {
+	t.Parallel()
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assert.Equal(t, "Google", r.Header.Get("Metadata-Flavor"))
+		_, _ = w.Write([]byte(`{"access_token":"token","expires_in":3599,"token_type":"Bearer"}`))
+	}))
+	defer server.Close()
+	source := &MetadataToken{Client: http.DefaultClient, URL: server.URL}
+	for i := 0; i < 2; i++ {
+		token, err := source.Get(context.Background())
+		require.Nil(t, err)
+		assert.Equal(t, "token", token)
+	}
+	assert.Equal(t, 1, requests)
+}
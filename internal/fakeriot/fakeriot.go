@@ -0,0 +1,79 @@
+// Package fakeriot contains the helpers shared by the fakes of the Riot API in the packages sandbox, fakeapi and
+// mockserver, so that they match summoner names and report errors the same way as the Riot API
+package fakeriot
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+)
+
+const contentType = "application/json;charset=utf-8"
+
+var errorMessages = map[int]string{
+	http.StatusBadRequest:           "Bad request",
+	http.StatusUnauthorized:         "Unauthorized",
+	http.StatusForbidden:            "Forbidden",
+	http.StatusNotFound:             "Data not found",
+	http.StatusMethodNotAllowed:     "Method not allowed",
+	http.StatusUnsupportedMediaType: "Unsupported media type",
+	http.StatusTooManyRequests:      "Rate limit exceeded",
+	http.StatusInternalServerError:  "Internal server error",
+	http.StatusBadGateway:           "Bad gateway",
+	http.StatusServiceUnavailable:   "Service unavailable",
+	http.StatusGatewayTimeout:       "Gateway timeout",
+}
+
+// NormalizeName returns the name in the form the Riot API uses to match summoner names
+func NormalizeName(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, " ", ""))
+}
+
+// Response returns a JSON response with the status code and body
+func Response(code int, body []byte) *http.Response {
+	return &http.Response{
+		StatusCode: code,
+		Status:     http.StatusText(code),
+		Header:     http.Header{"Content-Type": []string{contentType}},
+		Body:       io.NopCloser(bytes.NewReader(body)),
+	}
+}
+
+// ErrorResponse returns a response in the format used by the Riot API for errors
+func ErrorResponse(code int) *http.Response {
+	response := Response(code, errorBody(code))
+	errorHeader(response.Header, code)
+	return response
+}
+
+// WriteError writes an error response in the format used by the Riot API
+func WriteError(w http.ResponseWriter, code int) {
+	w.Header().Set("Content-Type", contentType)
+	errorHeader(w.Header(), code)
+	w.WriteHeader(code)
+	_, _ = w.Write(errorBody(code))
+}
+
+// errorHeader sets the headers the Riot API sends with the error
+func errorHeader(header http.Header, code int) {
+	if code == http.StatusTooManyRequests {
+		header.Set("Retry-After", "1")
+	}
+}
+
+// errorBody returns the body the Riot API sends for the error
+func errorBody(code int) []byte {
+	message, ok := errorMessages[code]
+	if !ok {
+		message = http.StatusText(code)
+	}
+	body, _ := json.Marshal(map[string]interface{}{
+		"status": map[string]interface{}{
+			"message":     message,
+			"status_code": code,
+		},
+	})
+	return body
+}
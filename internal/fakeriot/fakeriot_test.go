@@ -0,0 +1,42 @@
+package fakeriot
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mjourard/golio/api"
+)
+
+func TestNormalizeName(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "skjenax", NormalizeName("SK Jenax"))
+}
+
+func TestErrorResponse(t *testing.T) {
+	t.Parallel()
+	response := ErrorResponse(http.StatusNotFound)
+	body, err := io.ReadAll(response.Body)
+	require.Nil(t, err)
+	assert.Equal(t, http.StatusNotFound, response.StatusCode)
+	assert.JSONEq(t, `{"status":{"message":"Data not found","status_code":404}}`, string(body))
+	err = api.NewResponseError(http.MethodGet, &url.URL{}, response.StatusCode, body)
+	assert.True(t, errors.Is(err, api.ErrNotFound))
+	assert.Equal(t, "1", ErrorResponse(http.StatusTooManyRequests).Header.Get("Retry-After"))
+}
+
+func TestWriteError(t *testing.T) {
+	t.Parallel()
+	recorder := httptest.NewRecorder()
+	WriteError(recorder, http.StatusTeapot)
+	assert.Equal(t, http.StatusTeapot, recorder.Code)
+	assert.Equal(t, "application/json;charset=utf-8", recorder.Header().Get("Content-Type"))
+	assert.Empty(t, recorder.Header().Get("Retry-After"))
+	assert.JSONEq(t, `{"status":{"message":"I'm a teapot","status_code":418}}`, recorder.Body.String())
+}
@@ -1,2 +0,0 @@
-// Package mock includes mock constructs used for testing the API.
-package mock
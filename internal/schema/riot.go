@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"reflect"
+
+	"github.com/mjourard/golio/riot"
+)
+
+// RiotTypes maps the schemas of the Riot API to the types of the riot package decoding them
+var RiotTypes = map[string]reflect.Type{
+	"champion-mastery-v4.ChampionMasteryDTO":         reflect.TypeOf(riot.ChampionMastery{}),
+	"champion-v3.ChampionInfo":                       reflect.TypeOf(riot.ChampionInfo{}),
+	"league-v4.LeagueListDTO":                        reflect.TypeOf(riot.LeagueList{}),
+	"league-v4.LeagueEntryDTO":                       reflect.TypeOf(riot.LeagueItem{}),
+	"league-v4.MiniSeriesDTO":                        reflect.TypeOf(riot.MiniSeries{}),
+	"lol-status-v3.ShardStatus":                      reflect.TypeOf(riot.Status{}),
+	"lol-status-v3.Service":                          reflect.TypeOf(riot.Service{}),
+	"lol-status-v3.Incident":                         reflect.TypeOf(riot.Incident{}),
+	"lol-status-v3.Message":                          reflect.TypeOf(riot.StatusMessage{}),
+	"lol-status-v3.Translation":                      reflect.TypeOf(riot.StatusTranslation{}),
+	"match-v4.MatchDto":                              reflect.TypeOf(riot.Match{}),
+	"match-v4.ParticipantIdentityDto":                reflect.TypeOf(riot.ParticipantIdentity{}),
+	"match-v4.PlayerDto":                             reflect.TypeOf(riot.Player{}),
+	"match-v4.TeamStatsDto":                          reflect.TypeOf(riot.TeamStats{}),
+	"match-v4.TeamBansDto":                           reflect.TypeOf(riot.TeamBan{}),
+	"match-v4.ParticipantDto":                        reflect.TypeOf(riot.Participant{}),
+	"match-v4.ParticipantStatsDto":                   reflect.TypeOf(riot.ParticipantStats{}),
+	"match-v4.RuneDto":                               reflect.TypeOf(riot.Rune{}),
+	"match-v4.ParticipantTimelineDto":                reflect.TypeOf(riot.ParticipantTimeline{}),
+	"match-v4.MasteryDto":                            reflect.TypeOf(riot.LegacyMastery{}),
+	"match-v4.MatchlistDto":                          reflect.TypeOf(riot.Matchlist{}),
+	"match-v4.MatchReferenceDto":                     reflect.TypeOf(riot.MatchReference{}),
+	"match-v4.MatchTimelineDto":                      reflect.TypeOf(riot.MatchTimeline{}),
+	"match-v4.MatchFrameDto":                         reflect.TypeOf(riot.MatchFrame{}),
+	"match-v4.MatchParticipantFrameDto":              reflect.TypeOf(riot.ParticipantFrame{}),
+	"match-v4.MatchEventDto":                         reflect.TypeOf(riot.MatchEvent{}),
+	"match-v4.MatchPositionDto":                      reflect.TypeOf(riot.MatchPosition{}),
+	"spectator-v4.CurrentGameInfo":                   reflect.TypeOf(riot.GameInfo{}),
+	"spectator-v4.BannedChampion":                    reflect.TypeOf(riot.BannedChampion{}),
+	"spectator-v4.Observer":                          reflect.TypeOf(riot.Observer{}),
+	"spectator-v4.CurrentGameParticipant":            reflect.TypeOf(riot.CurrentGameParticipant{}),
+	"spectator-v4.GameCustomizationObject":           reflect.TypeOf(riot.GameCustomizationObject{}),
+	"spectator-v4.Perks":                             reflect.TypeOf(riot.Perks{}),
+	"spectator-v4.FeaturedGames":                     reflect.TypeOf(riot.FeaturedGames{}),
+	"summoner-v4.SummonerDTO":                        reflect.TypeOf(riot.Summoner{}),
+	"tournament-v4.LobbyEventDTOWrapper":             reflect.TypeOf(riot.LobbyEventList{}),
+	"tournament-v4.LobbyEventDTO":                    reflect.TypeOf(riot.LobbyEvent{}),
+	"tournament-v4.TournamentCodeDTO":                reflect.TypeOf(riot.Tournament{}),
+	"tournament-v4.TournamentCodeParameters":         reflect.TypeOf(riot.TournamentCodeParameters{}),
+	"tournament-v4.TournamentCodeUpdateParameters":   reflect.TypeOf(riot.TournamentUpdateParameters{}),
+	"tournament-v4.TournamentRegistrationParameters": reflect.TypeOf(riot.TournamentRegistrationParameters{}),
+	"tournament-v4.ProviderRegistrationParameters":   reflect.TypeOf(riot.ProviderRegistrationParameters{}),
+}
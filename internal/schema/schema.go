@@ -0,0 +1,85 @@
+// Package schema validates the types of golio against the OpenAPI schema of the Riot API maintained by the community
+// at https://github.com/MingweiSamuel/riotapi-schema. The schema is regenerated for every patch, so validating
+// against its latest version flags fields which were added, removed or changed by Riot.
+package schema
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// DefaultSource is the location of the latest version of the schema
+const DefaultSource = "http://www.mingweisamuel.com/riotapi-schema/openapi-3.0.0.json"
+
+const refPrefix = "#/components/schemas/"
+
+// Spec is the part of an OpenAPI document used for validation
+type Spec struct {
+	Components struct {
+		Schemas map[string]*Schema `json:"schemas"`
+	} `json:"components"`
+}
+
+// Schema is an OpenAPI schema object
+type Schema struct {
+	Ref                  string             `json:"$ref"`
+	Type                 string             `json:"type"`
+	Format               string             `json:"format"`
+	Properties           map[string]*Schema `json:"properties"`
+	Items                *Schema            `json:"items"`
+	AdditionalProperties *Schema            `json:"additionalProperties"`
+	Required             []string           `json:"required"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Boolean schemas, which OpenAPI allows e.g. for additionalProperties,
+// are decoded as an empty schema accepting any value
+func (s *Schema) UnmarshalJSON(data []byte) error {
+	if trimmed := bytes.TrimSpace(data); bytes.Equal(trimmed, []byte("true")) || bytes.Equal(trimmed, []byte("false")) {
+		*s = Schema{}
+		return nil
+	}
+	type schema Schema
+	return json.Unmarshal(data, (*schema)(s))
+}
+
+// Load reads the schema from source, which is either an HTTP(S) URL or a file path
+func Load(source string) (*Spec, error) {
+	var r io.ReadCloser
+	if strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://") {
+		response, err := http.Get(source)
+		if err != nil {
+			return nil, err
+		}
+		if response.StatusCode != http.StatusOK {
+			response.Body.Close()
+			return nil, fmt.Errorf("loading schema from %s: %s", source, response.Status)
+		}
+		r = response.Body
+	} else {
+		file, err := os.Open(source)
+		if err != nil {
+			return nil, err
+		}
+		r = file
+	}
+	defer r.Close()
+	spec := &Spec{}
+	if err := json.NewDecoder(r).Decode(spec); err != nil {
+		return nil, fmt.Errorf("decoding schema from %s: %v", source, err)
+	}
+	return spec, nil
+}
+
+// resolve returns the schema referenced by s or s itself if it is no reference
+func (spec *Spec) resolve(s *Schema) (*Schema, string) {
+	if s.Ref == "" {
+		return s, ""
+	}
+	name := strings.TrimPrefix(s.Ref, refPrefix)
+	return spec.Components.Schemas[name], name
+}
@@ -0,0 +1,31 @@
+package schema
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+	spec, err := Load("testdata/openapi.json")
+	require.Nil(t, err)
+	player := spec.Components.Schemas["test-v1.PlayerDTO"]
+	require.NotNil(t, player)
+	assert.Equal(t, "integer", player.Properties["level"].Type)
+	assert.Equal(t, "#/components/schemas/test-v1.TeamDTO", player.Properties["friends"].Items.Ref)
+	assert.Equal(t, &Schema{}, player.Properties["extra"].AdditionalProperties)
+
+	server := httptest.NewServer(http.FileServer(http.Dir("testdata")))
+	defer server.Close()
+	remote, err := Load(server.URL + "/openapi.json")
+	require.Nil(t, err)
+	assert.Equal(t, spec, remote)
+	_, err = Load(server.URL + "/missing.json")
+	assert.NotNil(t, err)
+	_, err = Load("testdata/missing.json")
+	assert.NotNil(t, err)
+}
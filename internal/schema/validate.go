@@ -0,0 +1,211 @@
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Problem is a difference between a schema and the Go type it is mapped to
+type Problem struct {
+	// Schema is the name of the schema, e.g. summoner-v4.SummonerDTO
+	Schema string
+	// Field is the name of the property, empty for problems of the whole schema
+	Field   string
+	Message string
+}
+
+func (p Problem) String() string {
+	if p.Field == "" {
+		return fmt.Sprintf("%s: %s", p.Schema, p.Message)
+	}
+	return fmt.Sprintf("%s.%s: %s", p.Schema, p.Field, p.Message)
+}
+
+// Validate compares each schema with the struct type it is mapped to in types and returns all differences, i.e.
+// properties without a field, fields without a property and fields with a type not matching their property.
+// Problems are sorted by schema and field
+func Validate(spec *Spec, types map[string]reflect.Type) []Problem {
+	var problems []Problem
+	for name, t := range types {
+		problems = append(problems, validateType(spec, types, name, t)...)
+	}
+	sort.SliceStable(problems, func(i, j int) bool {
+		if problems[i].Schema != problems[j].Schema {
+			return problems[i].Schema < problems[j].Schema
+		}
+		return problems[i].Field < problems[j].Field
+	})
+	return problems
+}
+
+func validateType(spec *Spec, types map[string]reflect.Type, name string, t reflect.Type) []Problem {
+	s, ok := spec.Components.Schemas[name]
+	if !ok {
+		return []Problem{{Schema: name, Message: "schema does not exist"}}
+	}
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return []Problem{{Schema: name, Message: fmt.Sprintf("%s is no struct", t)}}
+	}
+	fields := jsonFields(t)
+	var problems []Problem
+	for property, propertySchema := range s.Properties {
+		field, ok := fields[property]
+		if !ok {
+			problems = append(problems, Problem{Schema: name, Field: property,
+				Message: fmt.Sprintf("missing field of type %s", describe(propertySchema))})
+			continue
+		}
+		if message := compare(spec, types, propertySchema, field.Type); message != "" {
+			problems = append(problems, Problem{Schema: name, Field: property,
+				Message: fmt.Sprintf("field %s: %s", field.Name, message)})
+		}
+	}
+	for property, field := range fields {
+		if _, ok := s.Properties[property]; !ok {
+			problems = append(problems, Problem{Schema: name, Field: property,
+				Message: fmt.Sprintf("field %s does not exist in schema", field.Name)})
+		}
+	}
+	return problems
+}
+
+var unmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+// compare returns a description of the mismatch between s and t or an empty string if they match
+func compare(spec *Spec, types map[string]reflect.Type, s *Schema, t reflect.Type) string {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() == reflect.Interface {
+		return ""
+	}
+	// types with custom decoding, e.g. riot.Timestamp, define their own json representation
+	if reflect.PtrTo(t).Implements(unmarshalerType) {
+		return ""
+	}
+	resolved, ref := spec.resolve(s)
+	if resolved == nil {
+		return fmt.Sprintf("unknown schema reference %s", s.Ref)
+	}
+	if ref != "" && resolved.Type == "object" && resolved.AdditionalProperties == nil {
+		if t.Kind() != reflect.Struct {
+			return fmt.Sprintf("%s does not match %s", t, ref)
+		}
+		if mapped, ok := types[ref]; ok && deref(mapped) != t {
+			return fmt.Sprintf("%s does not match %s, which is mapped to %s", t, ref, deref(mapped))
+		}
+		return ""
+	}
+	s = resolved
+	mismatch := fmt.Sprintf("%s does not match %s", t, describe(s))
+	switch s.Type {
+	case "integer":
+		switch t.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			if s.Format == "int64" && t.Bits() < 64 {
+				return mismatch
+			}
+			return ""
+		}
+	case "number":
+		if t.Kind() == reflect.Float32 || t.Kind() == reflect.Float64 {
+			return ""
+		}
+	case "string":
+		if t.Kind() == reflect.String {
+			return ""
+		}
+	case "boolean":
+		if t.Kind() == reflect.Bool {
+			return ""
+		}
+	case "array":
+		if t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+			if s.Items == nil {
+				return ""
+			}
+			if message := compare(spec, types, s.Items, t.Elem()); message != "" {
+				return "items: " + message
+			}
+			return ""
+		}
+	case "object":
+		if t.Kind() == reflect.Map {
+			if s.AdditionalProperties == nil {
+				return ""
+			}
+			if message := compare(spec, types, s.AdditionalProperties, t.Elem()); message != "" {
+				return "values: " + message
+			}
+			return ""
+		}
+		if t.Kind() == reflect.Struct && s.AdditionalProperties == nil {
+			return ""
+		}
+	case "":
+		return ""
+	}
+	return mismatch
+}
+
+// describe returns a short description of the schema type, e.g. integer (int64) or array of match-v4.PlayerDto
+func describe(s *Schema) string {
+	if s.Ref != "" {
+		return strings.TrimPrefix(s.Ref, refPrefix)
+	}
+	switch {
+	case s.Type == "array" && s.Items != nil:
+		return "array of " + describe(s.Items)
+	case s.Type == "object" && s.AdditionalProperties != nil:
+		return "map of " + describe(s.AdditionalProperties)
+	case s.Format != "":
+		return fmt.Sprintf("%s (%s)", s.Type, s.Format)
+	case s.Type == "":
+		return "any"
+	}
+	return s.Type
+}
+
+// jsonFields returns the fields of t by their json name, including the fields of embedded structs
+func jsonFields(t reflect.Type) map[string]reflect.StructField {
+	fields := map[string]reflect.StructField{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+		if field.Anonymous && name == "" && deref(field.Type).Kind() == reflect.Struct {
+			for embeddedName, embedded := range jsonFields(deref(field.Type)) {
+				if _, ok := fields[embeddedName]; !ok {
+					fields[embeddedName] = embedded
+				}
+			}
+			continue
+		}
+		if field.PkgPath != "" {
+			// unexported
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fields[name] = field
+	}
+	return fields
+}
+
+func deref(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t
+}
@@ -0,0 +1,83 @@
+package schema
+
+import (
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type team struct {
+	TeamID int `json:"teamId"`
+}
+
+type base struct {
+	AccountID string `json:"accountId"`
+}
+
+// millis is decoded by custom logic and therefore matches any schema
+type millis struct{}
+
+func (m *millis) UnmarshalJSON([]byte) error {
+	return nil
+}
+
+type player struct {
+	base
+	Level     int32              `json:"level"`
+	Score     float64            `json:"score"`
+	Active    string             `json:"active"`
+	Team      *team              `json:"team"`
+	Friends   []*player          `json:"friends"`
+	Deltas    map[string]float64 `json:"deltas"`
+	Extra     map[string]interface{}
+	Queue     int    `json:"queue"`
+	Region    string `json:"-"`
+	Timestamp int    `json:"timestamp"`
+	CreatedAt millis `json:"createdAt"`
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+	spec, err := Load("testdata/openapi.json")
+	require.Nil(t, err)
+	types := map[string]reflect.Type{
+		"test-v1.PlayerDTO": reflect.TypeOf(&player{}),
+		"test-v1.TeamDTO":   reflect.TypeOf(team{}),
+		"test-v1.Missing":   reflect.TypeOf(team{}),
+		"test-v1.Queue":     reflect.TypeOf(0),
+	}
+	want := []string{
+		"test-v1.Missing: schema does not exist",
+		"test-v1.PlayerDTO.Extra: field Extra does not exist in schema",
+		"test-v1.PlayerDTO.active: field Active: string does not match boolean",
+		"test-v1.PlayerDTO.extra: missing field of type map of any",
+		"test-v1.PlayerDTO.friends: field Friends: items: schema.player does not match test-v1.TeamDTO, " +
+			"which is mapped to schema.team",
+		"test-v1.PlayerDTO.level: field Level: int32 does not match integer (int64)",
+		"test-v1.PlayerDTO.region: missing field of type string",
+		"test-v1.PlayerDTO.timestamp: field Timestamp does not exist in schema",
+		"test-v1.Queue: int is no struct",
+	}
+	var got []string
+	for _, problem := range Validate(spec, types) {
+		got = append(got, problem.String())
+	}
+	assert.Equal(t, want, got)
+}
+
+// TestRiotTypes validates the types of the riot package against the schema given by the environment variable
+// GOLIO_SCHEMA, e.g. GOLIO_SCHEMA=http://www.mingweisamuel.com/riotapi-schema/openapi-3.0.0.json
+func TestRiotTypes(t *testing.T) {
+	source := os.Getenv("GOLIO_SCHEMA")
+	if source == "" {
+		t.Skip("GOLIO_SCHEMA not set")
+	}
+	spec, err := Load(source)
+	require.Nil(t, err)
+	for _, problem := range Validate(spec, RiotTypes) {
+		t.Error(problem)
+	}
+}
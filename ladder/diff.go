@@ -0,0 +1,114 @@
+package ladder
+
+import (
+	"sort"
+	"time"
+
+	"github.com/mjourard/golio/riot"
+)
+
+// pointsPerDivision are the league points between the lowest entries of two adjacent divisions
+const pointsPerDivision = 100
+
+// Diff are the changes between two snapshots of a ladder
+type Diff struct {
+	From time.Time
+	To   time.Time
+	// Changes are the entries of both snapshots whose rank or games changed, the highest gain first
+	Changes []Change
+	// Entrants are the entries which are only in the newer snapshot, sorted by rank
+	Entrants []*riot.LeagueItem
+	// Dropouts are the entries which are only in the older snapshot, sorted by rank
+	Dropouts []*riot.LeagueItem
+}
+
+// Change is the change of the entry of a player between two snapshots
+type Change struct {
+	Before *riot.LeagueItem
+	After  *riot.LeagueItem
+	// LeaguePoints are the league points gained, negative for losses. Points are counted across divisions and tiers,
+	// e.g. the promotion from Diamond II 90 LP to Diamond I 10 LP is a gain of 20
+	LeaguePoints int
+	// Games is the number of ranked games played between the snapshots
+	Games int
+}
+
+// Decayed returns the changes which lost league points without playing a game, e.g. because of inactivity
+func (d *Diff) Decayed() []Change {
+	var decayed []Change
+	for _, change := range d.Changes {
+		if change.Games == 0 && change.LeaguePoints < 0 {
+			decayed = append(decayed, change)
+		}
+	}
+	return decayed
+}
+
+// Compare returns the changes from the older snapshot before to the newer snapshot after. Entries are matched by the
+// summoner ID
+func Compare(before, after *Snapshot) *Diff {
+	diff := &Diff{From: before.TakenAt, To: after.TakenAt}
+	previous := map[string]*riot.LeagueItem{}
+	for _, entry := range before.Entries {
+		if entry != nil {
+			previous[entry.SummonerID] = entry
+		}
+	}
+	for _, entry := range after.Entries {
+		if entry == nil {
+			continue
+		}
+		old, ok := previous[entry.SummonerID]
+		if !ok {
+			diff.Entrants = append(diff.Entrants, entry)
+			continue
+		}
+		delete(previous, entry.SummonerID)
+		change := Change{
+			Before:       old,
+			After:        entry,
+			LeaguePoints: ladderPoints(entry) - ladderPoints(old),
+			Games:        entry.TotalGames() - old.TotalGames(),
+		}
+		if change.LeaguePoints != 0 || change.Games != 0 || entry.Tier != old.Tier || entry.Rank != old.Rank {
+			diff.Changes = append(diff.Changes, change)
+		}
+	}
+	for _, entry := range before.Entries {
+		if entry != nil && previous[entry.SummonerID] == entry {
+			diff.Dropouts = append(diff.Dropouts, entry)
+		}
+	}
+	sort.SliceStable(diff.Changes, func(i, j int) bool {
+		return diff.Changes[i].LeaguePoints > diff.Changes[j].LeaguePoints
+	})
+	byRank := func(entries []*riot.LeagueItem) func(i, j int) bool {
+		return func(i, j int) bool {
+			return entries[j].Less(entries[i])
+		}
+	}
+	sort.SliceStable(diff.Entrants, byRank(diff.Entrants))
+	sort.SliceStable(diff.Dropouts, byRank(diff.Dropouts))
+	return diff
+}
+
+// ladderPoints returns the league points of the entry counted from Iron IV 0 LP. All apex tiers share the league
+// points above Diamond I, as their league points are kept on promotion
+func ladderPoints(entry *riot.LeagueItem) int {
+	tier := riot.Tier(entry.Tier)
+	if tier.IsApex() {
+		return len(riot.Tiers)*len(riot.Divisions)*pointsPerDivision + entry.LeaguePoints
+	}
+	points := entry.LeaguePoints
+	for i, t := range riot.Tiers {
+		if t == tier {
+			points += i * len(riot.Divisions) * pointsPerDivision
+		}
+	}
+	for i, d := range riot.Divisions {
+		if d == riot.Division(entry.Rank) {
+			points += (len(riot.Divisions) - 1 - i) * pointsPerDivision
+		}
+	}
+	return points
+}
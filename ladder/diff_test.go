@@ -0,0 +1,56 @@
+package ladder
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mjourard/golio/riot"
+)
+
+func entry(summonerID string, tier riot.Tier, rank riot.Division, lp, games int) *riot.LeagueItem {
+	return &riot.LeagueItem{SummonerID: summonerID, Tier: string(tier), Rank: string(rank), LeaguePoints: lp,
+		Wins: games}
+}
+
+func TestCompare(t *testing.T) {
+	t.Parallel()
+	before := &Snapshot{TakenAt: time.Unix(0, 0), Entries: []*riot.LeagueItem{
+		entry("promoted", riot.TierDiamond, riot.DivisionTwo, 90, 10),
+		entry("decayed", riot.TierMaster, riot.DivisionOne, 120, 50),
+		entry("unchanged", riot.TierMaster, riot.DivisionOne, 10, 50),
+		entry("climbed", riot.TierMaster, riot.DivisionOne, 480, 70),
+		entry("dropped low", riot.TierMaster, riot.DivisionOne, 0, 20),
+		entry("dropped high", riot.TierChallenger, riot.DivisionOne, 900, 20),
+		nil,
+	}}
+	after := &Snapshot{TakenAt: time.Unix(60, 0), Entries: []*riot.LeagueItem{
+		entry("promoted", riot.TierDiamond, riot.DivisionOne, 10, 11),
+		entry("decayed", riot.TierMaster, riot.DivisionOne, 45, 50),
+		entry("unchanged", riot.TierMaster, riot.DivisionOne, 10, 50),
+		entry("climbed", riot.TierGrandmaster, riot.DivisionOne, 520, 72),
+		entry("new low", riot.TierDiamond, riot.DivisionFour, 0, 1),
+		entry("new high", riot.TierMaster, riot.DivisionOne, 0, 1),
+	}}
+	diff := Compare(before, after)
+	assert.Equal(t, time.Unix(60, 0), diff.To)
+	require.Len(t, diff.Changes, 3)
+	assert.Equal(t, "climbed", diff.Changes[0].After.SummonerID)
+	assert.Equal(t, 40, diff.Changes[0].LeaguePoints)
+	assert.Equal(t, 2, diff.Changes[0].Games)
+	assert.Equal(t, "promoted", diff.Changes[1].After.SummonerID)
+	assert.Equal(t, 20, diff.Changes[1].LeaguePoints)
+	assert.Equal(t, []Change{{Before: before.Entries[1], After: after.Entries[1], LeaguePoints: -75}}, diff.Decayed())
+	assert.Equal(t, []*riot.LeagueItem{after.Entries[5], after.Entries[4]}, diff.Entrants)
+	assert.Equal(t, []*riot.LeagueItem{before.Entries[5], before.Entries[4]}, diff.Dropouts)
+}
+
+func TestLadderPoints(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, 0, ladderPoints(entry("", riot.TierIron, riot.DivisionFour, 0, 0)))
+	assert.Equal(t, 2399, ladderPoints(entry("", riot.TierDiamond, riot.DivisionOne, 99, 0)))
+	assert.Equal(t, 2400, ladderPoints(entry("", riot.TierMaster, riot.DivisionOne, 0, 0)))
+	assert.Equal(t, 3400, ladderPoints(entry("", riot.TierChallenger, riot.DivisionOne, 1000, 0)))
+}
@@ -0,0 +1,197 @@
+// Package ladder takes periodic snapshots of the ranked ladder of a queue and reports the changes between them, e.g.
+// for leaderboards and sites tracking the decay of apex players:
+//
+//	snapshotter := ladder.NewSnapshotter(client.Riot, riot.QueueRankedSolo,
+//		ladder.WithStore(ladder.SQLStore(s)), ladder.WithDivision(riot.TierDiamond, riot.DivisionOne))
+//	for event := range snapshotter.Watch(ctx, time.Hour) {
+//		if event.Diff != nil {
+//			fmt.Println(len(event.Diff.Entrants), len(event.Diff.Decayed()))
+//		}
+//	}
+//
+// The apex tiers are always scraped, divisions below are only scraped if they are added using WithDivision.
+package ladder
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/mjourard/golio/api"
+	"github.com/mjourard/golio/riot"
+	"github.com/mjourard/golio/store"
+)
+
+// Snapshot are the entries of the ladder of a queue at a point in time
+type Snapshot struct {
+	Region  api.Region
+	Queue   riot.Queue
+	TakenAt time.Time
+	Entries []*riot.LeagueItem
+}
+
+// Store persists snapshots
+type Store interface {
+	SaveSnapshot(ctx context.Context, snapshot *Snapshot) error
+}
+
+// StoreFunc is a function implementing Store
+type StoreFunc func(ctx context.Context, snapshot *Snapshot) error
+
+// SaveSnapshot calls f
+func (f StoreFunc) SaveSnapshot(ctx context.Context, snapshot *Snapshot) error {
+	return f(ctx, snapshot)
+}
+
+// SQLStore saves the snapshots as league snapshots of the store, so that the history of each player can be read
+// using store.LeagueHistory
+func SQLStore(s *store.Store) Store {
+	return StoreFunc(func(ctx context.Context, snapshot *Snapshot) error {
+		return s.SaveLeagueSnapshot(ctx, snapshot.Region, snapshot.TakenAt, snapshot.Entries)
+	})
+}
+
+// division is a division of a tier below the apex tiers
+type division struct {
+	tier     riot.Tier
+	division riot.Division
+}
+
+// Snapshotter takes snapshots of the ladder of a queue. It is not safe for concurrent use
+type Snapshotter struct {
+	client    *riot.Client
+	queue     riot.Queue
+	divisions []division
+	store     Store
+	logger    log.FieldLogger
+	previous  *Snapshot
+	now       func() time.Time
+}
+
+// Option is used to configure the Snapshotter
+type Option func(*Snapshotter)
+
+// WithDivision also scrapes all pages of the division of the tier below the apex tiers
+func WithDivision(tier riot.Tier, d riot.Division) Option {
+	return func(s *Snapshotter) {
+		s.divisions = append(s.divisions, division{tier: tier, division: d})
+	}
+}
+
+// WithStore saves every snapshot in the store
+func WithStore(store Store) Option {
+	return func(s *Snapshotter) {
+		s.store = store
+	}
+}
+
+// WithPrevious sets the snapshot the first snapshot is compared to, e.g. the last snapshot before a restart
+func WithPrevious(snapshot *Snapshot) Option {
+	return func(s *Snapshotter) {
+		s.previous = snapshot
+	}
+}
+
+// WithLogger sets the logger of the snapshotter
+func WithLogger(l log.FieldLogger) Option {
+	return func(s *Snapshotter) {
+		s.logger = l
+	}
+}
+
+// NewSnapshotter returns a snapshotter of the queue in the region of the client
+func NewSnapshotter(client *riot.Client, queue riot.Queue, options ...Option) *Snapshotter {
+	s := &Snapshotter{
+		client: client,
+		queue:  queue,
+		logger: log.StandardLogger(),
+		now:    time.Now,
+	}
+	for _, opt := range options {
+		opt(s)
+	}
+	s.logger = s.logger.WithField("client", "ladder")
+	return s
+}
+
+// Take scrapes the ladder, saves the snapshot in the store and returns it together with the changes since the
+// previous snapshot. The diff is nil for the first snapshot. A snapshot which could not be saved is still used as
+// the previous snapshot of the next call
+func (s *Snapshotter) Take(ctx context.Context) (*Snapshot, *Diff, error) {
+	snapshot := &Snapshot{Region: s.client.Region, Queue: s.queue, TakenAt: s.now()}
+	for _, get := range []func(riot.Queue) (*riot.LeagueList, error){
+		s.client.League.GetChallenger, s.client.League.GetGrandmaster, s.client.League.GetMaster,
+	} {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+		league, err := get(s.queue)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, entry := range league.Entries {
+			if entry == nil {
+				continue
+			}
+			entry.Tier = league.Tier
+			entry.QueueType = league.Queue
+			snapshot.Entries = append(snapshot.Entries, entry)
+		}
+	}
+	for _, d := range s.divisions {
+		entries, err := s.client.League.ListPlayersPager(s.queue, d.tier, d.division).All(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		snapshot.Entries = append(snapshot.Entries, entries...)
+	}
+	var diff *Diff
+	if s.previous != nil {
+		diff = Compare(s.previous, snapshot)
+	}
+	s.previous = snapshot
+	if s.store != nil {
+		if err := s.store.SaveSnapshot(ctx, snapshot); err != nil {
+			s.logger.WithField("method", "Take").Debug(err)
+			return snapshot, diff, err
+		}
+	}
+	return snapshot, diff, nil
+}
+
+// Event is a value returned by Watch, containing either a snapshot or an error
+type Event struct {
+	Snapshot *Snapshot
+	// Diff are the changes since the previous snapshot, nil for the first snapshot
+	Diff  *Diff
+	Error error
+}
+
+// Watch takes a snapshot immediately and then in the given interval and emits an event for each. Failed snapshots
+// are emitted as errors and the watcher continues. The returned channel is closed once ctx is done
+func (s *Snapshotter) Watch(ctx context.Context, interval time.Duration) <-chan Event {
+	events := make(chan Event, 1)
+	go func() {
+		defer close(events)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			snapshot, diff, err := s.Take(ctx)
+			if ctx.Err() != nil {
+				return
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case events <- Event{Snapshot: snapshot, Diff: diff, Error: err}:
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return events
+}
@@ -0,0 +1,105 @@
+package ladder
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mjourard/golio/api"
+	"github.com/mjourard/golio/mock"
+	"github.com/mjourard/golio/riot"
+)
+
+// ladderDoer answers league requests with one entry per apex tier and one page of Diamond I. The league points of
+// the challenger are increased by one for every request
+func ladderDoer() *mock.Doer {
+	challengerLP := 1000
+	return &mock.Doer{Custom: func(r *http.Request) (*http.Response, error) {
+		var object interface{}
+		switch {
+		case strings.Contains(r.URL.Path, "/challengerleagues/"):
+			challengerLP++
+			object = riot.LeagueList{Tier: "CHALLENGER", Queue: "RANKED_SOLO_5x5", Entries: []*riot.LeagueItem{
+				{SummonerID: "c", Rank: "I", LeaguePoints: challengerLP},
+			}}
+		case strings.Contains(r.URL.Path, "/grandmasterleagues/"):
+			object = riot.LeagueList{Tier: "GRANDMASTER", Queue: "RANKED_SOLO_5x5", Entries: []*riot.LeagueItem{
+				{SummonerID: "g", Rank: "I", LeaguePoints: 500},
+			}}
+		case strings.Contains(r.URL.Path, "/masterleagues/"):
+			object = riot.LeagueList{Tier: "MASTER", Queue: "RANKED_SOLO_5x5", Entries: []*riot.LeagueItem{
+				{SummonerID: "m", Rank: "I", LeaguePoints: 10}, nil,
+			}}
+		case r.URL.Query().Get("page") == "1":
+			object = []*riot.LeagueItem{{SummonerID: "d", Tier: "DIAMOND", Rank: "I", QueueType: "RANKED_SOLO_5x5"}}
+		default:
+			object = []*riot.LeagueItem{}
+		}
+		body, _ := json.Marshal(object)
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(body))}, nil
+	}}
+}
+
+func TestSnapshotter_Take(t *testing.T) {
+	t.Parallel()
+	client := riot.NewClient(api.RegionKorea, "API_KEY", ladderDoer(), logrus.StandardLogger())
+	var saved []*Snapshot
+	snapshotter := NewSnapshotter(client, riot.QueueRankedSolo, WithDivision(riot.TierDiamond, riot.DivisionOne),
+		WithStore(StoreFunc(func(_ context.Context, snapshot *Snapshot) error {
+			saved = append(saved, snapshot)
+			return nil
+		})))
+	snapshot, diff, err := snapshotter.Take(context.Background())
+	require.Nil(t, err)
+	assert.Nil(t, diff)
+	assert.Equal(t, api.RegionKorea, snapshot.Region)
+	require.Len(t, snapshot.Entries, 4)
+	assert.Equal(t, "CHALLENGER", snapshot.Entries[0].Tier)
+	assert.Equal(t, "RANKED_SOLO_5x5", snapshot.Entries[2].QueueType)
+	assert.Equal(t, "d", snapshot.Entries[3].SummonerID)
+
+	snapshotter.store = StoreFunc(func(context.Context, *Snapshot) error {
+		return errors.New("store failed")
+	})
+	snapshot, diff, err = snapshotter.Take(context.Background())
+	assert.EqualError(t, err, "store failed")
+	require.NotNil(t, snapshot)
+	require.NotNil(t, diff)
+	require.Len(t, diff.Changes, 1)
+	assert.Equal(t, 1, diff.Changes[0].LeaguePoints)
+	assert.Equal(t, []*Snapshot{saved[0]}, saved)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, _, err = snapshotter.Take(ctx)
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestSnapshotter_Watch(t *testing.T) {
+	t.Parallel()
+	client := riot.NewClient(api.RegionKorea, "API_KEY", ladderDoer(), logrus.StandardLogger())
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := NewSnapshotter(client, riot.QueueRankedSolo).Watch(ctx, time.Millisecond)
+	first := <-events
+	require.Nil(t, first.Error)
+	assert.Nil(t, first.Diff)
+	assert.Len(t, first.Snapshot.Entries, 3)
+	second := <-events
+	require.Nil(t, second.Error)
+	require.NotNil(t, second.Diff)
+	assert.Len(t, second.Diff.Changes, 1)
+	cancel()
+	for range events {
+	}
+}
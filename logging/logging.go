@@ -0,0 +1,174 @@
+// Package logging decouples the clients of golio from logrus. Applications pass any Logger, e.g. a *slog.Logger
+// wrapped by Slog, using golio.WithLogging:
+//
+//	client := golio.NewClient("API KEY", golio.WithLogging(logging.Slog(slog.Default())))
+//
+// Discard silences the clients completely.
+package logging
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"sort"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Level is the severity of a log message
+type Level int
+
+// All levels used by golio, from the most verbose to the most severe
+const (
+	LevelDebug Level = iota
+	LevelInfo
+	LevelWarn
+	LevelError
+)
+
+// Logger receives the log messages of golio. Implementations must be safe for concurrent use
+type Logger interface {
+	// Enabled returns whether messages of the level are logged. Messages of disabled levels are never passed to Log
+	Enabled(level Level) bool
+	// Log logs the message with the fields, e.g. the region and the endpoint of a request
+	Log(level Level, msg string, fields map[string]interface{})
+}
+
+type discard struct{}
+
+func (discard) Enabled(Level) bool { return false }
+
+func (discard) Log(Level, string, map[string]interface{}) {}
+
+// Discard returns a logger dropping all messages
+func Discard() Logger {
+	return discard{}
+}
+
+type slogLogger struct {
+	l *slog.Logger
+}
+
+var slogLevels = map[Level]slog.Level{
+	LevelDebug: slog.LevelDebug,
+	LevelInfo:  slog.LevelInfo,
+	LevelWarn:  slog.LevelWarn,
+	LevelError: slog.LevelError,
+}
+
+// Slog returns a logger writing to l. The fields are passed as attributes ordered by their keys
+func Slog(l *slog.Logger) Logger {
+	return slogLogger{l: l}
+}
+
+func (s slogLogger) Enabled(level Level) bool {
+	return s.l.Enabled(context.Background(), slogLevels[level])
+}
+
+func (s slogLogger) Log(level Level, msg string, fields map[string]interface{}) {
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	attrs := make([]slog.Attr, 0, len(keys))
+	for _, key := range keys {
+		attrs = append(attrs, slog.Any(key, fields[key]))
+	}
+	s.l.LogAttrs(context.Background(), slogLevels[level], msg, attrs...)
+}
+
+type logrusLogger struct {
+	l log.FieldLogger
+}
+
+// Logrus returns a logger writing to l
+func Logrus(l log.FieldLogger) Logger {
+	return logrusLogger{l: l}
+}
+
+func (l logrusLogger) Enabled(Level) bool {
+	return true
+}
+
+func (l logrusLogger) Log(level Level, msg string, fields map[string]interface{}) {
+	entry := l.l.WithFields(fields)
+	switch level {
+	case LevelDebug:
+		entry.Debug(msg)
+	case LevelInfo:
+		entry.Info(msg)
+	case LevelWarn:
+		entry.Warn(msg)
+	default:
+		entry.Error(msg)
+	}
+}
+
+// FieldLogger returns a logrus logger passing all messages to l, as used internally by the clients of golio
+func FieldLogger(l Logger) log.FieldLogger {
+	if adapter, ok := l.(logrusLogger); ok {
+		return adapter.l
+	}
+	logger := log.New()
+	logger.Out = io.Discard
+	logger.Formatter = discardFormatter{}
+	logger.Level = log.PanicLevel
+	for _, level := range []Level{LevelError, LevelWarn, LevelInfo, LevelDebug} {
+		if l.Enabled(level) {
+			logger.Level = LogrusLevel(level)
+		}
+	}
+	logger.AddHook(hook{l: l})
+	return logger
+}
+
+// LogrusLevel returns the logrus level of the level
+func LogrusLevel(level Level) log.Level {
+	switch level {
+	case LevelDebug:
+		return log.DebugLevel
+	case LevelInfo:
+		return log.InfoLevel
+	case LevelWarn:
+		return log.WarnLevel
+	}
+	return log.ErrorLevel
+}
+
+// hook passes the entries of a logrus logger to a Logger
+type hook struct {
+	l Logger
+}
+
+func (h hook) Levels() []log.Level {
+	return log.AllLevels
+}
+
+func (h hook) Fire(entry *log.Entry) error {
+	level := LevelError
+	switch entry.Level {
+	case log.TraceLevel, log.DebugLevel:
+		level = LevelDebug
+	case log.InfoLevel:
+		level = LevelInfo
+	case log.WarnLevel:
+		level = LevelWarn
+	}
+	if !h.l.Enabled(level) {
+		return nil
+	}
+	fields := make(map[string]interface{}, len(entry.Data))
+	for key, value := range entry.Data {
+		fields[key] = value
+	}
+	h.l.Log(level, entry.Message, fields)
+	return nil
+}
+
+// discardFormatter skips formatting the entries, which are only passed to the hook
+type discardFormatter struct{}
+
+func (discardFormatter) Format(*log.Entry) ([]byte, error) {
+	return nil, nil
+}
@@ -0,0 +1,49 @@
+package logging
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFieldLogger_slog(t *testing.T) {
+	t.Parallel()
+	buf := &bytes.Buffer{}
+	handler := slog.NewTextHandler(buf, &slog.HandlerOptions{
+		Level: slog.LevelInfo,
+		ReplaceAttr: func(_ []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	})
+	logger := FieldLogger(Slog(slog.New(handler)))
+	logger.WithField("endpoint", "/lol").Debug("dropped")
+	logger.WithFields(log.Fields{"region": "euw1", "endpoint": "/lol"}).Infof("waiting %d seconds", 2)
+	logger.Error("failed")
+	assert.Equal(t, "level=INFO msg=\"waiting 2 seconds\" endpoint=/lol region=euw1\nlevel=ERROR msg=failed\n",
+		buf.String())
+}
+
+func TestFieldLogger_discard(t *testing.T) {
+	t.Parallel()
+	logger := FieldLogger(Discard())
+	assert.Equal(t, log.PanicLevel, logger.(*log.Logger).Level)
+	logger.Error("dropped")
+}
+
+func TestFieldLogger_logrus(t *testing.T) {
+	t.Parallel()
+	logger := log.New()
+	assert.Equal(t, log.FieldLogger(logger), FieldLogger(Logrus(logger)))
+
+	buf := &bytes.Buffer{}
+	logger.Out = buf
+	logger.Formatter = &log.TextFormatter{DisableTimestamp: true}
+	Logrus(logger).Log(LevelWarn, "slow", map[string]interface{}{"region": "kr"})
+	assert.Equal(t, "level=warning msg=slow region=kr\n", buf.String())
+}
@@ -0,0 +1,95 @@
+// Package metrics exports metrics about the requests sent to the Riot API to Prometheus. A Collector is registered
+// on a prometheus.Registerer and added to a client as request observer, e.g.
+//
+//	collector, err := metrics.NewCollector(prometheus.DefaultRegisterer)
+//	if err != nil {
+//		...
+//	}
+//	client := golio.NewClient("API KEY", golio.WithRequestObserver(collector))
+//
+// All metrics are labeled with the endpoint family, e.g. summoner-v4, and the region of the client.
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/mjourard/golio/riot"
+)
+
+const namespace = "golio"
+
+// statusTransportError is the status label of requests which failed without a response
+const statusTransportError = "error"
+
+// Collector collects metrics about the requests of a client, it implements riot.RequestObserver
+type Collector struct {
+	requests      *prometheus.CounterVec
+	errors        *prometheus.CounterVec
+	duration      *prometheus.HistogramVec
+	retries       *prometheus.CounterVec
+	rateLimitWait *prometheus.CounterVec
+}
+
+// NewCollector creates the metrics and registers them on reg. Registering fails if the metrics are already registered
+func NewCollector(reg prometheus.Registerer) (*Collector, error) {
+	c := &Collector{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "requests_total",
+			Help:      "Number of requests sent to the Riot API by status code.",
+		}, []string{"family", "region", "method", "status"}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "request_errors_total",
+			Help:      "Number of requests failed with an error status code or without a response.",
+		}, []string{"family", "region", "status"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "request_duration_seconds",
+			Help:      "Latency of requests sent to the Riot API.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"family", "region"}),
+		retries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "retries_total",
+			Help:      "Number of requests sent again by status code of the failed attempt.",
+		}, []string{"family", "region", "status"}),
+		rateLimitWait: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "rate_limit_wait_seconds_total",
+			Help:      "Time spent waiting for rate limits to reset.",
+		}, []string{"family", "region"}),
+	}
+	for _, collector := range []prometheus.Collector{c.requests, c.errors, c.duration, c.retries, c.rateLimitWait} {
+		if err := reg.Register(collector); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+// ObserveRequest counts the request and records its latency
+func (c *Collector) ObserveRequest(stats riot.RequestStats) {
+	region := string(stats.Region)
+	status := statusTransportError
+	if stats.Err == nil {
+		status = strconv.Itoa(stats.StatusCode)
+	}
+	c.requests.WithLabelValues(stats.Family, region, stats.Method, status).Inc()
+	if stats.Err != nil || stats.StatusCode >= 400 {
+		c.errors.WithLabelValues(stats.Family, region, status).Inc()
+	}
+	c.duration.WithLabelValues(stats.Family, region).Observe(stats.Duration.Seconds())
+}
+
+// ObserveRetry counts the retry and records the time waited for rate limits
+func (c *Collector) ObserveRetry(stats riot.RetryStats) {
+	region := string(stats.Region)
+	c.retries.WithLabelValues(stats.Family, region, strconv.Itoa(stats.StatusCode)).Inc()
+	if stats.StatusCode == http.StatusTooManyRequests {
+		c.rateLimitWait.WithLabelValues(stats.Family, region).Add(stats.Wait.Seconds())
+	}
+}
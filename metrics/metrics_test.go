@@ -0,0 +1,46 @@
+package metrics
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mjourard/golio/api"
+	"github.com/mjourard/golio/riot"
+)
+
+func TestCollector(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	collector, err := NewCollector(reg)
+	require.Nil(t, err)
+	request := func(status int, err error) riot.RequestStats {
+		return riot.RequestStats{Method: http.MethodGet, Family: "summoner-v4", Region: api.RegionKorea,
+			StatusCode: status, Duration: 100 * time.Millisecond, Err: err}
+	}
+	collector.ObserveRequest(request(http.StatusOK, nil))
+	collector.ObserveRequest(request(http.StatusOK, nil))
+	collector.ObserveRequest(request(http.StatusNotFound, nil))
+	collector.ObserveRequest(request(0, errors.New("timeout")))
+	collector.ObserveRetry(riot.RetryStats{Family: "summoner-v4", Region: api.RegionKorea,
+		StatusCode: http.StatusTooManyRequests, Wait: 3 * time.Second})
+	collector.ObserveRetry(riot.RetryStats{Family: "summoner-v4", Region: api.RegionKorea,
+		StatusCode: http.StatusServiceUnavailable, Wait: time.Second})
+
+	assert.Equal(t, 2.0, testutil.ToFloat64(collector.requests.WithLabelValues("summoner-v4", "kr", "GET", "200")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(collector.requests.WithLabelValues("summoner-v4", "kr", "GET", "error")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(collector.errors.WithLabelValues("summoner-v4", "kr", "404")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(collector.errors.WithLabelValues("summoner-v4", "kr", "error")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(collector.retries.WithLabelValues("summoner-v4", "kr", "429")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(collector.retries.WithLabelValues("summoner-v4", "kr", "503")))
+	assert.Equal(t, 3.0, testutil.ToFloat64(collector.rateLimitWait.WithLabelValues("summoner-v4", "kr")))
+	assert.Equal(t, 1, testutil.CollectAndCount(collector.duration))
+
+	_, err = NewCollector(reg)
+	assert.NotNil(t, err)
+}
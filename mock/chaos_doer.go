@@ -0,0 +1,131 @@
+package mock
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/mjourard/golio/internal"
+)
+
+// ErrConnectionDropped is returned by the ChaosDoer instead of a response for dropped requests
+var ErrConnectionDropped = errors.New("chaos: connection dropped")
+
+// htmlErrorPage is returned as body of responses with a wrong content type, like proxies in front of an API do
+const htmlErrorPage = "<html><head><title>502 Bad Gateway</title></head><body><h1>Bad Gateway</h1></body></html>"
+
+// Chaos contains the probabilities of the faults injected by a ChaosDoer. Probabilities are between 0 and 1
+type Chaos struct {
+	// LatencyRate is the probability of delaying a request by a random duration up to MaxLatency
+	LatencyRate float64
+	MaxLatency  time.Duration
+	// DropRate is the probability of failing a request with ErrConnectionDropped
+	DropRate float64
+	// TruncateRate is the probability of cutting off the body of a response at a random position
+	TruncateRate float64
+	// WrongContentTypeRate is the probability of replacing a response with an HTML page
+	WrongContentTypeRate float64
+	// Seed is used to decide which faults are injected, so that a test run can be repeated
+	Seed int64
+}
+
+// ChaosDoer is an implementation of the Doer interface injecting faults into the responses of another Doer for
+// resilience testing. It is safe for concurrent use if the wrapped Doer is
+type ChaosDoer struct {
+	next  internal.Doer
+	chaos Chaos
+	mu    sync.Mutex
+	rand  *rand.Rand
+}
+
+// NewChaosDoer constructs a new ChaosDoer sending requests to next and injecting faults as configured by chaos,
+// e.g. NewChaosDoer(NewJSONMockDoer(object, 200), Chaos{DropRate: 0.1}) fails every tenth request on average
+func NewChaosDoer(next internal.Doer, chaos Chaos) *ChaosDoer {
+	return &ChaosDoer{
+		next:  next,
+		chaos: chaos,
+		rand:  rand.New(rand.NewSource(chaos.Seed)),
+	}
+}
+
+// Do sends the request to the wrapped Doer, injecting faults into the request and its response
+func (d *ChaosDoer) Do(r *http.Request) (*http.Response, error) {
+	if d.chance(d.chaos.LatencyRate) && d.chaos.MaxLatency > 0 {
+		timer := time.NewTimer(time.Duration(d.int63n(int64(d.chaos.MaxLatency))))
+		select {
+		case <-r.Context().Done():
+			timer.Stop()
+			return nil, r.Context().Err()
+		case <-timer.C:
+		}
+	}
+	if d.chance(d.chaos.DropRate) {
+		return nil, ErrConnectionDropped
+	}
+	response, err := d.next.Do(r)
+	if err != nil || response == nil {
+		return response, err
+	}
+	switch {
+	case d.chance(d.chaos.WrongContentTypeRate):
+		closeBody(response)
+		return d.replaceBody(response, "text/html", []byte(htmlErrorPage)), nil
+	case d.chance(d.chaos.TruncateRate):
+		content, err := readBody(response)
+		if err != nil {
+			return nil, err
+		}
+		return d.replaceBody(response, response.Header.Get("Content-Type"), content[:d.int63n(int64(len(content)))]), nil
+	}
+	return response, nil
+}
+
+// replaceBody returns a copy of the response with the given content type and body
+func (d *ChaosDoer) replaceBody(response *http.Response, contentType string, body []byte) *http.Response {
+	res := *response
+	res.Header = response.Header.Clone()
+	if res.Header == nil {
+		res.Header = http.Header{}
+	}
+	res.Header.Set("Content-Type", contentType)
+	res.Body = io.NopCloser(bytes.NewReader(body))
+	res.ContentLength = int64(len(body))
+	return &res
+}
+
+func (d *ChaosDoer) chance(p float64) bool {
+	if p <= 0 {
+		return false
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.rand.Float64() < p
+}
+
+// int63n returns a random number in [0, n) or 0 if n is not positive
+func (d *ChaosDoer) int63n(n int64) int64 {
+	if n <= 0 {
+		return 0
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.rand.Int63n(n)
+}
+
+func readBody(response *http.Response) ([]byte, error) {
+	if response.Body == nil {
+		return nil, nil
+	}
+	defer response.Body.Close()
+	return io.ReadAll(response.Body)
+}
+
+func closeBody(response *http.Response) {
+	if response.Body != nil {
+		_ = response.Body.Close()
+	}
+}
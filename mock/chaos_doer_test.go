@@ -0,0 +1,104 @@
+package mock
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestChaosDoer_Do(t *testing.T) {
+	object := map[string]string{"name": "summoner"}
+	tests := []struct {
+		name            string
+		chaos           Chaos
+		wantErr         error
+		wantContentType string
+		wantValidJSON   bool
+	}{
+		{
+			name:          "no chaos",
+			wantValidJSON: true,
+		},
+		{
+			name:    "drop",
+			chaos:   Chaos{DropRate: 1},
+			wantErr: ErrConnectionDropped,
+		},
+		{
+			name:            "wrong content type",
+			chaos:           Chaos{WrongContentTypeRate: 1},
+			wantContentType: "text/html",
+		},
+		{
+			name:  "truncate",
+			chaos: Chaos{TruncateRate: 1},
+		},
+		{
+			name:          "latency",
+			chaos:         Chaos{LatencyRate: 1, MaxLatency: time.Millisecond},
+			wantValidJSON: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewChaosDoer(NewJSONMockDoer(object, http.StatusOK), tt.chaos)
+			request, _ := http.NewRequest(http.MethodGet, "https://example.com", nil)
+			response, err := d.Do(request)
+			if err != tt.wantErr {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got := response.Header.Get("Content-Type"); tt.wantContentType != "" && got != tt.wantContentType {
+				t.Errorf("got content type %q, want %q", got, tt.wantContentType)
+			}
+			body, err := io.ReadAll(response.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got map[string]string
+			if valid := json.Unmarshal(body, &got) == nil; valid != tt.wantValidJSON {
+				t.Errorf("got valid json %v for %q, want %v", valid, body, tt.wantValidJSON)
+			}
+		})
+	}
+}
+
+func TestChaosDoer_Seed(t *testing.T) {
+	failures := func(seed int64) []bool {
+		d := NewChaosDoer(NewStatusMockDoer(http.StatusOK), Chaos{DropRate: 0.5, Seed: seed})
+		var res []bool
+		for i := 0; i < 50; i++ {
+			request, _ := http.NewRequest(http.MethodGet, "https://example.com", nil)
+			_, err := d.Do(request)
+			res = append(res, err != nil)
+		}
+		return res
+	}
+	a, b, other := failures(1), failures(1), failures(2)
+	same, differ := true, false
+	for i := range a {
+		same = same && a[i] == b[i]
+		differ = differ || a[i] != other[i]
+	}
+	if !same {
+		t.Error("same seed injected different faults")
+	}
+	if !differ {
+		t.Error("different seeds injected the same faults")
+	}
+}
+
+func TestChaosDoer_Cancel(t *testing.T) {
+	d := NewChaosDoer(NewStatusMockDoer(http.StatusOK), Chaos{LatencyRate: 1, MaxLatency: time.Hour})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	request, _ := http.NewRequestWithContext(ctx, http.MethodGet, "https://example.com", nil)
+	if _, err := d.Do(request); err != context.Canceled {
+		t.Errorf("got error %v, want %v", err, context.Canceled)
+	}
+}
@@ -0,0 +1,6 @@
+// Package mock includes mock constructs used for testing the API.
+// The Doers can be passed to golio.WithClient to test code using golio without network access, including its
+// handling of rate limits, unavailable services and faults like dropped connections or malformed responses.
+// Doer, SequenceDoer and Scenario record all requests, so tests can assert which endpoints were requested with which
+// parameters using the methods of Expectations.
+package mock
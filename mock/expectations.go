@@ -0,0 +1,234 @@
+package mock
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// Request is a request received by a mock Doer
+type Request struct {
+	Method string
+	// URL of the request, nil if the request had none
+	URL    *url.URL
+	Header http.Header
+	// Body of the request, nil for requests without a body
+	Body []byte
+}
+
+// Expectations records the requests received by a mock Doer and provides assertions on them, e.g.
+//
+//	doer := mock.NewJSONMockDoer(summoner, 200)
+//	client := golio.NewClient("API_KEY", golio.WithClient(doer))
+//	...
+//	doer.AssertCalled(t, http.MethodGet, "/lol/summoner/v4/summoners/by-name/*")
+//	doer.AssertHeader(t, "X-Riot-Token", "API_KEY")
+//
+// Targets have the format described at Scenario.Expect. The zero value is ready to use and it is safe for
+// concurrent use
+type Expectations struct {
+	mu       sync.Mutex
+	recorded []Request
+}
+
+// record adds the request, replacing its body with an unread copy of it
+func (e *Expectations) record(r *http.Request) {
+	recorded := Request{Method: r.Method, Header: r.Header.Clone()}
+	if r.URL != nil {
+		u := *r.URL
+		recorded.URL = &u
+	}
+	if r.Body != nil && r.Body != http.NoBody {
+		// read errors are passed on to the Doer through the remaining body
+		body, err := io.ReadAll(r.Body)
+		_ = r.Body.Close()
+		recorded.Body = body
+		r.Body = io.NopCloser(io.MultiReader(bytes.NewReader(body), errReader{err}))
+	}
+	e.mu.Lock()
+	e.recorded = append(e.recorded, recorded)
+	e.mu.Unlock()
+}
+
+// Calls returns the number of requests received so far
+func (e *Expectations) Calls() int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return len(e.recorded)
+}
+
+// CallsTo returns the number of requests received so far with the given method and matching the given target
+func (e *Expectations) CallsTo(method, target string) int {
+	t := parseTarget(target)
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	calls := 0
+	for _, r := range e.recorded {
+		if r.Method == method && r.URL != nil && t.matches(r.URL) {
+			calls++
+		}
+	}
+	return calls
+}
+
+// Recorded returns all requests received so far in the order they were received
+func (e *Expectations) Recorded() []Request {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return append([]Request(nil), e.recorded...)
+}
+
+// LastRequest returns the last request received or nil if no request was received
+func (e *Expectations) LastRequest() *Request {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if len(e.recorded) == 0 {
+		return nil
+	}
+	last := e.recorded[len(e.recorded)-1]
+	return &last
+}
+
+// LastURL returns the URL of the last request or an empty string if no request was received
+func (e *Expectations) LastURL() string {
+	last := e.LastRequest()
+	if last == nil || last.URL == nil {
+		return ""
+	}
+	return last.URL.String()
+}
+
+// LastBody returns the body of the last request or nil if no request was received
+func (e *Expectations) LastBody() []byte {
+	last := e.LastRequest()
+	if last == nil {
+		return nil
+	}
+	return last.Body
+}
+
+// Reset removes all recorded requests
+func (e *Expectations) Reset() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.recorded = nil
+}
+
+// AssertCalls reports to t if the number of requests received is not want
+func (e *Expectations) AssertCalls(t TestingT, want int) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	if got := e.Calls(); got != want {
+		t.Errorf("got %d requests, want %d:\n%s", got, want, e.describe())
+		return false
+	}
+	return true
+}
+
+// AssertCalled reports to t if no request with the given method and matching the given target was received
+func (e *Expectations) AssertCalled(t TestingT, method, target string) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	if e.CallsTo(method, target) == 0 {
+		t.Errorf("no request %s %s, got:\n%s", method, target, e.describe())
+		return false
+	}
+	return true
+}
+
+// AssertNotCalled reports to t if a request with the given method and matching the given target was received
+func (e *Expectations) AssertNotCalled(t TestingT, method, target string) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	if calls := e.CallsTo(method, target); calls > 0 {
+		t.Errorf("got %d unexpected requests %s %s", calls, method, target)
+		return false
+	}
+	return true
+}
+
+// AssertHeader reports to t if the last request was not received with the given header value
+func (e *Expectations) AssertHeader(t TestingT, key, want string) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	last := e.LastRequest()
+	if last == nil {
+		t.Errorf("no request received, want header %s: %s", key, want)
+		return false
+	}
+	if got := last.Header.Get(key); got != want {
+		t.Errorf("got header %s: %q, want %q", key, got, want)
+		return false
+	}
+	return true
+}
+
+// AssertJSONBody reports to t if the body of the last request is not the json representation of want
+func (e *Expectations) AssertJSONBody(t TestingT, want interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	last := e.LastRequest()
+	if last == nil {
+		t.Errorf("no request received, want a json body")
+		return false
+	}
+	wantBody, err := json.Marshal(want)
+	if err != nil {
+		t.Errorf("encoding wanted body: %v", err)
+		return false
+	}
+	var got, expected interface{}
+	if err := json.Unmarshal(last.Body, &got); err != nil {
+		t.Errorf("decoding body %q: %v", last.Body, err)
+		return false
+	}
+	_ = json.Unmarshal(wantBody, &expected)
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got body %s, want %s", last.Body, wantBody)
+		return false
+	}
+	return true
+}
+
+// describe lists the recorded requests for failure messages
+func (e *Expectations) describe() string {
+	var lines []string
+	for _, r := range e.Recorded() {
+		target := ""
+		if r.URL != nil {
+			target = r.URL.RequestURI()
+		}
+		lines = append(lines, "\t"+r.Method+" "+target)
+	}
+	if len(lines) == 0 {
+		return "\tnone"
+	}
+	return strings.Join(lines, "\n")
+}
+
+// tHelper is implemented by testing.T to mark helper functions
+type tHelper interface {
+	Helper()
+}
+
+// errReader returns err once all of the body was read, or io.EOF if err is nil
+type errReader struct {
+	err error
+}
+
+func (r errReader) Read([]byte) (int, error) {
+	if r.err == nil {
+		return 0, io.EOF
+	}
+	return 0, r.err
+}
@@ -0,0 +1,140 @@
+package mock
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func newRequest(t *testing.T, method, target, body string) *http.Request {
+	t.Helper()
+	var r io.Reader
+	if body != "" {
+		r = strings.NewReader(body)
+	}
+	request, err := http.NewRequest(method, "https://euw1.api.riotgames.com"+target, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	request.Header.Set("X-Riot-Token", "key")
+	return request
+}
+
+func TestExpectations(t *testing.T) {
+	doer := NewStatusMockDoer(http.StatusOK)
+	if doer.LastRequest() != nil || doer.LastURL() != "" || doer.LastBody() != nil {
+		t.Errorf("got a last request before any request")
+	}
+	requests := []*http.Request{
+		newRequest(t, http.MethodGet, "/lol/summoner/v4/summoners/by-name/name", ""),
+		newRequest(t, http.MethodGet, "/lol/match/v4/matchlists/by-account/id?beginIndex=0&endIndex=100", ""),
+		newRequest(t, http.MethodPost, "/lol/tournament-stub/v4/providers", `{"region":"EUW","url":"url"}`),
+	}
+	for _, request := range requests {
+		if _, err := doer.Do(request); err != nil {
+			t.Fatal(err)
+		}
+	}
+	body, _ := io.ReadAll(requests[2].Body)
+	if string(body) != `{"region":"EUW","url":"url"}` {
+		t.Errorf("got body %q after recording, want the original body", body)
+	}
+
+	tests := []struct {
+		method string
+		target string
+		want   int
+	}{
+		{http.MethodGet, "/lol/summoner/v4/summoners/by-name/name", 1},
+		{http.MethodGet, "/lol/summoner/v4/*", 1},
+		{http.MethodGet, "/lol/*", 2},
+		{http.MethodGet, "/lol/match/v4/matchlists/by-account/id?beginIndex=0", 1},
+		{http.MethodGet, "/lol/match/v4/matchlists/by-account/id?beginIndex=100", 0},
+		{http.MethodPost, "/lol/summoner/v4/summoners/by-name/name", 0},
+	}
+	for _, tt := range tests {
+		if got := doer.CallsTo(tt.method, tt.target); got != tt.want {
+			t.Errorf("%s %s: got %d calls, want %d", tt.method, tt.target, got, tt.want)
+		}
+	}
+	if got := doer.LastURL(); got != "https://euw1.api.riotgames.com/lol/tournament-stub/v4/providers" {
+		t.Errorf("got last URL %s", got)
+	}
+	if got := string(doer.LastBody()); got != `{"region":"EUW","url":"url"}` {
+		t.Errorf("got last body %s", got)
+	}
+
+	r := &recorder{}
+	ok := doer.AssertCalls(r, 3) &&
+		doer.AssertCalled(r, http.MethodPost, "/lol/tournament-stub/*") &&
+		doer.AssertNotCalled(r, http.MethodGet, "/lol/league/*") &&
+		doer.AssertHeader(r, "X-Riot-Token", "key") &&
+		doer.AssertJSONBody(r, map[string]string{"url": "url", "region": "EUW"})
+	if !ok || len(r.errors) > 0 {
+		t.Errorf("got failed assertions %v", r.errors)
+	}
+
+	r = &recorder{}
+	doer.AssertCalls(r, 2)
+	doer.AssertCalled(r, http.MethodGet, "/lol/league/*")
+	doer.AssertNotCalled(r, http.MethodGet, "/lol/*")
+	doer.AssertHeader(r, "X-Riot-Token", "other")
+	doer.AssertJSONBody(r, map[string]string{"url": "url"})
+	if len(r.errors) != 5 {
+		t.Errorf("got %d failed assertions, want 5: %v", len(r.errors), r.errors)
+	}
+
+	doer.Reset()
+	if doer.Calls() != 0 {
+		t.Errorf("got %d calls after reset, want 0", doer.Calls())
+	}
+	r = &recorder{}
+	doer.AssertHeader(r, "X-Riot-Token", "key")
+	doer.AssertJSONBody(r, nil)
+	if len(r.errors) != 2 {
+		t.Errorf("got %d failed assertions without requests, want 2", len(r.errors))
+	}
+}
+
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) {
+	return 0, errors.New("read error")
+}
+
+func TestExpectations_BodyError(t *testing.T) {
+	doer := NewSequenceDoer()
+	request := newRequest(t, http.MethodPost, "/codes", "")
+	request.Body = io.NopCloser(io.MultiReader(strings.NewReader("partial"), failingReader{}))
+	if _, err := doer.Do(request); err != nil {
+		t.Fatal(err)
+	}
+	body, err := io.ReadAll(request.Body)
+	if string(body) != "partial" || err == nil {
+		t.Errorf("got %q, %v, want the partial body and the read error", body, err)
+	}
+	if got := string(doer.LastBody()); got != "partial" {
+		t.Errorf("got recorded body %q, want partial", got)
+	}
+}
+
+func TestExpectations_Doers(t *testing.T) {
+	scenario := NewScenario().Expect(http.MethodGet, "/status").RespondStatus(http.StatusOK)
+	for name, doer := range map[string]interface {
+		Do(*http.Request) (*http.Response, error)
+		Calls() int
+	}{
+		"doer":     NewStatusMockDoer(http.StatusOK),
+		"custom":   &Doer{Custom: func(*http.Request) (*http.Response, error) { return nil, nil }},
+		"sequence": NewSequenceDoer(),
+		"scenario": scenario,
+	} {
+		_, _ = doer.Do(newRequest(t, http.MethodGet, "/status", ""))
+		_, _ = doer.Do(newRequest(t, http.MethodGet, "/other", ""))
+		if got := doer.Calls(); got != 2 {
+			t.Errorf("%s: got %d calls, want 2", name, got)
+		}
+	}
+}
@@ -8,8 +8,9 @@ import (
 )
 
 // Doer is an implementation of the Doer interface for testing purposes which always returns the set response
-// attribute
+// attribute. Requests are recorded for assertions
 type Doer struct {
+	Expectations
 	Response     http.Response
 	ResponseBody *ResponseBody
 	ResponseTime time.Duration
@@ -54,6 +55,7 @@ func NewHeaderMockDoer(code int, headers http.Header) *Doer {
 // Do returns the set HTTP response attribute after waiting for the specified amount of time
 // if a custom function is specified it is used instead
 func (d *Doer) Do(r *http.Request) (*http.Response, error) {
+	d.record(r)
 	if d.Custom != nil {
 		return d.Custom(r)
 	}
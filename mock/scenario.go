@@ -0,0 +1,195 @@
+package mock
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// ErrUnexpectedRequest is returned by a Scenario for requests not matching the next expectation
+var ErrUnexpectedRequest = errors.New("unexpected request")
+
+// TestingT is the part of testing.T used to report failed verifications
+type TestingT interface {
+	Errorf(format string, args ...interface{})
+}
+
+// Scenario is an implementation of the Doer interface answering a scripted sequence of expected requests, e.g.
+//
+//	scenario := mock.NewScenario().
+//		Expect(http.MethodGet, "/lol/summoner/v4/summoners/by-name/name").RespondJSON(200, summoner).
+//		Expect(http.MethodGet, "/lol/league/v4/entries/by-summoner/*").Respond(mock.RateLimited(1, 1)).
+//		Expect(http.MethodGet, "/lol/league/v4/entries/by-summoner/*").RespondJSON(200, entries)
+//	client := golio.NewClient("API_KEY", golio.WithClient(scenario))
+//	...
+//	scenario.Verify(t)
+//
+// Expectations are met in order. Requests not matching the next expectation fail with ErrUnexpectedRequest.
+// All requests are recorded, including unexpected ones. It is safe for concurrent use
+type Scenario struct {
+	Expectations
+	mu           sync.Mutex
+	expectations []*Expectation
+	next         int
+	unexpected   []string
+}
+
+// Expectation is an expected request of a Scenario
+type Expectation struct {
+	scenario *Scenario
+	method   string
+	target   target
+	step     Step
+	calls    int
+}
+
+// target is a path, optionally matching all paths with it as prefix, and query parameters which need to be part of
+// matching requests
+type target struct {
+	path   string
+	prefix bool
+	query  url.Values
+}
+
+// NewScenario constructs a new Scenario without expectations
+func NewScenario() *Scenario {
+	return &Scenario{}
+}
+
+// Expect adds an expectation for a request with the given method and target. The target is the path of the request,
+// optionally followed by query parameters which need to be part of the request. A path ending with * matches all
+// paths starting with the part before it
+func (s *Scenario) Expect(method, target string) *Expectation {
+	e := &Expectation{scenario: s, method: method, target: parseTarget(target)}
+	s.mu.Lock()
+	s.expectations = append(s.expectations, e)
+	s.mu.Unlock()
+	return e
+}
+
+// Respond answers the expected request with the given step. The expectation is met by step.Times matching requests,
+// e.g. Respond(RateLimited(2, 1)) answers two requests with 429
+func (e *Expectation) Respond(step Step) *Scenario {
+	e.step = step
+	return e.scenario
+}
+
+// RespondJSON answers the expected request with the json representation of object and the given status code
+// CAUTION: silently uses an empty body if object fails json marshaling
+func (e *Expectation) RespondJSON(code int, object interface{}) *Scenario {
+	step := OK(object)
+	step.StatusCode = code
+	return e.Respond(step)
+}
+
+// RespondStatus answers the expected request with an empty body and the given status code
+func (e *Expectation) RespondStatus(code int) *Scenario {
+	return e.Respond(Status(code, 1))
+}
+
+func (e *Expectation) String() string {
+	return e.method + " " + e.target.String()
+}
+
+func (e *Expectation) times() int {
+	if e.step.Times < 1 {
+		return 1
+	}
+	return e.step.Times
+}
+
+func (e *Expectation) matches(r *http.Request) bool {
+	return r.Method == e.method && e.target.matches(r.URL)
+}
+
+// parseTarget parses a target in the format described at Scenario.Expect
+func parseTarget(s string) target {
+	t := target{path: s}
+	if i := strings.Index(s, "?"); i >= 0 {
+		t.path = s[:i]
+		// invalid queries are kept as far as they can be parsed
+		t.query, _ = url.ParseQuery(s[i+1:])
+	}
+	if strings.HasSuffix(t.path, "*") {
+		t.path = strings.TrimSuffix(t.path, "*")
+		t.prefix = true
+	}
+	return t
+}
+
+func (t target) String() string {
+	s := t.path
+	if t.prefix {
+		s += "*"
+	}
+	if len(t.query) > 0 {
+		s += "?" + t.query.Encode()
+	}
+	return s
+}
+
+func (t target) matches(u *url.URL) bool {
+	if t.prefix && !strings.HasPrefix(u.Path, t.path) || !t.prefix && u.Path != t.path {
+		return false
+	}
+	query := u.Query()
+	for key, values := range t.query {
+		if strings.Join(query[key], ",") != strings.Join(values, ",") {
+			return false
+		}
+	}
+	return true
+}
+
+// Do answers the request with the response of the next expectation if the request matches it
+func (s *Scenario) Do(r *http.Request) (*http.Response, error) {
+	s.record(r)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	request := r.Method + " " + r.URL.RequestURI()
+	if s.next >= len(s.expectations) {
+		s.unexpected = append(s.unexpected, request+" after all expectations were met")
+		return nil, fmt.Errorf("%w: %s", ErrUnexpectedRequest, request)
+	}
+	e := s.expectations[s.next]
+	if !e.matches(r) {
+		s.unexpected = append(s.unexpected, fmt.Sprintf("%s instead of %s", request, e))
+		return nil, fmt.Errorf("%w: %s, expected %s", ErrUnexpectedRequest, request, e)
+	}
+	e.calls++
+	if e.calls >= e.times() {
+		s.next++
+	}
+	return e.step.response()
+}
+
+// Err returns an error describing all unexpected requests and unmet expectations or nil if the scenario was
+// completed as expected
+func (s *Scenario) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var problems []string
+	for _, request := range s.unexpected {
+		problems = append(problems, "unexpected request "+request)
+	}
+	for _, e := range s.expectations[s.next:] {
+		problems = append(problems, fmt.Sprintf("unmet expectation %s (%d of %d requests)", e, e.calls, e.times()))
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+	return errors.New(strings.Join(problems, "\n"))
+}
+
+// Verify reports all unexpected requests and unmet expectations to t
+func (s *Scenario) Verify(t TestingT) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	if err := s.Err(); err != nil {
+		t.Errorf("scenario not completed:\n%s", err)
+	}
+}
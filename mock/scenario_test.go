@@ -0,0 +1,95 @@
+package mock
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+type recorder struct {
+	errors []string
+}
+
+func (r *recorder) Errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func do(t *testing.T, d *Scenario, method, target string) (*http.Response, error) {
+	t.Helper()
+	request, err := http.NewRequest(method, "https://euw1.api.riotgames.com"+target, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d.Do(request)
+}
+
+func TestScenario_Do(t *testing.T) {
+	scenario := NewScenario().
+		Expect(http.MethodGet, "/summoner/name").RespondJSON(http.StatusOK, "summoner").
+		Expect(http.MethodGet, "/league/*").Respond(RateLimited(2, 1)).
+		Expect(http.MethodGet, "/matches?beginIndex=0").RespondStatus(http.StatusNotFound)
+
+	response, err := do(t, scenario, http.MethodGet, "/summoner/name")
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := io.ReadAll(response.Body)
+	if response.StatusCode != http.StatusOK || string(body) != `"summoner"` {
+		t.Errorf("got %d %s, want 200 \"summoner\"", response.StatusCode, body)
+	}
+	for i := 0; i < 2; i++ {
+		response, err = do(t, scenario, http.MethodGet, "/league/entries/id")
+		if err != nil || response.StatusCode != http.StatusTooManyRequests {
+			t.Fatalf("got %v %v, want 429", response, err)
+		}
+	}
+	if _, err := do(t, scenario, http.MethodGet, "/matches?beginIndex=100"); !errors.Is(err, ErrUnexpectedRequest) {
+		t.Errorf("got error %v, want %v", err, ErrUnexpectedRequest)
+	}
+	response, err = do(t, scenario, http.MethodGet, "/matches?endIndex=100&beginIndex=0")
+	if err != nil || response.StatusCode != http.StatusNotFound {
+		t.Fatalf("got %v %v, want 404", response, err)
+	}
+	if _, err := do(t, scenario, http.MethodGet, "/status"); !errors.Is(err, ErrUnexpectedRequest) {
+		t.Errorf("got error %v, want %v", err, ErrUnexpectedRequest)
+	}
+
+	r := &recorder{}
+	scenario.Verify(r)
+	if len(r.errors) != 1 {
+		t.Fatalf("got %d verification errors, want 1", len(r.errors))
+	}
+	for _, want := range []string{"GET /matches?beginIndex=100 instead of GET /matches?beginIndex=0",
+		"GET /status after all expectations were met"} {
+		if !strings.Contains(r.errors[0], want) {
+			t.Errorf("verification error %q does not contain %q", r.errors[0], want)
+		}
+	}
+}
+
+func TestScenario_Verify(t *testing.T) {
+	scenario := NewScenario().
+		Expect(http.MethodGet, "/summoner/name").RespondStatus(http.StatusOK).
+		Expect(http.MethodPost, "/codes").Respond(Unavailable(2))
+	if _, err := do(t, scenario, http.MethodGet, "/summoner/name"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := do(t, scenario, http.MethodPost, "/codes"); err != nil {
+		t.Fatal(err)
+	}
+	err := scenario.Err()
+	if err == nil || err.Error() != "unmet expectation POST /codes (1 of 2 requests)" {
+		t.Errorf("got error %v", err)
+	}
+	if _, err := do(t, scenario, http.MethodPost, "/codes"); err != nil {
+		t.Fatal(err)
+	}
+	r := &recorder{}
+	scenario.Verify(r)
+	if len(r.errors) != 0 {
+		t.Errorf("got verification errors %v", r.errors)
+	}
+}
@@ -0,0 +1,132 @@
+package mock
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+)
+
+// Step is a response returned by a SequenceDoer
+type Step struct {
+	// StatusCode of the response
+	StatusCode int
+	// Header of the response
+	Header http.Header
+	// Body of the response, nil for an empty body
+	Body []byte
+	// Err is returned instead of a response if set
+	Err error
+	// Times is the number of consecutive requests answered with this step, values below 1 are treated as 1
+	Times int
+}
+
+// OK returns a step answering with the json representation of object and status code 200
+// CAUTION: silently uses an empty body if object fails json marshaling
+func OK(object interface{}) Step {
+	body, _ := json.Marshal(object)
+	return Step{
+		StatusCode: http.StatusOK,
+		Body:       body,
+	}
+}
+
+// RateLimited returns a step answering times requests with 429 and the given Retry-After header in seconds
+func RateLimited(times, retryAfter int) Step {
+	return Step{
+		StatusCode: http.StatusTooManyRequests,
+		Header: http.Header{
+			"Retry-After": []string{strconv.Itoa(retryAfter)},
+		},
+		Times: times,
+	}
+}
+
+// Unavailable returns a step answering times requests with 503
+func Unavailable(times int) Step {
+	return Status(http.StatusServiceUnavailable, times)
+}
+
+// Status returns a step answering times requests with an empty body and the given status code
+func Status(code, times int) Step {
+	return Step{
+		StatusCode: code,
+		Times:      times,
+	}
+}
+
+// response returns a new response as described by the step
+func (s Step) response() (*http.Response, error) {
+	if s.Err != nil {
+		return nil, s.Err
+	}
+	return &http.Response{
+		StatusCode: s.StatusCode,
+		Header:     s.Header.Clone(),
+		Body:       io.NopCloser(bytes.NewReader(s.Body)),
+	}, nil
+}
+
+// SequenceDoer is an implementation of the Doer interface answering requests with a sequence of steps. Once all steps
+// are used, the last step is repeated. Requests are recorded for assertions. It is safe for concurrent use
+type SequenceDoer struct {
+	Expectations
+	mu       sync.Mutex
+	steps    []Step
+	requests []*http.Request
+}
+
+// NewSequenceDoer constructs a new SequenceDoer answering with the given steps in order,
+// e.g. NewSequenceDoer(RateLimited(2, 1), OK(object)) answers two requests with 429 and all further ones with object
+func NewSequenceDoer(steps ...Step) *SequenceDoer {
+	return &SequenceDoer{steps: steps}
+}
+
+// NewRateLimitDoer constructs a new SequenceDoer answering times requests with 429 and the given Retry-After header
+// in seconds and all further requests with the json representation of object
+func NewRateLimitDoer(object interface{}, times, retryAfter int) *SequenceDoer {
+	return NewSequenceDoer(RateLimited(times, retryAfter), OK(object))
+}
+
+// NewUnavailableDoer constructs a new SequenceDoer answering times requests with 503 and all further requests with
+// the json representation of object
+func NewUnavailableDoer(object interface{}, times int) *SequenceDoer {
+	return NewSequenceDoer(Unavailable(times), OK(object))
+}
+
+// Do returns the response of the current step
+func (d *SequenceDoer) Do(r *http.Request) (*http.Response, error) {
+	d.record(r)
+	d.mu.Lock()
+	step := d.step(len(d.requests))
+	d.requests = append(d.requests, r)
+	d.mu.Unlock()
+	return step.response()
+}
+
+// Requests returns all requests received so far
+func (d *SequenceDoer) Requests() []*http.Request {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return append([]*http.Request(nil), d.requests...)
+}
+
+// step returns the step answering the n-th request, starting at 0
+func (d *SequenceDoer) step(n int) Step {
+	if len(d.steps) == 0 {
+		return Step{StatusCode: http.StatusOK}
+	}
+	for _, step := range d.steps {
+		times := step.Times
+		if times < 1 {
+			times = 1
+		}
+		if n < times {
+			return step
+		}
+		n -= times
+	}
+	return d.steps[len(d.steps)-1]
+}
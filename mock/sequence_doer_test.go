@@ -0,0 +1,81 @@
+package mock
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestSequenceDoer_Do(t *testing.T) {
+	errRequest := fmt.Errorf("error")
+	tests := []struct {
+		name       string
+		doer       *SequenceDoer
+		wantCodes  []int
+		wantErrors []error
+	}{
+		{
+			name:      "rate limit",
+			doer:      NewRateLimitDoer("object", 2, 3),
+			wantCodes: []int{http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusOK, http.StatusOK},
+		},
+		{
+			name:      "unavailable",
+			doer:      NewUnavailableDoer("object", 1),
+			wantCodes: []int{http.StatusServiceUnavailable, http.StatusOK},
+		},
+		{
+			name:       "sequence",
+			doer:       NewSequenceDoer(Status(http.StatusNotFound, 0), Step{Err: errRequest}, Unavailable(1)),
+			wantCodes:  []int{http.StatusNotFound, 0, http.StatusServiceUnavailable, http.StatusServiceUnavailable},
+			wantErrors: []error{nil, errRequest, nil, nil},
+		},
+		{
+			name:      "empty",
+			doer:      NewSequenceDoer(),
+			wantCodes: []int{http.StatusOK},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i, want := range tt.wantCodes {
+				response, err := tt.doer.Do(&http.Request{})
+				var wantErr error
+				if tt.wantErrors != nil {
+					wantErr = tt.wantErrors[i]
+				}
+				if err != wantErr {
+					t.Fatalf("request %d: got error %v, want %v", i, err, wantErr)
+				}
+				if err != nil {
+					continue
+				}
+				if response.StatusCode != want {
+					t.Errorf("request %d: got status %d, want %d", i, response.StatusCode, want)
+				}
+			}
+			if got := len(tt.doer.Requests()); got != len(tt.wantCodes) {
+				t.Errorf("got %d requests, want %d", got, len(tt.wantCodes))
+			}
+		})
+	}
+}
+
+func TestSequenceDoer_Response(t *testing.T) {
+	doer := NewRateLimitDoer(map[string]int{"id": 1}, 1, 5)
+	response, _ := doer.Do(&http.Request{})
+	if got := response.Header.Get("Retry-After"); got != "5" {
+		t.Errorf("got Retry-After %q, want 5", got)
+	}
+	for i := 0; i < 2; i++ {
+		response, _ = doer.Do(&http.Request{})
+		body, err := io.ReadAll(response.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(body) != `{"id":1}` {
+			t.Errorf("got body %s", body)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package mockserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/mjourard/golio/internal/fakeriot"
+)
+
+// DataDragonVersions are the Data Dragon versions with fixtures, starting with the latest version.
+// Version 7.23.1 only contains the legacy runes and masteries
+var DataDragonVersions = []string{"10.6.1", "10.5.1", "7.23.1"}
+
+var (
+	dataDragonRealm    = regexp.MustCompile(`^/realms/([a-z]+)\.json$`)
+	dataDragonData     = regexp.MustCompile(`^/cdn/([\d.]+)/data/[a-z]{2}_[A-Z]{2}/([\w/]+\.json)$`)
+	communityDragonRaw = regexp.MustCompile(
+		`^/([\w.]+)/plugins/rcp-be-lol-game-data/global/default/v1/([\w-]+\.json)$`)
+)
+
+// WithDataDragonVersion sets the latest Data Dragon version announced by the realms and used for the latest Community
+// Dragon patch. The version needs to be one of DataDragonVersions. Defaults to the first one
+func WithDataDragonVersion(version string) Option {
+	return func(s *Server) {
+		s.dataDragonVersion = version
+	}
+}
+
+// isStaticData returns whether the path is a request to Data Dragon or Community Dragon, which need no API key
+func isStaticData(path string) bool {
+	return path == "/api/versions.json" || dataDragonRealm.MatchString(path) || dataDragonData.MatchString(path) ||
+		communityDragonRaw.MatchString(path)
+}
+
+// staticData returns the content for the given Data Dragon or Community Dragon request or false if there is none
+func (s *Server) staticData(path string) ([]byte, bool) {
+	if path == "/api/versions.json" {
+		return mustMarshal(DataDragonVersions), true
+	}
+	if params := dataDragonRealm.FindStringSubmatch(path); params != nil {
+		return mustMarshal(map[string]interface{}{
+			"n": map[string]string{
+				"item": s.dataDragonVersion, "rune": s.dataDragonVersion, "mastery": s.dataDragonVersion,
+				"summoner": s.dataDragonVersion, "champion": s.dataDragonVersion,
+				"profileicon": s.dataDragonVersion, "map": s.dataDragonVersion, "language": s.dataDragonVersion,
+			},
+			"v":              s.dataDragonVersion,
+			"l":              "en_US",
+			"cdn":            "https://ddragon.leagueoflegends.com/cdn",
+			"dd":             s.dataDragonVersion,
+			"lg":             s.dataDragonVersion,
+			"css":            s.dataDragonVersion,
+			"profileiconmax": 28,
+			"store":          nil,
+		}), true
+	}
+	if params := dataDragonData.FindStringSubmatch(path); params != nil {
+		return readFixture("ddragon/" + params[1] + "/" + params[2])
+	}
+	if params := communityDragonRaw.FindStringSubmatch(path); params != nil {
+		patch := params[1]
+		if patch == "latest" || patch == "pbe" {
+			patch = s.dataDragonVersion
+		}
+		// Community Dragon uses the major and minor version only
+		if parts := strings.Split(patch, "."); len(parts) > 2 {
+			patch = strings.Join(parts[:2], ".")
+		}
+		return readFixture("cdragon/" + patch + "/" + params[2])
+	}
+	return nil, false
+}
+
+func (s *Server) serveStaticData(w http.ResponseWriter, r *http.Request) {
+	content, found := s.staticData(r.URL.Path)
+	if !found {
+		fakeriot.WriteError(w, http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json;charset=utf-8")
+	_, _ = w.Write(content)
+}
+
+func readFixture(name string) ([]byte, bool) {
+	content, err := fixtures.ReadFile("fixtures/" + name)
+	return content, err == nil
+}
+
+func mustMarshal(v interface{}) []byte {
+	content, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return content
+}
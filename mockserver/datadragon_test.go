@@ -0,0 +1,120 @@
+package mockserver
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mjourard/golio/api"
+	"github.com/mjourard/golio/datadragon"
+)
+
+func TestServer_DataDragon(t *testing.T) {
+	t.Parallel()
+	s := New()
+	defer s.Close()
+	client := datadragon.NewClient(s.Client(), api.RegionEuropeWest, logrus.StandardLogger())
+	assert.Equal(t, DataDragonVersions[0], client.Version)
+
+	champions, err := client.GetChampions()
+	require.Nil(t, err)
+	assert.Len(t, champions, 5)
+	champion, err := client.GetChampion("Jinx")
+	require.Nil(t, err)
+	assert.Equal(t, "Jinx", champion.Name)
+	assert.NotEmpty(t, champion.Lore)
+	assert.Len(t, champion.Spells, 4)
+	item, err := client.GetItem("3031")
+	require.Nil(t, err)
+	assert.Equal(t, "Infinity Edge", item.Name)
+	assert.Equal(t, 3400, item.Gold.Total)
+	spells, err := client.GetSummonerSpells()
+	require.Nil(t, err)
+	assert.NotEmpty(t, spells)
+	icon, err := client.GetProfileIcon(29)
+	require.Nil(t, err)
+	assert.Equal(t, "29.png", icon.Image.Full)
+	runes, err := client.GetRunes()
+	require.Nil(t, err)
+	assert.NotEmpty(t, runes)
+	masteries, err := client.GetMasteries()
+	require.Nil(t, err)
+	assert.NotEmpty(t, masteries)
+}
+
+func TestServer_DataDragonVersion(t *testing.T) {
+	t.Parallel()
+	s := New(WithDataDragonVersion("10.5.1"))
+	defer s.Close()
+	client := datadragon.NewClient(s.Client(), api.RegionNorthAmerica, logrus.StandardLogger())
+	assert.Equal(t, "10.5.1", client.Version)
+	champions, err := client.GetChampions()
+	require.Nil(t, err)
+	assert.Len(t, champions, 4)
+	_, err = client.GetChampion("Jinx")
+	assert.True(t, errors.Is(err, api.ErrNotFound))
+	item, err := client.GetItem("3031")
+	require.Nil(t, err)
+	assert.Equal(t, 3200, item.Gold.Total)
+}
+
+func TestServer_CommunityDragon(t *testing.T) {
+	t.Parallel()
+	s := New(WithDataDragonVersion("10.5.1"))
+	defer s.Close()
+	tests := []struct {
+		name string
+		url  string
+		code int
+		size int
+	}{
+		{
+			name: "latest",
+			url:  "https://raw.communitydragon.org/latest/plugins/rcp-be-lol-game-data/global/default/v1/champion-summary.json",
+			code: http.StatusOK,
+			size: 5,
+		},
+		{
+			name: "patch",
+			url:  "https://raw.communitydragon.org/10.6/plugins/rcp-be-lol-game-data/global/default/v1/champion-summary.json",
+			code: http.StatusOK,
+			size: 6,
+		},
+		{
+			name: "perks",
+			url:  "https://raw.communitydragon.org/10.6/plugins/rcp-be-lol-game-data/global/default/v1/perks.json",
+			code: http.StatusOK,
+			size: 11,
+		},
+		{
+			name: "unknown patch",
+			url:  "https://raw.communitydragon.org/9.1/plugins/rcp-be-lol-game-data/global/default/v1/items.json",
+			code: http.StatusNotFound,
+		},
+		{
+			name: "versions",
+			url:  "https://ddragon.leagueoflegends.com/api/versions.json",
+			code: http.StatusOK,
+			size: len(DataDragonVersions),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response, err := s.Client().Get(tt.url)
+			require.Nil(t, err)
+			defer response.Body.Close()
+			require.Equal(t, tt.code, response.StatusCode)
+			if tt.code != http.StatusOK {
+				return
+			}
+			var content []interface{}
+			require.Nil(t, json.NewDecoder(response.Body).Decode(&content))
+			assert.Len(t, content, tt.size)
+		})
+	}
+}
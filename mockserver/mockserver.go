@@ -0,0 +1,191 @@
+// Package mockserver provides an HTTP server mocking the Riot API for integration tests.
+// The server answers the summoner, league, match, spectator and status endpoints with realistic canned payloads and
+// supports injecting latency and errors. No API key is needed, but like the Riot API the server rejects requests
+// without the X-Riot-Token header.
+// The server also serves versioned champion, item, summoner spell, profile icon and rune data of Data Dragon and
+// Community Dragon, see DataDragonVersions, so that features depending on static data can be tested offline.
+//
+//	server := mockserver.New(mockserver.WithLatency(50 * time.Millisecond))
+//	defer server.Close()
+//	client := golio.NewClient("API_KEY", golio.WithClient(server.Client()))
+package mockserver
+
+import (
+	"context"
+	"encoding/json"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mjourard/golio/internal/fakeriot"
+)
+
+const apiTokenHeaderKey = "X-Riot-Token"
+
+// Server is a mock of the Riot API
+type Server struct {
+	server        *httptest.Server
+	latency       time.Duration
+	mu            sync.Mutex
+	routeErrors   []routeError
+	errorRate     float64
+	errorRateCode int
+	rand          *rand.Rand
+	// dataDragonVersion is the latest version announced by the Data Dragon realms
+	dataDragonVersion string
+}
+
+type routeError struct {
+	prefix string
+	code   int
+}
+
+// Option is used to configure the Server
+type Option func(*Server)
+
+// WithLatency delays every response by the given duration
+func WithLatency(latency time.Duration) Option {
+	return func(s *Server) {
+		s.latency = latency
+	}
+}
+
+// WithRouteError answers all requests with a path starting with prefix with the given status code,
+// e.g. WithRouteError("/lol/match/v4/timelines", http.StatusNotFound)
+func WithRouteError(prefix string, code int) Option {
+	return func(s *Server) {
+		s.routeErrors = append(s.routeErrors, routeError{prefix: prefix, code: code})
+	}
+}
+
+// WithErrorRate answers the given fraction of requests (0 to 1) with the given status code instead of the payload.
+// Rate limit errors (429) are sent with a Retry-After header of one second
+func WithErrorRate(rate float64, code int) Option {
+	return func(s *Server) {
+		s.errorRate = rate
+		s.errorRateCode = code
+	}
+}
+
+// WithSeed sets the seed used to decide which requests fail when using WithErrorRate. Defaults to 1
+func WithSeed(seed int64) Option {
+	return func(s *Server) {
+		s.rand = rand.New(rand.NewSource(seed))
+	}
+}
+
+// New starts and returns a new mock server. The server should be closed using Close once it is not needed anymore
+func New(options ...Option) *Server {
+	s := &Server{
+		rand:              rand.New(rand.NewSource(1)),
+		dataDragonVersion: DataDragonVersions[0],
+	}
+	for _, opt := range options {
+		opt(s)
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// URL returns the base URL of the server
+func (s *Server) URL() string {
+	return s.server.URL
+}
+
+// Close shuts down the server
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Client returns an HTTP client sending all requests to the server regardless of the requested host, so that it can
+// be passed to golio.WithClient or riot.NewClient without changing any URLs. This includes requests to Data Dragon and
+// Community Dragon
+func (s *Server) Client() *http.Client {
+	target, _ := url.Parse(s.server.URL)
+	return &http.Client{
+		Transport: &rewriteTransport{
+			target: target,
+			next:   s.server.Client().Transport,
+		},
+	}
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if !sleep(r.Context(), s.latency) {
+		return
+	}
+	staticData := isStaticData(r.URL.Path)
+	if !staticData && r.Header.Get(apiTokenHeaderKey) == "" {
+		fakeriot.WriteError(w, http.StatusUnauthorized)
+		return
+	}
+	if code := s.injectedError(r.URL.Path); code != 0 {
+		fakeriot.WriteError(w, code)
+		return
+	}
+	if r.Method != http.MethodGet {
+		fakeriot.WriteError(w, http.StatusMethodNotAllowed)
+		return
+	}
+	if staticData {
+		s.serveStaticData(w, r)
+		return
+	}
+	payload, found := handle(r)
+	if !found {
+		fakeriot.WriteError(w, http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json;charset=utf-8")
+	_ = json.NewEncoder(w).Encode(payload)
+}
+
+func (s *Server) injectedError(path string) int {
+	for _, e := range s.routeErrors {
+		if strings.HasPrefix(path, e.prefix) {
+			return e.code
+		}
+	}
+	if s.errorRate <= 0 {
+		return 0
+	}
+	s.mu.Lock()
+	fail := s.rand.Float64() < s.errorRate
+	s.mu.Unlock()
+	if fail {
+		return s.errorRateCode
+	}
+	return 0
+}
+
+// sleep waits for the given duration and returns false if ctx is done before
+func sleep(ctx context.Context, d time.Duration) bool {
+	if d <= 0 {
+		return true
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+type rewriteTransport struct {
+	target *url.URL
+	next   http.RoundTripper
+}
+
+// RoundTrip sends the request to the target server
+func (t *rewriteTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	r = r.Clone(r.Context())
+	r.URL.Scheme = t.target.Scheme
+	r.URL.Host = t.target.Host
+	return t.next.RoundTrip(r)
+}
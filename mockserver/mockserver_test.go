@@ -0,0 +1,154 @@
+package mockserver
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mjourard/golio/api"
+	"github.com/mjourard/golio/riot"
+)
+
+func newClient(s *Server, apiKey string) *riot.Client {
+	return riot.NewClient(api.RegionEuropeWest, apiKey, s.Client(), logrus.StandardLogger())
+}
+
+func TestServer_Routes(t *testing.T) {
+	t.Parallel()
+	s := New()
+	defer s.Close()
+	client := newClient(s, "API_KEY")
+
+	summoner, err := client.Summoner.GetByName("Some Name")
+	require.Nil(t, err)
+	assert.Equal(t, "Some Name", summoner.Name)
+	assert.NotEmpty(t, summoner.AccountID)
+	summoner, err = client.Summoner.GetByPUUID("5L_HG95CwpWSVcC69ymTDBv3YAXXO2BxSWbogV-sR9CpBL0GrNl4ULhaMIG2nQD8WLmTjzYaOeGqW1")
+	require.Nil(t, err)
+	assert.Equal(t, "5L_HG95CwpWSVcC69ymTDBv3YAXXO2BxSWbogV-sR9CpBL0GrNl4ULhaMIG2nQD8WLmTjzYaOeGqW1", summoner.PUUID)
+
+	league, err := client.League.GetGrandmaster(riot.QueueRankedSolo)
+	require.Nil(t, err)
+	assert.Equal(t, "GRANDMASTER", league.Tier)
+	assert.NotEmpty(t, league.Entries)
+	entries, err := client.League.ListBySummoner("summoner")
+	require.Nil(t, err)
+	require.NotEmpty(t, entries)
+	assert.Equal(t, "summoner", entries[0].SummonerID)
+	players, err := client.League.ListPlayersPager(riot.QueueRankedSolo, riot.TierGold, riot.DivisionTwo).
+		All(context.Background())
+	require.Nil(t, err)
+	require.NotEmpty(t, players)
+	assert.Equal(t, string(riot.TierGold), players[0].Tier)
+
+	m, err := client.Match.Get(42)
+	require.Nil(t, err)
+	assert.Equal(t, 42, m.GameID)
+	assert.Len(t, m.Participants, 10)
+	assert.Len(t, m.ParticipantIdentities, 10)
+	begin, end := 5, 10
+	matches, err := client.Match.List("account", &riot.MatchFilter{BeginIndex: &begin, EndIndex: &end})
+	require.Nil(t, err)
+	assert.Len(t, matches.Matches, 5)
+	timeline, err := client.Match.GetTimeline(42)
+	require.Nil(t, err)
+	assert.NotEmpty(t, timeline.Events())
+
+	game, err := client.Spectator.GetCurrent("summoner")
+	require.Nil(t, err)
+	assert.Equal(t, "summoner", game.Participants[0].SummonerID)
+	featured, err := client.Spectator.ListFeatured()
+	require.Nil(t, err)
+	assert.NotEmpty(t, featured.GameList)
+
+	status, err := client.Status.Get()
+	require.Nil(t, err)
+	assert.NotEmpty(t, status.Services)
+	platform, err := client.Status.GetPlatformData()
+	require.Nil(t, err)
+	assert.NotEmpty(t, platform.Maintenances)
+}
+
+func TestServer_Errors(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		options []Option
+		apiKey  string
+		want    error
+	}{
+		{
+			name:   "missing api key",
+			apiKey: "",
+			want:   api.ErrUnauthorized,
+		},
+		{
+			name:    "route error",
+			options: []Option{WithRouteError("/lol/summoner", http.StatusForbidden)},
+			apiKey:  "API_KEY",
+			want:    api.ErrForbidden,
+		},
+		{
+			name:    "other route error",
+			options: []Option{WithRouteError("/lol/match", http.StatusForbidden)},
+			apiKey:  "API_KEY",
+		},
+		{
+			name:    "error rate",
+			options: []Option{WithErrorRate(1, http.StatusInternalServerError)},
+			apiKey:  "API_KEY",
+			want:    api.ErrInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := New(tt.options...)
+			defer s.Close()
+			_, err := newClient(s, tt.apiKey).Summoner.GetByName("name")
+			assert.True(t, errors.Is(err, tt.want), "got error %v", err)
+		})
+	}
+}
+
+func TestServer_Unknown(t *testing.T) {
+	t.Parallel()
+	s := New()
+	defer s.Close()
+	request, err := http.NewRequest(http.MethodGet, s.URL()+"/lol/unknown", nil)
+	require.Nil(t, err)
+	request.Header.Set(apiTokenHeaderKey, "API_KEY")
+	response, err := http.DefaultClient.Do(request)
+	require.Nil(t, err)
+	defer response.Body.Close()
+	assert.Equal(t, http.StatusNotFound, response.StatusCode)
+}
+
+func TestServer_Latency(t *testing.T) {
+	t.Parallel()
+	s := New(WithLatency(50 * time.Millisecond))
+	defer s.Close()
+	start := time.Now()
+	_, err := newClient(s, "API_KEY").Status.Get()
+	require.Nil(t, err)
+	assert.True(t, time.Since(start) >= 50*time.Millisecond)
+}
+
+func TestServer_ErrorRate(t *testing.T) {
+	t.Parallel()
+	s := New(WithErrorRate(0.5, http.StatusNotFound), WithSeed(7))
+	defer s.Close()
+	client := newClient(s, "API_KEY")
+	failed := 0
+	for i := 0; i < 100; i++ {
+		if _, err := client.Status.Get(); err != nil {
+			failed++
+		}
+	}
+	assert.True(t, failed > 20 && failed < 80, "%d requests failed", failed)
+}
@@ -0,0 +1,158 @@
+package mockserver
+
+import (
+	"embed"
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strconv"
+)
+
+// the fixtures of the Riot API are also the golden corpus the decoding of package riot is tested with
+//go:embed fixtures/*.json fixtures/ddragon fixtures/cdragon
+var fixtures embed.FS
+
+type route struct {
+	pattern *regexp.Regexp
+	// handler returns the payload for the request given the submatches of pattern
+	handler func(r *http.Request, params []string) (interface{}, bool)
+}
+
+var routes = []route{
+	{regexp.MustCompile(`^/lol/summoner/v4/summoners/by-name/([^/]+)$`), summonerWith("name")},
+	{regexp.MustCompile(`^/lol/summoner/v4/summoners/by-account/([^/]+)$`), summonerWith("accountId")},
+	{regexp.MustCompile(`^/lol/summoner/v4/summoners/by-puuid/([^/]+)$`), summonerWith("puuid")},
+	{regexp.MustCompile(`^/lol/summoner/v4/summoners/([^/]+)$`), summonerWith("id")},
+	{regexp.MustCompile(`^/lol/league/v4/(challenger|grandmaster|master)leagues/by-queue/([^/]+)$`), leagueByQueue},
+	{regexp.MustCompile(`^/lol/league/v4/leagues/([^/]+)$`), leagueByID},
+	{regexp.MustCompile(`^/lol/league/v4/entries/by-summoner/([^/]+)$`), leagueEntriesBySummoner},
+	{regexp.MustCompile(`^/lol/league/v4/entries/([^/]+)/([^/]+)/([^/]+)$`), leagueEntries},
+	{regexp.MustCompile(`^/lol/match/v4/matches/(\d+)$`), match},
+	{regexp.MustCompile(`^/lol/match/v4/matchlists/by-account/([^/]+)$`), matchlist},
+	{regexp.MustCompile(`^/lol/match/v4/timelines/by-match/(\d+)$`), fixtureRoute("timeline.json")},
+	{regexp.MustCompile(`^/lol/spectator/v4/active-games/by-summoner/([^/]+)$`), currentGame},
+	{regexp.MustCompile(`^/lol/spectator/v4/featured-games$`), fixtureRoute("featured_games.json")},
+	{regexp.MustCompile(`^/lol/status/v3/shard-data$`), fixtureRoute("status.json")},
+	{regexp.MustCompile(`^/lol/status/v4/platform-data$`), fixtureRoute("platform_data.json")},
+}
+
+// handle returns the payload for the given request or false if no route matches
+func handle(r *http.Request) (interface{}, bool) {
+	for _, route := range routes {
+		params := route.pattern.FindStringSubmatch(r.URL.Path)
+		if params == nil {
+			continue
+		}
+		return route.handler(r, params[1:])
+	}
+	return nil, false
+}
+
+// loadFixture returns a fresh copy of the given fixture which can be modified
+func loadFixture(name string) interface{} {
+	content, err := fixtures.ReadFile("fixtures/" + name)
+	if err != nil {
+		panic(err)
+	}
+	var res interface{}
+	if err := json.Unmarshal(content, &res); err != nil {
+		panic(err)
+	}
+	return res
+}
+
+func fixtureRoute(name string) func(*http.Request, []string) (interface{}, bool) {
+	return func(*http.Request, []string) (interface{}, bool) {
+		return loadFixture(name), true
+	}
+}
+
+// summonerWith returns the summoner fixture with field set to the requested value
+func summonerWith(field string) func(*http.Request, []string) (interface{}, bool) {
+	return func(_ *http.Request, params []string) (interface{}, bool) {
+		summoner := loadFixture("summoner.json").(map[string]interface{})
+		summoner[field] = params[0]
+		return summoner, true
+	}
+}
+
+func leagueByQueue(_ *http.Request, params []string) (interface{}, bool) {
+	tiers := map[string]string{"challenger": "CHALLENGER", "grandmaster": "GRANDMASTER", "master": "MASTER"}
+	league := loadFixture("league_list.json").(map[string]interface{})
+	league["tier"] = tiers[params[0]]
+	league["queue"] = params[1]
+	return league, true
+}
+
+func leagueByID(_ *http.Request, params []string) (interface{}, bool) {
+	league := loadFixture("league_list.json").(map[string]interface{})
+	league["leagueId"] = params[0]
+	return league, true
+}
+
+func leagueEntriesBySummoner(_ *http.Request, params []string) (interface{}, bool) {
+	entries := loadFixture("league_entries.json").([]interface{})
+	for _, entry := range entries {
+		entry.(map[string]interface{})["summonerId"] = params[0]
+	}
+	return entries, true
+}
+
+// leagueEntries returns the entries of the league fixture for the requested queue, tier and division on the first page
+// and an empty list for all other pages
+func leagueEntries(r *http.Request, params []string) (interface{}, bool) {
+	page, err := strconv.Atoi(r.URL.Query().Get("page"))
+	if err != nil {
+		page = 1
+	}
+	entries := []interface{}{}
+	if page > 1 {
+		return entries, true
+	}
+	league := loadFixture("league_list.json").(map[string]interface{})
+	for _, e := range league["entries"].([]interface{}) {
+		entry := e.(map[string]interface{})
+		entry["leagueId"] = league["leagueId"]
+		entry["queueType"] = params[0]
+		entry["tier"] = params[1]
+		entry["rank"] = params[2]
+		entries = append(entries, entry)
+	}
+	return entries, true
+}
+
+func match(_ *http.Request, params []string) (interface{}, bool) {
+	m := loadFixture("match.json").(map[string]interface{})
+	id, _ := strconv.Atoi(params[0])
+	m["gameId"] = id
+	return m, true
+}
+
+// matchlist returns the match references of the matchlist fixture between the requested beginIndex and endIndex
+func matchlist(r *http.Request, params []string) (interface{}, bool) {
+	list := loadFixture("matchlist.json").(map[string]interface{})
+	matches := list["matches"].([]interface{})
+	query := r.URL.Query()
+	begin, err := strconv.Atoi(query.Get("beginIndex"))
+	if err != nil || begin < 0 {
+		begin = 0
+	}
+	end, err := strconv.Atoi(query.Get("endIndex"))
+	if err != nil || end > len(matches) {
+		end = len(matches)
+	}
+	if begin > end {
+		begin = end
+	}
+	list["matches"] = matches[begin:end]
+	list["startIndex"] = begin
+	list["endIndex"] = end
+	return list, true
+}
+
+func currentGame(_ *http.Request, params []string) (interface{}, bool) {
+	game := loadFixture("current_game.json").(map[string]interface{})
+	participants := game["participants"].([]interface{})
+	participants[0].(map[string]interface{})["summonerId"] = params[0]
+	return game, true
+}
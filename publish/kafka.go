@@ -0,0 +1,106 @@
+package publish
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/mjourard/golio/internal"
+)
+
+const kafkaContentType = "application/vnd.kafka.binary.v2+json"
+
+// KafkaError is returned if the Kafka REST proxy rejected a message
+type KafkaError struct {
+	// StatusCode of the response
+	StatusCode int
+	// Code is the error code of the REST proxy, e.g. 40401 if the topic does not exist
+	Code    int
+	Message string
+}
+
+func (e *KafkaError) Error() string {
+	return fmt.Sprintf("publish: kafka rest proxy returned status %d: %d %s", e.StatusCode, e.Code, e.Message)
+}
+
+// KafkaREST publishes messages to Kafka topics using the v2 API of the Confluent REST proxy. It is safe for
+// concurrent use
+type KafkaREST struct {
+	baseURL string
+	client  internal.Doer
+}
+
+// NewKafkaREST returns a publisher sending messages to the REST proxy at the base URL, e.g. http://localhost:8082.
+// Authentication can be added using a custom client
+func NewKafkaREST(baseURL string, client internal.Doer) *KafkaREST {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &KafkaREST{baseURL: strings.TrimRight(baseURL, "/"), client: client}
+}
+
+type kafkaRecord struct {
+	Key   *string `json:"key"`
+	Value string  `json:"value"`
+}
+
+type kafkaResponse struct {
+	Offsets []struct {
+		ErrorCode *int    `json:"error_code"`
+		Error     *string `json:"error"`
+	} `json:"offsets"`
+	ErrorCode int    `json:"error_code"`
+	Message   string `json:"message"`
+}
+
+// Publish sends the message to the topic. An empty key is sent as null, so that Kafka distributes the messages over
+// all partitions
+func (k *KafkaREST) Publish(ctx context.Context, topic string, key, value []byte) error {
+	record := kafkaRecord{Value: base64.StdEncoding.EncodeToString(value)}
+	if len(key) > 0 {
+		encoded := base64.StdEncoding.EncodeToString(key)
+		record.Key = &encoded
+	}
+	body, err := json.Marshal(map[string][]kafkaRecord{"records": {record}})
+	if err != nil {
+		return err
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost,
+		k.baseURL+"/topics/"+url.PathEscape(topic), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", kafkaContentType)
+	request.Header.Set("Accept", "application/vnd.kafka.v2+json")
+	response, err := k.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	var result kafkaResponse
+	decodeErr := json.NewDecoder(response.Body).Decode(&result)
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return &KafkaError{StatusCode: response.StatusCode, Code: result.ErrorCode, Message: result.Message}
+	}
+	if decodeErr != nil {
+		return decodeErr
+	}
+	for _, offset := range result.Offsets {
+		if offset.ErrorCode != nil || offset.Error != nil {
+			kafkaErr := &KafkaError{StatusCode: response.StatusCode}
+			if offset.ErrorCode != nil {
+				kafkaErr.Code = *offset.ErrorCode
+			}
+			if offset.Error != nil {
+				kafkaErr.Message = *offset.Error
+			}
+			return kafkaErr
+		}
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package publish
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKafkaREST_Publish(t *testing.T) {
+	t.Parallel()
+	var body map[string][]map[string]interface{}
+	var path, contentType string
+	responses := []string{
+		`{"offsets":[{"partition":0,"offset":1,"error_code":null,"error":null}]}`,
+		`{"offsets":[{"partition":null,"offset":null,"error_code":50002,"error":"retriable"}]}`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path, contentType = r.URL.Path, r.Header.Get("Content-Type")
+		data, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(data, &body)
+		if len(responses) == 0 {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error_code":40401,"message":"Topic not found."}`))
+			return
+		}
+		_, _ = w.Write([]byte(responses[0]))
+		responses = responses[1:]
+	}))
+	defer server.Close()
+	kafka := NewKafkaREST(server.URL+"/", nil)
+
+	require.Nil(t, kafka.Publish(context.Background(), "golio.matches", []byte("1"), []byte("value")))
+	assert.Equal(t, "/topics/golio.matches", path)
+	assert.Equal(t, kafkaContentType, contentType)
+	assert.Equal(t, map[string][]map[string]interface{}{"records": {{"key": "MQ==", "value": "dmFsdWU="}}}, body)
+
+	err := kafka.Publish(context.Background(), "golio.matches", nil, []byte("value"))
+	assert.Equal(t, &KafkaError{StatusCode: http.StatusOK, Code: 50002, Message: "retriable"}, err)
+	assert.Nil(t, body["records"][0]["key"])
+
+	err = kafka.Publish(context.Background(), "unknown", nil, []byte("value"))
+	assert.Equal(t, &KafkaError{StatusCode: http.StatusNotFound, Code: 40401, Message: "Topic not found."}, err)
+	assert.Equal(t, "publish: kafka rest proxy returned status 404: 40401 Topic not found.", err.Error())
+}
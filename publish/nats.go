@@ -0,0 +1,193 @@
+package publish
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+)
+
+// ErrNATSClosed is returned by the NATS publisher after the connection was closed
+var ErrNATSClosed = errors.New("publish: nats connection closed")
+
+// NATS publishes messages to subjects of a NATS server using the core protocol. Keys are ignored. It is safe for
+// concurrent use
+type NATS struct {
+	conn   net.Conn
+	mu     sync.Mutex
+	writer *bufio.Writer
+	pongs  []chan error
+	err    error
+}
+
+// NATSOption is used to configure the NATS publisher
+type NATSOption func(*natsConnect)
+
+// natsConnect is the payload of the CONNECT message
+type natsConnect struct {
+	Verbose  bool   `json:"verbose"`
+	Pedantic bool   `json:"pedantic"`
+	Name     string `json:"name"`
+	Lang     string `json:"lang"`
+	User     string `json:"user,omitempty"`
+	Password string `json:"pass,omitempty"`
+	Token    string `json:"auth_token,omitempty"`
+}
+
+// WithNATSUser authenticates using the user and password
+func WithNATSUser(user, password string) NATSOption {
+	return func(c *natsConnect) {
+		c.User = user
+		c.Password = password
+	}
+}
+
+// WithNATSToken authenticates using the token
+func WithNATSToken(token string) NATSOption {
+	return func(c *natsConnect) {
+		c.Token = token
+	}
+}
+
+// DialNATS connects to the NATS server at the address, e.g. localhost:4222
+func DialNATS(ctx context.Context, address string, options ...NATSOption) (*NATS, error) {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return nil, err
+	}
+	n, err := NewNATS(ctx, conn, options...)
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	return n, nil
+}
+
+// NewNATS performs the handshake with the NATS server on the established connection, e.g. a TLS connection. The
+// connection is closed by Close
+func NewNATS(ctx context.Context, conn net.Conn, options ...NATSOption) (*NATS, error) {
+	reader := bufio.NewReader(conn)
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(line, "INFO ") {
+		return nil, fmt.Errorf("publish: unexpected nats greeting %q", strings.TrimSpace(line))
+	}
+	connect := natsConnect{Name: "golio", Lang: "go"}
+	for _, opt := range options {
+		opt(&connect)
+	}
+	payload, err := json.Marshal(connect)
+	if err != nil {
+		return nil, err
+	}
+	n := &NATS{conn: conn, writer: bufio.NewWriter(conn)}
+	n.mu.Lock()
+	_, _ = n.writer.WriteString("CONNECT " + string(payload) + "\r\n")
+	n.mu.Unlock()
+	go n.read(reader)
+	if err := n.Flush(ctx); err != nil {
+		_ = n.Close()
+		return nil, err
+	}
+	return n, nil
+}
+
+// Publish sends the value to the subject. The message is buffered, use Flush to wait until the server processed it
+func (n *NATS) Publish(ctx context.Context, topic string, _, value []byte) error {
+	if topic == "" || strings.ContainsAny(topic, " \t\r\n") {
+		return fmt.Errorf("publish: invalid nats subject %q", topic)
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.err != nil {
+		return n.err
+	}
+	_, _ = fmt.Fprintf(n.writer, "PUB %s %d\r\n", topic, len(value))
+	_, _ = n.writer.Write(value)
+	_, err := n.writer.WriteString("\r\n")
+	return err
+}
+
+// Flush sends all buffered messages and waits until the server processed them or ctx is done
+func (n *NATS) Flush(ctx context.Context) error {
+	pong := make(chan error, 1)
+	n.mu.Lock()
+	if n.err != nil {
+		n.mu.Unlock()
+		return n.err
+	}
+	n.pongs = append(n.pongs, pong)
+	_, _ = n.writer.WriteString("PING\r\n")
+	err := n.writer.Flush()
+	n.mu.Unlock()
+	if err != nil {
+		n.fail(err)
+		return err
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case err := <-pong:
+		return err
+	}
+}
+
+// Close flushes the buffered messages and closes the connection
+func (n *NATS) Close() error {
+	n.mu.Lock()
+	_ = n.writer.Flush()
+	n.mu.Unlock()
+	n.fail(ErrNATSClosed)
+	return n.conn.Close()
+}
+
+// read handles the messages of the server until the connection is closed
+func (n *NATS) read(reader *bufio.Reader) {
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			n.fail(err)
+			return
+		}
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "PING":
+			n.mu.Lock()
+			_, _ = n.writer.WriteString("PONG\r\n")
+			_ = n.writer.Flush()
+			n.mu.Unlock()
+		case line == "PONG":
+			n.mu.Lock()
+			if len(n.pongs) > 0 {
+				n.pongs[0] <- nil
+				n.pongs = n.pongs[1:]
+			}
+			n.mu.Unlock()
+		case strings.HasPrefix(line, "-ERR"):
+			n.fail(fmt.Errorf("publish: nats error: %s", strings.Trim(strings.TrimPrefix(line, "-ERR"), " '")))
+		}
+	}
+}
+
+// fail stores the first error of the connection and passes it to all waiting calls of Flush
+func (n *NATS) fail(err error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.err == nil {
+		n.err = err
+	}
+	for _, pong := range n.pongs {
+		pong <- n.err
+	}
+	n.pongs = nil
+}
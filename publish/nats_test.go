@@ -0,0 +1,79 @@
+package publish
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// natsServer answers the handshake on conn and returns the lines received afterwards
+func natsServer(conn net.Conn, connectErr string) <-chan string {
+	lines := make(chan string, 10)
+	go func() {
+		defer close(lines)
+		_, _ = io.WriteString(conn, "INFO {\"server_id\":\"test\"}\r\n")
+		reader := bufio.NewReader(conn)
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				return
+			}
+			line = strings.TrimSpace(line)
+			switch {
+			case line == "PING" && connectErr != "":
+				_, _ = io.WriteString(conn, "-ERR '"+connectErr+"'\r\n")
+			case line == "PING":
+				_, _ = io.WriteString(conn, "PONG\r\n")
+			default:
+				lines <- line
+			}
+		}
+	}()
+	return lines
+}
+
+func TestNATS(t *testing.T) {
+	t.Parallel()
+	client, server := net.Pipe()
+	lines := natsServer(server, "")
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	n, err := NewNATS(ctx, client, WithNATSToken("token"))
+	require.Nil(t, err)
+	assert.Contains(t, <-lines, `CONNECT {"verbose":false,"pedantic":false,"name":"golio","lang":"go",`+
+		`"auth_token":"token"}`)
+
+	require.Nil(t, n.Publish(ctx, "golio.matches", []byte("key"), []byte("value")))
+	require.Nil(t, n.Flush(ctx))
+	assert.Equal(t, "PUB golio.matches 5", <-lines)
+	assert.Equal(t, "value", <-lines)
+	assert.NotNil(t, n.Publish(ctx, "invalid subject", nil, nil))
+
+	require.Nil(t, n.Close())
+	assert.Equal(t, ErrNATSClosed, n.Publish(ctx, "golio.matches", nil, nil))
+}
+
+func TestNATS_errors(t *testing.T) {
+	t.Parallel()
+	client, server := net.Pipe()
+	natsServer(server, "Authorization Violation")
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err := NewNATS(ctx, client)
+	require.NotNil(t, err)
+	assert.Equal(t, "publish: nats error: Authorization Violation", err.Error())
+
+	client, server = net.Pipe()
+	go func() {
+		_, _ = io.WriteString(server, "HELLO\r\n")
+	}()
+	_, err = NewNATS(ctx, client)
+	assert.NotNil(t, err)
+}
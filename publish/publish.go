@@ -0,0 +1,183 @@
+// Package publish pushes streamed matches and the events of the watchers of golio onto topics of a message broker,
+// so that the data can be fed directly into data pipelines, e.g.
+//
+//	nats, err := publish.DialNATS(ctx, "localhost:4222")
+//	forwarder := publish.New(nats)
+//	go forwarder.ForwardStatus(ctx, "golio.status", client.Riot.Status.Watch(ctx, time.Minute))
+//
+// Messages are published to NATS using the core protocol and to Kafka using the Confluent REST proxy, other brokers
+// and native Kafka clients can be used by implementing Publisher or using PublisherFunc. Values are encoded as JSON
+// by default, see WithSerializer.
+package publish
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"strconv"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/mjourard/golio/riot"
+)
+
+// Publisher sends messages to topics of a message broker. Brokers without keys ignore the key
+type Publisher interface {
+	Publish(ctx context.Context, topic string, key, value []byte) error
+}
+
+// PublisherFunc is a function implementing Publisher, e.g. to wrap the writer of a Kafka client library
+type PublisherFunc func(ctx context.Context, topic string, key, value []byte) error
+
+// Publish calls f
+func (f PublisherFunc) Publish(ctx context.Context, topic string, key, value []byte) error {
+	return f(ctx, topic, key, value)
+}
+
+// Serializer encodes the values of the messages
+type Serializer func(v interface{}) ([]byte, error)
+
+// JSON encodes values using encoding/json
+func JSON(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+// MatchMessage is the value published for every match of a stream
+type MatchMessage struct {
+	Reference *riot.MatchReference `json:"reference"`
+	// Match is only set if the stream was created using riot.ListStreamWithMatches
+	Match *riot.Match `json:"match,omitempty"`
+	// Timeline is only set if the stream was created using riot.ListStreamWithTimelines
+	Timeline *riot.MatchTimeline `json:"timeline,omitempty"`
+}
+
+// StatusMessage is the value published for every incident and maintenance found by the status watcher
+type StatusMessage struct {
+	Type        riot.StatusEventType `json:"type"`
+	Incident    *riot.Incident       `json:"incident"`
+	Service     *riot.Service        `json:"service"`
+	Maintenance *riot.StatusEntry    `json:"maintenance,omitempty"`
+}
+
+// FreeRotationMessage is the value published for every change of the free champion rotation
+type FreeRotationMessage struct {
+	Rotation *riot.ChampionInfo      `json:"rotation"`
+	Added    []riot.RotationChampion `json:"added"`
+	Removed  []riot.RotationChampion `json:"removed"`
+}
+
+// Forwarder publishes the values of match streams and watchers. It is safe for concurrent use if the publisher is
+type Forwarder struct {
+	publisher  Publisher
+	serializer Serializer
+	logger     log.FieldLogger
+}
+
+// Option is used to configure the Forwarder
+type Option func(*Forwarder)
+
+// WithSerializer sets the serializer used to encode the messages. Defaults to JSON
+func WithSerializer(s Serializer) Option {
+	return func(f *Forwarder) {
+		f.serializer = s
+	}
+}
+
+// WithLogger sets the logger of the forwarder
+func WithLogger(l log.FieldLogger) Option {
+	return func(f *Forwarder) {
+		f.logger = l
+	}
+}
+
+// New returns a forwarder publishing messages using the publisher
+func New(publisher Publisher, options ...Option) *Forwarder {
+	f := &Forwarder{
+		publisher:  publisher,
+		serializer: JSON,
+		logger:     log.StandardLogger(),
+	}
+	for _, opt := range options {
+		opt(f)
+	}
+	f.logger = f.logger.WithField("client", "publish")
+	return f
+}
+
+// Publish encodes the value using the serializer of the forwarder and publishes it to the topic
+func (f *Forwarder) Publish(ctx context.Context, topic, key string, value interface{}) error {
+	data, err := f.serializer(value)
+	if err != nil {
+		return err
+	}
+	return f.publisher.Publish(ctx, topic, []byte(key), data)
+}
+
+// PublishMatches publishes a MatchMessage keyed by the game ID for every value of the channel returned by
+// riot.Client.Match.ListStream until the end of the stream. It stops and returns the error of the first value with an
+// error or the first message which could not be published
+func (f *Forwarder) PublishMatches(ctx context.Context, topic string, stream <-chan riot.MatchStreamValue) error {
+	for value := range stream {
+		if value.Error == io.EOF {
+			return nil
+		}
+		if value.Error != nil {
+			return value.Error
+		}
+		message := MatchMessage{Reference: value.MatchReference, Match: value.Match, Timeline: value.Timeline}
+		if err := f.Publish(ctx, topic, matchKey(value), message); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func matchKey(value riot.MatchStreamValue) string {
+	switch {
+	case value.MatchReference != nil:
+		return strconv.Itoa(value.GameID)
+	case value.Match != nil:
+		return strconv.Itoa(value.Match.GameID)
+	}
+	return ""
+}
+
+// ForwardStatus publishes a StatusMessage keyed by the incident or maintenance ID for every event of the channel
+// returned by riot.Client.Status.Watch until the channel is closed. Errors of the watcher and failed messages are
+// logged and skipped
+func (f *Forwarder) ForwardStatus(ctx context.Context, topic string, events <-chan riot.StatusEvent) {
+	for event := range events {
+		if event.Error != nil {
+			f.logger.Debug(event.Error)
+			continue
+		}
+		message := StatusMessage{Type: event.Type, Incident: event.Incident, Service: event.Service,
+			Maintenance: event.Maintenance}
+		key := ""
+		switch {
+		case event.Incident != nil:
+			key = strconv.Itoa(event.Incident.ID)
+		case event.Maintenance != nil:
+			key = strconv.Itoa(event.Maintenance.ID)
+		}
+		if err := f.Publish(ctx, topic, key, message); err != nil {
+			f.logger.WithField("topic", topic).Warn(err)
+		}
+	}
+}
+
+// ForwardFreeRotation publishes a FreeRotationMessage for every change of the channel returned by
+// riot.Client.Champion.WatchFreeRotation until the channel is closed. Failed requests of the watcher and failed
+// messages are logged and skipped
+func (f *Forwarder) ForwardFreeRotation(ctx context.Context, topic string, changes <-chan riot.FreeRotationChange) {
+	for change := range changes {
+		if change.Error != nil && change.ChampionInfo == nil {
+			f.logger.Debug(change.Error)
+			continue
+		}
+		message := FreeRotationMessage{Rotation: change.ChampionInfo, Added: change.Added, Removed: change.Removed}
+		if err := f.Publish(ctx, topic, "", message); err != nil {
+			f.logger.WithField("topic", topic).Warn(err)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package publish
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mjourard/golio/riot"
+)
+
+type message struct {
+	topic string
+	key   string
+	value string
+}
+
+type recorder struct {
+	mu       sync.Mutex
+	messages []message
+	err      error
+}
+
+func (r *recorder) Publish(_ context.Context, topic string, key, value []byte) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil {
+		return r.err
+	}
+	r.messages = append(r.messages, message{topic: topic, key: string(key), value: string(value)})
+	return nil
+}
+
+func TestForwarder_PublishMatches(t *testing.T) {
+	t.Parallel()
+	stream := make(chan riot.MatchStreamValue, 3)
+	stream <- riot.MatchStreamValue{MatchReference: &riot.MatchReference{GameID: 1}, Match: &riot.Match{GameID: 1}}
+	stream <- riot.MatchStreamValue{MatchReference: &riot.MatchReference{GameID: 2}}
+	stream <- riot.MatchStreamValue{Error: io.EOF}
+	close(stream)
+	r := &recorder{}
+	require.Nil(t, New(r).PublishMatches(context.Background(), "matches", stream))
+	require.Len(t, r.messages, 2)
+	assert.Equal(t, "matches", r.messages[0].topic)
+	assert.Equal(t, "1", r.messages[0].key)
+	var got MatchMessage
+	require.Nil(t, json.Unmarshal([]byte(r.messages[0].value), &got))
+	assert.Equal(t, 1, got.Match.GameID)
+	assert.NotContains(t, r.messages[1].value, "match\"")
+
+	streamErr := errors.New("error")
+	stream = make(chan riot.MatchStreamValue, 2)
+	stream <- riot.MatchStreamValue{Error: streamErr}
+	stream <- riot.MatchStreamValue{MatchReference: &riot.MatchReference{GameID: 3}}
+	close(stream)
+	assert.Equal(t, streamErr, New(r).PublishMatches(context.Background(), "matches", stream))
+
+	stream = make(chan riot.MatchStreamValue, 1)
+	stream <- riot.MatchStreamValue{MatchReference: &riot.MatchReference{GameID: 3}}
+	close(stream)
+	r.err = errors.New("broker down")
+	assert.Equal(t, r.err, New(r).PublishMatches(context.Background(), "matches", stream))
+}
+
+func TestForwarder_ForwardStatus(t *testing.T) {
+	t.Parallel()
+	events := make(chan riot.StatusEvent, 3)
+	events <- riot.StatusEvent{Error: errors.New("error")}
+	events <- riot.StatusEvent{Type: riot.StatusEventTypeIncidentPublished, Incident: &riot.Incident{ID: 7}}
+	events <- riot.StatusEvent{Type: riot.StatusEventTypeMaintenanceUpdated, Maintenance: &riot.StatusEntry{ID: 8}}
+	close(events)
+	r := &recorder{}
+	New(r).ForwardStatus(context.Background(), "status", events)
+	require.Len(t, r.messages, 2)
+	assert.Equal(t, "7", r.messages[0].key)
+	assert.Contains(t, r.messages[0].value, `"type":"INCIDENT_PUBLISHED"`)
+	assert.Equal(t, "8", r.messages[1].key)
+	assert.Contains(t, r.messages[1].value, `"type":"MAINTENANCE_UPDATED"`)
+}
+
+func TestForwarder_ForwardFreeRotation(t *testing.T) {
+	t.Parallel()
+	changes := make(chan riot.FreeRotationChange, 2)
+	changes <- riot.FreeRotationChange{Error: errors.New("error")}
+	changes <- riot.FreeRotationChange{ChampionInfo: &riot.ChampionInfo{FreeChampionIDs: []int{1}}}
+	close(changes)
+	r := &recorder{}
+	serializer := func(v interface{}) ([]byte, error) {
+		return []byte("custom"), nil
+	}
+	New(r, WithSerializer(serializer)).ForwardFreeRotation(context.Background(), "rotation", changes)
+	assert.Equal(t, []message{{topic: "rotation", value: "custom"}}, r.messages)
+}
+
+func TestPublisherFunc(t *testing.T) {
+	t.Parallel()
+	var got string
+	f := PublisherFunc(func(_ context.Context, topic string, _, _ []byte) error {
+		got = topic
+		return nil
+	})
+	require.Nil(t, New(f).Publish(context.Background(), "topic", "", 1))
+	assert.Equal(t, "topic", got)
+	assert.NotNil(t, New(f).Publish(context.Background(), "topic", "", func() {}))
+}
@@ -0,0 +1,63 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Cache is a riot.Cache keeping the values in Redis, so that the instances of a service share the cached responses,
+// e.g. of summoners and league pages. The keys of riot.WithCache start with the region followed by the versioned path
+// of the endpoint, e.g. euw1/lol/summoner/v4/summoners/id, so responses of different regions and API versions never
+// collide. It keeps idle connections open for reuse and is safe for concurrent use
+type Cache struct {
+	*pool
+}
+
+// NewCache returns a cache using the Redis server at the address, e.g. localhost:6379. No connection is opened until
+// the first command is sent
+func NewCache(address string, options ...Option) *Cache {
+	return &Cache{newPool(address, options)}
+}
+
+// Get returns the value of the key. The returned bool is false if the key does not exist or is expired
+func (c *Cache) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	reply, err := c.do(ctx, "GET", c.prefix+key)
+	if err != nil {
+		return nil, false, err
+	}
+	if reply == nil {
+		return nil, false, nil
+	}
+	value, ok := reply.(string)
+	if !ok {
+		return nil, false, fmt.Errorf("redis: unexpected reply %v", reply)
+	}
+	return []byte(value), true, nil
+}
+
+// Set stores the value for the key, expiring after the ttl. A ttl of 0 stores the value without expiration
+func (c *Cache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	args := []string{"SET", c.prefix + key, string(value)}
+	if ttl > 0 {
+		milliseconds := ttl.Milliseconds()
+		if milliseconds == 0 {
+			milliseconds = 1
+		}
+		args = append(args, "PX", strconv.FormatInt(milliseconds, 10))
+	}
+	_, err := c.do(ctx, args...)
+	return err
+}
+
+// Delete removes the key
+func (c *Cache) Delete(ctx context.Context, key string) error {
+	_, err := c.do(ctx, "DEL", c.prefix+key)
+	return err
+}
+
+// Close closes the idle connections. Commands of a closed cache return ErrClosed
+func (c *Cache) Close() error {
+	return c.close()
+}
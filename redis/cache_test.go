@@ -0,0 +1,70 @@
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mjourard/golio/api"
+	"github.com/mjourard/golio/mock"
+	"github.com/mjourard/golio/riot"
+)
+
+var _ riot.Cache = &Cache{}
+
+func TestCache(t *testing.T) {
+	t.Parallel()
+	server := miniredis.RunT(t)
+	c := NewCache(server.Addr(), WithKeyPrefix("golio:"))
+	defer c.Close()
+	ctx := context.Background()
+
+	_, ok, err := c.Get(ctx, "euw1/missing")
+	require.Nil(t, err)
+	assert.False(t, ok)
+
+	require.Nil(t, c.Set(ctx, "euw1/lol/summoner/v4/summoners/id", []byte(`{"name":"name"}`), time.Minute))
+	value, ok, err := c.Get(ctx, "euw1/lol/summoner/v4/summoners/id")
+	require.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, `{"name":"name"}`, string(value))
+	assert.Equal(t, time.Minute, server.TTL("golio:euw1/lol/summoner/v4/summoners/id"))
+
+	require.Nil(t, c.Set(ctx, "euw1/static", []byte("static"), 0))
+	assert.Equal(t, time.Duration(0), server.TTL("golio:euw1/static"))
+
+	server.FastForward(time.Minute)
+	_, ok, err = c.Get(ctx, "euw1/lol/summoner/v4/summoners/id")
+	require.Nil(t, err)
+	assert.False(t, ok)
+
+	require.Nil(t, c.Delete(ctx, "euw1/static"))
+	require.Nil(t, c.Delete(ctx, "euw1/static"))
+	assert.False(t, server.Exists("golio:euw1/static"))
+
+	require.Nil(t, c.Close())
+	_, _, err = c.Get(ctx, "euw1/static")
+	assert.Equal(t, ErrClosed, err)
+}
+
+// TestCache_clients shares the responses between the clients of two instances through one Redis server
+func TestCache_clients(t *testing.T) {
+	t.Parallel()
+	server := miniredis.RunT(t)
+	doer := mock.NewSequenceDoer(mock.OK(riot.Summoner{Name: "first"}), mock.OK(riot.Summoner{Name: "second"}))
+	for i := 0; i < 2; i++ {
+		c := NewCache(server.Addr())
+		defer c.Close()
+		client := riot.NewClient(api.RegionKorea, "API_KEY", doer, logrus.StandardLogger(),
+			riot.WithCache(c, time.Minute))
+		summoner, err := client.Summoner.GetByID("id")
+		require.Nil(t, err)
+		assert.Equal(t, "first", summoner.Name)
+	}
+	assert.Len(t, doer.Requests(), 1)
+}
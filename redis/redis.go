@@ -0,0 +1,348 @@
+// Package redis provides a riot.RateLimitStore keeping the rate limit counters in Redis, so that the instances of a
+// horizontally scaled service sharing an API key coordinate its quota, e.g.
+//
+//	store := redis.NewRateLimitStore("localhost:6379", redis.WithPassword("secret"))
+//	defer store.Close()
+//	client := golio.NewClient("API KEY", golio.WithRateLimitStore(store))
+//
+// The windows of the counters are started and ended by the clock of Redis, so the instances agree on them even if
+// their clocks are skewed. Cache shares cached responses between the instances the same way:
+//
+//	cache := redis.NewCache("localhost:6379", redis.WithKeyPrefix("golio:"))
+//	client := golio.NewClient("API KEY", golio.WithCache(cache, time.Hour))
+//
+// The store and the cache talk the Redis protocol directly, so no Redis client library is required.
+package redis
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// incrementWindowScript increments the counter and starts its window if the counter is new or has no expiry
+const incrementWindowScript = `local count = redis.call('INCR', KEYS[1])
+local ttl = redis.call('PTTL', KEYS[1])
+if ttl < 0 then
+	redis.call('PEXPIRE', KEYS[1], ARGV[1])
+	ttl = tonumber(ARGV[1])
+end
+return {count, ttl}`
+
+// incrementScript increments the counter and sets its expiry
+const incrementScript = `local count = redis.call('INCR', KEYS[1])
+redis.call('PEXPIREAT', KEYS[1], ARGV[1])
+return count`
+
+// ErrClosed is returned by the methods of a closed store
+var ErrClosed = errors.New("redis: store is closed")
+
+// Error is returned if Redis answered with an error, e.g. for a wrong password
+type Error struct {
+	Message string
+}
+
+func (e *Error) Error() string {
+	return "redis: " + e.Message
+}
+
+// RateLimitStore is a riot.RateLimitStore and riot.WindowRateLimitStore keeping the counters in Redis. It keeps idle
+// connections open for reuse and is safe for concurrent use
+type RateLimitStore struct {
+	*pool
+}
+
+// pool sends commands to Redis, keeping idle connections open for reuse
+type pool struct {
+	address  string
+	prefix   string
+	password string
+	database int
+	maxIdle  int
+	dial     func(ctx context.Context, network, address string) (net.Conn, error)
+
+	mu     sync.Mutex
+	idle   []*conn
+	closed bool
+}
+
+// Option is used to configure the store and the cache
+type Option func(*pool)
+
+// WithPassword authenticates the connections with the password
+func WithPassword(password string) Option {
+	return func(s *pool) {
+		s.password = password
+	}
+}
+
+// WithDatabase selects the database of the counters. Defaults to 0
+func WithDatabase(database int) Option {
+	return func(s *pool) {
+		s.database = database
+	}
+}
+
+// WithMaxIdleConns sets the number of idle connections kept open. Defaults to 2
+func WithMaxIdleConns(n int) Option {
+	return func(s *pool) {
+		s.maxIdle = n
+	}
+}
+
+// WithKeyPrefix prefixes all keys written to Redis, e.g. golio: to share a server with other applications
+func WithKeyPrefix(prefix string) Option {
+	return func(s *pool) {
+		s.prefix = prefix
+	}
+}
+
+// WithDialer sets the function opening the connections, e.g. to use TLS. Defaults to a net.Dialer
+func WithDialer(dial func(ctx context.Context, network, address string) (net.Conn, error)) Option {
+	return func(s *pool) {
+		s.dial = dial
+	}
+}
+
+// NewRateLimitStore returns a store using the Redis server at the address, e.g. localhost:6379. No connection is
+// opened until the first counter is incremented
+func NewRateLimitStore(address string, options ...Option) *RateLimitStore {
+	return &RateLimitStore{newPool(address, options)}
+}
+
+func newPool(address string, options []Option) *pool {
+	p := &pool{
+		address: address,
+		maxIdle: 2,
+		dial:    (&net.Dialer{Timeout: 5 * time.Second}).DialContext,
+	}
+	for _, opt := range options {
+		opt(p)
+	}
+	return p
+}
+
+// Increment increments the counter with the key and lets it expire at the given time
+func (s *RateLimitStore) Increment(ctx context.Context, key string, expires time.Time) (int64, error) {
+	reply, err := s.do(ctx, "EVAL", incrementScript, "1", s.prefix+key, strconv.FormatInt(expires.UnixMilli(), 10))
+	if err != nil {
+		return 0, err
+	}
+	count, ok := reply.(int64)
+	if !ok {
+		return 0, fmt.Errorf("redis: unexpected reply %v", reply)
+	}
+	return count, nil
+}
+
+// IncrementWindow increments the counter with the key, starting a window of the given length on the clock of Redis if
+// the counter does not exist, and returns the count and the time left until the window ends
+func (s *RateLimitStore) IncrementWindow(ctx context.Context, key string,
+	window time.Duration) (int64, time.Duration, error) {
+	reply, err := s.do(ctx, "EVAL", incrementWindowScript, "1", s.prefix+key, strconv.FormatInt(window.Milliseconds(), 10))
+	if err != nil {
+		return 0, 0, err
+	}
+	values, ok := reply.([]interface{})
+	if !ok || len(values) != 2 {
+		return 0, 0, fmt.Errorf("redis: unexpected reply %v", reply)
+	}
+	count, ok := values[0].(int64)
+	if !ok {
+		return 0, 0, fmt.Errorf("redis: unexpected count %v", values[0])
+	}
+	left, ok := values[1].(int64)
+	if !ok {
+		return 0, 0, fmt.Errorf("redis: unexpected time to live %v", values[1])
+	}
+	return count, time.Duration(left) * time.Millisecond, nil
+}
+
+// Close closes the idle connections. Commands of a closed store return ErrClosed
+func (s *RateLimitStore) Close() error {
+	return s.close()
+}
+
+// close closes the idle connections. Commands of a closed pool return ErrClosed
+func (s *pool) close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closed = true
+	var err error
+	for _, c := range s.idle {
+		if closeErr := c.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}
+	s.idle = nil
+	return err
+}
+
+// do sends the command on an idle or new connection and returns the reply. Error replies are returned as *Error
+func (s *pool) do(ctx context.Context, args ...string) (interface{}, error) {
+	c, err := s.conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	reply, err := c.do(ctx, args...)
+	var redisErr *Error
+	if err != nil && !errors.As(err, &redisErr) {
+		c.Close()
+		return nil, err
+	}
+	s.release(c)
+	return reply, err
+}
+
+// conn returns an idle connection or opens a new one
+func (s *pool) conn(ctx context.Context) (*conn, error) {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return nil, ErrClosed
+	}
+	if n := len(s.idle); n > 0 {
+		c := s.idle[n-1]
+		s.idle = s.idle[:n-1]
+		s.mu.Unlock()
+		return c, nil
+	}
+	s.mu.Unlock()
+	netConn, err := s.dial(ctx, "tcp", s.address)
+	if err != nil {
+		return nil, err
+	}
+	c := &conn{Conn: netConn, reader: bufio.NewReader(netConn)}
+	if s.password != "" {
+		if _, err := c.do(ctx, "AUTH", s.password); err != nil {
+			c.Close()
+			return nil, err
+		}
+	}
+	if s.database != 0 {
+		if _, err := c.do(ctx, "SELECT", strconv.Itoa(s.database)); err != nil {
+			c.Close()
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+// release keeps the connection for reuse or closes it if enough connections are idle
+func (s *pool) release(c *conn) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed || len(s.idle) >= s.maxIdle {
+		c.Close()
+		return
+	}
+	s.idle = append(s.idle, c)
+}
+
+// conn is a connection to Redis
+type conn struct {
+	net.Conn
+	reader *bufio.Reader
+}
+
+// do writes the command and reads its reply. The connection must not be reused if an error other than *Error is
+// returned
+func (c *conn) do(ctx context.Context, args ...string) (interface{}, error) {
+	deadline, _ := ctx.Deadline()
+	if err := c.SetDeadline(deadline); err != nil {
+		return nil, err
+	}
+	// unblock the connection when ctx is done before the reply was read
+	stop := context.AfterFunc(ctx, func() {
+		_ = c.SetDeadline(time.Unix(1, 0))
+	})
+	defer stop()
+	if _, err := c.Write(encodeCommand(args)); err != nil {
+		return nil, contextError(ctx, err)
+	}
+	reply, err := readReply(c.reader)
+	if err != nil {
+		return nil, contextError(ctx, err)
+	}
+	if redisErr, ok := reply.(*Error); ok {
+		return nil, redisErr
+	}
+	return reply, nil
+}
+
+// contextError returns the error of ctx if it is done, as the connection failed because of it
+func contextError(ctx context.Context, err error) error {
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return ctxErr
+	}
+	return err
+}
+
+// encodeCommand encodes the command as array of bulk strings
+func encodeCommand(args []string) []byte {
+	buf := []byte("*" + strconv.Itoa(len(args)) + "\r\n")
+	for _, arg := range args {
+		buf = append(buf, '$')
+		buf = strconv.AppendInt(buf, int64(len(arg)), 10)
+		buf = append(buf, "\r\n"...)
+		buf = append(buf, arg...)
+		buf = append(buf, "\r\n"...)
+	}
+	return buf
+}
+
+// readReply reads a reply. Simple and bulk strings are returned as string, integers as int64, arrays as
+// []interface{}, nil replies as nil and errors as *Error
+func readReply(r *bufio.Reader) (interface{}, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	if len(line) < 3 || line[len(line)-2] != '\r' {
+		return nil, fmt.Errorf("redis: malformed reply %q", line)
+	}
+	kind, value := line[0], line[1:len(line)-2]
+	switch kind {
+	case '+':
+		return value, nil
+	case '-':
+		return &Error{Message: value}, nil
+	case ':':
+		return strconv.ParseInt(value, 10, 64)
+	case '$':
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, err
+		}
+		if n < 0 {
+			return nil, nil
+		}
+		buf := make([]byte, n+2)
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return nil, err
+		}
+		return string(buf[:n]), nil
+	case '*':
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, err
+		}
+		if n < 0 {
+			return nil, nil
+		}
+		values := make([]interface{}, n)
+		for i := range values {
+			if values[i], err = readReply(r); err != nil {
+				return nil, err
+			}
+		}
+		return values, nil
+	}
+	return nil, fmt.Errorf("redis: malformed reply %q", line)
+}
@@ -0,0 +1,148 @@
+package redis
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mjourard/golio/api"
+	"github.com/mjourard/golio/mock"
+	"github.com/mjourard/golio/riot"
+)
+
+var _ riot.WindowRateLimitStore = &RateLimitStore{}
+
+func TestRateLimitStore_IncrementWindow(t *testing.T) {
+	t.Parallel()
+	server := miniredis.RunT(t)
+	store := NewRateLimitStore(server.Addr())
+	defer store.Close()
+	for i := int64(1); i <= 2; i++ {
+		count, left, err := store.IncrementWindow(context.Background(), "key", 2*time.Minute)
+		require.Nil(t, err)
+		assert.Equal(t, i, count)
+		assert.Equal(t, 2*time.Minute, left)
+	}
+	// the window is measured by the clock of Redis, not by the clock of the instance
+	server.FastForward(time.Minute)
+	count, left, err := store.IncrementWindow(context.Background(), "key", 2*time.Minute)
+	require.Nil(t, err)
+	assert.Equal(t, int64(3), count)
+	assert.Equal(t, time.Minute, left)
+	server.FastForward(time.Minute)
+	count, left, err = store.IncrementWindow(context.Background(), "key", 2*time.Minute)
+	require.Nil(t, err)
+	assert.Equal(t, int64(1), count)
+	assert.Equal(t, 2*time.Minute, left)
+}
+
+func TestRateLimitStore_Increment(t *testing.T) {
+	t.Parallel()
+	server := miniredis.RunT(t)
+	store := NewRateLimitStore(server.Addr())
+	defer store.Close()
+	server.SetTime(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	for i := int64(1); i <= 2; i++ {
+		count, err := store.Increment(context.Background(), "key", time.Date(2020, 1, 1, 0, 1, 0, 0, time.UTC))
+		require.Nil(t, err)
+		assert.Equal(t, i, count)
+	}
+	assert.Equal(t, time.Minute, server.TTL("key"))
+}
+
+func TestRateLimitStore_options(t *testing.T) {
+	t.Parallel()
+	server := miniredis.RunT(t)
+	server.RequireAuth("secret")
+	store := NewRateLimitStore(server.Addr())
+	_, _, err := store.IncrementWindow(context.Background(), "key", time.Second)
+	var redisErr *Error
+	assert.True(t, errors.As(err, &redisErr))
+	require.Nil(t, store.Close())
+
+	store = NewRateLimitStore(server.Addr(), WithPassword("secret"), WithDatabase(2), WithMaxIdleConns(1))
+	_, _, err = store.IncrementWindow(context.Background(), "key", time.Second)
+	require.Nil(t, err)
+	value, err := server.DB(2).Get("key")
+	require.Nil(t, err)
+	assert.Equal(t, "1", value)
+	assert.Len(t, store.idle, 1)
+
+	require.Nil(t, store.Close())
+	_, _, err = store.IncrementWindow(context.Background(), "key", time.Second)
+	assert.Equal(t, ErrClosed, err)
+}
+
+func TestRateLimitStore_errors(t *testing.T) {
+	t.Parallel()
+	server := miniredis.RunT(t)
+	store := NewRateLimitStore(server.Addr())
+	defer store.Close()
+	require.Nil(t, server.Set("key", "text"))
+	_, _, err := store.IncrementWindow(context.Background(), "key", time.Second)
+	var redisErr *Error
+	assert.True(t, errors.As(err, &redisErr))
+	// the connection stays usable after an error reply
+	_, _, err = store.IncrementWindow(context.Background(), "other", time.Second)
+	assert.Nil(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, _, err = store.IncrementWindow(ctx, "other", time.Second)
+	assert.True(t, errors.Is(err, context.Canceled))
+
+	server.Close()
+	_, _, err = store.IncrementWindow(context.Background(), "other", time.Second)
+	assert.NotNil(t, err)
+}
+
+func TestReadReply(t *testing.T) {
+	t.Parallel()
+	reply, err := readReply(bufio.NewReader(strings.NewReader("*3\r\n:1\r\n$5\r\nhello\r\n$-1\r\n")))
+	require.Nil(t, err)
+	assert.Equal(t, []interface{}{int64(1), "hello", nil}, reply)
+	reply, err = readReply(bufio.NewReader(strings.NewReader("-ERR wrong\r\n")))
+	require.Nil(t, err)
+	assert.Equal(t, &Error{Message: "ERR wrong"}, reply)
+	_, err = readReply(bufio.NewReader(strings.NewReader("?\r\n")))
+	assert.NotNil(t, err)
+	assert.Equal(t, "*2\r\n$4\r\nPTTL\r\n$3\r\nkey\r\n", string(encodeCommand([]string{"PTTL", "key"})))
+}
+
+// TestRateLimitStore_clients coordinates the clients of two instances through one Redis server
+func TestRateLimitStore_clients(t *testing.T) {
+	t.Parallel()
+	server := miniredis.RunT(t)
+	doer := &mock.Doer{Custom: func(*http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{}`))}, nil
+	}}
+	var clients []*riot.Client
+	for i := 0; i < 2; i++ {
+		store := NewRateLimitStore(server.Addr())
+		defer store.Close()
+		clients = append(clients, riot.NewClient(api.RegionKorea, "API_KEY", doer, logrus.StandardLogger(),
+			riot.WithRateLimitStore(store, riot.RateLimit{Limit: 2, Window: 10 * time.Second})))
+	}
+	for _, c := range clients {
+		_, _, err := c.Do(http.MethodGet, "/lol/status/v4/platform-data", nil)
+		require.Nil(t, err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, _, err := clients[0].WithContext(ctx).Do(http.MethodGet, "/lol/status/v4/platform-data", nil)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+
+	server.FastForward(10 * time.Second)
+	_, _, err = clients[1].Do(http.MethodGet, "/lol/status/v4/platform-data", nil)
+	assert.Nil(t, err)
+}
@@ -0,0 +1,49 @@
+package riot
+
+import (
+	"fmt"
+	"net/url"
+
+	log "github.com/sirupsen/logrus"
+)
+
+type accountClient struct {
+	c *Client
+}
+
+// GetByPUUID returns the account with the given PUUID. The request is sent to the continent of the region of the
+// client
+func (a *accountClient) GetByPUUID(puuid string) (*Account, error) {
+	logger := a.logger().WithField("method", "GetByPUUID")
+	if err := validatePUUID(puuid); err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
+	return a.get(fmt.Sprintf(endpointGetAccountByPUUID, puuid), logger)
+}
+
+// GetByRiotID returns the account with the given Riot ID, consisting of the game name and the tag line, e.g. the
+// game name "Name" and the tag line "EUW" for "Name#EUW". The request is sent to the continent of the region of the
+// client
+func (a *accountClient) GetByRiotID(gameName, tagLine string) (*Account, error) {
+	logger := a.logger().WithField("method", "GetByRiotID")
+	if err := validateIDs("gameName", gameName, "tagLine", tagLine); err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
+	endpoint := fmt.Sprintf(endpointGetAccountByRiotID, url.PathEscape(gameName), url.PathEscape(tagLine))
+	return a.get(endpoint, logger)
+}
+
+func (a *accountClient) get(endpoint string, logger log.FieldLogger) (*Account, error) {
+	var account *Account
+	if err := a.c.getInto(endpoint, &account); err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
+	return account, nil
+}
+
+func (a *accountClient) logger() log.FieldLogger {
+	return a.c.categoryLogger(LogCategoryAccount)
+}
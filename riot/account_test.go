@@ -0,0 +1,100 @@
+package riot
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mjourard/golio/api"
+	"github.com/mjourard/golio/internal"
+	"github.com/mjourard/golio/mock"
+)
+
+func TestAccountClient_GetByPUUID(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		want    *Account
+		doer    internal.Doer
+		wantErr error
+	}{
+		{
+			name: "get response",
+			want: &Account{PUUID: testPUUID, GameName: "Name", TagLine: "EUW"},
+			doer: mock.NewJSONMockDoer(Account{PUUID: testPUUID, GameName: "Name", TagLine: "EUW"}, 200),
+		},
+		{
+			name: "unknown error status",
+			wantErr: api.Error{
+				Message:    "unknown error reason",
+				StatusCode: 999,
+			},
+			doer: mock.NewStatusMockDoer(999),
+		},
+		{
+			name:    "not found",
+			wantErr: api.ErrNotFound,
+			doer:    mock.NewStatusMockDoer(http.StatusNotFound),
+		},
+		{
+			name:    "unavailable twice",
+			wantErr: api.ErrServiceUnavailable,
+			doer:    mock.NewStatusMockDoer(http.StatusServiceUnavailable),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
+			got, err := client.Account.GetByPUUID(testPUUID)
+			require.True(t, errors.Is(err, tt.wantErr), fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+			if tt.wantErr == nil {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestAccountClient_GetByRiotID(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		region api.Region
+		want   string
+	}{
+		{
+			name:   "europe",
+			region: api.RegionEuropeWest,
+			want:   "https://europe.api.riotgames.com/riot/account/v1/accounts/by-riot-id/Some%20Name/EUW",
+		},
+		{
+			name:   "americas",
+			region: api.RegionBrasil,
+			want:   "https://americas.api.riotgames.com/riot/account/v1/accounts/by-riot-id/Some%20Name/EUW",
+		},
+		{
+			name:   "asia",
+			region: api.RegionKorea,
+			want:   "https://asia.api.riotgames.com/riot/account/v1/accounts/by-riot-id/Some%20Name/EUW",
+		},
+		{
+			name:   "sea",
+			region: api.RegionOceania,
+			want:   "https://asia.api.riotgames.com/riot/account/v1/accounts/by-riot-id/Some%20Name/EUW",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doer := mock.NewJSONMockDoer(Account{GameName: "Some Name", TagLine: "EUW"}, 200)
+			client := NewClient(tt.region, "API_KEY", doer, logrus.StandardLogger())
+			got, err := client.Account.GetByRiotID("Some Name", "EUW")
+			require.Nil(t, err)
+			assert.Equal(t, "Some Name", got.GameName)
+			assert.Equal(t, tt.want, doer.LastURL())
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package riot
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/mjourard/golio/api"
+)
+
+// AuditResult is the outcome of a request recorded by an AuditSink
+type AuditResult string
+
+// All possible audit results
+const (
+	AuditResultSuccess     AuditResult = "success"
+	AuditResultRateLimited AuditResult = "rate_limited"
+	AuditResultError       AuditResult = "error"
+	// AuditResultTransportError is recorded for requests without a response, e.g. timeouts
+	AuditResultTransportError AuditResult = "transport_error"
+)
+
+// AuditRecord describes a single call to the Riot API. Every attempt of a retried request is recorded, since every
+// attempt counts towards the quota of the API key
+type AuditRecord struct {
+	Time     time.Time
+	Method   string
+	Endpoint string
+	// Family is the API of the endpoint, e.g. summoner-v4, see EndpointFamily
+	Family     string
+	Region     api.Region
+	StatusCode int
+	Result     AuditResult
+	// Label is the label of the context of the request, see WithAuditLabel
+	Label string
+}
+
+// AuditSink records the calls of a client, e.g. to create quota usage reports. Record is called synchronously from
+// the goroutine sending the request, so it must be fast and safe for concurrent use
+type AuditSink interface {
+	Record(AuditRecord)
+}
+
+// AuditSinkFunc is an AuditSink calling the function
+type AuditSinkFunc func(AuditRecord)
+
+// Record calls f with the record
+func (f AuditSinkFunc) Record(record AuditRecord) {
+	f(record)
+}
+
+// WithAuditSink records every call of the client to the sink
+func WithAuditSink(sink AuditSink) Option {
+	return func(c *Client) {
+		c.audit = sink
+	}
+}
+
+type auditLabelKey struct{}
+
+// WithAuditLabel returns a context labeling the requests sent with it in the audit records, e.g. with the feature of
+// the application sending them, to attribute the quota usage
+func WithAuditLabel(ctx context.Context, label string) context.Context {
+	return context.WithValue(ctx, auditLabelKey{}, label)
+}
+
+// AuditLabel returns the label set by WithAuditLabel or an empty string
+func AuditLabel(ctx context.Context) string {
+	label, _ := ctx.Value(auditLabelKey{}).(string)
+	return label
+}
+
+// auditResult returns the result for the status code and the error of the http client
+func auditResult(statusCode int, err error) AuditResult {
+	switch {
+	case err != nil || statusCode == 0:
+		return AuditResultTransportError
+	case statusCode == http.StatusTooManyRequests:
+		return AuditResultRateLimited
+	case statusCode < 200 || statusCode > 299:
+		return AuditResultError
+	}
+	return AuditResultSuccess
+}
+
+// UsageKey groups the calls counted by a UsageCounter
+type UsageKey struct {
+	// Day is the UTC date of the calls, e.g. 2020-01-31
+	Day    string
+	Family string
+	Region api.Region
+	Label  string
+	Result AuditResult
+}
+
+// UsageCounter is an AuditSink counting the calls by day, endpoint family, region, label and result, e.g. for daily
+// quota usage reports. It is safe for concurrent use
+type UsageCounter struct {
+	mu     sync.Mutex
+	counts map[UsageKey]int64
+}
+
+// Record counts the call
+func (u *UsageCounter) Record(record AuditRecord) {
+	key := UsageKey{
+		Day:    record.Time.UTC().Format("2006-01-02"),
+		Family: record.Family,
+		Region: record.Region,
+		Label:  record.Label,
+		Result: record.Result,
+	}
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	if u.counts == nil {
+		u.counts = map[UsageKey]int64{}
+	}
+	u.counts[key]++
+}
+
+// Counts returns a copy of the current counts
+func (u *UsageCounter) Counts() map[UsageKey]int64 {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	counts := make(map[UsageKey]int64, len(u.counts))
+	for key, count := range u.counts {
+		counts[key] = count
+	}
+	return counts
+}
+
+// Reset removes all counts, e.g. after a report was created
+func (u *UsageCounter) Reset() {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.counts = nil
+}
@@ -0,0 +1,67 @@
+package riot
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mjourard/golio/api"
+	"github.com/mjourard/golio/mock"
+)
+
+func TestWithAuditSink(t *testing.T) {
+	t.Parallel()
+	var mu sync.Mutex
+	var records []AuditRecord
+	sink := AuditSinkFunc(func(record AuditRecord) {
+		mu.Lock()
+		defer mu.Unlock()
+		records = append(records, record)
+	})
+	doer := mock.NewSequenceDoer(mock.RateLimited(1, 0), mock.OK(Summoner{}))
+	client := NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger(), WithAuditSink(sink))
+	var summoner Summoner
+	ctx := WithAuditLabel(context.Background(), "profile page")
+	require.Nil(t, client.GetInto(ctx, "/lol/summoner/v4/summoners/by-name/name", &summoner))
+	require.Len(t, records, 2)
+	assert.Equal(t, AuditResultRateLimited, records[0].Result)
+	assert.Equal(t, AuditResultSuccess, records[1].Result)
+	for _, record := range records {
+		assert.Equal(t, "summoner-v4", record.Family)
+		assert.Equal(t, api.RegionEuropeWest, record.Region)
+		assert.Equal(t, "profile page", record.Label)
+		assert.WithinDuration(t, time.Now(), record.Time, time.Minute)
+	}
+}
+
+func TestAuditResult(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, AuditResultSuccess, auditResult(http.StatusOK, nil))
+	assert.Equal(t, AuditResultRateLimited, auditResult(http.StatusTooManyRequests, nil))
+	assert.Equal(t, AuditResultError, auditResult(http.StatusNotFound, nil))
+	assert.Equal(t, AuditResultTransportError, auditResult(0, errors.New("timeout")))
+}
+
+func TestUsageCounter(t *testing.T) {
+	t.Parallel()
+	counter := &UsageCounter{}
+	day := time.Date(2020, 1, 31, 23, 0, 0, 0, time.UTC)
+	record := AuditRecord{Time: day, Family: "match-v4", Region: api.RegionKorea, Result: AuditResultSuccess}
+	counter.Record(record)
+	counter.Record(record)
+	record.Time = day.Add(2 * time.Hour)
+	counter.Record(record)
+	assert.Equal(t, map[UsageKey]int64{
+		{Day: "2020-01-31", Family: "match-v4", Region: api.RegionKorea, Result: AuditResultSuccess}: 2,
+		{Day: "2020-02-01", Family: "match-v4", Region: api.RegionKorea, Result: AuditResultSuccess}: 1,
+	}, counter.Counts())
+	counter.Reset()
+	assert.Empty(t, counter.Counts())
+}
@@ -0,0 +1,109 @@
+package riot
+
+import (
+	"math"
+	"math/rand"
+	"net/http"
+	"time"
+
+	"github.com/mjourard/golio/api"
+)
+
+// Backoff is the default RetryPolicy. Responses with one of the StatusCodes are retried after exponentially growing
+// delays. Responses with status 429 are retried after the time sent in their Retry-After header, or after the
+// exponential delay if the header is missing or malformed and the rate limit headers tell no reset. Both count towards
+// the limit of elapsed time. Retries after the Retry-After header are limited by MaxRateLimitAttempts, all others by
+// MaxAttempts. Requests failing without response are not retried
+type Backoff struct {
+	// InitialDelay is the delay before the first retry of a server error
+	InitialDelay time.Duration
+	// Multiplier is applied to the delay after every retry, e.g. 2 doubles it
+	Multiplier float64
+	// Jitter is the fraction by which the delays are randomized, e.g. 0.2 waits between 80% and 120% of the delay, so
+	// that clients failing at the same time do not retry at the same time
+	Jitter float64
+	// MaxAttempts is the maximum number of attempts of a request answered with a server error or with status 429
+	// without a valid Retry-After header including the first one, or 0 for no limit
+	MaxAttempts int
+	// MaxRateLimitAttempts is the maximum number of attempts of a request answered with status 429 and a Retry-After
+	// header including the first one, or 0 to retry until the request is not rate limited anymore
+	MaxRateLimitAttempts int
+	// MaxElapsedTime is the maximum time from the first attempt until a retry would be sent, or 0 for no limit
+	MaxElapsedTime time.Duration
+	// StatusCodes are the status codes of the server errors which are retried, e.g. 500, 502, 503 and 504. Defaults
+	// to 503 if empty
+	StatusCodes []int
+}
+
+// DefaultBackoff is used by clients created without WithBackoff or WithRetryPolicy. It retries a request once after
+// one second for status 503 or for status 429 without a valid Retry-After header, and after the time sent in the
+// Retry-After header for status 429 as often as needed
+var DefaultBackoff = Backoff{
+	InitialDelay: time.Second,
+	Multiplier:   2,
+	MaxAttempts:  2,
+}
+
+// WithBackoff sets the backoff for retrying requests answered with server errors or status 429, see WithRetryPolicy.
+// Defaults to DefaultBackoff
+func WithBackoff(backoff Backoff) Option {
+	return WithRetryPolicy(backoff)
+}
+
+// ShouldRetry returns the delay before the retry following the given failed attempt
+func (b Backoff) ShouldRetry(attempt int, response *http.Response, _ error) (time.Duration, bool) {
+	if response == nil {
+		return 0, false
+	}
+	if response.StatusCode == http.StatusTooManyRequests {
+		if wait, ok := api.ParseRetryAfter(response.Header.Get("Retry-After"), time.Now()); ok {
+			return wait, b.MaxRateLimitAttempts <= 0 || attempt < b.MaxRateLimitAttempts
+		}
+	}
+	if b.MaxAttempts > 0 && attempt >= b.MaxAttempts {
+		return 0, false
+	}
+	if response.StatusCode == http.StatusTooManyRequests {
+		return b.delay(attempt), true
+	}
+	statusCodes := b.StatusCodes
+	if len(statusCodes) == 0 {
+		statusCodes = []int{http.StatusServiceUnavailable}
+	}
+	for _, code := range statusCodes {
+		if response.StatusCode == code {
+			return b.delay(attempt), true
+		}
+	}
+	return 0, false
+}
+
+// WithRateLimitErrors makes the client return an *api.RateLimitError for responses with status 429 instead of waiting
+// for the time of their Retry-After header and retrying, e.g. for schedulers handling rate limits themselves. The
+// error contains the duration of the Retry-After header and the type of the exceeded limit. Server errors are still
+// retried according to the RetryPolicy
+func WithRateLimitErrors() Option {
+	return func(c *Client) {
+		c.rateLimitErrors = true
+	}
+}
+
+// delay returns the delay before the retry following the given failed attempt, starting at 1
+func (b Backoff) delay(attempt int) time.Duration {
+	multiplier := b.Multiplier
+	if multiplier < 1 {
+		multiplier = 1
+	}
+	d := float64(b.InitialDelay) * math.Pow(multiplier, float64(attempt-1))
+	if b.Jitter > 0 {
+		d *= 1 + b.Jitter*(2*rand.Float64()-1)
+	}
+	if d > math.MaxInt64 {
+		return time.Duration(math.MaxInt64)
+	}
+	return time.Duration(d)
+}
+
+func (b Backoff) retryTimeLimit() time.Duration {
+	return b.MaxElapsedTime
+}
@@ -0,0 +1,176 @@
+package riot
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mjourard/golio/api"
+	"github.com/mjourard/golio/mock"
+)
+
+func TestBackoff_delay(t *testing.T) {
+	t.Parallel()
+	backoff := Backoff{InitialDelay: time.Second, Multiplier: 2}
+	assert.Equal(t, time.Second, backoff.delay(1))
+	assert.Equal(t, 2*time.Second, backoff.delay(2))
+	assert.Equal(t, 8*time.Second, backoff.delay(4))
+	assert.Equal(t, time.Second, Backoff{InitialDelay: time.Second}.delay(3))
+	backoff.Jitter = 0.2
+	for i := 0; i < 100; i++ {
+		delay := backoff.delay(2)
+		assert.GreaterOrEqual(t, int64(delay), int64(1600*time.Millisecond))
+		assert.LessOrEqual(t, int64(delay), int64(2400*time.Millisecond))
+	}
+}
+
+func TestBackoff_ShouldRetry(t *testing.T) {
+	t.Parallel()
+	response := func(status int, header http.Header) *http.Response {
+		return &http.Response{StatusCode: status, Header: header}
+	}
+	backoff := Backoff{InitialDelay: time.Second, MaxAttempts: 3}
+	delay, retry := backoff.ShouldRetry(2, response(http.StatusServiceUnavailable, nil), nil)
+	assert.True(t, retry)
+	assert.Equal(t, time.Second, delay)
+	_, retry = backoff.ShouldRetry(3, response(http.StatusServiceUnavailable, nil), nil)
+	assert.False(t, retry)
+	_, retry = backoff.ShouldRetry(1, response(http.StatusInternalServerError, nil), nil)
+	assert.False(t, retry)
+	_, retry = backoff.ShouldRetry(1, nil, errors.New("connection refused"))
+	assert.False(t, retry)
+	delay, retry = backoff.ShouldRetry(1, response(http.StatusTooManyRequests, http.Header{"Retry-After": {"3"}}), nil)
+	assert.True(t, retry)
+	assert.Equal(t, 3*time.Second, delay)
+	delay, retry = backoff.ShouldRetry(2, response(http.StatusTooManyRequests, http.Header{"Retry-After": {"abc"}}), nil)
+	assert.True(t, retry)
+	assert.Equal(t, time.Second, delay)
+	// responses with status 429 are retried regardless of MaxAttempts unless MaxRateLimitAttempts is set
+	_, retry = backoff.ShouldRetry(5, response(http.StatusTooManyRequests, http.Header{"Retry-After": {"1"}}), nil)
+	assert.True(t, retry)
+	_, retry = DefaultBackoff.ShouldRetry(10, response(http.StatusTooManyRequests, http.Header{"Retry-After": {"1"}}),
+		nil)
+	assert.True(t, retry)
+	_, retry = DefaultBackoff.ShouldRetry(2, response(http.StatusTooManyRequests, nil), nil)
+	assert.False(t, retry)
+	_, retry = DefaultBackoff.ShouldRetry(2, response(http.StatusServiceUnavailable, nil), nil)
+	assert.False(t, retry)
+	backoff.MaxRateLimitAttempts = 2
+	_, retry = backoff.ShouldRetry(2, response(http.StatusTooManyRequests, http.Header{"Retry-After": {"1"}}), nil)
+	assert.False(t, retry)
+
+	backoff.StatusCodes = []int{http.StatusInternalServerError, http.StatusGatewayTimeout}
+	_, retry = backoff.ShouldRetry(1, response(http.StatusInternalServerError, nil), nil)
+	assert.True(t, retry)
+	_, retry = backoff.ShouldRetry(1, response(http.StatusServiceUnavailable, nil), nil)
+	assert.False(t, retry)
+	assert.Equal(t, time.Duration(0), retryTimeLimit(backoff))
+	assert.Equal(t, time.Minute, retryTimeLimit(IdempotentOnly(Backoff{MaxElapsedTime: time.Minute})))
+}
+
+func TestWithBackoff(t *testing.T) {
+	t.Parallel()
+	backoff := Backoff{InitialDelay: 10 * time.Millisecond, Multiplier: 2, MaxAttempts: 4}
+	doer := mock.NewUnavailableDoer(Summoner{Name: "name"}, 3)
+	c := NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger(), WithBackoff(backoff))
+	start := time.Now()
+	summoner, err := c.Summoner.GetByID("id")
+	require.Nil(t, err)
+	assert.Equal(t, "name", summoner.Name)
+	assert.Len(t, doer.Requests(), 4)
+	assert.GreaterOrEqual(t, int64(time.Since(start)), int64(70*time.Millisecond))
+
+	backoff.MaxAttempts = 3
+	doer = mock.NewUnavailableDoer(Summoner{}, 3)
+	c = NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger(), WithBackoff(backoff))
+	_, err = c.Summoner.GetByID("id")
+	assert.True(t, errors.Is(err, api.ErrServiceUnavailable))
+	assert.Len(t, doer.Requests(), 3)
+
+	backoff = Backoff{InitialDelay: time.Hour, MaxElapsedTime: time.Minute}
+	doer = mock.NewUnavailableDoer(Summoner{}, 1)
+	c = NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger(), WithBackoff(backoff))
+	_, err = c.Summoner.GetByID("id")
+	assert.True(t, errors.Is(err, api.ErrServiceUnavailable))
+	assert.Len(t, doer.Requests(), 1)
+
+	doer = mock.NewRateLimitDoer(Summoner{}, 2, 0)
+	c = NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger(),
+		WithBackoff(Backoff{MaxRateLimitAttempts: 2}))
+	_, err = c.Summoner.GetByID("id")
+	var rateLimitErr *api.RateLimitError
+	assert.True(t, errors.As(err, &rateLimitErr))
+	assert.Len(t, doer.Requests(), 2)
+}
+
+func TestWithRateLimitErrors(t *testing.T) {
+	t.Parallel()
+	doer := mock.NewSequenceDoer(mock.Step{
+		StatusCode: http.StatusTooManyRequests,
+		Header:     http.Header{"Retry-After": {"7"}, "X-Rate-Limit-Type": {api.RateLimitTypeMethod}},
+	}, mock.OK(Summoner{}))
+	c := NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger(), WithRateLimitErrors())
+	start := time.Now()
+	_, err := c.Summoner.GetByID("id")
+	assert.Less(t, int64(time.Since(start)), int64(time.Second))
+	var rateLimitErr *api.RateLimitError
+	require.True(t, errors.As(err, &rateLimitErr))
+	assert.Equal(t, api.RateLimitTypeMethod, rateLimitErr.Type)
+	assert.Equal(t, 7*time.Second, rateLimitErr.RetryAfter)
+	assert.True(t, errors.Is(err, api.ErrRateLimitExceeded))
+	assert.Len(t, doer.Requests(), 1)
+	assert.True(t, c.RateLimitStatus().ReadyAt("summoner-v4").After(time.Now()))
+	_, err = c.Summoner.GetByID("id")
+	assert.Nil(t, err)
+}
+
+func TestClient_retryAfterFallback(t *testing.T) {
+	t.Parallel()
+	header := http.Header{
+		headerAppRateLimit:      {"20:1,100:120"},
+		headerAppRateLimitCount: {"20:1,5:120"},
+	}
+	doer := mock.NewSequenceDoer(mock.Step{StatusCode: http.StatusTooManyRequests, Header: header},
+		mock.OK(Summoner{Name: "name"}))
+	c := NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger())
+	start := time.Now()
+	summoner, err := c.Summoner.GetByID("id")
+	require.Nil(t, err)
+	assert.Equal(t, "name", summoner.Name)
+	// the exhausted window of one second resets before the retry
+	assert.GreaterOrEqual(t, int64(time.Since(start)), int64(900*time.Millisecond))
+
+	date := time.Now().Add(time.Hour).UTC().Format(http.TimeFormat)
+	doer = mock.NewSequenceDoer(mock.Step{StatusCode: http.StatusTooManyRequests,
+		Header: http.Header{"Retry-After": {date}, "X-Rate-Limit-Type": {api.RateLimitTypeApplication}}})
+	c = NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger(), WithRateLimitErrors())
+	_, err = c.Summoner.GetByID("id")
+	var rateLimitErr *api.RateLimitError
+	require.True(t, errors.As(err, &rateLimitErr))
+	assert.InDelta(t, float64(time.Hour), float64(rateLimitErr.RetryAfter), float64(2*time.Second))
+	assert.True(t, c.RateLimitStatus().Throttled())
+
+	// without any information the exponential delay of the backoff is used
+	doer = mock.NewSequenceDoer(mock.Step{StatusCode: http.StatusTooManyRequests,
+		Header: http.Header{"Retry-After": {"soon"}}}, mock.OK(Summoner{}))
+	c = NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger(),
+		WithBackoff(Backoff{InitialDelay: 10 * time.Millisecond, MaxAttempts: 2}))
+	_, err = c.Summoner.GetByID("id")
+	require.Nil(t, err)
+	assert.Len(t, doer.Requests(), 2)
+}
+
+func TestClient_defaultBackoffRateLimited(t *testing.T) {
+	t.Parallel()
+	doer := mock.NewSequenceDoer(mock.RateLimited(3, 0), mock.OK(Summoner{Name: "name"}))
+	c := NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger())
+	summoner, err := c.Summoner.GetByID("id")
+	require.Nil(t, err)
+	assert.Equal(t, "name", summoner.Name)
+	assert.Len(t, doer.Requests(), 4)
+}
@@ -0,0 +1,52 @@
+package riot
+
+import (
+	"net/url"
+	"strings"
+)
+
+// routePlaceholder is replaced by the routing value of a request in the base URL, see WithBaseURL
+const routePlaceholder = "{route}"
+
+// KeyPlacement is the way the API key is sent with the requests
+type KeyPlacement int
+
+// All supported placements of the API key
+const (
+	// KeyInHeader sends the key in the X-Riot-Token header
+	KeyInHeader KeyPlacement = iota
+	// KeyInQuery sends the key as the api_key query parameter
+	KeyInQuery
+	// KeyOmitted sends no key, e.g. for proxies adding the key themselves
+	KeyOmitted
+)
+
+// WithBaseURL sends the requests to the base URL instead of the Riot API, e.g. to a proxy enforcing the rate limits
+// of all clients. The endpoint of a request is appended to the base URL and {route} is replaced by the routing value
+// of the request, i.e. the platform ID of the region or its continent, e.g. http://proxy:8080/{route}. Defaults to
+// https://{route}.api.riotgames.com
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		c.baseURL = strings.TrimRight(baseURL, "/")
+	}
+}
+
+// WithKeyPlacement sets the way the API key is sent with the requests. Defaults to KeyInHeader
+func WithKeyPlacement(placement KeyPlacement) Option {
+	return func(c *Client) {
+		c.keyPlacement = placement
+	}
+}
+
+// requestURL returns the URL of the endpoint requested using the routing value
+func (c *Client) requestURL(route, endpoint string) string {
+	u := strings.ReplaceAll(c.baseURL, routePlaceholder, route) + endpoint
+	if c.keyPlacement != KeyInQuery {
+		return u
+	}
+	separator := "?"
+	if strings.Contains(endpoint, "?") {
+		separator = "&"
+	}
+	return u + separator + "api_key=" + url.QueryEscape(c.apiKey(endpoint))
+}
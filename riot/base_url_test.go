@@ -0,0 +1,82 @@
+package riot
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mjourard/golio/api"
+	"github.com/mjourard/golio/mock"
+)
+
+func TestWithBaseURL(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		options    []Option
+		endpoint   string
+		wantURL    string
+		wantHeader string
+	}{
+		{
+			name:       "default",
+			endpoint:   "/lol/summoner/v4/summoners/id",
+			wantURL:    "https://euw1.api.riotgames.com/lol/summoner/v4/summoners/id",
+			wantHeader: "API KEY",
+		},
+		{
+			name:       "proxy",
+			options:    []Option{WithBaseURL("http://proxy:8080/{route}/")},
+			endpoint:   "/riot/account/v1/accounts/by-puuid/puuid",
+			wantURL:    "http://proxy:8080/europe/riot/account/v1/accounts/by-puuid/puuid",
+			wantHeader: "API KEY",
+		},
+		{
+			name:     "key in query",
+			options:  []Option{WithKeyPlacement(KeyInQuery)},
+			endpoint: "/lol/league/v4/entries/RANKED_SOLO_5x5/GOLD/I?page=1",
+			wantURL:  "https://euw1.api.riotgames.com/lol/league/v4/entries/RANKED_SOLO_5x5/GOLD/I?page=1&api_key=API+KEY",
+		},
+		{
+			name:     "key omitted",
+			options:  []Option{WithBaseURL("http://proxy"), WithKeyPlacement(KeyOmitted)},
+			endpoint: "/lol/status/v3/shard-data",
+			wantURL:  "http://proxy/lol/status/v3/shard-data",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClient(api.RegionEuropeWest, "API KEY", mock.NewStatusMockDoer(http.StatusOK),
+				logrus.StandardLogger(), tt.options...)
+			request, err := c.newRequest(context.Background(), http.MethodGet, tt.endpoint, nil)
+			require.Nil(t, err)
+			assert.Equal(t, tt.wantURL, request.URL.String())
+			assert.Equal(t, tt.wantHeader, request.Header.Get(apiTokenHeaderKey))
+		})
+	}
+}
+
+func TestKeyInQuery_redacted(t *testing.T) {
+	t.Parallel()
+	c := NewClient(api.RegionEuropeWest, "secret", mock.NewStatusMockDoer(http.StatusNotFound),
+		logrus.StandardLogger(), WithKeyPlacement(KeyInQuery))
+	_, err := c.Summoner.GetByID("id")
+	require.NotNil(t, err)
+	assert.NotContains(t, err.Error(), "secret")
+}
+
+func TestKeyInQuery_transportErrorRedacted(t *testing.T) {
+	t.Parallel()
+	c := NewClient(api.RegionEuropeWest, "SECRETKEY", http.DefaultClient, logrus.StandardLogger(),
+		WithKeyPlacement(KeyInQuery), WithBaseURL("http://127.0.0.1:1/{route}"))
+	_, err := c.Status.Get()
+	var transportErr *api.TransportError
+	require.True(t, errors.As(err, &transportErr))
+	assert.NotContains(t, err.Error(), "SECRETKEY")
+	assert.Contains(t, err.Error(), "api_key=REDACTED")
+}
@@ -0,0 +1,47 @@
+package riot
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/mjourard/golio/api"
+	"github.com/mjourard/golio/internal"
+)
+
+// Cache stores the bodies of responses by key with a time to live. The caches of package cache, e.g. cache.NewLRU,
+// implement it. It is the same interface as cache.Cache and datadragon.Cache
+type Cache = internal.Cache
+
+// WithCache serves GET requests of the client from the cache, so that identical requests within the ttl are not sent
+// to the Riot API again. Responses are stored by the region and the endpoint of the request, see CacheKey, after they
+// were decoded successfully. Errors of the cache are logged and never fail a request. Requests sent with a context
+// from WithoutCache bypass the cache
+func WithCache(cache Cache, ttl time.Duration) Option {
+	return func(c *Client) {
+		c.cache = cache
+		c.cacheTTL = ttl
+	}
+}
+
+type noCacheKey struct{}
+
+// WithoutCache returns a context whose requests are neither served from nor stored in the cache set by WithCache,
+// e.g. to fetch the current state of a live game:
+//
+//	game, err := client.WithContext(riot.WithoutCache(ctx)).Spectator.GetCurrent(summonerID)
+func WithoutCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noCacheKey{}, true)
+}
+
+// cacheDisabled returns whether the cache was disabled for the requests of ctx using WithoutCache
+func cacheDisabled(ctx context.Context) bool {
+	disabled, _ := ctx.Value(noCacheKey{}).(bool)
+	return disabled
+}
+
+// CacheKey returns the key of the response to a GET request of the endpoint in the region, e.g.
+// euw1/lol/summoner/v4/summoners/id. The endpoint may contain query parameters
+func CacheKey(region api.Region, endpoint string) string {
+	return string(region) + "/" + strings.TrimPrefix(endpoint, "/")
+}
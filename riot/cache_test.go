@@ -0,0 +1,105 @@
+package riot
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mjourard/golio/api"
+	"github.com/mjourard/golio/mock"
+)
+
+// mapCache is a Cache keeping the values and their ttl in maps
+type mapCache struct {
+	mu     sync.Mutex
+	values map[string][]byte
+	ttls   map[string]time.Duration
+	err    error
+}
+
+func newMapCache() *mapCache {
+	return &mapCache{values: map[string][]byte{}, ttls: map[string]time.Duration{}}
+}
+
+func (c *mapCache) Get(_ context.Context, key string) ([]byte, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	value, ok := c.values[key]
+	return value, ok, c.err
+}
+
+func (c *mapCache) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err != nil {
+		return c.err
+	}
+	c.values[key] = value
+	c.ttls[key] = ttl
+	return nil
+}
+
+func (c *mapCache) Delete(_ context.Context, key string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.values, key)
+	return nil
+}
+
+func TestCacheKey(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "euw1/lol/summoner/v4/summoners/id", CacheKey(api.RegionEuropeWest, "/lol/summoner/v4/summoners/id"))
+}
+
+func TestWithCache(t *testing.T) {
+	t.Parallel()
+	cache := newMapCache()
+	doer := mock.NewSequenceDoer(mock.OK(Summoner{Name: "first"}), mock.OK(Summoner{Name: "second"}))
+	var captured int
+	c := NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger(), WithCache(cache, time.Minute),
+		WithRawCapture(func(RawResponse) { captured++ }))
+	for i := 0; i < 2; i++ {
+		summoner, err := c.Summoner.GetByID("id")
+		require.Nil(t, err)
+		assert.Equal(t, "first", summoner.Name)
+	}
+	assert.Len(t, doer.Requests(), 1)
+	assert.Equal(t, 1, captured)
+	assert.Equal(t, time.Minute, cache.ttls["euw1/lol/summoner/v4/summoners/id"])
+
+	summoner, err := c.WithContext(WithoutCache(context.Background())).Summoner.GetByID("id")
+	require.Nil(t, err)
+	assert.Equal(t, "second", summoner.Name)
+	assert.Len(t, doer.Requests(), 2)
+
+	// other regions have their own entries
+	_, err = c.ForRegion(api.RegionKorea).Summoner.GetByID("id")
+	require.Nil(t, err)
+	assert.Len(t, doer.Requests(), 3)
+}
+
+func TestWithCache_errors(t *testing.T) {
+	t.Parallel()
+	cache := newMapCache()
+	cache.err = errors.New("unavailable")
+	doer := mock.NewSequenceDoer(mock.OK(Summoner{Name: "name"}))
+	c := NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger(), WithCache(cache, time.Minute))
+	summoner, err := c.Summoner.GetByID("id")
+	require.Nil(t, err)
+	assert.Equal(t, "name", summoner.Name)
+
+	// undecodable responses are not cached
+	cache = newMapCache()
+	c = NewClient(api.RegionEuropeWest, "API_KEY", mock.NewSequenceDoer(mock.OK("invalid")), logrus.StandardLogger(),
+		WithCache(cache, time.Minute))
+	_, err = c.Summoner.GetByID("id")
+	var decodeErr *api.DecodeError
+	assert.True(t, errors.As(err, &decodeErr))
+	assert.Empty(t, cache.values)
+}
@@ -1,6 +1,15 @@
 package riot
 
-import log "github.com/sirupsen/logrus"
+import (
+	"context"
+	"sort"
+	"strconv"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/mjourard/golio/datadragon"
+)
 
 type championClient struct {
 	c *Client
@@ -17,6 +26,121 @@ func (c *championClient) GetFreeRotation() (*ChampionInfo, error) {
 	return info, nil
 }
 
+// RotationChampion is a champion that entered or left the free champion rotation
+type RotationChampion struct {
+	ID   int
+	Name string
+}
+
+// FreeRotationChange value returned by WatchFreeRotation, containing either a change in the free champion rotation or
+// an error
+type FreeRotationChange struct {
+	*ChampionInfo
+	Added   []RotationChampion
+	Removed []RotationChampion
+	Error   error
+}
+
+// WatchFreeRotation polls the free champion rotation in the given interval and emits a value every time the rotation
+// changes. The first rotation fetched is used as baseline and is not emitted.
+// Added and removed champions are resolved to their names using the given Data Dragon client. If dd is nil or the
+// names can not be resolved the names are left empty and the resolution error is set on the emitted value.
+// Failed requests are emitted as errors and polling continues. The returned channel is closed once ctx is done.
+func (c *championClient) WatchFreeRotation(ctx context.Context, dd *datadragon.Client,
+	interval time.Duration) <-chan FreeRotationChange {
+	logger := c.logger().WithField("method", "WatchFreeRotation")
+	cChanges := make(chan FreeRotationChange, 10)
+	go func() {
+		defer close(cChanges)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		var previous *ChampionInfo
+		client := c.c.WithContext(ctx).Champion
+		for {
+			current, err := client.GetFreeRotation()
+			if err != nil {
+				logger.Debug(err)
+				if !sendRotationChange(ctx, cChanges, FreeRotationChange{Error: err}) {
+					return
+				}
+			} else if current != nil {
+				if previous != nil {
+					added, removed := diffChampionIDs(previous.FreeChampionIDs, current.FreeChampionIDs)
+					if len(added) > 0 || len(removed) > 0 {
+						change := FreeRotationChange{ChampionInfo: current}
+						change.Added, change.Error = resolveRotationChampions(dd, added)
+						var removedErr error
+						change.Removed, removedErr = resolveRotationChampions(dd, removed)
+						if change.Error == nil {
+							change.Error = removedErr
+						}
+						if !sendRotationChange(ctx, cChanges, change) {
+							return
+						}
+					}
+				}
+				previous = current
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return cChanges
+}
+
 func (c *championClient) logger() log.FieldLogger {
-	return c.c.logger().WithField("category", "champion")
+	return c.c.categoryLogger(LogCategoryChampion)
+}
+
+func sendRotationChange(ctx context.Context, c chan<- FreeRotationChange, change FreeRotationChange) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case c <- change:
+		return true
+	}
+}
+
+// diffChampionIDs returns the sorted IDs contained in current but not in previous and the other way around
+func diffChampionIDs(previous, current []int) (added, removed []int) {
+	before := make(map[int]bool, len(previous))
+	for _, id := range previous {
+		before[id] = true
+	}
+	after := make(map[int]bool, len(current))
+	for _, id := range current {
+		after[id] = true
+		if !before[id] {
+			added = append(added, id)
+		}
+	}
+	for _, id := range previous {
+		if !after[id] {
+			removed = append(removed, id)
+		}
+	}
+	sort.Ints(added)
+	sort.Ints(removed)
+	return added, removed
+}
+
+func resolveRotationChampions(dd *datadragon.Client, ids []int) ([]RotationChampion, error) {
+	res := make([]RotationChampion, 0, len(ids))
+	var resolveErr error
+	for _, id := range ids {
+		champion := RotationChampion{ID: id}
+		if dd != nil && resolveErr == nil {
+			data, err := dd.GetChampionByID(strconv.Itoa(id))
+			if err != nil {
+				resolveErr = err
+			} else {
+				champion.Name = data.Name
+			}
+		}
+		res = append(res, champion)
+	}
+	return res, resolveErr
 }
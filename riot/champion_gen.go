@@ -0,0 +1,461 @@
+// Code generated by golio-champions from Data Dragon 10.6.1. DO NOT EDIT.
+
+package riot
+
+// All champions of Data Dragon 10.6.1 by their ID
+const (
+	ChampionAnnie        Champion = 1
+	ChampionOlaf         Champion = 2
+	ChampionGalio        Champion = 3
+	ChampionTwistedFate  Champion = 4
+	ChampionXinZhao      Champion = 5
+	ChampionUrgot        Champion = 6
+	ChampionLeBlanc      Champion = 7
+	ChampionVladimir     Champion = 8
+	ChampionFiddlesticks Champion = 9
+	ChampionKayle        Champion = 10
+	ChampionMasterYi     Champion = 11
+	ChampionAlistar      Champion = 12
+	ChampionRyze         Champion = 13
+	ChampionSion         Champion = 14
+	ChampionSivir        Champion = 15
+	ChampionSoraka       Champion = 16
+	ChampionTeemo        Champion = 17
+	ChampionTristana     Champion = 18
+	ChampionWarwick      Champion = 19
+	ChampionNunuWillump  Champion = 20
+	ChampionMissFortune  Champion = 21
+	ChampionAshe         Champion = 22
+	ChampionTryndamere   Champion = 23
+	ChampionJax          Champion = 24
+	ChampionMorgana      Champion = 25
+	ChampionZilean       Champion = 26
+	ChampionSinged       Champion = 27
+	ChampionEvelynn      Champion = 28
+	ChampionTwitch       Champion = 29
+	ChampionKarthus      Champion = 30
+	ChampionChoGath      Champion = 31
+	ChampionAmumu        Champion = 32
+	ChampionRammus       Champion = 33
+	ChampionAnivia       Champion = 34
+	ChampionShaco        Champion = 35
+	ChampionDrMundo      Champion = 36
+	ChampionSona         Champion = 37
+	ChampionKassadin     Champion = 38
+	ChampionIrelia       Champion = 39
+	ChampionJanna        Champion = 40
+	ChampionGangplank    Champion = 41
+	ChampionCorki        Champion = 42
+	ChampionKarma        Champion = 43
+	ChampionTaric        Champion = 44
+	ChampionVeigar       Champion = 45
+	ChampionTrundle      Champion = 48
+	ChampionSwain        Champion = 50
+	ChampionCaitlyn      Champion = 51
+	ChampionBlitzcrank   Champion = 53
+	ChampionMalphite     Champion = 54
+	ChampionKatarina     Champion = 55
+	ChampionNocturne     Champion = 56
+	ChampionMaokai       Champion = 57
+	ChampionRenekton     Champion = 58
+	ChampionJarvanIV     Champion = 59
+	ChampionElise        Champion = 60
+	ChampionOrianna      Champion = 61
+	ChampionWukong       Champion = 62
+	ChampionBrand        Champion = 63
+	ChampionLeeSin       Champion = 64
+	ChampionVayne        Champion = 67
+	ChampionRumble       Champion = 68
+	ChampionCassiopeia   Champion = 69
+	ChampionSkarner      Champion = 72
+	ChampionHeimerdinger Champion = 74
+	ChampionNasus        Champion = 75
+	ChampionNidalee      Champion = 76
+	ChampionUdyr         Champion = 77
+	ChampionPoppy        Champion = 78
+	ChampionGragas       Champion = 79
+	ChampionPantheon     Champion = 80
+	ChampionEzreal       Champion = 81
+	ChampionMordekaiser  Champion = 82
+	ChampionYorick       Champion = 83
+	ChampionAkali        Champion = 84
+	ChampionKennen       Champion = 85
+	ChampionGaren        Champion = 86
+	ChampionLeona        Champion = 89
+	ChampionMalzahar     Champion = 90
+	ChampionTalon        Champion = 91
+	ChampionRiven        Champion = 92
+	ChampionKogMaw       Champion = 96
+	ChampionShen         Champion = 98
+	ChampionLux          Champion = 99
+	ChampionXerath       Champion = 101
+	ChampionShyvana      Champion = 102
+	ChampionAhri         Champion = 103
+	ChampionGraves       Champion = 104
+	ChampionFizz         Champion = 105
+	ChampionVolibear     Champion = 106
+	ChampionRengar       Champion = 107
+	ChampionVarus        Champion = 110
+	ChampionNautilus     Champion = 111
+	ChampionViktor       Champion = 112
+	ChampionSejuani      Champion = 113
+	ChampionFiora        Champion = 114
+	ChampionZiggs        Champion = 115
+	ChampionLulu         Champion = 117
+	ChampionDraven       Champion = 119
+	ChampionHecarim      Champion = 120
+	ChampionKhaZix       Champion = 121
+	ChampionDarius       Champion = 122
+	ChampionJayce        Champion = 126
+	ChampionLissandra    Champion = 127
+	ChampionDiana        Champion = 131
+	ChampionQuinn        Champion = 133
+	ChampionSyndra       Champion = 134
+	ChampionAurelionSol  Champion = 136
+	ChampionKayn         Champion = 141
+	ChampionZoe          Champion = 142
+	ChampionZyra         Champion = 143
+	ChampionKaiSa        Champion = 145
+	ChampionGnar         Champion = 150
+	ChampionZac          Champion = 154
+	ChampionYasuo        Champion = 157
+	ChampionVelKoz       Champion = 161
+	ChampionTaliyah      Champion = 163
+	ChampionCamille      Champion = 164
+	ChampionBraum        Champion = 201
+	ChampionJhin         Champion = 202
+	ChampionKindred      Champion = 203
+	ChampionJinx         Champion = 222
+	ChampionTahmKench    Champion = 223
+	ChampionSenna        Champion = 235
+	ChampionLucian       Champion = 236
+	ChampionZed          Champion = 238
+	ChampionKled         Champion = 240
+	ChampionEkko         Champion = 245
+	ChampionQiyana       Champion = 246
+	ChampionVi           Champion = 254
+	ChampionAatrox       Champion = 266
+	ChampionNami         Champion = 267
+	ChampionAzir         Champion = 268
+	ChampionYuumi        Champion = 350
+	ChampionThresh       Champion = 412
+	ChampionIllaoi       Champion = 420
+	ChampionRekSai       Champion = 421
+	ChampionIvern        Champion = 427
+	ChampionKalista      Champion = 429
+	ChampionBard         Champion = 432
+	ChampionRakan        Champion = 497
+	ChampionXayah        Champion = 498
+	ChampionOrnn         Champion = 516
+	ChampionSylas        Champion = 517
+	ChampionNeeko        Champion = 518
+	ChampionAphelios     Champion = 523
+	ChampionPyke         Champion = 555
+	ChampionSett         Champion = 875
+	ChampionLillia       Champion = 876
+)
+
+// Champions is a list of all champions sorted by ID
+var Champions = []Champion{
+	ChampionAnnie,
+	ChampionOlaf,
+	ChampionGalio,
+	ChampionTwistedFate,
+	ChampionXinZhao,
+	ChampionUrgot,
+	ChampionLeBlanc,
+	ChampionVladimir,
+	ChampionFiddlesticks,
+	ChampionKayle,
+	ChampionMasterYi,
+	ChampionAlistar,
+	ChampionRyze,
+	ChampionSion,
+	ChampionSivir,
+	ChampionSoraka,
+	ChampionTeemo,
+	ChampionTristana,
+	ChampionWarwick,
+	ChampionNunuWillump,
+	ChampionMissFortune,
+	ChampionAshe,
+	ChampionTryndamere,
+	ChampionJax,
+	ChampionMorgana,
+	ChampionZilean,
+	ChampionSinged,
+	ChampionEvelynn,
+	ChampionTwitch,
+	ChampionKarthus,
+	ChampionChoGath,
+	ChampionAmumu,
+	ChampionRammus,
+	ChampionAnivia,
+	ChampionShaco,
+	ChampionDrMundo,
+	ChampionSona,
+	ChampionKassadin,
+	ChampionIrelia,
+	ChampionJanna,
+	ChampionGangplank,
+	ChampionCorki,
+	ChampionKarma,
+	ChampionTaric,
+	ChampionVeigar,
+	ChampionTrundle,
+	ChampionSwain,
+	ChampionCaitlyn,
+	ChampionBlitzcrank,
+	ChampionMalphite,
+	ChampionKatarina,
+	ChampionNocturne,
+	ChampionMaokai,
+	ChampionRenekton,
+	ChampionJarvanIV,
+	ChampionElise,
+	ChampionOrianna,
+	ChampionWukong,
+	ChampionBrand,
+	ChampionLeeSin,
+	ChampionVayne,
+	ChampionRumble,
+	ChampionCassiopeia,
+	ChampionSkarner,
+	ChampionHeimerdinger,
+	ChampionNasus,
+	ChampionNidalee,
+	ChampionUdyr,
+	ChampionPoppy,
+	ChampionGragas,
+	ChampionPantheon,
+	ChampionEzreal,
+	ChampionMordekaiser,
+	ChampionYorick,
+	ChampionAkali,
+	ChampionKennen,
+	ChampionGaren,
+	ChampionLeona,
+	ChampionMalzahar,
+	ChampionTalon,
+	ChampionRiven,
+	ChampionKogMaw,
+	ChampionShen,
+	ChampionLux,
+	ChampionXerath,
+	ChampionShyvana,
+	ChampionAhri,
+	ChampionGraves,
+	ChampionFizz,
+	ChampionVolibear,
+	ChampionRengar,
+	ChampionVarus,
+	ChampionNautilus,
+	ChampionViktor,
+	ChampionSejuani,
+	ChampionFiora,
+	ChampionZiggs,
+	ChampionLulu,
+	ChampionDraven,
+	ChampionHecarim,
+	ChampionKhaZix,
+	ChampionDarius,
+	ChampionJayce,
+	ChampionLissandra,
+	ChampionDiana,
+	ChampionQuinn,
+	ChampionSyndra,
+	ChampionAurelionSol,
+	ChampionKayn,
+	ChampionZoe,
+	ChampionZyra,
+	ChampionKaiSa,
+	ChampionGnar,
+	ChampionZac,
+	ChampionYasuo,
+	ChampionVelKoz,
+	ChampionTaliyah,
+	ChampionCamille,
+	ChampionBraum,
+	ChampionJhin,
+	ChampionKindred,
+	ChampionJinx,
+	ChampionTahmKench,
+	ChampionSenna,
+	ChampionLucian,
+	ChampionZed,
+	ChampionKled,
+	ChampionEkko,
+	ChampionQiyana,
+	ChampionVi,
+	ChampionAatrox,
+	ChampionNami,
+	ChampionAzir,
+	ChampionYuumi,
+	ChampionThresh,
+	ChampionIllaoi,
+	ChampionRekSai,
+	ChampionIvern,
+	ChampionKalista,
+	ChampionBard,
+	ChampionRakan,
+	ChampionXayah,
+	ChampionOrnn,
+	ChampionSylas,
+	ChampionNeeko,
+	ChampionAphelios,
+	ChampionPyke,
+	ChampionSett,
+	ChampionLillia,
+}
+
+var championData = map[Champion]championInfo{
+	ChampionAnnie:        {"Annie", "Annie", "the Dark Child"},
+	ChampionOlaf:         {"Olaf", "Olaf", "the Berserker"},
+	ChampionGalio:        {"Galio", "Galio", "the Colossus"},
+	ChampionTwistedFate:  {"TwistedFate", "Twisted Fate", "the Card Master"},
+	ChampionXinZhao:      {"XinZhao", "Xin Zhao", "the Seneschal of Demacia"},
+	ChampionUrgot:        {"Urgot", "Urgot", "the Dreadnought"},
+	ChampionLeBlanc:      {"Leblanc", "LeBlanc", "the Deceiver"},
+	ChampionVladimir:     {"Vladimir", "Vladimir", "the Crimson Reaper"},
+	ChampionFiddlesticks: {"Fiddlesticks", "Fiddlesticks", "the Harbinger of Doom"},
+	ChampionKayle:        {"Kayle", "Kayle", "the Righteous"},
+	ChampionMasterYi:     {"MasterYi", "Master Yi", "the Wuju Bladesman"},
+	ChampionAlistar:      {"Alistar", "Alistar", "the Minotaur"},
+	ChampionRyze:         {"Ryze", "Ryze", "the Rune Mage"},
+	ChampionSion:         {"Sion", "Sion", "The Undead Juggernaut"},
+	ChampionSivir:        {"Sivir", "Sivir", "the Battle Mistress"},
+	ChampionSoraka:       {"Soraka", "Soraka", "the Starchild"},
+	ChampionTeemo:        {"Teemo", "Teemo", "the Swift Scout"},
+	ChampionTristana:     {"Tristana", "Tristana", "the Yordle Gunner"},
+	ChampionWarwick:      {"Warwick", "Warwick", "the Uncaged Wrath of Zaun"},
+	ChampionNunuWillump:  {"Nunu", "Nunu & Willump", "the Boy and His Yeti"},
+	ChampionMissFortune:  {"MissFortune", "Miss Fortune", "the Bounty Hunter"},
+	ChampionAshe:         {"Ashe", "Ashe", "the Frost Archer"},
+	ChampionTryndamere:   {"Tryndamere", "Tryndamere", "the Barbarian King"},
+	ChampionJax:          {"Jax", "Jax", "Grandmaster at Arms"},
+	ChampionMorgana:      {"Morgana", "Morgana", "the Fallen"},
+	ChampionZilean:       {"Zilean", "Zilean", "the Chronokeeper"},
+	ChampionSinged:       {"Singed", "Singed", "the Mad Chemist"},
+	ChampionEvelynn:      {"Evelynn", "Evelynn", "Agony's Embrace"},
+	ChampionTwitch:       {"Twitch", "Twitch", "the Plague Rat"},
+	ChampionKarthus:      {"Karthus", "Karthus", "the Deathsinger"},
+	ChampionChoGath:      {"Chogath", "Cho'Gath", "the Terror of the Void"},
+	ChampionAmumu:        {"Amumu", "Amumu", "the Sad Mummy"},
+	ChampionRammus:       {"Rammus", "Rammus", "the Armordillo"},
+	ChampionAnivia:       {"Anivia", "Anivia", "the Cryophoenix"},
+	ChampionShaco:        {"Shaco", "Shaco", "the Demon Jester"},
+	ChampionDrMundo:      {"DrMundo", "Dr. Mundo", "the Madman of Zaun"},
+	ChampionSona:         {"Sona", "Sona", "Maven of the Strings"},
+	ChampionKassadin:     {"Kassadin", "Kassadin", "the Void Walker"},
+	ChampionIrelia:       {"Irelia", "Irelia", "the Blade Dancer"},
+	ChampionJanna:        {"Janna", "Janna", "the Storm's Fury"},
+	ChampionGangplank:    {"Gangplank", "Gangplank", "the Saltwater Scourge"},
+	ChampionCorki:        {"Corki", "Corki", "the Daring Bombardier"},
+	ChampionKarma:        {"Karma", "Karma", "the Enlightened One"},
+	ChampionTaric:        {"Taric", "Taric", "the Shield of Valoran"},
+	ChampionVeigar:       {"Veigar", "Veigar", "the Tiny Master of Evil"},
+	ChampionTrundle:      {"Trundle", "Trundle", "the Troll King"},
+	ChampionSwain:        {"Swain", "Swain", "the Noxian Grand General"},
+	ChampionCaitlyn:      {"Caitlyn", "Caitlyn", "the Sheriff of Piltover"},
+	ChampionBlitzcrank:   {"Blitzcrank", "Blitzcrank", "the Great Steam Golem"},
+	ChampionMalphite:     {"Malphite", "Malphite", "Shard of the Monolith"},
+	ChampionKatarina:     {"Katarina", "Katarina", "the Sinister Blade"},
+	ChampionNocturne:     {"Nocturne", "Nocturne", "the Eternal Nightmare"},
+	ChampionMaokai:       {"Maokai", "Maokai", "the Twisted Treant"},
+	ChampionRenekton:     {"Renekton", "Renekton", "the Butcher of the Sands"},
+	ChampionJarvanIV:     {"JarvanIV", "Jarvan IV", "the Exemplar of Demacia"},
+	ChampionElise:        {"Elise", "Elise", "the Spider Queen"},
+	ChampionOrianna:      {"Orianna", "Orianna", "the Lady of Clockwork"},
+	ChampionWukong:       {"MonkeyKing", "Wukong", "the Monkey King"},
+	ChampionBrand:        {"Brand", "Brand", "the Burning Vengeance"},
+	ChampionLeeSin:       {"LeeSin", "Lee Sin", "the Blind Monk"},
+	ChampionVayne:        {"Vayne", "Vayne", "the Night Hunter"},
+	ChampionRumble:       {"Rumble", "Rumble", "the Mechanized Menace"},
+	ChampionCassiopeia:   {"Cassiopeia", "Cassiopeia", "the Serpent's Embrace"},
+	ChampionSkarner:      {"Skarner", "Skarner", "the Crystal Vanguard"},
+	ChampionHeimerdinger: {"Heimerdinger", "Heimerdinger", "the Revered Inventor"},
+	ChampionNasus:        {"Nasus", "Nasus", "the Curator of the Sands"},
+	ChampionNidalee:      {"Nidalee", "Nidalee", "the Bestial Huntress"},
+	ChampionUdyr:         {"Udyr", "Udyr", "the Spirit Walker"},
+	ChampionPoppy:        {"Poppy", "Poppy", "Keeper of the Hammer"},
+	ChampionGragas:       {"Gragas", "Gragas", "the Rabble Rouser"},
+	ChampionPantheon:     {"Pantheon", "Pantheon", "the Unbreakable Spear"},
+	ChampionEzreal:       {"Ezreal", "Ezreal", "the Prodigal Explorer"},
+	ChampionMordekaiser:  {"Mordekaiser", "Mordekaiser", "the Iron Revenant"},
+	ChampionYorick:       {"Yorick", "Yorick", "Shepherd of Souls"},
+	ChampionAkali:        {"Akali", "Akali", "the Rogue Assassin"},
+	ChampionKennen:       {"Kennen", "Kennen", "the Heart of the Tempest"},
+	ChampionGaren:        {"Garen", "Garen", "The Might of Demacia"},
+	ChampionLeona:        {"Leona", "Leona", "the Radiant Dawn"},
+	ChampionMalzahar:     {"Malzahar", "Malzahar", "the Prophet of the Void"},
+	ChampionTalon:        {"Talon", "Talon", "the Blade's Shadow"},
+	ChampionRiven:        {"Riven", "Riven", "the Exile"},
+	ChampionKogMaw:       {"KogMaw", "Kog'Maw", "the Mouth of the Abyss"},
+	ChampionShen:         {"Shen", "Shen", "the Eye of Twilight"},
+	ChampionLux:          {"Lux", "Lux", "the Lady of Luminosity"},
+	ChampionXerath:       {"Xerath", "Xerath", "the Magus Ascendant"},
+	ChampionShyvana:      {"Shyvana", "Shyvana", "the Half-Dragon"},
+	ChampionAhri:         {"Ahri", "Ahri", "the Nine-Tailed Fox"},
+	ChampionGraves:       {"Graves", "Graves", "the Outlaw"},
+	ChampionFizz:         {"Fizz", "Fizz", "the Tidal Trickster"},
+	ChampionVolibear:     {"Volibear", "Volibear", "the Thunder's Roar"},
+	ChampionRengar:       {"Rengar", "Rengar", "the Pridestalker"},
+	ChampionVarus:        {"Varus", "Varus", "the Arrow of Retribution"},
+	ChampionNautilus:     {"Nautilus", "Nautilus", "the Titan of the Depths"},
+	ChampionViktor:       {"Viktor", "Viktor", "the Machine Herald"},
+	ChampionSejuani:      {"Sejuani", "Sejuani", "Fury of the North"},
+	ChampionFiora:        {"Fiora", "Fiora", "the Grand Duelist"},
+	ChampionZiggs:        {"Ziggs", "Ziggs", "the Hexplosives Expert"},
+	ChampionLulu:         {"Lulu", "Lulu", "the Fae Sorceress"},
+	ChampionDraven:       {"Draven", "Draven", "the Glorious Executioner"},
+	ChampionHecarim:      {"Hecarim", "Hecarim", "the Shadow of War"},
+	ChampionKhaZix:       {"Khazix", "Kha'Zix", "the Voidreaver"},
+	ChampionDarius:       {"Darius", "Darius", "the Hand of Noxus"},
+	ChampionJayce:        {"Jayce", "Jayce", "the Defender of Tomorrow"},
+	ChampionLissandra:    {"Lissandra", "Lissandra", "the Ice Witch"},
+	ChampionDiana:        {"Diana", "Diana", "Scorn of the Moon"},
+	ChampionQuinn:        {"Quinn", "Quinn", "Demacia's Wings"},
+	ChampionSyndra:       {"Syndra", "Syndra", "the Dark Sovereign"},
+	ChampionAurelionSol:  {"AurelionSol", "Aurelion Sol", "The Star Forger"},
+	ChampionKayn:         {"Kayn", "Kayn", "the Shadow Reaper"},
+	ChampionZoe:          {"Zoe", "Zoe", "the Aspect of Twilight"},
+	ChampionZyra:         {"Zyra", "Zyra", "Rise of the Thorns"},
+	ChampionKaiSa:        {"Kaisa", "Kai'Sa", "Daughter of the Void"},
+	ChampionGnar:         {"Gnar", "Gnar", "the Missing Link"},
+	ChampionZac:          {"Zac", "Zac", "the Secret Weapon"},
+	ChampionYasuo:        {"Yasuo", "Yasuo", "the Unforgiven"},
+	ChampionVelKoz:       {"Velkoz", "Vel'Koz", "the Eye of the Void"},
+	ChampionTaliyah:      {"Taliyah", "Taliyah", "the Stoneweaver"},
+	ChampionCamille:      {"Camille", "Camille", "the Steel Shadow"},
+	ChampionBraum:        {"Braum", "Braum", "the Heart of the Freljord"},
+	ChampionJhin:         {"Jhin", "Jhin", "the Virtuoso"},
+	ChampionKindred:      {"Kindred", "Kindred", "The Eternal Hunters"},
+	ChampionJinx:         {"Jinx", "Jinx", "the Loose Cannon"},
+	ChampionTahmKench:    {"TahmKench", "Tahm Kench", "the River King"},
+	ChampionSenna:        {"Senna", "Senna", "the Redeemer"},
+	ChampionLucian:       {"Lucian", "Lucian", "the Purifier"},
+	ChampionZed:          {"Zed", "Zed", "the Master of Shadows"},
+	ChampionKled:         {"Kled", "Kled", "the Cantankerous Cavalier"},
+	ChampionEkko:         {"Ekko", "Ekko", "the Boy Who Shattered Time"},
+	ChampionQiyana:       {"Qiyana", "Qiyana", "Empress of the Elements"},
+	ChampionVi:           {"Vi", "Vi", "the Piltover Enforcer"},
+	ChampionAatrox:       {"Aatrox", "Aatrox", "the Darkin Blade"},
+	ChampionNami:         {"Nami", "Nami", "the Tidecaller"},
+	ChampionAzir:         {"Azir", "Azir", "the Emperor of the Sands"},
+	ChampionYuumi:        {"Yuumi", "Yuumi", "the Magical Cat"},
+	ChampionThresh:       {"Thresh", "Thresh", "the Chain Warden"},
+	ChampionIllaoi:       {"Illaoi", "Illaoi", "the Kraken Priestess"},
+	ChampionRekSai:       {"RekSai", "Rek'Sai", "the Void Burrower"},
+	ChampionIvern:        {"Ivern", "Ivern", "the Green Father"},
+	ChampionKalista:      {"Kalista", "Kalista", "the Spear of Vengeance"},
+	ChampionBard:         {"Bard", "Bard", "the Wandering Caretaker"},
+	ChampionRakan:        {"Rakan", "Rakan", "The Charmer"},
+	ChampionXayah:        {"Xayah", "Xayah", "the Rebel"},
+	ChampionOrnn:         {"Ornn", "Ornn", "The Fire below the Mountain"},
+	ChampionSylas:        {"Sylas", "Sylas", "the Unshackled"},
+	ChampionNeeko:        {"Neeko", "Neeko", "the Curious Chameleon"},
+	ChampionAphelios:     {"Aphelios", "Aphelios", "the Weapon of the Faithful"},
+	ChampionPyke:         {"Pyke", "Pyke", "the Bloodharbor Ripper"},
+	ChampionSett:         {"Sett", "Sett", "the Boss"},
+	ChampionLillia:       {"Lillia", "Lillia", "the Bashful Bloom"},
+}
@@ -0,0 +1,60 @@
+package riot
+
+import (
+	"strconv"
+	"strings"
+)
+
+//go:generate go run ../cmd/golio-champions -o champion_gen.go
+
+// Champion is the numeric ID of a champion as used by the Riot API, e.g. in champion masteries and matches.
+// The constants of all champions are generated from Data Dragon, e.g. ChampionAhri
+type Champion int
+
+type championInfo struct {
+	key   string
+	name  string
+	title string
+}
+
+// String returns the name of the champion, e.g. "Kai'Sa", or "Champion <id>" for unknown champions
+func (c Champion) String() string {
+	if info, ok := championData[c]; ok {
+		return info.name
+	}
+	return "Champion " + strconv.Itoa(int(c))
+}
+
+// Name returns the name of the champion, e.g. "Kai'Sa", or an empty string for unknown champions
+func (c Champion) Name() string {
+	return championData[c].name
+}
+
+// Title returns the title of the champion, e.g. "Daughter of the Void", or an empty string for unknown champions
+func (c Champion) Title() string {
+	return championData[c].title
+}
+
+// Key returns the ID of the champion used by Data Dragon, e.g. "Kaisa", or an empty string for unknown champions
+func (c Champion) Key() string {
+	return championData[c].key
+}
+
+// IsKnown returns whether the champion is one of the champions in Champions. Champions released after the
+// constants were generated are unknown
+func (c Champion) IsKnown() bool {
+	_, ok := championData[c]
+	return ok
+}
+
+// ChampionByName returns the champion with the given name or Data Dragon ID, ignoring case, e.g. "kai'sa" or
+// "Kaisa". The second return value is false if no such champion is known
+func ChampionByName(name string) (Champion, bool) {
+	name = strings.TrimSpace(name)
+	for c, info := range championData {
+		if strings.EqualFold(info.name, name) || strings.EqualFold(info.key, name) {
+			return c, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,85 @@
+package riot
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChampion(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		champion  Champion
+		want      string
+		wantName  string
+		wantTitle string
+		wantKey   string
+	}{
+		{
+			name:      "known",
+			champion:  ChampionAhri,
+			want:      "Ahri",
+			wantName:  "Ahri",
+			wantTitle: "the Nine-Tailed Fox",
+			wantKey:   "Ahri",
+		},
+		{
+			name:      "different key",
+			champion:  62,
+			want:      "Wukong",
+			wantName:  "Wukong",
+			wantTitle: "the Monkey King",
+			wantKey:   "MonkeyKing",
+		},
+		{
+			name:     "unknown",
+			champion: 99999,
+			want:     "Champion 99999",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.champion.String())
+			assert.Equal(t, tt.wantName, tt.champion.Name())
+			assert.Equal(t, tt.wantTitle, tt.champion.Title())
+			assert.Equal(t, tt.wantKey, tt.champion.Key())
+			assert.Equal(t, tt.wantName != "", tt.champion.IsKnown())
+		})
+	}
+}
+
+func TestChampions(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, Champion(103), ChampionAhri)
+	assert.Len(t, Champions, len(championData))
+	assert.True(t, sort.SliceIsSorted(Champions, func(i, j int) bool { return Champions[i] < Champions[j] }))
+	for _, c := range Champions {
+		got, ok := ChampionByName(c.Name())
+		assert.True(t, ok)
+		assert.Equal(t, c, got)
+	}
+}
+
+func TestChampionByName(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		want   Champion
+		wantOK bool
+	}{
+		{name: "Kai'Sa", want: ChampionKaiSa, wantOK: true},
+		{name: " kai'sa ", want: ChampionKaiSa, wantOK: true},
+		{name: "Kaisa", want: ChampionKaiSa, wantOK: true},
+		{name: "monkeyking", want: ChampionWukong, wantOK: true},
+		{name: "Unknown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ChampionByName(tt.name)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -13,6 +13,10 @@ type championMasteryClient struct {
 // List returns information about masteries for the summoner with the given ID
 func (c *championMasteryClient) List(summonerID string) ([]*ChampionMastery, error) {
 	logger := c.logger().WithField("method", "List")
+	if err := validateID("summonerID", summonerID); err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
 	var masteries []*ChampionMastery
 	if err := c.c.getInto(
 		fmt.Sprintf(endpointGetChampionMasteries, summonerID),
@@ -28,6 +32,10 @@ func (c *championMasteryClient) List(summonerID string) ([]*ChampionMastery, err
 // given ID has
 func (c *championMasteryClient) Get(summonerID, championID string) (*ChampionMastery, error) {
 	logger := c.logger().WithField("method", "Get")
+	if err := validateIDs("summonerID", summonerID, "championID", championID); err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
 	var mastery *ChampionMastery
 	if err := c.c.getInto(
 		fmt.Sprintf(endpointGetChampionMastery, summonerID, championID),
@@ -43,6 +51,10 @@ func (c *championMasteryClient) Get(summonerID, championID string) (*ChampionMas
 // given ID
 func (c *championMasteryClient) GetTotal(summonerID string) (int, error) {
 	logger := c.logger().WithField("method", "GetTotal")
+	if err := validateID("summonerID", summonerID); err != nil {
+		logger.Debug(err)
+		return 0, err
+	}
 	var score int
 	if err := c.c.getInto(fmt.Sprintf(endpointGetChampionMasteryTotalScore, summonerID), &score); err != nil {
 		logger.Debug(err)
@@ -52,5 +64,5 @@ func (c *championMasteryClient) GetTotal(summonerID string) (int, error) {
 }
 
 func (c *championMasteryClient) logger() log.FieldLogger {
-	return c.c.logger().WithField("category", "champion mastery")
+	return c.c.categoryLogger(LogCategoryChampionMastery)
 }
@@ -0,0 +1,72 @@
+package riot
+
+import (
+	"fmt"
+	"strings"
+)
+
+// The rules of the mastery system. Levels up to masteryTokenLevel are reached with champion points, the levels above
+// need tokens earned by playing games with a high grade at the previous level
+const (
+	maxMasteryLevel   = 7
+	masteryTokenLevel = 5
+)
+
+// masteryTokensNeeded is the number of tokens needed to level up from the given level
+var masteryTokensNeeded = map[int]int{
+	5: 2,
+	6: 3,
+}
+
+// IsMaxLevel returns true if the champion is at the highest mastery level
+func (m *ChampionMastery) IsMaxLevel() bool {
+	return m.ChampionLevel >= maxMasteryLevel
+}
+
+// PointsToNextLevel returns the champion points needed to reach the next level. Levels above 5 need tokens instead
+// of points, so 0 is returned from level 5 on, see TokensToNextLevel
+func (m *ChampionMastery) PointsToNextLevel() int {
+	if m.ChampionLevel >= masteryTokenLevel || m.ChampionPointsUntilNextLevel < 0 {
+		return 0
+	}
+	return m.ChampionPointsUntilNextLevel
+}
+
+// TokensToNextLevel returns the number of tokens still needed to reach the next level or 0 if the next level is
+// reached with points or the champion is at the highest level
+func (m *ChampionMastery) TokensToNextLevel() int {
+	needed := masteryTokensNeeded[m.ChampionLevel] - m.TokensEarned
+	if needed < 0 {
+		return 0
+	}
+	return needed
+}
+
+// CanLevelUp returns true if the summoner earned all tokens needed for the next level. The level up happens when the
+// summoner combines the tokens with an essence or shard in the client
+func (m *ChampionMastery) CanLevelUp() bool {
+	needed, ok := masteryTokensNeeded[m.ChampionLevel]
+	return ok && m.TokensEarned >= needed
+}
+
+// Summary returns a short human readable description of the mastery progress and chest availability, e.g.
+// "level 6, 1/3 tokens, chest available"
+func (m *ChampionMastery) Summary() string {
+	parts := []string{fmt.Sprintf("level %d", m.ChampionLevel)}
+	switch {
+	case m.IsMaxLevel():
+		parts = append(parts, "max level")
+	case m.CanLevelUp():
+		parts = append(parts, "ready to level up")
+	case m.ChampionLevel >= masteryTokenLevel:
+		parts = append(parts, fmt.Sprintf("%d/%d tokens", m.TokensEarned, masteryTokensNeeded[m.ChampionLevel]))
+	default:
+		parts = append(parts, fmt.Sprintf("%d points to level %d", m.PointsToNextLevel(), m.ChampionLevel+1))
+	}
+	if m.ChestGranted {
+		parts = append(parts, "chest granted")
+	} else {
+		parts = append(parts, "chest available")
+	}
+	return strings.Join(parts, ", ")
+}
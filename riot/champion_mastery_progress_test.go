@@ -0,0 +1,60 @@
+package riot
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChampionMastery_progress(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		mastery     ChampionMastery
+		wantPoints  int
+		wantTokens  int
+		wantLevelUp bool
+		wantMax     bool
+		wantSummary string
+	}{
+		{
+			name:        "points",
+			mastery:     ChampionMastery{ChampionLevel: 3, ChampionPointsUntilNextLevel: 1200},
+			wantPoints:  1200,
+			wantSummary: "level 3, 1200 points to level 4, chest available",
+		},
+		{
+			name:        "tokens",
+			mastery:     ChampionMastery{ChampionLevel: 6, TokensEarned: 1, ChestGranted: true},
+			wantTokens:  2,
+			wantSummary: "level 6, 1/3 tokens, chest granted",
+		},
+		{
+			name:        "no tokens",
+			mastery:     ChampionMastery{ChampionLevel: 5, ChampionPointsUntilNextLevel: -3000},
+			wantTokens:  2,
+			wantSummary: "level 5, 0/2 tokens, chest available",
+		},
+		{
+			name:        "level up",
+			mastery:     ChampionMastery{ChampionLevel: 5, TokensEarned: 2},
+			wantLevelUp: true,
+			wantSummary: "level 5, ready to level up, chest available",
+		},
+		{
+			name:        "max level",
+			mastery:     ChampionMastery{ChampionLevel: 7, ChestGranted: true},
+			wantMax:     true,
+			wantSummary: "level 7, max level, chest granted",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.wantPoints, test.mastery.PointsToNextLevel())
+			assert.Equal(t, test.wantTokens, test.mastery.TokensToNextLevel())
+			assert.Equal(t, test.wantLevelUp, test.mastery.CanLevelUp())
+			assert.Equal(t, test.wantMax, test.mastery.IsMaxLevel())
+			assert.Equal(t, test.wantSummary, test.mastery.Summary())
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package riot
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
@@ -11,7 +12,7 @@ import (
 
 	"github.com/mjourard/golio/api"
 	"github.com/mjourard/golio/internal"
-	"github.com/mjourard/golio/internal/mock"
+	"github.com/mjourard/golio/mock"
 )
 
 func TestChampionMasteryClient_List(t *testing.T) {
@@ -43,12 +44,12 @@ func TestChampionMasteryClient_List(t *testing.T) {
 		{
 			name: "rate limited",
 			want: []*ChampionMastery{},
-			doer: rateLimitDoer([]*ChampionMastery{}),
+			doer: mock.NewRateLimitDoer([]*ChampionMastery{}, 1, 1),
 		},
 		{
 			name: "unavailable once",
 			want: []*ChampionMastery{},
-			doer: unavailableOnceDoer([]*ChampionMastery{}),
+			doer: mock.NewUnavailableDoer([]*ChampionMastery{}, 1),
 		},
 		{
 			name:    "unavailable twice",
@@ -60,7 +61,7 @@ func TestChampionMasteryClient_List(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			client := NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
 			got, err := client.ChampionMastery.List("id")
-			require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+			require.True(t, errors.Is(err, tt.wantErr), fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
 			if tt.wantErr == nil {
 				assert.Equal(t, got, tt.want)
 			}
@@ -97,12 +98,12 @@ func TestChampionMasteryClient_Get(t *testing.T) {
 		{
 			name: "rate limited",
 			want: &ChampionMastery{},
-			doer: rateLimitDoer(&ChampionMastery{}),
+			doer: mock.NewRateLimitDoer(&ChampionMastery{}, 1, 1),
 		},
 		{
 			name: "unavailable once",
 			want: &ChampionMastery{},
-			doer: unavailableOnceDoer(&ChampionMastery{}),
+			doer: mock.NewUnavailableDoer(&ChampionMastery{}, 1),
 		},
 		{
 			name:    "unavailable twice",
@@ -114,7 +115,7 @@ func TestChampionMasteryClient_Get(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			client := NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
 			got, err := client.ChampionMastery.Get("id", "id")
-			require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+			require.True(t, errors.Is(err, tt.wantErr), fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
 			if tt.wantErr == nil {
 				assert.Equal(t, got, tt.want)
 			}
@@ -151,12 +152,12 @@ func TestChampionMasteryClient_GetTotal(t *testing.T) {
 		{
 			name: "rate limited",
 			want: 1,
-			doer: rateLimitDoer(1),
+			doer: mock.NewRateLimitDoer(1, 1, 1),
 		},
 		{
 			name: "unavailable once",
 			want: 1,
-			doer: unavailableOnceDoer(1),
+			doer: mock.NewUnavailableDoer(1, 1),
 		},
 		{
 			name:    "unavailable twice",
@@ -168,7 +169,7 @@ func TestChampionMasteryClient_GetTotal(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			client := NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
 			got, err := client.ChampionMastery.GetTotal("id")
-			require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+			require.True(t, errors.Is(err, tt.wantErr), fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
 			if tt.wantErr == nil {
 				assert.Equal(t, got, tt.want)
 			}
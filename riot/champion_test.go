@@ -1,17 +1,22 @@
 package riot
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/mjourard/golio/api"
+	"github.com/mjourard/golio/datadragon"
 	"github.com/mjourard/golio/internal"
-	"github.com/mjourard/golio/internal/mock"
+	"github.com/mjourard/golio/mock"
 )
 
 func TestChampionClient_GetFreeRotation(t *testing.T) {
@@ -43,12 +48,12 @@ func TestChampionClient_GetFreeRotation(t *testing.T) {
 		{
 			name: "rate limited",
 			want: &ChampionInfo{},
-			doer: rateLimitDoer(ChampionInfo{}),
+			doer: mock.NewRateLimitDoer(ChampionInfo{}, 1, 1),
 		},
 		{
 			name: "unavailable once",
 			want: &ChampionInfo{},
-			doer: unavailableOnceDoer(ChampionInfo{}),
+			doer: mock.NewUnavailableDoer(ChampionInfo{}, 1),
 		},
 		{
 			name:    "unavailable twice",
@@ -60,10 +65,95 @@ func TestChampionClient_GetFreeRotation(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			client := NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
 			got, err := client.Champion.GetFreeRotation()
-			require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+			require.True(t, errors.Is(err, tt.wantErr), fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
 			if tt.wantErr == nil {
 				assert.Equal(t, got, tt.want)
 			}
 		})
 	}
 }
+
+func TestChampionClient_WatchFreeRotation(t *testing.T) {
+	t.Parallel()
+	dd := datadragon.NewClient(dataDragonResponseDoer(map[string]datadragon.ChampionData{
+		"champion1": {ID: "1", Name: "champion1"},
+		"champion2": {ID: "2", Name: "champion2"},
+		"champion3": {ID: "3", Name: "champion3"},
+	}), api.RegionEuropeWest, logrus.StandardLogger())
+	tests := []struct {
+		name string
+		doer internal.Doer
+		dd   *datadragon.Client
+		want FreeRotationChange
+	}{
+		{
+			name: "rotation changed",
+			doer: sequenceDoer(
+				ChampionInfo{FreeChampionIDs: []int{1, 2}},
+				ChampionInfo{FreeChampionIDs: []int{1, 2}},
+				ChampionInfo{FreeChampionIDs: []int{2, 3}},
+			),
+			dd: dd,
+			want: FreeRotationChange{
+				ChampionInfo: &ChampionInfo{FreeChampionIDs: []int{2, 3}},
+				Added:        []RotationChampion{{ID: 3, Name: "champion3"}},
+				Removed:      []RotationChampion{{ID: 1, Name: "champion1"}},
+			},
+		},
+		{
+			name: "without data dragon",
+			doer: sequenceDoer(
+				ChampionInfo{FreeChampionIDs: []int{1}},
+				ChampionInfo{FreeChampionIDs: []int{2}},
+			),
+			want: FreeRotationChange{
+				ChampionInfo: &ChampionInfo{FreeChampionIDs: []int{2}},
+				Added:        []RotationChampion{{ID: 2}},
+				Removed:      []RotationChampion{{ID: 1}},
+			},
+		},
+		{
+			name: "request error",
+			doer: mock.NewStatusMockDoer(http.StatusNotFound),
+			want: FreeRotationChange{Error: api.ErrNotFound},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			client := NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
+			changes := client.Champion.WatchFreeRotation(ctx, tt.dd, time.Millisecond)
+			select {
+			case got := <-changes:
+				if tt.want.Error != nil {
+					assert.True(t, errors.Is(got.Error, tt.want.Error), "got error %v", got.Error)
+					got.Error = tt.want.Error
+				}
+				assert.Equal(t, tt.want, got)
+			case <-time.After(time.Second):
+				t.Fatal("no change emitted")
+			}
+			cancel()
+			for range changes {
+			}
+		})
+	}
+}
+
+// sequenceDoer responds with the given objects in order, repeating the last object once all others have been used
+func sequenceDoer(objects ...interface{}) internal.Doer {
+	mu := sync.Mutex{}
+	i := 0
+	return &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			object := objects[i]
+			if i < len(objects)-1 {
+				i++
+			}
+			return mock.NewJSONMockDoer(object, 200).Do(r)
+		},
+	}
+}
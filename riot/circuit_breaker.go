@@ -0,0 +1,98 @@
+package riot
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/mjourard/golio/api"
+)
+
+// WithCircuitBreaker opens a circuit for a region and endpoint family after the given number of consecutive responses
+// with status 5xx, e.g. during an incident of the Riot platform. While the circuit is open, requests of the family to
+// the region fail with an *api.CircuitOpenError matching api.ErrCircuitOpen without being sent. After the cooldown a
+// single request is sent to test the service: the circuit closes if it succeeds and opens again otherwise. The
+// circuits are shared by all views of the client
+func WithCircuitBreaker(failures int, cooldown time.Duration) Option {
+	return func(c *Client) {
+		c.circuits = &circuitBreaker{failures: failures, cooldown: cooldown}
+	}
+}
+
+// circuitBreaker keeps the circuits of the regions and endpoint families
+type circuitBreaker struct {
+	failures int
+	cooldown time.Duration
+	mu       sync.Mutex
+	circuits map[circuitKey]*circuit
+}
+
+type circuitKey struct {
+	region api.Region
+	family string
+}
+
+// circuit is the state of a single region and endpoint family
+type circuit struct {
+	// failures is the number of consecutive responses with status 5xx
+	failures  int
+	open      bool
+	openUntil time.Time
+	// probing is set while the request testing the service after the cooldown is sent
+	probing bool
+}
+
+// circuit returns the circuit of the region and family. The lock must be held
+func (b *circuitBreaker) circuit(region api.Region, family string) *circuit {
+	if b.circuits == nil {
+		b.circuits = map[circuitKey]*circuit{}
+	}
+	key := circuitKey{region: region, family: family}
+	if b.circuits[key] == nil {
+		b.circuits[key] = &circuit{}
+	}
+	return b.circuits[key]
+}
+
+// allow returns an *api.CircuitOpenError if no request may be sent for the region and family. Otherwise the result of
+// the request has to be passed to record together with probe, which is true if the request tests the service after the
+// cooldown
+func (b *circuitBreaker) allow(region api.Region, family string, now time.Time) (probe bool, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	c := b.circuit(region, family)
+	if !c.open {
+		return false, nil
+	}
+	if now.Before(c.openUntil) || c.probing {
+		return false, &api.CircuitOpenError{Region: region, Family: family, Until: c.openUntil}
+	}
+	c.probing = true
+	return true, nil
+}
+
+// record updates the circuit with the status code of a response, or 0 if no response was received. Only the probe
+// closes or opens an open circuit again, the results of requests sent before the circuit opened are ignored. It returns
+// true if the circuit was opened
+func (b *circuitBreaker) record(region api.Region, family string, probe bool, statusCode int, now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	c := b.circuit(region, family)
+	if probe {
+		c.probing = false
+	}
+	switch {
+	case statusCode == 0 || c.open && !probe:
+		return false
+	case statusCode < http.StatusInternalServerError:
+		*c = circuit{}
+		return false
+	}
+	c.failures++
+	if !probe && c.failures < b.failures {
+		return false
+	}
+	c.open = true
+	c.openUntil = now.Add(b.cooldown)
+	return true
+}
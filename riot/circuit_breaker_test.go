@@ -0,0 +1,109 @@
+package riot
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mjourard/golio/api"
+	"github.com/mjourard/golio/mock"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	t.Parallel()
+	breaker := &circuitBreaker{failures: 2, cooldown: time.Minute}
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	send := func(status int) bool {
+		probe, err := breaker.allow(api.RegionKorea, "match-v5", now)
+		require.Nil(t, err)
+		return breaker.record(api.RegionKorea, "match-v5", probe, status, now)
+	}
+	for _, status := range []int{http.StatusInternalServerError, http.StatusOK, http.StatusBadGateway} {
+		assert.False(t, send(status))
+	}
+	assert.True(t, send(http.StatusServiceUnavailable))
+	_, err := breaker.allow(api.RegionKorea, "match-v5", now.Add(time.Second))
+	assert.Equal(t, &api.CircuitOpenError{Region: api.RegionKorea, Family: "match-v5", Until: now.Add(time.Minute)}, err)
+	_, err = breaker.allow(api.RegionKorea, "summoner-v4", now)
+	assert.Nil(t, err)
+	_, err = breaker.allow(api.RegionEuropeWest, "match-v5", now)
+	assert.Nil(t, err)
+
+	// a single request tests the service after the cooldown
+	now = now.Add(time.Minute)
+	probe, err := breaker.allow(api.RegionKorea, "match-v5", now)
+	require.Nil(t, err)
+	assert.True(t, probe)
+	_, err = breaker.allow(api.RegionKorea, "match-v5", now)
+	assert.True(t, errors.Is(err, api.ErrCircuitOpen))
+	assert.True(t, breaker.record(api.RegionKorea, "match-v5", probe, http.StatusServiceUnavailable, now))
+	_, err = breaker.allow(api.RegionKorea, "match-v5", now)
+	assert.True(t, errors.Is(err, api.ErrCircuitOpen))
+
+	now = now.Add(time.Minute)
+	assert.False(t, send(0))
+	assert.False(t, send(http.StatusOK))
+	probe, err = breaker.allow(api.RegionKorea, "match-v5", now)
+	assert.Nil(t, err)
+	assert.False(t, probe)
+}
+
+func TestCircuitBreaker_requestsBeforeOpening(t *testing.T) {
+	t.Parallel()
+	breaker := &circuitBreaker{failures: 1, cooldown: time.Minute}
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	// two requests are sent while the circuit is closed, the first one opens it
+	for i := 0; i < 2; i++ {
+		probe, err := breaker.allow(api.RegionKorea, "match-v5", now)
+		require.Nil(t, err)
+		require.False(t, probe)
+	}
+	assert.True(t, breaker.record(api.RegionKorea, "match-v5", false, http.StatusServiceUnavailable, now))
+
+	now = now.Add(time.Minute)
+	probe, err := breaker.allow(api.RegionKorea, "match-v5", now)
+	require.Nil(t, err)
+	require.True(t, probe)
+	// the result of the second request neither ends the probe nor closes the circuit
+	assert.False(t, breaker.record(api.RegionKorea, "match-v5", false, http.StatusOK, now))
+	_, err = breaker.allow(api.RegionKorea, "match-v5", now)
+	assert.True(t, errors.Is(err, api.ErrCircuitOpen))
+	assert.False(t, breaker.record(api.RegionKorea, "match-v5", false, 0, now))
+	_, err = breaker.allow(api.RegionKorea, "match-v5", now)
+	assert.True(t, errors.Is(err, api.ErrCircuitOpen))
+
+	assert.False(t, breaker.record(api.RegionKorea, "match-v5", probe, http.StatusOK, now))
+	probe, err = breaker.allow(api.RegionKorea, "match-v5", now)
+	assert.Nil(t, err)
+	assert.False(t, probe)
+}
+
+func TestWithCircuitBreaker(t *testing.T) {
+	t.Parallel()
+	doer := mock.NewSequenceDoer(mock.Status(http.StatusInternalServerError, 2), mock.OK(Summoner{Name: "name"}))
+	c := NewClient(api.RegionKorea, "API_KEY", doer, logrus.StandardLogger(),
+		WithCircuitBreaker(2, 50*time.Millisecond))
+	for i := 0; i < 2; i++ {
+		_, err := c.Summoner.GetByID("id")
+		assert.True(t, errors.Is(err, api.ErrInternalServerError))
+	}
+	_, err := c.Summoner.GetByID("id")
+	var circuitErr *api.CircuitOpenError
+	require.True(t, errors.As(err, &circuitErr))
+	assert.Equal(t, "summoner-v4", circuitErr.Family)
+	assert.True(t, api.IsRetryable(err))
+	assert.Len(t, doer.Requests(), 2)
+
+	_, err = c.ForRegion(api.RegionEuropeWest).Summoner.GetByID("id")
+	assert.Nil(t, err)
+	time.Sleep(60 * time.Millisecond)
+	summoner, err := c.Summoner.GetByID("id")
+	require.Nil(t, err)
+	assert.Equal(t, "name", summoner.Name)
+	assert.Len(t, doer.Requests(), 4)
+}
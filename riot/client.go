@@ -1,52 +1,215 @@
 // Package riot provides methods for accessing the Riot API for League of Legends.
 // This includes dynamic data like the current game a summoner is in or their ranked standing.
+//
+// The types of the responses follow one policy for optional fields: nested objects are pointers, which are nil if
+// the API omitted the object, e.g. the MiniSeries of a LeagueItem. Numbers, strings and booleans are values and
+// fields omitted by the API have their zero value. Optional fields of request bodies are tagged with omitempty and
+// are not sent if they have their zero value.
 package riot
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
-	"strconv"
+	"reflect"
+	"strings"
 	"time"
 
 	log "github.com/sirupsen/logrus"
 
 	"github.com/mjourard/golio/api"
 	"github.com/mjourard/golio/internal"
+	"github.com/mjourard/golio/logging"
 )
 
-// Client provides access to all Riot API endpoints
+// errorBodyLimit is the number of bytes read from the body of an error response to decode the error of the Riot API
+const errorBodyLimit = 4 << 10
+
+// drainLimit is the number of bytes of a response body read before closing it, see closeBody
+const drainLimit = 64 << 10
+
+// ErrNullResponse is returned if the Riot API answers with null instead of the requested object
+var ErrNullResponse = errors.New("riot: response body is null")
+
+// UnknownFieldError is reported in strict decoding mode for a response containing a field which does not exist in
+// the types of golio, which usually means that Riot added a field to the endpoint
+type UnknownFieldError struct {
+	Endpoint string
+	// Field is the name of the first unknown field in the response
+	Field string
+	// Fields are the paths of all unknown fields in the response, e.g. participants[].newField
+	Fields []string
+	Err    error
+}
+
+func (e *UnknownFieldError) Error() string {
+	switch len(e.Fields) {
+	case 0:
+		return fmt.Sprintf("riot: unknown field %s in response of %s", e.Field, e.Endpoint)
+	case 1:
+		return fmt.Sprintf("riot: unknown field %s in response of %s", e.Fields[0], e.Endpoint)
+	}
+	return fmt.Sprintf("riot: unknown fields %s in response of %s", strings.Join(e.Fields, ", "), e.Endpoint)
+}
+
+// Unwrap returns the error of the json decoder
+func (e *UnknownFieldError) Unwrap() error {
+	return e.Err
+}
+
+// RawResponse is the body of a successful response of the Riot API before decoding
+type RawResponse struct {
+	// Method of the request, e.g. GET
+	Method   string
+	Endpoint string
+	Body     json.RawMessage
+}
+
+// Client provides access to all Riot API endpoints. A client and its views, see WithContext and ForRegion, are safe
+// for concurrent use by any number of goroutines: the clients of the endpoint groups are set once by NewClient and
+// all state shared between requests, e.g. the rate limits and the statistics, is synchronized. Hooks and observers
+// passed as options are called concurrently and have to synchronize their own state
 type Client struct {
-	l               log.FieldLogger
-	Region          api.Region
-	apiKey          string
-	client          internal.Doer
-	ChampionMastery *championMasteryClient
-	Champion        *championClient
-	League          *leagueClient
-	Status          *statusClient
-	Match           *matchClient
-	Spectator       *spectatorClient
-	Summoner        *summonerClient
-	ThirdPartyCode  *thirdPartyCodeClient
-	Tournament      *tournamentClient
-}
-
-// NewClient returns a new api client for the Riot API
-func NewClient(region api.Region, apiKey string, client internal.Doer, logger log.FieldLogger) *Client {
+	l                log.FieldLogger
+	Region           api.Region
+	keys             KeyProvider
+	routeKeys        []routeKey
+	baseURL          string
+	keyPlacement     KeyPlacement
+	header           http.Header
+	client           internal.Doer
+	doer             internal.Doer
+	middleware       []Middleware
+	strict           bool
+	unknownField     func(*UnknownFieldError)
+	rawCapture       func(RawResponse)
+	observers        []RequestObserver
+	stats            *clientStats
+	rateLimits       *rateLimitTracker
+	regionLimits     *regionRateLimits
+	logLevels        map[LogCategory]logging.Level
+	categoryLoggers  map[LogCategory]log.FieldLogger
+	retryLogSampling int64
+	retryLogs        *int64
+	audit            AuditSink
+	limitStore       *storeLimiter
+	rateLimiter      bool
+	bulkReserve      float64
+	retryPolicy      RetryPolicy
+	rateLimitErrors  bool
+	circuits         *circuitBreaker
+	scheduler        *Scheduler
+	cache            Cache
+	cacheTTL         time.Duration
+	err              error
+	ctx              context.Context
+	Account          *accountClient
+	ChampionMastery  *championMasteryClient
+	Champion         *championClient
+	League           *leagueClient
+	Status           *statusClient
+	Match            *matchClient
+	Spectator        *spectatorClient
+	Summoner         *summonerClient
+	ThirdPartyCode   *thirdPartyCodeClient
+	Tournament       *tournamentClient
+}
+
+// Option is used to alter the attributes of a client
+type Option func(*Client)
+
+// WithStrictDecoding makes the client detect fields in responses which do not exist in the types of golio.
+// If hook is nil, such responses fail with an *UnknownFieldError. Otherwise hook is called with the error and the
+// response is decoded ignoring the unknown fields, so that new fields are reported without breaking the application
+func WithStrictDecoding(hook func(*UnknownFieldError)) Option {
+	return func(c *Client) {
+		c.strict = true
+		c.unknownField = hook
+	}
+}
+
+// WithRawCapture calls hook with the body of every successful response before it is decoded, so that the exact
+// payloads can be persisted, including fields which do not exist in the types of golio. The hook must not modify
+// the body
+func WithRawCapture(hook func(RawResponse)) Option {
+	return func(c *Client) {
+		c.rawCapture = hook
+	}
+}
+
+// WithBaseContext sets the context of the requests of the client, e.g. a context cancelled on shutdown, so that the
+// waits for rate limits and retries end immediately when it is done. Views created using WithContext use their
+// context instead
+func WithBaseContext(ctx context.Context) Option {
+	return func(c *Client) {
+		c.ctx = ctx
+	}
+}
+
+// NewClient returns a new api client for the Riot API. The logger may be nil, e.g. if a logger not depending on logrus
+// is set using WithLogging, in which case nothing is logged
+func NewClient(region api.Region, apiKey string, client internal.Doer, logger log.FieldLogger,
+	options ...Option) *Client {
+	if logger == nil {
+		logger = logging.FieldLogger(logging.Discard())
+	}
 	c := &Client{
-		Region: region,
-		apiKey: apiKey,
-		client: client,
-		l:      logger.WithField("client", "riot api"),
+		Region:       region,
+		keys:         staticKey(apiKey),
+		baseURL:      defaultBaseURL,
+		client:       client,
+		doer:         client,
+		l:            logger.WithField("client", "riot api"),
+		err:          region.Validate(),
+		stats:        &clientStats{},
+		regionLimits: &regionRateLimits{},
+		retryLogs:    new(int64),
+		retryPolicy:  DefaultBackoff,
+	}
+	c.rateLimits = c.regionLimits.tracker(region)
+	for _, opt := range options {
+		opt(c)
+	}
+	c.categoryLoggers = categoryLoggers(c.l, c.logLevels)
+	c.client = chain(c.doer, c.middleware)
+	c.initEndpoints()
+	return c
+}
+
+// Clone returns a copy of the client with the options applied in addition to the options of c, e.g. a client with an
+// additional observer for a single job. The copy shares the http client, the rate limits and the statistics with c,
+// so that both stay within the same limits. Unlike the views, the options of c are not affected by the copy
+func (c *Client) Clone(options ...Option) *Client {
+	clone := *c
+	clone.routeKeys = append([]routeKey(nil), c.routeKeys...)
+	clone.header = c.header.Clone()
+	clone.middleware = append([]Middleware(nil), c.middleware...)
+	clone.observers = append([]RequestObserver(nil), c.observers...)
+	clone.logLevels = make(map[LogCategory]logging.Level, len(c.logLevels))
+	for category, level := range c.logLevels {
+		clone.logLevels[category] = level
+	}
+	for _, opt := range options {
+		opt(&clone)
 	}
+	clone.categoryLoggers = categoryLoggers(clone.l, clone.logLevels)
+	clone.client = chain(clone.doer, clone.middleware)
+	clone.initEndpoints()
+	return &clone
+}
+
+// initEndpoints sets the clients of the endpoint groups, which send their requests using c
+func (c *Client) initEndpoints() {
 	common := &struct {
 		c *Client
 	}{
 		c: c,
 	}
+	c.Account = (*accountClient)(common)
 	c.ChampionMastery = (*championMasteryClient)(common)
 	c.Summoner = (*summonerClient)(common)
 	c.Champion = (*championClient)(common)
@@ -56,26 +219,221 @@ func NewClient(region api.Region, apiKey string, client internal.Doer, logger lo
 	c.Spectator = (*spectatorClient)(common)
 	c.Tournament = (*tournamentClient)(common)
 	c.ThirdPartyCode = (*thirdPartyCodeClient)(common)
-	return c
+}
+
+// WithContext returns a view of the client whose requests are cancelled when ctx is done, including the waits for
+// rate limits and retries, e.g. client.WithContext(ctx).Summoner.GetByName(name). The view shares the http client,
+// the rate limits, the statistics and all options with c
+func (c *Client) WithContext(ctx context.Context) *Client {
+	view := *c
+	view.ctx = ctx
+	view.initEndpoints()
+	return &view
+}
+
+// ForRegion returns a view of the client sending its requests to the region, e.g. for services serving players of
+// all regions with a single client. The view shares the http client, the statistics and all options with c. Rate
+// limits are tracked per region, as the Riot API applies them per region
+func (c *Client) ForRegion(region api.Region) *Client {
+	view := *c
+	view.Region = region
+	view.err = region.Validate()
+	view.rateLimits = c.regionLimits.tracker(region)
+	view.initEndpoints()
+	return &view
+}
+
+// context returns the context of the requests of the client, see WithContext
+func (c *Client) context() context.Context {
+	if c.ctx == nil {
+		return context.Background()
+	}
+	return c.ctx
+}
+
+// Err returns the error which occurred constructing the client, e.g. an *api.UnknownRegionError for an unknown region.
+// All requests of a client with an error fail with the error without being sent
+func (c *Client) Err() error {
+	return c.err
 }
 
 func (c *Client) getInto(endpoint string, target interface{}) error {
+	return c.getIntoContext(c.context(), endpoint, target)
+}
+
+func (c *Client) getIntoContext(ctx context.Context, endpoint string, target interface{}) error {
 	logger := c.logger().WithFields(log.Fields{
 		"method":   "getInto",
 		"endpoint": endpoint,
 	})
-	response, err := c.get(endpoint)
+	if c.cache != nil && !cacheDisabled(ctx) {
+		return c.getIntoCached(ctx, logger, endpoint, target)
+	}
+	response, err := c.doRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		logger.Debug(err)
+		return err
+	}
+	defer closeBody(response)
+	if err := c.decode(http.MethodGet, endpoint, response.Body, target); err != nil {
+		logger.Debug(err)
+		return err
+	}
+	return nil
+}
+
+// getIntoCached decodes the body cached for the endpoint into target or, if it is not cached, sends the request and
+// caches the body of the response once it was decoded
+func (c *Client) getIntoCached(ctx context.Context, logger log.FieldLogger, endpoint string, target interface{}) error {
+	key := CacheKey(c.Region, endpoint)
+	body, ok, err := c.cache.Get(ctx, key)
+	if err != nil {
+		logger.Warnf("reading cache: %v", err)
+	}
+	if ok {
+		if err := c.decodeBytes(endpoint, body, target); err != nil {
+			logger.Debug(err)
+			return err
+		}
+		return nil
+	}
+	response, err := c.doRequest(ctx, http.MethodGet, endpoint, nil)
 	if err != nil {
 		logger.Debug(err)
 		return err
 	}
-	if err := json.NewDecoder(response.Body).Decode(target); err != nil {
+	defer closeBody(response)
+	body, err = c.readBody(http.MethodGet, endpoint, response.Body)
+	if err != nil {
+		err = decodeError(endpoint, err)
+		logger.Debug(err)
+		return err
+	}
+	if err := c.decodeBytes(endpoint, body, target); err != nil {
 		logger.Debug(err)
 		return err
 	}
+	if err := c.cache.Set(ctx, key, body, c.cacheTTL); err != nil {
+		logger.Warnf("writing cache: %v", err)
+	}
 	return nil
 }
 
+// GetInto decodes the response to a GET request of the endpoint into target, e.g. for endpoints not supported by
+// golio yet. The endpoint is the path of the URL including query parameters. The request is sent like the requests
+// of all other methods, including the handling of rate limits, but is cancelled when ctx is done
+func (c *Client) GetInto(ctx context.Context, endpoint string, target interface{}) error {
+	return c.getIntoContext(ctx, endpoint, target)
+}
+
+// GetRaw returns the body of the response to a GET request of the endpoint without decoding it, e.g. for endpoints
+// not supported by golio yet. The endpoint is the path of the URL including query parameters,
+// e.g. /lol/summoner/v4/summoners/by-name/name
+func (c *Client) GetRaw(endpoint string) (json.RawMessage, error) {
+	logger := c.logger().WithFields(log.Fields{
+		"method":   "GetRaw",
+		"endpoint": endpoint,
+	})
+	response, err := c.get(endpoint)
+	if err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
+	defer closeBody(response)
+	body, err := c.readBody(http.MethodGet, endpoint, response.Body)
+	if err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
+	if !json.Valid(body) {
+		err = &api.DecodeError{Endpoint: endpoint, Err: errors.New("response is no valid json")}
+		logger.Debug(err)
+		return nil, err
+	}
+	return body, nil
+}
+
+// Forward sends a request with the method, endpoint and body and returns the response unchanged, including error
+// responses, e.g. for proxies. The headers are added to the request except for the API key header, which is always
+// replaced by the key of the client, see WithKeyPlacement. The request is not retried but is tracked like all other
+// requests, e.g. by RateLimitStatus and the observers. The caller has to close the body of the response
+func (c *Client) Forward(ctx context.Context, method, endpoint string, header http.Header,
+	body io.Reader) (*http.Response, error) {
+	logger := c.categoryLogger(LogCategoryTransport).WithFields(log.Fields{
+		"method":   "Forward",
+		"endpoint": endpoint,
+	})
+	request, err := c.newRequest(ctx, method, endpoint, body)
+	if err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
+	for key, values := range header {
+		if http.CanonicalHeaderKey(key) == apiTokenHeaderKey || request.Header.Get(key) != "" {
+			continue
+		}
+		for _, value := range values {
+			request.Header.Add(key, value)
+		}
+	}
+	if c.limitStore != nil {
+		if err := c.limitStore.wait(ctx, c, endpoint, logger); err != nil {
+			logger.Debug(err)
+			return nil, err
+		}
+	}
+	response, err := c.send(request, endpoint)
+	if err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
+	if response.StatusCode == http.StatusTooManyRequests {
+		if wait, ok := api.ParseRetryAfter(response.Header.Get("Retry-After"), time.Now()); ok {
+			c.rateLimits.throttle(EndpointFamily(endpoint), response.Header.Get(headerRateLimitType), time.Now().Add(wait))
+		}
+	}
+	return response, nil
+}
+
+// Do sends a request with the method, endpoint and body encoded as json and returns the body and the headers of the
+// response, e.g. for endpoints not supported by golio yet. A nil body sends no body. Unlike Forward, the request is
+// retried and error responses are returned as api.ResponseError like for all other methods
+func (c *Client) Do(method, endpoint string, body interface{}) (json.RawMessage, http.Header, error) {
+	logger := c.logger().WithFields(log.Fields{
+		"method":   "Do",
+		"endpoint": endpoint,
+	})
+	var reader io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			logger.Debug(err)
+			return nil, nil, err
+		}
+		reader = bytes.NewReader(encoded)
+	}
+	response, err := c.doRequest(c.context(), method, endpoint, reader)
+	if err != nil {
+		logger.Debug(err)
+		return nil, nil, err
+	}
+	defer closeBody(response)
+	raw, err := c.readBody(method, endpoint, response.Body)
+	if err != nil {
+		logger.Debug(err)
+		return nil, nil, err
+	}
+	if len(raw) == 0 {
+		return nil, response.Header, nil
+	}
+	if !json.Valid(raw) {
+		err = &api.DecodeError{Endpoint: endpoint, Err: errors.New("response is no valid json")}
+		logger.Debug(err)
+		return nil, nil, err
+	}
+	return raw, response.Header, nil
+}
+
 func (c *Client) postInto(endpoint string, body, target interface{}) error {
 	logger := c.logger().WithFields(log.Fields{
 		"method":   "postInto",
@@ -86,13 +444,104 @@ func (c *Client) postInto(endpoint string, body, target interface{}) error {
 		logger.Debug(err)
 		return err
 	}
-	if err := json.NewDecoder(response.Body).Decode(target); err != nil {
+	defer closeBody(response)
+	if err := c.decode(http.MethodPost, endpoint, response.Body, target); err != nil {
 		logger.Debug(err)
 		return err
 	}
 	return nil
 }
 
+// readBody reads the body of a successful response and passes it to the raw capture hook
+func (c *Client) readBody(method, endpoint string, r io.Reader) ([]byte, error) {
+	body, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	if c.rawCapture != nil {
+		c.rawCapture(RawResponse{Method: method, Endpoint: endpoint, Body: body})
+	}
+	return body, nil
+}
+
+// decode decodes the json from the response body r into target. Errors except for an *UnknownFieldError are returned
+// as *api.DecodeError
+func (c *Client) decode(method, endpoint string, r io.Reader, target interface{}) error {
+	return decodeError(endpoint, c.decodeBody(method, endpoint, r, target))
+}
+
+// decodeBytes decodes the json body of a response read before into target like decode, without passing it to the
+// hook of WithRawCapture again
+func (c *Client) decodeBytes(endpoint string, body []byte, target interface{}) error {
+	return decodeError(endpoint, c.decodeBodyBytes(endpoint, body, target))
+}
+
+// decodeError returns err of decoding the response of the endpoint as *api.DecodeError unless it is nil or an
+// *UnknownFieldError
+func decodeError(endpoint string, err error) error {
+	var unknown *UnknownFieldError
+	if err == nil || errors.As(err, &unknown) {
+		return err
+	}
+	return &api.DecodeError{Endpoint: endpoint, Err: err}
+}
+
+// decodeBody decodes the json from the response body r into target. In strict decoding mode, unknown fields are
+// either an error or reported to the hook
+func (c *Client) decodeBody(method, endpoint string, r io.Reader, target interface{}) error {
+	if !c.strict && c.rawCapture == nil {
+		return decodeJSON(json.NewDecoder(r), target)
+	}
+	body, err := c.readBody(method, endpoint, r)
+	if err != nil {
+		return err
+	}
+	return c.decodeBodyBytes(endpoint, body, target)
+}
+
+// decodeBodyBytes decodes the json body into target like decodeBody
+func (c *Client) decodeBodyBytes(endpoint string, body []byte, target interface{}) error {
+	if !c.strict {
+		return decodeJSON(json.NewDecoder(bytes.NewReader(body)), target)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.DisallowUnknownFields()
+	err := decodeJSON(decoder, target)
+	const prefix = "json: unknown field "
+	if err == nil || !strings.HasPrefix(err.Error(), prefix) {
+		return err
+	}
+	unknown := &UnknownFieldError{
+		Endpoint: endpoint,
+		Field:    strings.Trim(strings.TrimPrefix(err.Error(), prefix), `"`),
+		Fields:   unknownFields(body, reflect.TypeOf(target)),
+		Err:      err,
+	}
+	if len(unknown.Fields) == 0 {
+		unknown.Fields = []string{unknown.Field}
+	}
+	if c.unknownField == nil {
+		return unknown
+	}
+	c.unknownField(unknown)
+	// the strict decoder stopped at the unknown field, so target is decoded again from scratch
+	v := reflect.ValueOf(target).Elem()
+	v.Set(reflect.Zero(v.Type()))
+	return decodeJSON(json.NewDecoder(bytes.NewReader(body)), target)
+}
+
+// decodeJSON decodes the next json value of decoder into target. If target is a pointer to a pointer, a null body is
+// an error instead of leaving the pointer nil, so that methods never return nil without an error
+func decodeJSON(decoder *json.Decoder, target interface{}) error {
+	if err := decoder.Decode(target); err != nil {
+		return err
+	}
+	if v := reflect.ValueOf(target); v.Kind() == reflect.Ptr && v.Elem().Kind() == reflect.Ptr && v.Elem().IsNil() {
+		return ErrNullResponse
+	}
+	return nil
+}
+
 func (c *Client) put(endpoint string, body interface{}) error {
 	logger := c.logger().WithFields(log.Fields{
 		"method":   "put",
@@ -103,12 +552,16 @@ func (c *Client) put(endpoint string, body interface{}) error {
 		logger.Debug(err)
 		return err
 	}
-	_, err := c.doRequest("PUT", endpoint, buf)
-	return err
+	response, err := c.doRequest(c.context(), "PUT", endpoint, buf)
+	if err != nil {
+		return err
+	}
+	closeBody(response)
+	return nil
 }
 
 func (c *Client) get(endpoint string) (*http.Response, error) {
-	return c.doRequest("GET", endpoint, nil)
+	return c.doRequest(c.context(), "GET", endpoint, nil)
 }
 
 func (c *Client) post(endpoint string, body interface{}) (*http.Response, error) {
@@ -121,73 +574,271 @@ func (c *Client) post(endpoint string, body interface{}) (*http.Response, error)
 		logger.Debug(err)
 		return nil, err
 	}
-	return c.doRequest("POST", endpoint, buf)
+	return c.doRequest(c.context(), "POST", endpoint, buf)
 }
 
-func (c *Client) doRequest(method, endpoint string, body io.Reader) (*http.Response, error) {
-	logger := c.logger().WithFields(log.Fields{
+func (c *Client) doRequest(ctx context.Context, method, endpoint string, body io.Reader) (*http.Response, error) {
+	logger := c.categoryLogger(LogCategoryTransport).WithFields(log.Fields{
 		"method":   "doRequest",
 		"endpoint": endpoint,
 	})
-	request, err := c.newRequest(method, endpoint, body)
-	if err != nil {
-		logger.Debug(err)
-		return nil, err
-	}
-	response, err := c.client.Do(request)
-	if err != nil {
-		logger.Debug(err)
-		return nil, err
-	}
-	if response.StatusCode == http.StatusServiceUnavailable {
-		logger.Info("service unavailable, retrying")
-		time.Sleep(time.Second)
-		response, err = c.client.Do(request)
+	start := time.Now()
+	for attempt := 1; ; attempt++ {
+		request, err := c.newRequest(ctx, method, endpoint, body)
 		if err != nil {
 			logger.Debug(err)
 			return nil, err
 		}
-	}
-	if response.StatusCode == http.StatusTooManyRequests {
-		retry := response.Header.Get("Retry-After")
-		seconds, err := strconv.Atoi(retry)
-		if err != nil {
+		response, err := c.scheduledSend(ctx, request, endpoint, logger)
+		var transportErr *api.TransportError
+		if err != nil && !errors.As(err, &transportErr) {
 			logger.Debug(err)
 			return nil, err
 		}
-		logger.Infof("rate limited, waiting %d seconds", seconds)
-		time.Sleep(time.Duration(seconds) * time.Second)
-		return c.doRequest(method, endpoint, body)
-	}
-	if response.StatusCode < 200 || response.StatusCode > 299 {
-		logger.Debugf("error response: %v", response.Status)
-		err, ok := api.StatusToError[response.StatusCode]
-		if !ok {
-			err = api.Error{
-				Message:    "unknown error reason",
-				StatusCode: response.StatusCode,
+		statusCode := 0
+		var retryAfter time.Duration
+		if response != nil {
+			statusCode = response.StatusCode
+			if statusCode >= 200 && statusCode <= 299 {
+				return response, nil
 			}
 		}
-		return nil, err
+		if statusCode == http.StatusTooManyRequests {
+			retryAfter = c.retryAfter(endpoint, response)
+			c.rateLimits.throttle(EndpointFamily(endpoint), response.Header.Get(headerRateLimitType),
+				time.Now().Add(retryAfter))
+		}
+		wait, retry := c.retryPolicy.ShouldRetry(attempt, response, err)
+		if wait < retryAfter {
+			wait = retryAfter
+		}
+		if limit := retryTimeLimit(c.retryPolicy); limit > 0 && time.Since(start)+wait > limit {
+			retry = false
+		}
+		if !retry || (statusCode == http.StatusTooManyRequests && c.rateLimitErrors) {
+			if err == nil {
+				responseErr := c.responseError(method, endpoint, request, response)
+				err = responseErr
+				if statusCode == http.StatusTooManyRequests {
+					rateLimitErr := api.NewRateLimitError(responseErr)
+					if rateLimitErr.RetryAfter == 0 {
+						rateLimitErr.RetryAfter = retryAfter
+					}
+					err = rateLimitErr
+				}
+			}
+			logger.Debug(err)
+			return nil, err
+		}
+		switch {
+		case err != nil:
+			c.logRetry(logger, "%v, retrying in %s", err, wait)
+		case statusCode == http.StatusTooManyRequests:
+			closeBody(response)
+			c.logRetry(logger, "rate limited, waiting %s", wait)
+		default:
+			closeBody(response)
+			c.logRetry(logger, "%s, retrying in %s", strings.ToLower(http.StatusText(statusCode)), wait)
+		}
+		c.stats.retry(EndpointFamily(endpoint), wait)
+		c.observeRetry(c.retryStats(method, endpoint, statusCode, wait))
+		if err := sleep(ctx, wait); err != nil {
+			logger.Debug(err)
+			return nil, err
+		}
+		if body, err = replay(request); err != nil {
+			logger.Debug(err)
+			return nil, err
+		}
+	}
+}
+
+// scheduledSend waits until the scheduler admits the request, see WithScheduler, and for the rate limit store before
+// sending the request
+func (c *Client) scheduledSend(ctx context.Context, request *http.Request, endpoint string,
+	logger log.FieldLogger) (*http.Response, error) {
+	if c.scheduler != nil {
+		if err := c.scheduler.acquire(ctx); err != nil {
+			return nil, err
+		}
+		defer c.scheduler.release()
+	}
+	if c.limitStore != nil {
+		if err := c.limitStore.wait(ctx, c, endpoint, logger); err != nil {
+			return nil, err
+		}
+	}
+	return c.send(request, endpoint)
+}
+
+// retryAfter returns the time to wait after a response with status 429. Without a valid Retry-After header it is the
+// time until the exhausted rate limit windows reported by the response reset, or 0 if none is exhausted, e.g. for
+// limits of the service
+func (c *Client) retryAfter(endpoint string, response *http.Response) time.Duration {
+	now := time.Now()
+	if wait, ok := api.ParseRetryAfter(response.Header.Get("Retry-After"), now); ok {
+		return wait
+	}
+	if wait := c.rateLimits.status().ReadyAt(EndpointFamily(endpoint)).Sub(now); wait > 0 {
+		return wait
+	}
+	return 0
+}
+
+// responseError reads the beginning of the body of an error response, closes it and returns the error for it
+func (c *Client) responseError(method, endpoint string, request *http.Request,
+	response *http.Response) *api.ResponseError {
+	var body []byte
+	if response.Body != nil {
+		body, _ = io.ReadAll(io.LimitReader(response.Body, errorBodyLimit))
+		closeBody(response)
+	}
+	responseErr := api.NewResponseError(method, request.URL, response.StatusCode, body)
+	responseErr.Endpoint = endpoint
+	responseErr.Header = api.ErrorHeader(response.Header)
+	return responseErr
+}
+
+// send sends the request using the http client and reports it to the observers
+func (c *Client) send(request *http.Request, endpoint string) (*http.Response, error) {
+	if c.rateLimiter {
+		if err := c.waitForToken(request.Context(), endpoint); err != nil {
+			return nil, err
+		}
+	}
+	probe := false
+	if c.circuits != nil {
+		var err error
+		if probe, err = c.circuits.allow(c.Region, EndpointFamily(endpoint), time.Now()); err != nil {
+			return nil, err
+		}
+	}
+	start := time.Now()
+	c.stats.begin()
+	response, err := c.client.Do(request)
+	// the URL of the error contains the API key if it is sent as query parameter
+	err = api.RedactURLError(err)
+	statusCode := 0
+	if response != nil {
+		if response.Request == nil {
+			response.Request = request
+		}
+		statusCode = response.StatusCode
+		c.rateLimits.update(EndpointFamily(endpoint), response.Header, time.Now())
+	}
+	if c.circuits != nil && c.circuits.record(c.Region, EndpointFamily(endpoint), probe, statusCode, time.Now()) {
+		c.categoryLogger(LogCategoryTransport).WithField("endpoint", endpoint).
+			Warnf("circuit opened after server errors, sending no requests for %s", c.circuits.cooldown)
+	}
+	c.stats.end(EndpointFamily(endpoint), statusCode, err)
+	if len(c.observers) > 0 {
+		stats := RequestStats{
+			Method:     request.Method,
+			Endpoint:   endpoint,
+			Family:     EndpointFamily(endpoint),
+			Region:     c.Region,
+			Duration:   time.Since(start),
+			StatusCode: statusCode,
+			Err:        err,
+		}
+		c.observeRequest(stats)
+	}
+	if c.audit != nil {
+		c.audit.Record(AuditRecord{
+			Time:       start,
+			Method:     request.Method,
+			Endpoint:   endpoint,
+			Family:     EndpointFamily(endpoint),
+			Region:     c.Region,
+			StatusCode: statusCode,
+			Result:     auditResult(statusCode, err),
+			Label:      AuditLabel(request.Context()),
+		})
+	}
+	if err != nil {
+		return nil, &api.TransportError{Method: request.Method, Endpoint: endpoint, Err: err}
 	}
 	return response, nil
 }
 
-func (c *Client) newRequest(method, endpoint string, body io.Reader) (*http.Request, error) {
+func (c *Client) retryStats(method, endpoint string, statusCode int, wait time.Duration) RetryStats {
+	return RetryStats{
+		Method:     method,
+		Endpoint:   endpoint,
+		Family:     EndpointFamily(endpoint),
+		Region:     c.Region,
+		StatusCode: statusCode,
+		Wait:       wait,
+	}
+}
+
+func (c *Client) newRequest(ctx context.Context, method, endpoint string, body io.Reader) (*http.Request, error) {
 	logger := c.logger().WithFields(log.Fields{
 		"method":   "newRequest",
 		"endpoint": endpoint,
 	})
-	request, err := http.NewRequest(method, fmt.Sprintf(apiURLFormat, scheme, c.Region, baseURL, endpoint), body)
+	if c.err != nil {
+		logger.Debug(c.err)
+		return nil, c.err
+	}
+	host := string(c.Region)
+	for prefix, continent := range continentalEndpoints {
+		if strings.HasPrefix(endpoint, prefix) {
+			host = string(continent(c.Region))
+		}
+	}
+	request, err := http.NewRequestWithContext(ctx, method, c.requestURL(host, endpoint), body)
 	if err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
-	request.Header.Add(apiTokenHeaderKey, c.apiKey)
+	if c.keyPlacement == KeyInHeader {
+		request.Header.Add(apiTokenHeaderKey, c.apiKey(endpoint))
+	}
 	request.Header.Add("Accept", "application/json")
+	c.addHeaders(request)
 	return request, nil
 }
 
+// closeBody drains and closes the body of the response, so that the connection can be reused by the http client.
+// Bodies longer than drainLimit are closed without draining them, which is cheaper than reading them
+func closeBody(response *http.Response) {
+	if response == nil || response.Body == nil {
+		return
+	}
+	_, _ = io.Copy(io.Discard, io.LimitReader(response.Body, drainLimit))
+	_ = response.Body.Close()
+}
+
+// replay returns a copy of the body of the request for a new request or nil if the request has no body
+func replay(request *http.Request) (io.Reader, error) {
+	if request.GetBody == nil {
+		return nil, nil
+	}
+	body, err := request.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+	buf, err := io.ReadAll(body)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(buf), nil
+}
+
+// sleep waits for the duration d or until ctx is done, returning the error of ctx in the latter case
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
 func (c *Client) logger() log.FieldLogger {
 	return c.l.WithField("region", c.Region)
 }
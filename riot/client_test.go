@@ -1,17 +1,23 @@
 package riot
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/mjourard/golio/api"
 	"github.com/mjourard/golio/internal"
-	"github.com/mjourard/golio/internal/mock"
+	"github.com/mjourard/golio/mock"
 )
 
 func TestClient_doRequest(t *testing.T) {
@@ -67,7 +73,7 @@ func TestClient_doRequest(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			c := NewClient(api.RegionEuropeNorthEast, "", tt.doer, logrus.StandardLogger())
-			_, err := c.doRequest(tt.args.method, tt.args.endpoint, tt.args.body)
+			_, err := c.doRequest(context.Background(), tt.args.method, tt.args.endpoint, tt.args.body)
 			assert.Equal(t, err != nil, tt.wantErr)
 		})
 	}
@@ -94,6 +100,413 @@ func TestClient_getInto(t *testing.T) {
 	}
 }
 
+func TestClient_getIntoNull(t *testing.T) {
+	t.Parallel()
+	c := NewClient(api.RegionOceania, "API_KEY", mock.NewJSONMockDoer(nil, 200), logrus.StandardLogger())
+	var summoner *Summoner
+	assert.True(t, errors.Is(c.getInto("endpoint", &summoner), ErrNullResponse))
+	var entries []*LeagueItem
+	assert.Nil(t, c.getInto("endpoint", &entries))
+	match, err := c.Match.Get(1)
+	assert.Nil(t, match)
+	assert.True(t, errors.Is(err, ErrNullResponse))
+	var decodeErr *api.DecodeError
+	require.True(t, errors.As(err, &decodeErr))
+	assert.Equal(t, "/lol/match/v4/matches/1", decodeErr.Endpoint)
+}
+
+func TestClient_errorResponse(t *testing.T) {
+	t.Parallel()
+	doer := &mock.Doer{Custom: func(*http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusForbidden,
+			Header:     http.Header{"X-App-Rate-Limit": {"20:1"}, "Content-Type": {"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(`{"status":{"message":"Forbidden","status_code":403}}`)),
+		}, nil
+	}}
+	c := NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger())
+	_, err := c.Summoner.GetByName("name")
+	var responseErr *api.ResponseError
+	require.True(t, errors.As(err, &responseErr))
+	assert.True(t, errors.Is(err, api.ErrForbidden))
+	assert.Equal(t, http.StatusForbidden, responseErr.StatusCode)
+	assert.Equal(t, "/lol/summoner/v4/summoners/by-name/name", responseErr.Endpoint)
+	assert.Equal(t, &api.Status{Message: "Forbidden", StatusCode: http.StatusForbidden}, responseErr.Status)
+	assert.Equal(t, http.Header{"X-App-Rate-Limit": {"20:1"}}, responseErr.Header)
+	assert.Equal(t, "GET https://euw1.api.riotgames.com/lol/summoner/v4/summoners/by-name/name: forbidden (403): "+
+		`{"status":{"message":"Forbidden","status_code":403}}`, err.Error())
+	assert.NotContains(t, err.Error(), "API_KEY")
+}
+
+func TestClient_errorTypes(t *testing.T) {
+	t.Parallel()
+	refused := errors.New("connection refused")
+	c := NewClient(api.RegionEuropeWest, "API_KEY", &mock.Doer{Custom: func(*http.Request) (*http.Response, error) {
+		return nil, refused
+	}}, logrus.StandardLogger())
+	_, err := c.Summoner.GetByName("name")
+	var transportErr *api.TransportError
+	require.True(t, errors.As(err, &transportErr))
+	assert.True(t, errors.Is(err, refused))
+	assert.Equal(t, "/lol/summoner/v4/summoners/by-name/name", transportErr.Endpoint)
+	assert.True(t, api.IsRetryable(err))
+
+	c = NewClient(api.RegionEuropeWest, "API_KEY", &mock.Doer{Custom: func(*http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusTooManyRequests,
+			Header:     http.Header{"X-Rate-Limit-Type": {"service"}},
+			Body:       io.NopCloser(strings.NewReader("{}")),
+		}, nil
+	}}, logrus.StandardLogger(), WithRateLimitErrors())
+	_, err = c.Summoner.GetByName("name")
+	var rateLimitErr *api.RateLimitError
+	require.True(t, errors.As(err, &rateLimitErr))
+	assert.True(t, errors.Is(err, api.ErrRateLimitExceeded))
+	assert.Equal(t, "service", rateLimitErr.Type)
+	assert.True(t, api.IsRetryable(err))
+
+	c = NewClient(api.RegionEuropeWest, "API_KEY", &mock.Doer{Custom: func(*http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{"id":1}`))}, nil
+	}}, logrus.StandardLogger())
+	_, err = c.Summoner.GetByName("name")
+	var decodeErr *api.DecodeError
+	require.True(t, errors.As(err, &decodeErr))
+	var typeErr *json.UnmarshalTypeError
+	assert.True(t, errors.As(err, &typeErr))
+	assert.False(t, api.IsRetryable(err))
+}
+
+func TestClient_unknownRegion(t *testing.T) {
+	t.Parallel()
+	doer := mock.NewJSONMockDoer(Summoner{}, 200)
+	c := NewClient("EUW", "API_KEY", doer, logrus.StandardLogger())
+	var regionErr *api.UnknownRegionError
+	require.True(t, errors.As(c.Err(), &regionErr))
+	assert.Equal(t, "EUW", regionErr.Region)
+	summoner, err := c.Summoner.GetByName("name")
+	assert.Nil(t, summoner)
+	assert.Equal(t, c.Err(), err)
+	doer.AssertCalls(t, 0)
+	assert.Nil(t, NewClient(api.RegionKorea, "API_KEY", doer, logrus.StandardLogger()).Err())
+}
+
+func TestClient_getIntoStrict(t *testing.T) {
+	t.Parallel()
+	body := map[string]interface{}{"id": "id", "name": "name", "newField": true}
+	tests := []struct {
+		name        string
+		options     []Option
+		body        interface{}
+		want        *Summoner
+		wantErr     bool
+		wantUnknown []string
+	}{
+		{
+			name: "lenient",
+			body: body,
+			want: &Summoner{ID: "id", Name: "name"},
+		},
+		{
+			name:    "strict error",
+			options: []Option{WithStrictDecoding(nil)},
+			body:    body,
+			wantErr: true,
+		},
+		{
+			name:        "strict hook",
+			body:        body,
+			want:        &Summoner{ID: "id", Name: "name"},
+			wantUnknown: []string{"newField"},
+		},
+		{
+			name:    "strict known fields",
+			options: []Option{WithStrictDecoding(nil)},
+			body:    map[string]interface{}{"id": "id"},
+			want:    &Summoner{ID: "id"},
+		},
+		{
+			name:    "strict null",
+			options: []Option{WithStrictDecoding(nil)},
+			body:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var unknown []string
+			options := tt.options
+			if tt.wantUnknown != nil {
+				options = append(options, WithStrictDecoding(func(err *UnknownFieldError) {
+					assert.Equal(t, "endpoint", err.Endpoint)
+					assert.NotNil(t, errors.Unwrap(err))
+					unknown = append(unknown, err.Field)
+				}))
+			}
+			c := NewClient(api.RegionOceania, "API_KEY", mock.NewJSONMockDoer(tt.body, 200),
+				logrus.StandardLogger(), options...)
+			var got *Summoner
+			err := c.getInto("endpoint", &got)
+			assert.Equal(t, tt.wantUnknown, unknown)
+			if tt.wantErr {
+				require.NotNil(t, err)
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+	c := NewClient(api.RegionOceania, "API_KEY", mock.NewJSONMockDoer(body, 200), logrus.StandardLogger(),
+		WithStrictDecoding(nil))
+	_, err := c.Summoner.GetByID("id")
+	var unknown *UnknownFieldError
+	require.True(t, errors.As(err, &unknown))
+	assert.Equal(t, "newField", unknown.Field)
+	assert.Equal(t, []string{"newField"}, unknown.Fields)
+	assert.Equal(t, "riot: unknown field newField in response of /lol/summoner/v4/summoners/id", err.Error())
+}
+
+func TestClient_rawCapture(t *testing.T) {
+	t.Parallel()
+	var captured []RawResponse
+	capture := WithRawCapture(func(raw RawResponse) {
+		captured = append(captured, raw)
+	})
+	body := map[string]interface{}{"id": "id", "newField": true}
+	c := NewClient(api.RegionOceania, "API_KEY", mock.NewJSONMockDoer(body, 200), logrus.StandardLogger(), capture,
+		WithStrictDecoding(func(*UnknownFieldError) {}))
+	summoner, err := c.Summoner.GetByID("id")
+	require.Nil(t, err)
+	assert.Equal(t, &Summoner{ID: "id"}, summoner)
+	_, err = c.Tournament.CreateProvider(&ProviderRegistrationParameters{}, true)
+	require.NotNil(t, err)
+	require.Len(t, captured, 2)
+	assert.Equal(t, http.MethodGet, captured[0].Method)
+	assert.Equal(t, "/lol/summoner/v4/summoners/id", captured[0].Endpoint)
+	assert.JSONEq(t, `{"id":"id","newField":true}`, string(captured[0].Body))
+	assert.Equal(t, http.MethodPost, captured[1].Method)
+
+	c = NewClient(api.RegionOceania, "API_KEY", mock.NewStatusMockDoer(http.StatusNotFound),
+		logrus.StandardLogger(), capture)
+	_, err = c.Summoner.GetByID("id")
+	assert.True(t, errors.Is(err, api.ErrNotFound))
+	assert.Len(t, captured, 2)
+}
+
+func TestClient_GetInto(t *testing.T) {
+	t.Parallel()
+	c := NewClient(api.RegionOceania, "API_KEY", mock.NewJSONMockDoer(map[string]int{"id": 1}, 200),
+		logrus.StandardLogger())
+	var got struct {
+		ID int `json:"id"`
+	}
+	require.Nil(t, c.GetInto(context.Background(), "/lol/new", &got))
+	assert.Equal(t, 1, got.ID)
+
+	c = NewClient(api.RegionOceania, "API_KEY", mock.NewRateLimitDoer(nil, 1, 60), logrus.StandardLogger())
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := c.GetInto(ctx, "/lol/new", &got)
+	assert.True(t, errors.Is(err, context.Canceled))
+}
+
+func TestClient_WithContext(t *testing.T) {
+	t.Parallel()
+	c := NewClient(api.RegionOceania, "API_KEY", mock.NewRateLimitDoer(&Summoner{ID: "id"}, 1, 60),
+		logrus.StandardLogger())
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err := c.WithContext(ctx).Summoner.GetByID("id")
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.Less(t, int64(time.Since(start)), int64(time.Second))
+	assert.Equal(t, int64(1), c.StatsSnapshot().RateLimited)
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	value := <-c.WithContext(cancelled).Match.ListStream("account", nil)
+	assert.True(t, errors.Is(value.Error, context.Canceled))
+	summoner, err := c.Summoner.GetByID("id")
+	require.Nil(t, err)
+	assert.Equal(t, "id", summoner.ID)
+}
+
+func TestWithBaseContext(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	c := NewClient(api.RegionOceania, "API_KEY", mock.NewRateLimitDoer(&Summoner{ID: "id"}, 1, 60),
+		logrus.StandardLogger(), WithBaseContext(ctx))
+	time.AfterFunc(10*time.Millisecond, cancel)
+	start := time.Now()
+	_, err := c.Summoner.GetByID("id")
+	assert.True(t, errors.Is(err, context.Canceled))
+	assert.Less(t, int64(time.Since(start)), int64(time.Second))
+
+	c = NewClient(api.RegionOceania, "API_KEY", mock.NewRateLimitDoer([]*LeagueItem{}, 1, 60),
+		logrus.StandardLogger())
+	pager := c.League.ListPlayersPager(QueueRankedSolo, TierGold, DivisionOne)
+	deadline, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	start = time.Now()
+	_, err = pager.Next(deadline)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.Less(t, int64(time.Since(start)), int64(time.Second))
+}
+
+func TestClient_ForRegion(t *testing.T) {
+	t.Parallel()
+	var hosts []string
+	doer := &mock.Doer{Custom: func(r *http.Request) (*http.Response, error) {
+		hosts = append(hosts, r.URL.Host)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"X-App-Rate-Limit": {"20:1"}, "X-App-Rate-Limit-Count": {"1:1"}},
+			Body:       io.NopCloser(strings.NewReader(`{"id":"id"}`)),
+		}, nil
+	}}
+	c := NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger())
+	korea := c.ForRegion(api.RegionKorea)
+	_, err := korea.Summoner.GetByID("id")
+	require.Nil(t, err)
+	_, err = korea.Account.GetByRiotID("name", "KR1")
+	require.Nil(t, err)
+	assert.Equal(t, []string{"kr.api.riotgames.com", "asia.api.riotgames.com"}, hosts)
+	assert.Equal(t, api.RegionEuropeWest, c.Region)
+	assert.Len(t, korea.RateLimitStatus().App, 1)
+	assert.Empty(t, c.RateLimitStatus().App)
+	assert.Len(t, c.ForRegion(api.RegionKorea).RateLimitStatus().App, 1)
+	assert.Equal(t, int64(2), c.StatsSnapshot().Requests)
+
+	_, err = c.ForRegion("xx1").Summoner.GetByID("id")
+	var unknown *api.UnknownRegionError
+	assert.True(t, errors.As(err, &unknown))
+	assert.Len(t, hosts, 2)
+}
+
+func TestClient_newRequest(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		region   api.Region
+		endpoint string
+		want     string
+	}{
+		{api.RegionKorea, "/lol/summoner/v4/summoners/id", "kr.api.riotgames.com"},
+		{api.RegionOceania, "/lol/match/v5/matches/OC1_1", "sea.api.riotgames.com"},
+		{api.RegionEuropeWest, "/tft/match/v1/matches/EUW1_1", "europe.api.riotgames.com"},
+		{api.RegionBrasil, "/lor/match/v1/matches/id", "americas.api.riotgames.com"},
+		{api.RegionOceania, "/riot/account/v1/accounts/by-puuid/puuid", "asia.api.riotgames.com"},
+	}
+	for _, tt := range tests {
+		c := NewClient(tt.region, "API_KEY", mock.NewStatusMockDoer(http.StatusOK), logrus.StandardLogger())
+		request, err := c.newRequest(context.Background(), http.MethodGet, tt.endpoint, nil)
+		require.Nil(t, err)
+		assert.Equal(t, tt.want, request.URL.Host, tt.endpoint)
+	}
+}
+
+func TestClient_GetRaw(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		doer    internal.Doer
+		want    string
+		wantErr error
+	}{
+		{
+			name: "get response",
+			doer: mock.NewJSONMockDoer(map[string]int{"id": 1}, 200),
+			want: `{"id":1}`,
+		},
+		{
+			name:    "not found",
+			doer:    mock.NewStatusMockDoer(http.StatusNotFound),
+			wantErr: api.ErrNotFound,
+		},
+		{
+			name: "invalid json",
+			doer: &mock.Doer{Custom: func(*http.Request) (*http.Response, error) {
+				return &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader("{"))}, nil
+			}},
+			wantErr: errors.New("decoding response of /lol/new: response is no valid json"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClient(api.RegionOceania, "API_KEY", tt.doer, logrus.StandardLogger())
+			got, err := c.GetRaw("/lol/new")
+			if tt.wantErr != nil {
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr.Error())
+			} else {
+				require.Nil(t, err)
+				assert.JSONEq(t, tt.want, string(got))
+			}
+		})
+	}
+}
+
+func TestClient_Forward(t *testing.T) {
+	t.Parallel()
+	var got *http.Request
+	doer := &mock.Doer{Custom: func(r *http.Request) (*http.Response, error) {
+		got = r
+		header := http.Header{}
+		header.Set("Retry-After", "10")
+		return &http.Response{
+			StatusCode: http.StatusTooManyRequests,
+			Header:     header,
+			Body:       io.NopCloser(strings.NewReader(`{"status":{}}`)),
+		}, nil
+	}}
+	c := NewClient(api.RegionOceania, "API_KEY", doer, logrus.StandardLogger())
+	header := http.Header{}
+	header.Set("X-Riot-Token", "CLIENT_KEY")
+	header.Set("X-Custom", "value")
+	response, err := c.Forward(context.Background(), http.MethodPost, "/lol/new/v1/path?a=b", header,
+		strings.NewReader("body"))
+	require.Nil(t, err)
+	defer response.Body.Close()
+	assert.Equal(t, http.StatusTooManyRequests, response.StatusCode)
+	assert.Equal(t, "https://oc1.api.riotgames.com/lol/new/v1/path?a=b", got.URL.String())
+	assert.Equal(t, http.MethodPost, got.Method)
+	assert.Equal(t, []string{"API_KEY"}, got.Header.Values("X-Riot-Token"))
+	assert.Equal(t, "value", got.Header.Get("X-Custom"))
+	assert.True(t, c.RateLimitStatus().Throttled())
+}
+
+func TestClient_Do(t *testing.T) {
+	t.Parallel()
+	var bodies []string
+	calls := 0
+	doer := &mock.Doer{Custom: func(r *http.Request) (*http.Response, error) {
+		calls++
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		header := http.Header{}
+		header.Set("X-Custom", "value")
+		status := http.StatusOK
+		if calls == 1 {
+			status = http.StatusServiceUnavailable
+		}
+		return &http.Response{
+			StatusCode: status,
+			Header:     header,
+			Body:       io.NopCloser(strings.NewReader(`{"id":1}`)),
+		}, nil
+	}}
+	c := NewClient(api.RegionOceania, "API_KEY", doer, logrus.StandardLogger())
+	raw, header, err := c.Do(http.MethodPost, "/lol/new/v1/path", map[string]string{"name": "value"})
+	require.Nil(t, err)
+	assert.JSONEq(t, `{"id":1}`, string(raw))
+	assert.Equal(t, "value", header.Get("X-Custom"))
+	require.Len(t, bodies, 2)
+	assert.JSONEq(t, `{"name":"value"}`, bodies[0])
+	assert.Equal(t, bodies[0], bodies[1])
+
+	c = NewClient(api.RegionOceania, "API_KEY", mock.NewStatusMockDoer(http.StatusForbidden), logrus.StandardLogger())
+	_, _, err = c.Do(http.MethodGet, "/lol/new/v1/path", nil)
+	assert.True(t, errors.Is(err, api.ErrForbidden))
+}
+
 func TestClient_postInto(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -157,34 +570,6 @@ func TestClient_put(t *testing.T) {
 	}
 }
 
-func rateLimitDoer(object interface{}) internal.Doer {
-	rateLimitCount := 0
-	return &mock.Doer{
-		Custom: func(r *http.Request) (*http.Response, error) {
-			if rateLimitCount == 1 {
-				return mock.NewJSONMockDoer(object, 200).Do(r)
-			}
-			rateLimitCount++
-			return mock.NewHeaderMockDoer(http.StatusTooManyRequests, http.Header{
-				"Retry-After": []string{"1"},
-			}).Do(r)
-		},
-	}
-}
-
-func unavailableOnceDoer(object interface{}) internal.Doer {
-	unavailableCount := 0
-	return &mock.Doer{
-		Custom: func(r *http.Request) (*http.Response, error) {
-			if unavailableCount == 1 {
-				return mock.NewJSONMockDoer(object, 200).Do(r)
-			}
-			unavailableCount++
-			return mock.NewStatusMockDoer(http.StatusServiceUnavailable).Do(r)
-		},
-	}
-}
-
 func failOnSecondDoer() internal.Doer {
 	count := 0
 	return &mock.Doer{
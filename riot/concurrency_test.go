@@ -0,0 +1,88 @@
+package riot
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mjourard/golio/api"
+	"github.com/mjourard/golio/mock"
+)
+
+// TestClient_concurrent uses a single client with all stateful options from many goroutines. Run it with -race to
+// detect unsynchronized state
+func TestClient_concurrent(t *testing.T) {
+	t.Parallel()
+	var calls int64
+	doer := &mock.Doer{Custom: func(r *http.Request) (*http.Response, error) {
+		count := atomic.AddInt64(&calls, 1)
+		header := http.Header{}
+		header.Set(headerAppRateLimit, "20:1,100:120")
+		header.Set(headerAppRateLimitCount, fmt.Sprintf("%d:1,%d:120", count%20, count%100))
+		header.Set(headerMethodRateLimit, "2000:60")
+		header.Set(headerMethodRateLimitCount, fmt.Sprintf("%d:60", count))
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     header,
+			Body:       io.NopCloser(strings.NewReader(`{"id":"id","name":"name","newField":true}`)),
+		}, nil
+	}}
+	key := NewRotatingKey("API_KEY")
+	var captured, unknown int64
+	counter := &UsageCounter{}
+	client := NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger(),
+		WithKeyProvider(key),
+		WithRawCapture(func(RawResponse) { atomic.AddInt64(&captured, 1) }),
+		WithStrictDecoding(func(*UnknownFieldError) { atomic.AddInt64(&unknown, 1) }),
+		WithRequestObserver(NewErrorMonitor(ErrorThreshold{Rate: 0.5, Window: 10}, func(ErrorAlert) {})),
+		WithAuditSink(counter),
+		WithRetryLogSampling(10),
+		WithMiddleware(func(next Doer) Doer {
+			return DoerFunc(func(r *http.Request) (*http.Response, error) {
+				r.Header.Set("X-Correlation-Id", "id")
+				return next.Do(r)
+			})
+		}),
+	)
+	const goroutines = 100
+	var wg sync.WaitGroup
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			var c *Client
+			switch i % 3 {
+			case 0:
+				c = client
+			case 1:
+				c = client.WithContext(context.Background())
+			case 2:
+				c = client.ForRegion(api.RegionNorthAmerica)
+			}
+			if i%10 == 0 {
+				key.Set(fmt.Sprintf("KEY_%d", i))
+			}
+			summoner, err := c.Summoner.GetByID("id")
+			assert.Nil(t, err)
+			assert.Equal(t, "name", summoner.Name)
+			_, _, err = c.Do(http.MethodGet, "/lol/summoner/v4/summoners/id", nil)
+			assert.Nil(t, err)
+			_ = c.RateLimitStatus().Remaining("summoner-v4")
+			_ = c.StatsSnapshot()
+			_ = counter.Counts()
+		}(i)
+	}
+	wg.Wait()
+	assert.Equal(t, int64(2*goroutines), atomic.LoadInt64(&calls))
+	assert.Equal(t, int64(2*goroutines), atomic.LoadInt64(&captured))
+	assert.Equal(t, int64(goroutines), atomic.LoadInt64(&unknown))
+	assert.Equal(t, int64(2*goroutines), client.StatsSnapshot().Requests)
+}
@@ -0,0 +1,72 @@
+package riot
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mjourard/golio/api"
+)
+
+// newKeepAliveServer returns a server answering with a summoner, or 404 for endpoints containing "missing", and a
+// pointer to the number of connections opened to it. The responses end with whitespace, so that decoding them does
+// not read the body until EOF
+func newKeepAliveServer(tb testing.TB) (*httptest.Server, *int64) {
+	var connections int64
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if strings.Contains(r.URL.Path, "missing") {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprintln(w, `{"status":{"message":"Data not found","status_code":404}}`)
+			return
+		}
+		fmt.Fprintln(w, `{"id":"id","name":"name"}`+strings.Repeat(" ", 16<<10))
+	}))
+	server.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			atomic.AddInt64(&connections, 1)
+		}
+	}
+	server.Start()
+	tb.Cleanup(server.Close)
+	return server, &connections
+}
+
+func TestClient_connectionReuse(t *testing.T) {
+	t.Parallel()
+	server, connections := newKeepAliveServer(t)
+	c := NewClient(api.RegionEuropeWest, "API_KEY", server.Client(), logrus.StandardLogger(),
+		WithBaseURL(server.URL))
+	for i := 0; i < 5; i++ {
+		_, err := c.Summoner.GetByID("id")
+		require.Nil(t, err)
+		_, err = c.Summoner.GetByID("missing")
+		require.NotNil(t, err)
+		_, err = c.GetRaw("/lol/summoner/v4/summoners/id")
+		require.Nil(t, err)
+		_, _, err = c.Do(http.MethodGet, "/lol/summoner/v4/summoners/id", nil)
+		require.Nil(t, err)
+	}
+	assert.Equal(t, int64(1), atomic.LoadInt64(connections))
+}
+
+// BenchmarkClient_keepAlive reports the connections opened per request, which is close to 0 if connections are reused
+func BenchmarkClient_keepAlive(b *testing.B) {
+	server, connections := newKeepAliveServer(b)
+	c := NewClient(api.RegionEuropeWest, "API_KEY", server.Client(), logrus.New(), WithBaseURL(server.URL))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := c.Summoner.GetByID("id"); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.ReportMetric(float64(atomic.LoadInt64(connections))/float64(b.N), "conns/op")
+}
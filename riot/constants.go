@@ -1,9 +1,9 @@
 package riot
 
+import "github.com/mjourard/golio/api"
+
 const (
-	apiURLFormat                         = "%s://%s.%s%s"
-	baseURL                              = "api.riotgames.com"
-	scheme                               = "https"
+	defaultBaseURL                       = "https://{route}.api.riotgames.com"
 	apiTokenHeaderKey                    = "X-Riot-Token"
 	endpointMasteryBase                  = "/lol/champion-mastery/v4"
 	endpointGetChampionMasteries         = endpointMasteryBase + "/champion-masteries/by-summoner/%s"
@@ -20,6 +20,7 @@ const (
 	endpointGetLeague                    = endpointLeagueBase + "/leagues/%s"
 	endpointStatusBase                   = "/lol/status/v3"
 	endpointGetStatus                    = endpointStatusBase + "/shard-data"
+	endpointGetPlatformData              = "/lol/status/v4/platform-data"
 	endpointMatchBase                    = "/lol/match/v4"
 	endpointGetMatch                     = endpointMatchBase + "/matches/%d"
 	endpointGetMatchesByAccount          = endpointMatchBase + "/matchlists/by-account/%s%s"
@@ -45,8 +46,23 @@ const (
 	endpointGetTournament                = endpointTournamentBase + "/codes/%s"
 	endpointUpdateTournament             = endpointTournamentBase + "/codes/%s"
 	endpointGetThirdPartyCode            = endpointPlatformBase + "/third-party-code/by-summoner/%s"
+	endpointAccountBase                  = "/riot/account/v1"
+	endpointGetAccountByPUUID            = endpointAccountBase + "/accounts/by-puuid/%s"
+	endpointGetAccountByRiotID           = endpointAccountBase + "/accounts/by-riot-id/%s/%s"
+	endpointMatchV5Base                  = "/lol/match/v5"
+	endpointTFTMatchBase                 = "/tft/match/v1"
+	endpointLORMatchBase                 = "/lor/match/v1"
 )
 
+// continentalEndpoints map the prefixes of endpoints which are served by the host of a continent to the continent
+// serving a region. Endpoints of these families not supported by golio yet can be requested using Client.GetInto
+var continentalEndpoints = map[string]func(api.Region) api.Continent{
+	endpointAccountBase:  api.Region.AccountContinent,
+	endpointMatchV5Base:  api.Region.Continent,
+	endpointTFTMatchBase: api.Region.Continent,
+	endpointLORMatchBase: api.Region.Continent,
+}
+
 // Identification is the different parameters of summoner identification
 type Identification string
 
@@ -78,6 +94,10 @@ const (
 	TierGold          = "GOLD"
 	TierPlatinum      = "PLATINUM"
 	TierDiamond       = "DIAMOND"
+	// The apex tiers consist of a single league with only division I
+	TierMaster      = "MASTER"
+	TierGrandmaster = "GRANDMASTER"
+	TierChallenger  = "CHALLENGER"
 )
 
 // Division is the sub division that a summoner is in within their tier (1,2,3 or 4)
@@ -91,24 +111,68 @@ const (
 	DivisionFour           = "IV"
 )
 
+// MapType is the map of the games of a tournament code
+type MapType string
+
+// All possible map types
+const (
+	MapTypeSummonersRift   MapType = "SUMMONERS_RIFT"
+	MapTypeTwistedTreeline         = "TWISTED_TREELINE"
+	MapTypeHowlingAbyss            = "HOWLING_ABYSS"
+)
+
+// PickType is the champion selection of the games of a tournament code
+type PickType string
+
+// All possible pick types
+const (
+	PickTypeBlindPick       PickType = "BLIND_PICK"
+	PickTypeDraftMode                = "DRAFT_MODE"
+	PickTypeAllRandom                = "ALL_RANDOM"
+	PickTypeTournamentDraft          = "TOURNAMENT_DRAFT"
+)
+
+// SpectatorType is the type of spectators allowed in the games of a tournament code
+type SpectatorType string
+
+// All possible spectator types
+const (
+	SpectatorTypeNone      SpectatorType = "NONE"
+	SpectatorTypeLobbyOnly               = "LOBBYONLY"
+	SpectatorTypeAll                     = "ALL"
+)
+
 // MatchEventType is the type of an event
 type MatchEventType string
 
 // All legal value for match event types
 const (
-	MatchEventTypeChampionKill     MatchEventType = "CHAMPION_KILL"
-	MatchEventTypeWardPlaced                      = "WARD_PLACED"
-	MatchEventTypeWardKill                        = "WARD_KILL"
-	MatchEventTypeBuildingKill                    = "BUILDING_KILL"
-	MatchEventTypeEliteMonsterKill                = "ELITE_MONSTER_KILL"
-	MatchEventTypeItemPurchased                   = "ITEM_PURCHASED"
-	MatchEventTypeItemSold                        = "ITEM_SOLD"
-	MatchEventTypeItemDestroyed                   = "ITEM_DESTROYED"
-	MatchEventTypeItemUndo                        = "ITEM_UNDO"
-	MatchEventTypeSkillLevelUp                    = "SKILL_LEVEL_UP"
-	MatchEventTypeAscendedEvent                   = "ASCENDED_EVENT"
-	MatchEventTypeCapturePoint                    = "CAPTURE_POINT"
-	MatchEventTypePoroKingSummon                  = "PORO_KING_SUMMON"
+	MatchEventTypeChampionKill         MatchEventType = "CHAMPION_KILL"
+	MatchEventTypeChampionSpecialKill  MatchEventType = "CHAMPION_SPECIAL_KILL"
+	MatchEventTypeWardPlaced           MatchEventType = "WARD_PLACED"
+	MatchEventTypeWardKill             MatchEventType = "WARD_KILL"
+	MatchEventTypeBuildingKill         MatchEventType = "BUILDING_KILL"
+	MatchEventTypeTurretPlateDestroyed MatchEventType = "TURRET_PLATE_DESTROYED"
+	MatchEventTypeEliteMonsterKill     MatchEventType = "ELITE_MONSTER_KILL"
+	MatchEventTypeDragonSoulGiven      MatchEventType = "DRAGON_SOUL_GIVEN"
+	MatchEventTypeItemPurchased        MatchEventType = "ITEM_PURCHASED"
+	MatchEventTypeItemSold             MatchEventType = "ITEM_SOLD"
+	MatchEventTypeItemDestroyed        MatchEventType = "ITEM_DESTROYED"
+	MatchEventTypeItemUndo             MatchEventType = "ITEM_UNDO"
+	MatchEventTypeSkillLevelUp         MatchEventType = "SKILL_LEVEL_UP"
+	MatchEventTypeLevelUp              MatchEventType = "LEVEL_UP"
+	MatchEventTypeAscendedEvent        MatchEventType = "ASCENDED_EVENT"
+	MatchEventTypeCapturePoint         MatchEventType = "CAPTURE_POINT"
+	MatchEventTypePoroKingSummon       MatchEventType = "PORO_KING_SUMMON"
+	MatchEventTypeGameEnd              MatchEventType = "GAME_END"
+)
+
+// Types of elite monster kills. The Riot API sends MatchEventTypeEliteMonsterKill for all of them, TimelineEvent.Type
+// returns the type for the killed monster instead
+const (
+	MatchEventTypeDragonKill     MatchEventType = "DRAGON_KILL"
+	MatchEventTypeBaronKill      MatchEventType = "BARON_KILL"
+	MatchEventTypeRiftHeraldKill MatchEventType = "RIFT_HERALD_KILL"
 )
 
 var (
@@ -137,20 +201,47 @@ var (
 		DivisionFour,
 	}
 
+	// MapTypes is a list of all available map types
+	MapTypes = []MapType{
+		MapTypeSummonersRift,
+		MapTypeTwistedTreeline,
+		MapTypeHowlingAbyss,
+	}
+
+	// PickTypes is a list of all available pick types
+	PickTypes = []PickType{
+		PickTypeBlindPick,
+		PickTypeDraftMode,
+		PickTypeAllRandom,
+		PickTypeTournamentDraft,
+	}
+
+	// SpectatorTypes is a list of all available spectator types
+	SpectatorTypes = []SpectatorType{
+		SpectatorTypeNone,
+		SpectatorTypeLobbyOnly,
+		SpectatorTypeAll,
+	}
+
 	// MatchEventTypes is a list of all available match events
 	MatchEventTypes = []MatchEventType{
 		MatchEventTypeChampionKill,
+		MatchEventTypeChampionSpecialKill,
 		MatchEventTypeWardPlaced,
 		MatchEventTypeWardKill,
 		MatchEventTypeBuildingKill,
+		MatchEventTypeTurretPlateDestroyed,
 		MatchEventTypeEliteMonsterKill,
+		MatchEventTypeDragonSoulGiven,
 		MatchEventTypeItemPurchased,
 		MatchEventTypeItemSold,
 		MatchEventTypeItemDestroyed,
 		MatchEventTypeItemUndo,
 		MatchEventTypeSkillLevelUp,
+		MatchEventTypeLevelUp,
 		MatchEventTypeAscendedEvent,
 		MatchEventTypeCapturePoint,
 		MatchEventTypePoroKingSummon,
+		MatchEventTypeGameEnd,
 	}
 )
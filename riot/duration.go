@@ -0,0 +1,74 @@
+package riot
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Riot sends durations either in seconds or in milliseconds depending on the endpoint. The two types below hide the
+// unit so that all durations can be used as time.Duration, e.g. match.GameDuration.Minutes(). The unit used by the
+// API is documented at each field
+
+// DurationSeconds is a duration sent by the Riot API as whole seconds
+type DurationSeconds struct {
+	time.Duration
+}
+
+// Raw returns the duration in seconds, as sent by the Riot API
+func (d DurationSeconds) Raw() int64 {
+	return int64(d.Duration / time.Second)
+}
+
+// MarshalJSON encodes the duration as whole seconds
+func (d DurationSeconds) MarshalJSON() ([]byte, error) {
+	return strconv.AppendInt(nil, d.Raw(), 10), nil
+}
+
+// UnmarshalJSON decodes a duration given as seconds
+func (d *DurationSeconds) UnmarshalJSON(data []byte) error {
+	raw, err := parseDuration(data)
+	if err != nil || raw == nil {
+		return err
+	}
+	d.Duration = time.Duration(*raw) * time.Second
+	return nil
+}
+
+// DurationMillis is a duration sent by the Riot API as milliseconds
+type DurationMillis struct {
+	time.Duration
+}
+
+// Raw returns the duration in milliseconds, as sent by the Riot API
+func (d DurationMillis) Raw() int64 {
+	return int64(d.Duration / time.Millisecond)
+}
+
+// MarshalJSON encodes the duration as milliseconds
+func (d DurationMillis) MarshalJSON() ([]byte, error) {
+	return strconv.AppendInt(nil, d.Raw(), 10), nil
+}
+
+// UnmarshalJSON decodes a duration given as milliseconds
+func (d *DurationMillis) UnmarshalJSON(data []byte) error {
+	raw, err := parseDuration(data)
+	if err != nil || raw == nil {
+		return err
+	}
+	d.Duration = time.Duration(*raw) * time.Millisecond
+	return nil
+}
+
+// parseDuration returns the integer in data or nil for null
+func parseDuration(data []byte) (*int64, error) {
+	if bytes.Equal(data, []byte("null")) {
+		return nil, nil
+	}
+	raw, err := strconv.ParseInt(string(data), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("riot: invalid duration %s: %w", data, err)
+	}
+	return &raw, nil
+}
@@ -0,0 +1,61 @@
+package riot
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDuration_JSON(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		data    string
+		want    Match
+		wantErr bool
+	}{
+		{
+			name: "seconds",
+			data: `{"gameDuration":1800}`,
+			want: Match{GameDuration: DurationSeconds{Duration: 30 * time.Minute}},
+		},
+		{
+			name: "null",
+			data: `{"gameDuration":null}`,
+		},
+		{
+			name:    "invalid",
+			data:    `{"gameDuration":"long"}`,
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var got Match
+			err := json.Unmarshal([]byte(test.data), &got)
+			if test.wantErr {
+				assert.NotNil(t, err)
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, test.want.GameDuration, got.GameDuration)
+		})
+	}
+}
+
+func TestDurationMillis_JSON(t *testing.T) {
+	t.Parallel()
+	var frame MatchFrame
+	require.Nil(t, json.Unmarshal([]byte(`{"timestamp":61500}`), &frame))
+	assert.Equal(t, 61500*time.Millisecond, frame.Timestamp.Duration)
+	assert.Equal(t, int64(61500), frame.Timestamp.Raw())
+	data, err := json.Marshal(frame.Timestamp)
+	require.Nil(t, err)
+	assert.Equal(t, "61500", string(data))
+	data, err = json.Marshal(DurationSeconds{Duration: 90 * time.Second})
+	require.Nil(t, err)
+	assert.Equal(t, "90", string(data))
+}
@@ -0,0 +1,136 @@
+package riot
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/mjourard/golio/api"
+)
+
+// ErrorThreshold configures when an ErrorMonitor raises an alert. A zero field disables its check
+type ErrorThreshold struct {
+	// Rate is the share of failed requests in [0, 1] among the last Window requests
+	Rate float64
+	// Window is the number of the most recent requests used for Rate
+	Window int
+	// ConsecutiveFailures is the number of failed requests in a row
+	ConsecutiveFailures int
+}
+
+// ErrorAlert is passed to the callback of an ErrorMonitor when the errors of an endpoint family in a region cross the
+// threshold, or fall below it again
+type ErrorAlert struct {
+	Region api.Region
+	// Family is the API of the endpoint, e.g. summoner-v4, see EndpointFamily
+	Family string
+	// Rate is the share of failed requests among the last requests
+	Rate float64
+	// ConsecutiveFailures is the number of the last requests which failed in a row
+	ConsecutiveFailures int
+	// Recovered is true if the errors fell below the threshold again after an alert
+	Recovered bool
+}
+
+// ErrorMonitor is a RequestObserver calling a callback when the error rate or the number of consecutive failures of
+// an endpoint family in a region crosses a threshold, e.g. to page on-call or to switch to a degraded mode. Requests
+// fail if they return no response, a server error or a status code of an invalid API key. The callback is called
+// once when the threshold is crossed and once when the errors fall below it again
+type ErrorMonitor struct {
+	threshold ErrorThreshold
+	callback  func(ErrorAlert)
+	mu        sync.Mutex
+	states    map[errorMonitorKey]*errorState
+}
+
+type errorMonitorKey struct {
+	region api.Region
+	family string
+}
+
+// errorState contains the results of the last requests of a family in a ring buffer
+type errorState struct {
+	results     []bool
+	next        int
+	failures    int
+	consecutive int
+	alerting    bool
+}
+
+// NewErrorMonitor returns a monitor calling callback for the threshold, add it to a client using WithRequestObserver.
+// The callback is called synchronously from the goroutine sending the request, so it must be fast
+func NewErrorMonitor(threshold ErrorThreshold, callback func(ErrorAlert)) *ErrorMonitor {
+	return &ErrorMonitor{
+		threshold: threshold,
+		callback:  callback,
+		states:    map[errorMonitorKey]*errorState{},
+	}
+}
+
+// ObserveRequest records the result of the request and calls the callback if the threshold is crossed
+func (m *ErrorMonitor) ObserveRequest(stats RequestStats) {
+	failed := stats.Err != nil || stats.StatusCode >= http.StatusInternalServerError ||
+		stats.StatusCode == http.StatusUnauthorized || stats.StatusCode == http.StatusForbidden
+	key := errorMonitorKey{region: stats.Region, family: stats.Family}
+	m.mu.Lock()
+	state, ok := m.states[key]
+	if !ok {
+		state = &errorState{results: make([]bool, 0, m.threshold.Window)}
+		m.states[key] = state
+	}
+	state.record(failed, m.threshold.Window)
+	exceeded := m.exceeded(state)
+	changed := exceeded != state.alerting
+	state.alerting = exceeded
+	alert := ErrorAlert{
+		Region:              stats.Region,
+		Family:              stats.Family,
+		Rate:                state.rate(),
+		ConsecutiveFailures: state.consecutive,
+		Recovered:           !exceeded,
+	}
+	m.mu.Unlock()
+	if changed {
+		m.callback(alert)
+	}
+}
+
+// ObserveRetry does nothing, every attempt of a request is observed by ObserveRequest
+func (m *ErrorMonitor) ObserveRetry(RetryStats) {}
+
+func (m *ErrorMonitor) exceeded(state *errorState) bool {
+	if m.threshold.ConsecutiveFailures > 0 && state.consecutive >= m.threshold.ConsecutiveFailures {
+		return true
+	}
+	return m.threshold.Rate > 0 && m.threshold.Window > 0 && len(state.results) == m.threshold.Window &&
+		state.rate() >= m.threshold.Rate
+}
+
+func (s *errorState) record(failed bool, window int) {
+	if failed {
+		s.consecutive++
+	} else {
+		s.consecutive = 0
+	}
+	if window <= 0 {
+		return
+	}
+	if len(s.results) < window {
+		s.results = append(s.results, failed)
+	} else {
+		if s.results[s.next] {
+			s.failures--
+		}
+		s.results[s.next] = failed
+		s.next = (s.next + 1) % window
+	}
+	if failed {
+		s.failures++
+	}
+}
+
+func (s *errorState) rate() float64 {
+	if len(s.results) == 0 {
+		return 0
+	}
+	return float64(s.failures) / float64(len(s.results))
+}
@@ -0,0 +1,73 @@
+package riot
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mjourard/golio/api"
+	"github.com/mjourard/golio/mock"
+)
+
+func TestErrorMonitor_Rate(t *testing.T) {
+	t.Parallel()
+	var alerts []ErrorAlert
+	monitor := NewErrorMonitor(ErrorThreshold{Rate: 0.5, Window: 4}, func(alert ErrorAlert) {
+		alerts = append(alerts, alert)
+	})
+	observe := func(statusCode int) {
+		monitor.ObserveRequest(RequestStats{Region: api.RegionKorea, Family: "match-v4", StatusCode: statusCode})
+	}
+	for _, statusCode := range []int{200, 500, 404, 503} {
+		observe(statusCode)
+	}
+	require.Len(t, alerts, 1)
+	assert.Equal(t, ErrorAlert{Region: api.RegionKorea, Family: "match-v4", Rate: 0.5, ConsecutiveFailures: 1},
+		alerts[0])
+	observe(500)
+	require.Len(t, alerts, 1)
+	observe(200)
+	observe(200)
+	require.Len(t, alerts, 1)
+	observe(200)
+	require.Len(t, alerts, 2)
+	assert.True(t, alerts[1].Recovered)
+	assert.Equal(t, 0.25, alerts[1].Rate)
+}
+
+func TestErrorMonitor_ConsecutiveFailures(t *testing.T) {
+	t.Parallel()
+	var alerts []ErrorAlert
+	monitor := NewErrorMonitor(ErrorThreshold{ConsecutiveFailures: 2}, func(alert ErrorAlert) {
+		alerts = append(alerts, alert)
+	})
+	monitor.ObserveRequest(RequestStats{Family: "summoner-v4", Err: errors.New("timeout")})
+	monitor.ObserveRequest(RequestStats{Family: "league-v4", StatusCode: http.StatusForbidden})
+	assert.Empty(t, alerts)
+	monitor.ObserveRequest(RequestStats{Family: "summoner-v4", StatusCode: http.StatusUnauthorized})
+	require.Len(t, alerts, 1)
+	assert.Equal(t, "summoner-v4", alerts[0].Family)
+	assert.Equal(t, 2, alerts[0].ConsecutiveFailures)
+	assert.False(t, alerts[0].Recovered)
+}
+
+func TestErrorMonitor_Client(t *testing.T) {
+	t.Parallel()
+	var alerts []ErrorAlert
+	monitor := NewErrorMonitor(ErrorThreshold{ConsecutiveFailures: 2}, func(alert ErrorAlert) {
+		alerts = append(alerts, alert)
+	})
+	client := NewClient(api.RegionEuropeWest, "API_KEY", mock.NewStatusMockDoer(http.StatusInternalServerError),
+		logrus.StandardLogger(), WithRequestObserver(monitor))
+	for i := 0; i < 3; i++ {
+		_, err := client.Summoner.GetByName("name")
+		require.NotNil(t, err)
+	}
+	require.Len(t, alerts, 1)
+	assert.Equal(t, api.RegionEuropeWest, alerts[0].Region)
+	assert.Equal(t, "summoner-v4", alerts[0].Family)
+}
@@ -0,0 +1,74 @@
+package riot
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/mjourard/golio/api"
+)
+
+// RegionResult is the result of a request fanned out to a region
+type RegionResult[T any] struct {
+	Region api.Region
+	Value  T
+	Err    error
+}
+
+// FanOut sends the request to all regions concurrently using views of c for the regions, see ForRegion, and returns
+// the results in the order of the regions. The views share the http client and the rate limits of c and are
+// cancelled when ctx is done, e.g.
+//
+//	results := FanOut(ctx, client, api.Regions, func(c *Client) (*Summoner, error) {
+//		return c.Summoner.GetByPUUID(puuid)
+//	})
+func FanOut[T any](ctx context.Context, c *Client, regions []api.Region,
+	request func(c *Client) (T, error)) []RegionResult[T] {
+	results := make([]RegionResult[T], len(regions))
+	var wg sync.WaitGroup
+	for i, region := range regions {
+		wg.Add(1)
+		go func(i int, region api.Region) {
+			defer wg.Done()
+			value, err := request(c.WithContext(ctx).ForRegion(region))
+			results[i] = RegionResult[T]{Region: region, Value: value, Err: err}
+		}(i, region)
+	}
+	wg.Wait()
+	return results
+}
+
+// FindRegion sends the request to all regions concurrently like FanOut and returns the result of the first region
+// answering successfully, cancelling the requests of the other regions, e.g. to find the region of a PUUID. Regions
+// answering with api.ErrNotFound are skipped. If no region succeeds, the first other error is returned, or
+// api.ErrNotFound if all regions answered with it
+func FindRegion[T any](ctx context.Context, c *Client, regions []api.Region,
+	request func(c *Client) (T, error)) (RegionResult[T], error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	results := make(chan RegionResult[T], len(regions))
+	for _, region := range regions {
+		go func(region api.Region) {
+			value, err := request(c.WithContext(ctx).ForRegion(region))
+			results <- RegionResult[T]{Region: region, Value: value, Err: err}
+		}(region)
+	}
+	var failure error
+	for range regions {
+		result := <-results
+		if result.Err == nil {
+			return result, nil
+		}
+		if failure == nil && !errors.Is(result.Err, api.ErrNotFound) && !errors.Is(result.Err, context.Canceled) {
+			failure = result.Err
+		}
+	}
+	if failure == nil {
+		if err := ctx.Err(); err != nil {
+			failure = err
+		} else {
+			failure = api.ErrNotFound
+		}
+	}
+	return RegionResult[T]{}, failure
+}
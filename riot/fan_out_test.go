@@ -0,0 +1,119 @@
+package riot
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mjourard/golio/api"
+	"github.com/mjourard/golio/mock"
+)
+
+// regionDoer answers with a summoner for the hosts of the regions and with the status for all other hosts
+func regionDoer(status int, regions ...api.Region) *mock.Doer {
+	return &mock.Doer{Custom: func(r *http.Request) (*http.Response, error) {
+		for _, region := range regions {
+			if strings.HasPrefix(r.URL.Host, string(region)+".") {
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(`{"name":"` + string(region) + `"}`)),
+				}, nil
+			}
+		}
+		return &http.Response{StatusCode: status, Body: io.NopCloser(strings.NewReader("{}"))}, nil
+	}}
+}
+
+func getSummoner(c *Client) (*Summoner, error) {
+	return c.Summoner.GetByID("id")
+}
+
+func TestFanOut(t *testing.T) {
+	t.Parallel()
+	c := NewClient(api.RegionEuropeWest, "API_KEY", regionDoer(http.StatusNotFound, api.RegionKorea),
+		logrus.StandardLogger())
+	regions := []api.Region{api.RegionNorthAmerica, api.RegionKorea, api.Region("xx")}
+	results := FanOut(context.Background(), c, regions, getSummoner)
+	require.Len(t, results, 3)
+	assert.Equal(t, api.RegionNorthAmerica, results[0].Region)
+	assert.True(t, errors.Is(results[0].Err, api.ErrNotFound))
+	require.Nil(t, results[1].Err)
+	assert.Equal(t, string(api.RegionKorea), results[1].Value.Name)
+	var unknown *api.UnknownRegionError
+	assert.True(t, errors.As(results[2].Err, &unknown))
+}
+
+func TestFindRegion(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		doer    *mock.Doer
+		want    api.Region
+		wantErr error
+	}{
+		{
+			name: "found",
+			doer: regionDoer(http.StatusNotFound, api.RegionOceania),
+			want: api.RegionOceania,
+		},
+		{
+			name:    "not found",
+			doer:    regionDoer(http.StatusNotFound),
+			wantErr: api.ErrNotFound,
+		},
+		{
+			name:    "error",
+			doer:    regionDoer(http.StatusForbidden),
+			wantErr: api.ErrForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
+			got, err := FindRegion(context.Background(), c, api.Regions, getSummoner)
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr))
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, tt.want, got.Region)
+			assert.Equal(t, string(tt.want), got.Value.Name)
+		})
+	}
+}
+
+func TestClient_Clone(t *testing.T) {
+	t.Parallel()
+	var mu sync.Mutex
+	var order []string
+	record := func(name string) Middleware {
+		return func(next Doer) Doer {
+			return DoerFunc(func(r *http.Request) (*http.Response, error) {
+				mu.Lock()
+				order = append(order, name)
+				mu.Unlock()
+				return next.Do(r)
+			})
+		}
+	}
+	observer := &recordingObserver{}
+	c := NewClient(api.RegionEuropeWest, "API_KEY", regionDoer(http.StatusNotFound, api.RegionEuropeWest),
+		logrus.StandardLogger(), WithMiddleware(record("client")))
+	clone := c.Clone(WithMiddleware(record("clone")), WithRequestObserver(observer))
+	_, err := clone.Summoner.GetByID("id")
+	require.Nil(t, err)
+	_, err = c.Summoner.GetByID("id")
+	require.Nil(t, err)
+	assert.Equal(t, []string{"client", "clone", "client"}, order)
+	assert.Len(t, observer.requests, 1)
+	assert.Equal(t, int64(2), c.StatsSnapshot().Requests)
+	assert.Equal(t, c.StatsSnapshot(), clone.StatsSnapshot())
+}
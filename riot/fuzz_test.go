@@ -0,0 +1,117 @@
+package riot
+
+import (
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/mjourard/golio/api"
+	"github.com/mjourard/golio/datadragon"
+	"github.com/mjourard/golio/mock"
+)
+
+// Fuzz targets feeding malformed and truncated payloads through the decoding of the client and the helpers working
+// on the decoded values. Run them with e.g. go test -run=^$ -fuzz=FuzzMatch ./riot
+
+// addSeeds adds the given fixture of the mock server, truncated versions of it and a few edge cases as seeds
+func addSeeds(f *testing.F, file string) {
+	content, err := os.ReadFile(filepath.Join(fixturesDir, file))
+	if err != nil {
+		f.Fatal(err)
+	}
+	f.Add(content)
+	for _, n := range []int{len(content) / 2, len(content) / 3, 1} {
+		f.Add(content[:n])
+	}
+	for _, seed := range []string{"", "null", "{}", "[]", `{"participants":[null]}`, `{"frames":[{"events":[null]}]}`} {
+		f.Add([]byte(seed))
+	}
+}
+
+// fuzzClients returns a client answering every request with data and a Data Dragon client answering with 404
+func fuzzClients(data []byte) (*Client, *datadragon.Client) {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	doer := mock.NewSequenceDoer(mock.Step{StatusCode: http.StatusOK, Body: data})
+	return NewClient(api.RegionEuropeWest, "API_KEY", doer, logger),
+		datadragon.NewClient(mock.NewStatusMockDoer(http.StatusNotFound), api.RegionEuropeWest, logger)
+}
+
+func FuzzMatch(f *testing.F) {
+	addSeeds(f, "match.json")
+	f.Fuzz(func(t *testing.T, data []byte) {
+		client, dd := fuzzClients(data)
+		match, err := client.Match.Get(1)
+		if err != nil {
+			return
+		}
+		for _, team := range match.Teams {
+			if team == nil {
+				continue
+			}
+			for _, ban := range team.Bans {
+				if ban != nil {
+					_, _ = ban.GetChampion(dd)
+				}
+			}
+		}
+		for _, p := range match.Participants {
+			if p == nil {
+				continue
+			}
+			_, _ = p.GetChampion(dd)
+			_, _ = p.GetSpell1(dd)
+			_, _ = p.GetSpell2(dd)
+			if p.Stats != nil {
+				_, _ = p.Stats.GetItem0(dd)
+				_, _ = p.Stats.GetItem6(dd)
+			}
+		}
+	})
+}
+
+func FuzzMatchTimeline(f *testing.F) {
+	addSeeds(f, "timeline.json")
+	f.Fuzz(func(t *testing.T, data []byte) {
+		client, _ := fuzzClients(data)
+		timeline, err := client.Match.GetTimeline(1)
+		if err != nil {
+			return
+		}
+		for _, event := range timeline.Events() {
+			_ = event.Time()
+			_ = event.Raw()
+			if kill, ok := event.(*ObjectiveKillEvent); ok {
+				_ = kill.IsBuilding()
+			}
+		}
+	})
+}
+
+func FuzzGameInfo(f *testing.F) {
+	addSeeds(f, "current_game.json")
+	f.Fuzz(func(t *testing.T, data []byte) {
+		client, dd := fuzzClients(data)
+		game, err := client.Spectator.GetCurrent("summoner")
+		if err != nil {
+			return
+		}
+		for _, ban := range game.BannedChampions {
+			if ban != nil {
+				_, _ = ban.GetChampion(dd)
+			}
+		}
+		for _, p := range game.Participants {
+			if p == nil {
+				continue
+			}
+			_, _ = p.GetChampion(dd)
+			_, _ = p.GetSpell1(dd)
+			_, _ = p.GetSpell2(dd)
+		}
+	})
+}
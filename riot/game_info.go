@@ -0,0 +1,68 @@
+package riot
+
+import (
+	"sort"
+	"time"
+)
+
+// noBan is the champion ID of bans which were not used
+const noBan = -1
+
+// Elapsed returns the time since the start of the game, see ElapsedAt
+func (i *GameInfo) Elapsed() time.Duration {
+	return i.ElapsedAt(time.Now())
+}
+
+// ElapsedAt returns the time between the start of the game and now. It is 0 while the game is loading, because the
+// start time is only set once the game started. Unlike GameLength, it is not outdated by the caching of the
+// spectator endpoint
+func (i *GameInfo) ElapsedAt(now time.Time) time.Duration {
+	if i.GameStartTime.IsZero() || now.Before(i.GameStartTime.Time) {
+		return 0
+	}
+	return now.Sub(i.GameStartTime.Time)
+}
+
+// Teams returns the participants of the game grouped by their team ID, e.g. 100 for blue side and 200 for red side
+func (i *GameInfo) Teams() map[int][]*CurrentGameParticipant {
+	teams := map[int][]*CurrentGameParticipant{}
+	for _, p := range i.Participants {
+		if p != nil {
+			teams[p.TeamID] = append(teams[p.TeamID], p)
+		}
+	}
+	return teams
+}
+
+// BannedChampionNames returns the names of the banned champions in the order of the bans. Unused bans are skipped
+func (i *GameInfo) BannedChampionNames() []string {
+	bans := make([]*BannedChampion, 0, len(i.BannedChampions))
+	for _, ban := range i.BannedChampions {
+		if ban != nil && ban.ChampionID != noBan {
+			bans = append(bans, ban)
+		}
+	}
+	sort.SliceStable(bans, func(a, b int) bool {
+		return bans[a].PickTurn < bans[b].PickTurn
+	})
+	names := make([]string, 0, len(bans))
+	for _, ban := range bans {
+		names = append(names, Champion(ban.ChampionID).String())
+	}
+	return names
+}
+
+// Queue returns the queue of the game
+func (i *GameInfo) Queue() QueueID {
+	return QueueID(i.GameQueueConfigID)
+}
+
+// QueueName returns the name of the queue of the game as shown in the client, e.g. "Ranked Solo/Duo"
+func (i *GameInfo) QueueName() string {
+	return i.Queue().String()
+}
+
+// IsRanked returns whether the game affects the ranking of the players
+func (i *GameInfo) IsRanked() bool {
+	return i.Queue().IsRanked()
+}
@@ -0,0 +1,51 @@
+package riot
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGameInfo_ElapsedAt(t *testing.T) {
+	t.Parallel()
+	start := time.Unix(1585000000, 0)
+	info := &GameInfo{GameStartTime: Timestamp{start}}
+	assert.Equal(t, 90*time.Second, info.ElapsedAt(start.Add(90*time.Second)))
+	assert.Equal(t, time.Duration(0), info.ElapsedAt(start.Add(-time.Second)))
+	assert.Equal(t, time.Duration(0), (&GameInfo{}).ElapsedAt(start))
+	assert.True(t, info.Elapsed() > 0)
+}
+
+func TestGameInfo_Teams(t *testing.T) {
+	t.Parallel()
+	blue1 := &CurrentGameParticipant{SummonerName: "blue1", TeamID: 100}
+	blue2 := &CurrentGameParticipant{SummonerName: "blue2", TeamID: 100}
+	red := &CurrentGameParticipant{SummonerName: "red", TeamID: 200}
+	info := &GameInfo{Participants: []*CurrentGameParticipant{blue1, red, nil, blue2}}
+	assert.Equal(t, map[int][]*CurrentGameParticipant{100: {blue1, blue2}, 200: {red}}, info.Teams())
+}
+
+func TestGameInfo_BannedChampionNames(t *testing.T) {
+	t.Parallel()
+	info := &GameInfo{BannedChampions: []*BannedChampion{
+		{PickTurn: 2, ChampionID: int(ChampionWukong), TeamID: 200},
+		{PickTurn: 1, ChampionID: int(ChampionAhri), TeamID: 100},
+		{PickTurn: 3, ChampionID: -1, TeamID: 100},
+		nil,
+		{PickTurn: 4, ChampionID: 99999, TeamID: 200},
+	}}
+	assert.Equal(t, []string{"Ahri", "Wukong", "Champion 99999"}, info.BannedChampionNames())
+	assert.Equal(t, []string{}, (&GameInfo{}).BannedChampionNames())
+}
+
+func TestGameInfo_Queue(t *testing.T) {
+	t.Parallel()
+	info := &GameInfo{GameQueueConfigID: 420}
+	assert.Equal(t, QueueIDRankedSolo, info.Queue())
+	assert.Equal(t, "Ranked Solo/Duo", info.QueueName())
+	assert.True(t, info.IsRanked())
+	info.GameQueueConfigID = 450
+	assert.Equal(t, "ARAM", info.QueueName())
+	assert.False(t, info.IsRanked())
+}
@@ -0,0 +1,24 @@
+package riot
+
+// Get sends a GET request of the endpoint and decodes the response into a new value of type T, e.g. for endpoints not
+// supported by golio yet using your own types. The endpoint is the path of the URL including query parameters. The
+// request is retried and errors are returned like for all other methods, using the context of c, see WithContext
+func Get[T any](c *Client, endpoint string) (T, error) {
+	var result T
+	if err := c.getInto(endpoint, &result); err != nil {
+		var zero T
+		return zero, err
+	}
+	return result, nil
+}
+
+// Post sends a POST request of the endpoint with the body encoded as json and decodes the response into a new value
+// of type T, see Get
+func Post[T any](c *Client, endpoint string, body interface{}) (T, error) {
+	var result T
+	if err := c.postInto(endpoint, body, &result); err != nil {
+		var zero T
+		return zero, err
+	}
+	return result, nil
+}
@@ -0,0 +1,52 @@
+package riot
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mjourard/golio/api"
+	"github.com/mjourard/golio/mock"
+)
+
+type newEndpointDTO struct {
+	Name string `json:"name"`
+}
+
+func TestGet(t *testing.T) {
+	t.Parallel()
+	doer := mock.NewSequenceDoer(mock.Unavailable(1), mock.OK(newEndpointDTO{Name: "name"}))
+	c := NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger())
+	got, err := Get[*newEndpointDTO](c, "/lol/new/v1/path")
+	require.Nil(t, err)
+	assert.Equal(t, &newEndpointDTO{Name: "name"}, got)
+
+	c = NewClient(api.RegionEuropeWest, "API_KEY", mock.NewStatusMockDoer(http.StatusNotFound),
+		logrus.StandardLogger())
+	got, err = Get[*newEndpointDTO](c, "/lol/new/v1/path")
+	assert.True(t, errors.Is(err, api.ErrNotFound))
+	assert.Nil(t, got)
+}
+
+func TestPost(t *testing.T) {
+	t.Parallel()
+	var sent newEndpointDTO
+	doer := &mock.Doer{Custom: func(r *http.Request) (*http.Response, error) {
+		if err := json.NewDecoder(r.Body).Decode(&sent); err != nil {
+			return nil, err
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("[1,2]"))}, nil
+	}}
+	c := NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger())
+	got, err := Post[[]int](c, "/lol/new/v1/path", newEndpointDTO{Name: "name"})
+	require.Nil(t, err)
+	assert.Equal(t, []int{1, 2}, got)
+	assert.Equal(t, "name", sent.Name)
+}
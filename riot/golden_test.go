@@ -0,0 +1,100 @@
+package riot
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	// goldenDir holds the golden responses of the routes not served by the mock server
+	goldenDir = filepath.Join("testdata", "golden")
+	// fixturesDir holds the responses served by the mock server, which are part of the golden corpus, so that the
+	// payloads of the mock server can't drift from the responses the decoding is tested with
+	fixturesDir = filepath.Join("..", "mockserver", "fixtures")
+)
+
+// TestGolden decodes anonymized real responses of the Riot API strictly, so that fields added or renamed by Riot
+// fail the tests instead of being dropped silently
+func TestGolden(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		dir    string
+		file   string
+		target interface{}
+	}{
+		{dir: goldenDir, file: "champion_masteries.json", target: &[]*ChampionMastery{}},
+		{dir: goldenDir, file: "champion_rotation.json", target: &ChampionInfo{}},
+		{dir: fixturesDir, file: "current_game.json", target: &GameInfo{}},
+		{dir: fixturesDir, file: "featured_games.json", target: &FeaturedGames{}},
+		{dir: fixturesDir, file: "league_entries.json", target: &[]*LeagueItem{}},
+		{dir: fixturesDir, file: "league_list.json", target: &LeagueList{}},
+		{dir: goldenDir, file: "lobby_events.json", target: &LobbyEventList{}},
+		{dir: fixturesDir, file: "match.json", target: &Match{}},
+		{dir: fixturesDir, file: "matchlist.json", target: &Matchlist{}},
+		{dir: fixturesDir, file: "status.json", target: &Status{}},
+		{dir: fixturesDir, file: "summoner.json", target: &Summoner{}},
+		{dir: fixturesDir, file: "timeline.json", target: &MatchTimeline{}},
+		{dir: goldenDir, file: "tournament_code.json", target: &Tournament{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			content, err := os.ReadFile(filepath.Join(tt.dir, tt.file))
+			require.Nil(t, err)
+			decoder := json.NewDecoder(bytes.NewReader(content))
+			decoder.DisallowUnknownFields()
+			require.Nil(t, decoder.Decode(tt.target))
+			// encoding the decoded value again has to retain every value of the response
+			encoded, err := json.Marshal(tt.target)
+			require.Nil(t, err)
+			var original, roundTrip interface{}
+			require.Nil(t, json.Unmarshal(content, &original))
+			require.Nil(t, json.Unmarshal(encoded, &roundTrip))
+			for _, problem := range missingValues(original, roundTrip, "") {
+				t.Error(problem)
+			}
+		})
+	}
+}
+
+// missingValues returns the paths of all values of want which are not contained in got
+func missingValues(want, got interface{}, path string) []string {
+	switch w := want.(type) {
+	case map[string]interface{}:
+		g, ok := got.(map[string]interface{})
+		if !ok {
+			return []string{fmt.Sprintf("%s: want object, got %v", path, got)}
+		}
+		var res []string
+		for key, value := range w {
+			gotValue, ok := g[key]
+			if !ok {
+				res = append(res, fmt.Sprintf("%s.%s: missing", path, key))
+				continue
+			}
+			res = append(res, missingValues(value, gotValue, path+"."+key)...)
+		}
+		return res
+	case []interface{}:
+		g, ok := got.([]interface{})
+		if !ok || len(g) != len(w) {
+			return []string{fmt.Sprintf("%s: want %d items, got %v", path, len(w), got)}
+		}
+		var res []string
+		for i := range w {
+			res = append(res, missingValues(w[i], g[i], fmt.Sprintf("%s[%d]", path, i))...)
+		}
+		return res
+	default:
+		if !assert.ObjectsAreEqual(want, got) {
+			return []string{fmt.Sprintf("%s: want %v, got %v", path, want, got)}
+		}
+		return nil
+	}
+}
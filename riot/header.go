@@ -0,0 +1,34 @@
+package riot
+
+import (
+	"net/http"
+)
+
+// WithUserAgent sets the User-Agent header of all requests, e.g. "my-app/1.2 (contact@example.com)". Riot asks
+// production applications to identify themselves
+func WithUserAgent(userAgent string) Option {
+	return WithHeader("User-Agent", userAgent)
+}
+
+// WithHeader adds a header with the value to all requests, e.g. a header identifying the team for a proxy. Headers
+// set by the client itself, e.g. the API key and Accept headers, take precedence
+func WithHeader(key, value string) Option {
+	return func(c *Client) {
+		if c.header == nil {
+			c.header = http.Header{}
+		}
+		c.header.Add(key, value)
+	}
+}
+
+// addHeaders adds the headers set by WithHeader to the request except for the headers it already has
+func (c *Client) addHeaders(request *http.Request) {
+	for key, values := range c.header {
+		if request.Header.Get(key) != "" {
+			continue
+		}
+		for _, value := range values {
+			request.Header.Add(key, value)
+		}
+	}
+}
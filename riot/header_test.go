@@ -0,0 +1,35 @@
+package riot
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mjourard/golio/api"
+	"github.com/mjourard/golio/mock"
+)
+
+func TestWithHeader(t *testing.T) {
+	t.Parallel()
+	c := NewClient(api.RegionEuropeWest, "API KEY", mock.NewStatusMockDoer(http.StatusOK), logrus.StandardLogger(),
+		WithUserAgent("app/1.0"), WithHeader("X-Team", "a"), WithHeader("X-Team", "b"),
+		WithHeader("Accept", "text/plain"), WithHeader(apiTokenHeaderKey, "OTHER KEY"))
+	request, err := c.newRequest(context.Background(), http.MethodGet, "/lol/status/v4/platform-data", nil)
+	require.Nil(t, err)
+	assert.Equal(t, "app/1.0", request.Header.Get("User-Agent"))
+	assert.Equal(t, []string{"a", "b"}, request.Header.Values("X-Team"))
+	assert.Equal(t, []string{"application/json"}, request.Header.Values("Accept"))
+	assert.Equal(t, []string{"API KEY"}, request.Header.Values(apiTokenHeaderKey))
+
+	clone := c.Clone(WithHeader("X-Team", "c"))
+	request, err = c.newRequest(context.Background(), http.MethodGet, "/lol/status/v4/platform-data", nil)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"a", "b"}, request.Header.Values("X-Team"))
+	request, err = clone.newRequest(context.Background(), http.MethodGet, "/lol/status/v4/platform-data", nil)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"a", "b", "c"}, request.Header.Values("X-Team"))
+}
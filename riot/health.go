@@ -0,0 +1,73 @@
+package riot
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/mjourard/golio/api"
+)
+
+// HealthReport contains the diagnostics of a health check, see Client.HealthCheck
+type HealthReport struct {
+	Region    api.Region
+	CheckedAt time.Time
+	// Latency is the time until the response was received, including retries
+	Latency time.Duration
+	// Reachable is true if the Riot API responded to the request
+	Reachable bool
+	// KeyValid is true if the Riot API accepted the API key
+	KeyValid bool
+	// StatusCode of the response or 0 if there was none
+	StatusCode int
+	// Incidents is the number of incidents and maintenances currently reported for the services of the region
+	Incidents int
+	// Err is the error of the request or nil if the check succeeded
+	Err error
+}
+
+// Healthy returns true if the region was reachable and the API key was accepted
+func (r *HealthReport) Healthy() bool {
+	return r.Err == nil
+}
+
+// HealthCheck sends a cheap authenticated request to the status endpoint to verify that the API key is valid and the
+// Region is reachable, e.g. for readiness probes. The report is always returned, the error is the error of the request
+// and equal to HealthReport.Err
+func (c *Client) HealthCheck(ctx context.Context) (*HealthReport, error) {
+	logger := c.logger().WithField("method", "HealthCheck")
+	report := &HealthReport{
+		Region:    c.Region,
+		CheckedAt: time.Now(),
+	}
+	var status *Status
+	err := c.getIntoContext(ctx, endpointGetStatus, &status)
+	report.Latency = time.Since(report.CheckedAt)
+	var responseErr *api.ResponseError
+	switch {
+	case err == nil:
+		report.Reachable = true
+		report.KeyValid = true
+		report.StatusCode = http.StatusOK
+	case errors.As(err, &responseErr):
+		report.Reachable = true
+		report.StatusCode = responseErr.StatusCode
+		report.KeyValid = responseErr.StatusCode != http.StatusUnauthorized &&
+			responseErr.StatusCode != http.StatusForbidden
+	}
+	if err != nil {
+		logger.Debug(err)
+		report.Err = err
+		return report, err
+	}
+	if status != nil {
+		for _, service := range status.Services {
+			if service == nil {
+				continue
+			}
+			report.Incidents += len(service.Incidents)
+		}
+	}
+	return report, nil
+}
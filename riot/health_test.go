@@ -0,0 +1,73 @@
+package riot
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mjourard/golio/api"
+	"github.com/mjourard/golio/internal"
+	"github.com/mjourard/golio/mock"
+)
+
+func TestClient_HealthCheck(t *testing.T) {
+	t.Parallel()
+	transportErr := errors.New("connection refused")
+	tests := []struct {
+		name string
+		doer internal.Doer
+		want HealthReport
+	}{
+		{
+			name: "healthy",
+			doer: mock.NewJSONMockDoer(Status{Services: []*Service{{Incidents: []*Incident{{}, {}}}, nil}}, 200),
+			want: HealthReport{Reachable: true, KeyValid: true, StatusCode: http.StatusOK, Incidents: 2},
+		},
+		{
+			name: "invalid key",
+			doer: mock.NewStatusMockDoer(http.StatusForbidden),
+			want: HealthReport{Reachable: true, StatusCode: http.StatusForbidden},
+		},
+		{
+			name: "server error",
+			doer: mock.NewStatusMockDoer(http.StatusInternalServerError),
+			want: HealthReport{Reachable: true, KeyValid: true, StatusCode: http.StatusInternalServerError},
+		},
+		{
+			name: "unreachable",
+			doer: &mock.Doer{Custom: func(*http.Request) (*http.Response, error) {
+				return nil, transportErr
+			}},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			client := NewClient(api.RegionEuropeWest, "API_KEY", test.doer, logrus.StandardLogger())
+			got, err := client.HealthCheck(context.Background())
+			require.NotNil(t, got)
+			assert.Equal(t, err, got.Err)
+			assert.Equal(t, err == nil, got.Healthy())
+			assert.Equal(t, api.RegionEuropeWest, got.Region)
+			assert.False(t, got.CheckedAt.IsZero())
+			got.Region, got.CheckedAt, got.Latency, got.Err = "", test.want.CheckedAt, 0, nil
+			assert.Equal(t, test.want, *got)
+		})
+	}
+}
+
+func TestClient_HealthCheck_unknownRegion(t *testing.T) {
+	t.Parallel()
+	client := NewClient("xx1", "API_KEY", mock.NewStatusMockDoer(http.StatusOK), logrus.StandardLogger())
+	got, err := client.HealthCheck(context.Background())
+	var regionErr *api.UnknownRegionError
+	assert.True(t, errors.As(err, &regionErr))
+	assert.False(t, got.Reachable)
+	assert.False(t, got.Healthy())
+}
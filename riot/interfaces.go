@@ -0,0 +1,101 @@
+package riot
+
+import (
+	"context"
+	"time"
+
+	"github.com/mjourard/golio/datadragon"
+)
+
+//go:generate mockery --name ".*API" --output ./mocks --outpkg mocks --case underscore --disable-version-string
+
+// AccountAPI provides access to the account endpoints
+type AccountAPI interface {
+	GetByPUUID(puuid string) (*Account, error)
+	GetByRiotID(gameName, tagLine string) (*Account, error)
+}
+
+// ChampionMasteryAPI provides access to the champion mastery endpoints
+type ChampionMasteryAPI interface {
+	List(summonerID string) ([]*ChampionMastery, error)
+	Get(summonerID, championID string) (*ChampionMastery, error)
+	GetTotal(summonerID string) (int, error)
+}
+
+// ChampionAPI provides access to the champion endpoints
+type ChampionAPI interface {
+	GetFreeRotation() (*ChampionInfo, error)
+	WatchFreeRotation(ctx context.Context, dd *datadragon.Client, interval time.Duration) <-chan FreeRotationChange
+}
+
+// LeagueAPI provides access to the league endpoints
+type LeagueAPI interface {
+	GetChallenger(queue Queue) (*LeagueList, error)
+	GetGrandmaster(queue Queue) (*LeagueList, error)
+	GetMaster(queue Queue) (*LeagueList, error)
+	ListBySummoner(summonerID string) ([]*LeagueItem, error)
+	ListPlayers(queue Queue, tier Tier, division Division, page int) ([]*LeagueItem, error)
+	ListPlayersPager(queue Queue, tier Tier, division Division) *Pager[*LeagueItem]
+	Get(leagueID string) (*LeagueList, error)
+}
+
+// StatusAPI provides access to the status endpoints
+type StatusAPI interface {
+	Get() (*Status, error)
+	GetPlatformData() (*PlatformData, error)
+	Watch(ctx context.Context, interval time.Duration) <-chan StatusEvent
+}
+
+// MatchAPI provides access to the match endpoints
+type MatchAPI interface {
+	Get(id int) (*Match, error)
+	List(accountID string, filter *MatchFilter) (*Matchlist, error)
+	ListPager(accountID string, filter *MatchFilter) *Pager[*MatchReference]
+	ListStream(accountID string, filter *MatchFilter, options ...ListStreamOption) <-chan MatchStreamValue
+	GetTimeline(matchID int) (*MatchTimeline, error)
+	StreamTimelineEvents(matchID int) <-chan TimelineEventStreamValue
+	ListIDsByTournamentCode(tournamentCode string) ([]int, error)
+	GetForTournament(matchID int, tournamentCode string) (*Match, error)
+}
+
+// SpectatorAPI provides access to the spectator endpoints
+type SpectatorAPI interface {
+	GetCurrent(summonerID string) (*GameInfo, error)
+	ListFeatured() (*FeaturedGames, error)
+}
+
+// SummonerAPI provides access to the summoner endpoints
+type SummonerAPI interface {
+	GetByName(name string) (*Summoner, error)
+	GetByAccountID(id string) (*Summoner, error)
+	GetByPUUID(puuid string) (*Summoner, error)
+	GetByID(summonerID string) (*Summoner, error)
+}
+
+// ThirdPartyCodeAPI provides access to the third party code endpoint
+type ThirdPartyCodeAPI interface {
+	Get(summonerID string) (string, error)
+}
+
+// TournamentAPI provides access to the tournament and tournament stub endpoints
+type TournamentAPI interface {
+	CreateCodes(id, count int, params *TournamentCodeParameters, stub bool) ([]string, error)
+	ListLobbyEvents(code string, useStub bool) (*LobbyEventList, error)
+	CreateProvider(parameters *ProviderRegistrationParameters, useStub bool) (int, error)
+	Create(parameters *TournamentRegistrationParameters, useStub bool) (int, error)
+	Get(code string) (*Tournament, error)
+	Update(code string, parameters TournamentUpdateParameters) error
+}
+
+var (
+	_ AccountAPI         = (*accountClient)(nil)
+	_ ChampionMasteryAPI = (*championMasteryClient)(nil)
+	_ ChampionAPI        = (*championClient)(nil)
+	_ LeagueAPI          = (*leagueClient)(nil)
+	_ StatusAPI          = (*statusClient)(nil)
+	_ MatchAPI           = (*matchClient)(nil)
+	_ SpectatorAPI       = (*spectatorClient)(nil)
+	_ SummonerAPI        = (*summonerClient)(nil)
+	_ ThirdPartyCodeAPI  = (*thirdPartyCodeClient)(nil)
+	_ TournamentAPI      = (*tournamentClient)(nil)
+)
@@ -0,0 +1,85 @@
+package riot
+
+import (
+	"strings"
+	"sync"
+)
+
+// KeyProvider returns the API key used for a request of the endpoint. It is called for every request, so keys can be
+// rotated without creating a new client. Implementations must be safe for concurrent use
+type KeyProvider interface {
+	APIKey(endpoint string) string
+}
+
+// KeyProviderFunc is a function implementing KeyProvider
+type KeyProviderFunc func(endpoint string) string
+
+// APIKey calls f
+func (f KeyProviderFunc) APIKey(endpoint string) string {
+	return f(endpoint)
+}
+
+// staticKey is the KeyProvider of the key given to NewClient
+type staticKey string
+
+func (k staticKey) APIKey(string) string {
+	return string(k)
+}
+
+// RotatingKey is a KeyProvider whose key can be replaced at any time, e.g. to rotate development keys which expire
+// every day. It is safe for concurrent use
+type RotatingKey struct {
+	mu  sync.RWMutex
+	key string
+}
+
+// NewRotatingKey returns a provider of the key
+func NewRotatingKey(key string) *RotatingKey {
+	return &RotatingKey{key: key}
+}
+
+// Set replaces the key for all following requests
+func (k *RotatingKey) Set(key string) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.key = key
+}
+
+// APIKey returns the current key
+func (k *RotatingKey) APIKey(string) string {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	return k.key
+}
+
+// routeKey is a KeyProvider used for the endpoints starting with the prefix
+type routeKey struct {
+	prefix   string
+	provider KeyProvider
+}
+
+// WithKeyProvider replaces the API key given to NewClient by the provider
+func WithKeyProvider(provider KeyProvider) Option {
+	return func(c *Client) {
+		c.keys = provider
+	}
+}
+
+// WithRouteKey uses the provider for all endpoints starting with the prefix, e.g. a separate tournament key for
+// /lol/tournament/v4. The provider of the longest matching prefix is used
+func WithRouteKey(prefix string, provider KeyProvider) Option {
+	return func(c *Client) {
+		c.routeKeys = append(c.routeKeys, routeKey{prefix: prefix, provider: provider})
+	}
+}
+
+// apiKey returns the API key for a request of the endpoint
+func (c *Client) apiKey(endpoint string) string {
+	provider, length := c.keys, -1
+	for _, route := range c.routeKeys {
+		if strings.HasPrefix(endpoint, route.prefix) && len(route.prefix) > length {
+			provider, length = route.provider, len(route.prefix)
+		}
+	}
+	return provider.APIKey(endpoint)
+}
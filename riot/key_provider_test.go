@@ -0,0 +1,38 @@
+package riot
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mjourard/golio/api"
+	"github.com/mjourard/golio/mock"
+)
+
+func TestWithKeyProvider(t *testing.T) {
+	t.Parallel()
+	doer := mock.NewJSONMockDoer(Summoner{}, http.StatusOK)
+	key := NewRotatingKey("first")
+	c := NewClient(api.RegionEuropeWest, "unused", doer, logrus.StandardLogger(), WithKeyProvider(key),
+		WithRouteKey("/lol/tournament", KeyProviderFunc(func(string) string {
+			return "tournament"
+		})),
+		WithRouteKey("/lol/tournament/v4/codes", KeyProviderFunc(func(endpoint string) string {
+			return "codes " + endpoint[len(endpoint)-4:]
+		})))
+
+	_, err := c.Summoner.GetByID("id")
+	require.Nil(t, err)
+	doer.AssertHeader(t, apiTokenHeaderKey, "first")
+	key.Set("second")
+	_, err = c.Summoner.GetByID("id")
+	require.Nil(t, err)
+	doer.AssertHeader(t, apiTokenHeaderKey, "second")
+
+	assert.Equal(t, "tournament", c.apiKey("/lol/tournament/v4/providers"))
+	assert.Equal(t, "codes code", c.apiKey("/lol/tournament/v4/codes/code"))
+	assert.Equal(t, "second", c.ForRegion(api.RegionKorea).apiKey("/lol/summoner/v4/summoners/id"))
+}
@@ -1,6 +1,7 @@
 package riot
 
 import (
+	"context"
 	"fmt"
 
 	log "github.com/sirupsen/logrus"
@@ -13,6 +14,10 @@ type leagueClient struct {
 // GetChallenger returns the current Challenger league for the Region
 func (l *leagueClient) GetChallenger(queue Queue) (*LeagueList, error) {
 	logger := l.logger().WithField("method", "GetChallenger")
+	if err := validateID("queue", string(queue)); err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
 	var list *LeagueList
 	if err := l.c.getInto(fmt.Sprintf(endpointGetChallengerLeague, queue), &list); err != nil {
 		logger.Debug(err)
@@ -24,6 +29,10 @@ func (l *leagueClient) GetChallenger(queue Queue) (*LeagueList, error) {
 // GetGrandmaster returns the current Grandmaster league for the Region
 func (l *leagueClient) GetGrandmaster(queue Queue) (*LeagueList, error) {
 	logger := l.logger().WithField("method", "GetGrandmaster")
+	if err := validateID("queue", string(queue)); err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
 	var list *LeagueList
 	if err := l.c.getInto(fmt.Sprintf(endpointGetGrandmasterLeague, queue), &list); err != nil {
 		logger.Debug(err)
@@ -35,6 +44,10 @@ func (l *leagueClient) GetGrandmaster(queue Queue) (*LeagueList, error) {
 // GetMaster returns the current Master league for the Region
 func (l *leagueClient) GetMaster(queue Queue) (*LeagueList, error) {
 	logger := l.logger().WithField("method", "GetMaster")
+	if err := validateID("queue", string(queue)); err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
 	var list *LeagueList
 	if err := l.c.getInto(fmt.Sprintf(endpointGetMasterLeague, queue), &list); err != nil {
 		logger.Debug(err)
@@ -46,6 +59,10 @@ func (l *leagueClient) GetMaster(queue Queue) (*LeagueList, error) {
 // ListBySummoner returns all leagues a summoner with the given ID is in
 func (l *leagueClient) ListBySummoner(summonerID string) ([]*LeagueItem, error) {
 	logger := l.logger().WithField("method", "ListBySummoner")
+	if err := validateID("summonerID", summonerID); err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
 	var leagues []*LeagueItem
 	if err := l.c.getInto(fmt.Sprintf(endpointGetLeaguesBySummoner, summonerID), &leagues); err != nil {
 		logger.Debug(err)
@@ -58,6 +75,10 @@ func (l *leagueClient) ListBySummoner(summonerID string) ([]*LeagueItem, error)
 // Include the page number to work with RIOT's pagination
 func (l *leagueClient) ListPlayers(queue Queue, tier Tier, division Division, page int) ([]*LeagueItem, error) {
 	logger := l.logger().WithField("method", "ListPlayers")
+	if err := validateLeague(queue, tier, division, page); err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
 	var leagues []*LeagueItem
 	if err := l.c.getInto(fmt.Sprintf(endpointGetLeagues, queue, tier, division, page), &leagues); err != nil {
 		logger.Debug(err)
@@ -66,9 +87,20 @@ func (l *leagueClient) ListPlayers(queue Queue, tier Tier, division Division, pa
 	return leagues, nil
 }
 
+// ListPlayersPager returns a pager over all players within a league specified by its Queue, Tier and Division
+func (l *leagueClient) ListPlayersPager(queue Queue, tier Tier, division Division) *Pager[*LeagueItem] {
+	return newPager(1, 0, func(ctx context.Context, page int) ([]*LeagueItem, error) {
+		return l.c.WithContext(ctx).League.ListPlayers(queue, tier, division, page)
+	})
+}
+
 // Get returns a ranked league with the specified ID
 func (l *leagueClient) Get(leagueID string) (*LeagueList, error) {
 	logger := l.logger().WithField("method", "Get")
+	if err := validateID("leagueID", leagueID); err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
 	var leagues *LeagueList
 	if err := l.c.getInto(fmt.Sprintf(endpointGetLeague, leagueID), &leagues); err != nil {
 		logger.Debug(err)
@@ -78,5 +110,5 @@ func (l *leagueClient) Get(leagueID string) (*LeagueList, error) {
 }
 
 func (l *leagueClient) logger() log.FieldLogger {
-	return l.c.logger().WithField("category", "league")
+	return l.c.categoryLogger(LogCategoryLeague)
 }
@@ -0,0 +1,62 @@
+package riot
+
+// rankScoreTierStep is the difference in RankScore between the lowest entries of two adjacent tiers. It is larger
+// than the league points reachable in an apex tier, so entries never overtake entries of a higher tier
+const rankScoreTierStep = 10000
+
+// rankScoreDivisionStep is the difference in RankScore between the lowest entries of two adjacent divisions
+const rankScoreDivisionStep = 100
+
+// TotalGames returns the number of ranked games played in the current season
+func (i *LeagueItem) TotalGames() int {
+	return i.Wins + i.Losses
+}
+
+// WinRate returns the share of won games in [0, 1] or 0 if no game was played
+func (i *LeagueItem) WinRate() float64 {
+	if i.TotalGames() == 0 {
+		return 0
+	}
+	return float64(i.Wins) / float64(i.TotalGames())
+}
+
+// IsInPromos returns true if the summoner is playing a promotion series to the next tier
+func (i *LeagueItem) IsInPromos() bool {
+	return i.MiniSeries != nil
+}
+
+// PromoProgress returns the games won and lost in the current promotion series and the number of wins needed for the
+// promotion. All values are 0 if the summoner is not in a promotion series
+func (i *LeagueItem) PromoProgress() (wins, losses, target int) {
+	if i.MiniSeries == nil {
+		return 0, 0, 0
+	}
+	return i.MiniSeries.Wins, i.MiniSeries.Losses, i.MiniSeries.Target
+}
+
+// RankScore returns a key to order entries of different tiers and divisions, with higher scores for higher ranks.
+// It combines tier, division and league points, so it can be used to sort mixed lists, e.g. the entries of all queues
+// of a summoner. The score is no estimation of the matchmaking rating. Entries with an unknown tier score 0
+func (i *LeagueItem) RankScore() int {
+	tier := Tier(i.Tier).value()
+	if tier == 0 {
+		return 0
+	}
+	score := tier*rankScoreTierStep + i.LeaguePoints
+	if division := Division(i.Rank).value(); division > 0 {
+		score += (division - 1) * rankScoreDivisionStep
+	}
+	return score
+}
+
+// Compare returns -1 if the rank of i is lower than the rank of other, 1 if it is higher and 0 if both are equal.
+// Tiers, divisions and league points are compared in this order, e.g. to check whether a summoner climbed since an
+// earlier entry
+func (i *LeagueItem) Compare(other *LeagueItem) int {
+	return compareInts(i.RankScore(), other.RankScore())
+}
+
+// Less returns whether the rank of i is lower than the rank of other, e.g. to sort leaderboards with sort.Slice
+func (i *LeagueItem) Less(other *LeagueItem) bool {
+	return i.Compare(other) < 0
+}
@@ -0,0 +1,74 @@
+package riot
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLeagueItem_WinRate(t *testing.T) {
+	t.Parallel()
+	item := &LeagueItem{Wins: 30, Losses: 10}
+	assert.Equal(t, 40, item.TotalGames())
+	assert.Equal(t, 0.75, item.WinRate())
+	assert.Equal(t, 0.0, (&LeagueItem{}).WinRate())
+}
+
+func TestLeagueItem_PromoProgress(t *testing.T) {
+	t.Parallel()
+	item := &LeagueItem{}
+	assert.False(t, item.IsInPromos())
+	wins, losses, target := item.PromoProgress()
+	assert.Equal(t, []int{0, 0, 0}, []int{wins, losses, target})
+	item.MiniSeries = &MiniSeries{Progress: "WLWNN", Wins: 2, Losses: 1, Target: 3}
+	assert.True(t, item.IsInPromos())
+	wins, losses, target = item.PromoProgress()
+	assert.Equal(t, []int{2, 1, 3}, []int{wins, losses, target})
+}
+
+func TestLeagueItem_RankScore(t *testing.T) {
+	t.Parallel()
+	items := []*LeagueItem{
+		{SummonerName: "gold-1-0", Tier: TierGold, Rank: "I"},
+		{SummonerName: "challenger", Tier: "CHALLENGER", Rank: "I", LeaguePoints: 900},
+		{SummonerName: "unknown", Tier: "UNRANKED"},
+		{SummonerName: "gold-4-99", Tier: TierGold, Rank: DivisionFour, LeaguePoints: 99},
+		{SummonerName: "master", Tier: "MASTER", Rank: "I", LeaguePoints: 1500},
+		{SummonerName: "iron-4-0", Tier: string(TierIron), Rank: string(DivisionFour)},
+		{SummonerName: "gold-2-50", Tier: TierGold, Rank: DivisionTwo, LeaguePoints: 50},
+		{SummonerName: "platinum-4-0", Tier: TierPlatinum, Rank: DivisionFour},
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].RankScore() > items[j].RankScore()
+	})
+	var got []string
+	for _, item := range items {
+		got = append(got, item.SummonerName)
+	}
+	assert.Equal(t, []string{"challenger", "master", "platinum-4-0", "gold-1-0", "gold-2-50", "gold-4-99", "iron-4-0",
+		"unknown"}, got)
+	assert.Equal(t, 0, (&LeagueItem{Tier: "UNRANKED", LeaguePoints: 50}).RankScore())
+}
+
+func TestLeagueItem_Compare(t *testing.T) {
+	t.Parallel()
+	before := &LeagueItem{Tier: TierGold, Rank: "II", LeaguePoints: 80}
+	tests := []struct {
+		name  string
+		after *LeagueItem
+		want  int
+	}{
+		{name: "league points", after: &LeagueItem{Tier: TierGold, Rank: "II", LeaguePoints: 95}, want: 1},
+		{name: "equal", after: &LeagueItem{Tier: TierGold, Rank: "II", LeaguePoints: 80}, want: 0},
+		{name: "division", after: &LeagueItem{Tier: TierGold, Rank: "III", LeaguePoints: 99}, want: -1},
+		{name: "tier", after: &LeagueItem{Tier: TierPlatinum, Rank: "IV"}, want: 1},
+		{name: "demoted", after: &LeagueItem{Tier: TierSilver, Rank: "I", LeaguePoints: 100}, want: -1},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, test.after.Compare(before))
+			assert.Equal(t, test.want < 0, test.after.Less(before))
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package riot
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
@@ -11,7 +13,7 @@ import (
 
 	"github.com/mjourard/golio/api"
 	"github.com/mjourard/golio/internal"
-	"github.com/mjourard/golio/internal/mock"
+	"github.com/mjourard/golio/mock"
 )
 
 func TestLeagueClient_GetChallenger(t *testing.T) {
@@ -43,12 +45,12 @@ func TestLeagueClient_GetChallenger(t *testing.T) {
 		{
 			name: "rate limited",
 			want: &LeagueList{},
-			doer: rateLimitDoer(LeagueList{}),
+			doer: mock.NewRateLimitDoer(LeagueList{}, 1, 1),
 		},
 		{
 			name: "unavailable once",
 			want: &LeagueList{},
-			doer: unavailableOnceDoer(LeagueList{}),
+			doer: mock.NewUnavailableDoer(LeagueList{}, 1),
 		},
 		{
 			name:    "unavailable twice",
@@ -60,7 +62,7 @@ func TestLeagueClient_GetChallenger(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			client := NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
 			got, err := client.League.GetChallenger(QueueRankedSolo)
-			require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+			require.True(t, errors.Is(err, tt.wantErr), fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
 			if tt.wantErr == nil {
 				assert.Equal(t, got, tt.want)
 			}
@@ -97,12 +99,12 @@ func TestLeagueClient_GetGrandmaster(t *testing.T) {
 		{
 			name: "rate limited",
 			want: &LeagueList{},
-			doer: rateLimitDoer(LeagueList{}),
+			doer: mock.NewRateLimitDoer(LeagueList{}, 1, 1),
 		},
 		{
 			name: "unavailable once",
 			want: &LeagueList{},
-			doer: unavailableOnceDoer(LeagueList{}),
+			doer: mock.NewUnavailableDoer(LeagueList{}, 1),
 		},
 		{
 			name:    "unavailable twice",
@@ -114,7 +116,7 @@ func TestLeagueClient_GetGrandmaster(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			client := NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
 			got, err := client.League.GetGrandmaster(QueueRankedSolo)
-			require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+			require.True(t, errors.Is(err, tt.wantErr), fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
 			if tt.wantErr == nil {
 				assert.Equal(t, got, tt.want)
 			}
@@ -151,12 +153,12 @@ func TestLeagueClient_GetMaster(t *testing.T) {
 		{
 			name: "rate limited",
 			want: &LeagueList{},
-			doer: rateLimitDoer(LeagueList{}),
+			doer: mock.NewRateLimitDoer(LeagueList{}, 1, 1),
 		},
 		{
 			name: "unavailable once",
 			want: &LeagueList{},
-			doer: unavailableOnceDoer(LeagueList{}),
+			doer: mock.NewUnavailableDoer(LeagueList{}, 1),
 		},
 		{
 			name:    "unavailable twice",
@@ -168,7 +170,7 @@ func TestLeagueClient_GetMaster(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			client := NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
 			got, err := client.League.GetMaster(QueueRankedSolo)
-			require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+			require.True(t, errors.Is(err, tt.wantErr), fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
 			if tt.wantErr == nil {
 				assert.Equal(t, got, tt.want)
 			}
@@ -209,13 +211,13 @@ func TestLeagueClient_ListPlayers(t *testing.T) {
 		{
 			name: "rate limited",
 			want: []*LeagueItem{},
-			doer: rateLimitDoer([]*LeagueItem{}),
+			doer: mock.NewRateLimitDoer([]*LeagueItem{}, 1, 1),
 			page: 1,
 		},
 		{
 			name: "unavailable once",
 			want: []*LeagueItem{},
-			doer: unavailableOnceDoer([]*LeagueItem{}),
+			doer: mock.NewUnavailableDoer([]*LeagueItem{}, 1),
 			page: 1,
 		},
 		{
@@ -229,7 +231,7 @@ func TestLeagueClient_ListPlayers(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			client := NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
 			got, err := client.League.ListPlayers(QueueRankedSolo, TierGold, DivisionOne, 1)
-			require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+			require.True(t, errors.Is(err, tt.wantErr), fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
 			if tt.wantErr == nil {
 				assert.Equal(t, got, tt.want)
 			}
@@ -237,6 +239,46 @@ func TestLeagueClient_ListPlayers(t *testing.T) {
 	}
 }
 
+func TestLeagueClient_ListPlayersPager(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		want    []*LeagueItem
+		doer    internal.Doer
+		wantErr error
+	}{
+		{
+			name: "all pages",
+			want: []*LeagueItem{{SummonerID: "1"}, {SummonerID: "2"}},
+			doer: &mock.Doer{
+				Custom: func(r *http.Request) (*http.Response, error) {
+					switch r.URL.Query().Get("page") {
+					case "1":
+						return mock.NewJSONMockDoer([]*LeagueItem{{SummonerID: "1"}}, 200).Do(r)
+					case "2":
+						return mock.NewJSONMockDoer([]*LeagueItem{{SummonerID: "2"}}, 200).Do(r)
+					default:
+						return mock.NewJSONMockDoer([]*LeagueItem{}, 200).Do(r)
+					}
+				},
+			},
+		},
+		{
+			name:    "not found",
+			wantErr: api.ErrNotFound,
+			doer:    mock.NewStatusMockDoer(http.StatusNotFound),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
+			got, err := client.League.ListPlayersPager(QueueRankedSolo, TierGold, DivisionOne).All(context.Background())
+			require.True(t, errors.Is(err, tt.wantErr))
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func TestLeagueClient_ListBySummoner(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -266,12 +308,12 @@ func TestLeagueClient_ListBySummoner(t *testing.T) {
 		{
 			name: "rate limited",
 			want: []*LeagueItem{},
-			doer: rateLimitDoer([]*LeagueItem{}),
+			doer: mock.NewRateLimitDoer([]*LeagueItem{}, 1, 1),
 		},
 		{
 			name: "unavailable once",
 			want: []*LeagueItem{},
-			doer: unavailableOnceDoer([]*LeagueItem{}),
+			doer: mock.NewUnavailableDoer([]*LeagueItem{}, 1),
 		},
 		{
 			name:    "unavailable twice",
@@ -283,7 +325,7 @@ func TestLeagueClient_ListBySummoner(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			client := NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
 			got, err := client.League.ListBySummoner("id")
-			require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+			require.True(t, errors.Is(err, tt.wantErr), fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
 			if tt.wantErr == nil {
 				assert.Equal(t, got, tt.want)
 			}
@@ -320,12 +362,12 @@ func TestLeagueClient_Get(t *testing.T) {
 		{
 			name: "rate limited",
 			want: &LeagueList{},
-			doer: rateLimitDoer(LeagueList{}),
+			doer: mock.NewRateLimitDoer(LeagueList{}, 1, 1),
 		},
 		{
 			name: "unavailable once",
 			want: &LeagueList{},
-			doer: unavailableOnceDoer(LeagueList{}),
+			doer: mock.NewUnavailableDoer(LeagueList{}, 1),
 		},
 		{
 			name:    "unavailable twice",
@@ -337,7 +379,7 @@ func TestLeagueClient_Get(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			client := NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
 			got, err := client.League.Get("id")
-			require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+			require.True(t, errors.Is(err, tt.wantErr), fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
 			if tt.wantErr == nil {
 				assert.Equal(t, got, tt.want)
 			}
@@ -0,0 +1,111 @@
+package riot
+
+import (
+	"sync/atomic"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/mjourard/golio/logging"
+)
+
+// LogCategory is the value of the category field of the logs of a part of the client
+type LogCategory string
+
+// All categories of the logs of the client
+const (
+	// LogCategoryTransport is the category of sending requests, retries and rate limits
+	LogCategoryTransport       LogCategory = "transport"
+	LogCategoryAccount         LogCategory = "account"
+	LogCategoryChampion        LogCategory = "champion"
+	LogCategoryChampionMastery LogCategory = "champion mastery"
+	LogCategoryLeague          LogCategory = "league"
+	LogCategoryMatch           LogCategory = "match"
+	LogCategorySpectator       LogCategory = "spectator"
+	LogCategoryStatus          LogCategory = "status"
+	LogCategorySummoner        LogCategory = "summoner"
+	LogCategoryThirdPartyCode  LogCategory = "third party code"
+	LogCategoryTournament      LogCategory = "tournament"
+)
+
+// WithLogging sets a logger which does not depend on logrus, e.g. logging.Slog(slog.Default()) or logging.Discard(),
+// instead of the logger passed to NewClient
+func WithLogging(l logging.Logger) Option {
+	return func(c *Client) {
+		c.l = logging.FieldLogger(l).WithField("client", "riot api")
+	}
+}
+
+// WithLogLevel sets the level of the logs of the category, e.g. WithLogLevel(LogCategoryMatch, logging.LevelWarn)
+// silences the debug logs of the match endpoints while the transport keeps logging at the level of the logger of the
+// client. The output, formatter and hooks of the logger of the client are used for the category. The option has no
+// effect if the logger of the client is a logrus.FieldLogger which is neither a *log.Logger nor a *log.Entry
+func WithLogLevel(category LogCategory, level logging.Level) Option {
+	return func(c *Client) {
+		if c.logLevels == nil {
+			c.logLevels = map[LogCategory]logging.Level{}
+		}
+		c.logLevels[category] = level
+	}
+}
+
+// WithRetryLogSampling logs only every n-th message about retries and rate limits, which are logged for every
+// affected request and flood the logs under load. The number of suppressed messages is added to the logged ones
+func WithRetryLogSampling(n int) Option {
+	return func(c *Client) {
+		c.retryLogSampling = int64(n)
+	}
+}
+
+// categoryLoggers returns loggers of the configured log levels by category, derived from base
+func categoryLoggers(base log.FieldLogger, levels map[LogCategory]logging.Level) map[LogCategory]log.FieldLogger {
+	entry, ok := base.(*log.Entry)
+	if !ok {
+		logger, isLogger := base.(*log.Logger)
+		if !isLogger {
+			return nil
+		}
+		entry = log.NewEntry(logger)
+	}
+	loggers := make(map[LogCategory]log.FieldLogger, len(levels))
+	for category, level := range levels {
+		logger := &log.Logger{
+			Out:          entry.Logger.Out,
+			Hooks:        entry.Logger.Hooks,
+			Formatter:    entry.Logger.Formatter,
+			ReportCaller: entry.Logger.ReportCaller,
+			Level:        logging.LogrusLevel(level),
+			ExitFunc:     entry.Logger.ExitFunc,
+		}
+		loggers[category] = logger.WithFields(entry.Data)
+	}
+	return loggers
+}
+
+// categoryLogger returns the logger for the category, using the level configured by WithLogLevel
+func (c *Client) categoryLogger(category LogCategory) log.FieldLogger {
+	logger := c.l
+	if l, ok := c.categoryLoggers[category]; ok {
+		logger = l
+	}
+	return logger.WithFields(log.Fields{
+		"region":   c.Region,
+		"category": string(category),
+	})
+}
+
+// logRetry logs a message about a retry at info level, sampled as configured by WithRetryLogSampling
+func (c *Client) logRetry(logger log.FieldLogger, format string, args ...interface{}) {
+	n := c.retryLogSampling
+	if n <= 1 {
+		logger.Infof(format, args...)
+		return
+	}
+	count := atomic.AddInt64(c.retryLogs, 1)
+	if (count-1)%n != 0 {
+		return
+	}
+	if count > 1 {
+		logger = logger.WithField("suppressed", n-1)
+	}
+	logger.Infof(format, args...)
+}
@@ -0,0 +1,101 @@
+package riot
+
+import (
+	"sync"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mjourard/golio/api"
+	"github.com/mjourard/golio/logging"
+	"github.com/mjourard/golio/mock"
+)
+
+func TestWithLogLevel(t *testing.T) {
+	t.Parallel()
+	logger, hook := test.NewNullLogger()
+	logger.SetLevel(log.DebugLevel)
+	client := NewClient(api.RegionEuropeWest, "API_KEY", mock.NewStatusMockDoer(404), logger,
+		WithLogLevel(LogCategorySummoner, logging.LevelWarn))
+	_, err := client.Summoner.GetByName("name")
+	require.NotNil(t, err)
+	for _, entry := range hook.AllEntries() {
+		assert.NotEqual(t, string(LogCategorySummoner), entry.Data["category"])
+	}
+	hook.Reset()
+	_, err = client.Account.GetByPUUID("puuid")
+	require.NotNil(t, err)
+	require.NotEmpty(t, hook.AllEntries())
+	assert.Equal(t, string(LogCategoryAccount), hook.LastEntry().Data["category"])
+	assert.Equal(t, "riot api", hook.LastEntry().Data["client"])
+}
+
+func TestWithLogLevel_verbose(t *testing.T) {
+	t.Parallel()
+	logger, hook := test.NewNullLogger()
+	logger.SetLevel(log.WarnLevel)
+	client := NewClient(api.RegionEuropeWest, "API_KEY", mock.NewStatusMockDoer(404), logger,
+		WithLogLevel(LogCategoryTransport, logging.LevelDebug))
+	_, err := client.Summoner.GetByName("name")
+	require.NotNil(t, err)
+	require.Len(t, hook.AllEntries(), 1)
+	assert.Equal(t, string(LogCategoryTransport), hook.LastEntry().Data["category"])
+}
+
+// recordingLogger is a logging.Logger recording the messages of all levels
+type recordingLogger struct {
+	mu       sync.Mutex
+	messages []map[string]interface{}
+}
+
+func (l *recordingLogger) Enabled(logging.Level) bool {
+	return true
+}
+
+func (l *recordingLogger) Log(_ logging.Level, _ string, fields map[string]interface{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.messages = append(l.messages, fields)
+}
+
+func TestWithLogging(t *testing.T) {
+	t.Parallel()
+	logger := &recordingLogger{}
+	client := NewClient(api.RegionEuropeWest, "API_KEY", mock.NewStatusMockDoer(404), nil, WithLogging(logger),
+		WithLogLevel(LogCategorySummoner, logging.LevelWarn))
+	_, err := client.Account.GetByPUUID("puuid")
+	require.NotNil(t, err)
+	require.NotEmpty(t, logger.messages)
+	assert.Equal(t, "riot api", logger.messages[0]["client"])
+	count := len(logger.messages)
+	_, err = client.Summoner.GetByName("name")
+	require.NotNil(t, err)
+	for _, fields := range logger.messages[count:] {
+		assert.NotEqual(t, string(LogCategorySummoner), fields["category"])
+	}
+
+	// without a logger nothing is logged
+	client = NewClient(api.RegionEuropeWest, "API_KEY", mock.NewStatusMockDoer(404), nil)
+	_, err = client.Summoner.GetByName("name")
+	require.NotNil(t, err)
+}
+
+func TestWithRetryLogSampling(t *testing.T) {
+	t.Parallel()
+	logger, hook := test.NewNullLogger()
+	client := NewClient(api.RegionEuropeWest, "API_KEY", mock.NewStatusMockDoer(200), logger,
+		WithRetryLogSampling(3))
+	entry := client.categoryLogger(LogCategoryTransport)
+	for i := 0; i < 7; i++ {
+		client.logRetry(entry, "rate limited, waiting %d seconds", i)
+	}
+	entries := hook.AllEntries()
+	require.Len(t, entries, 3)
+	assert.Equal(t, "rate limited, waiting 0 seconds", entries[0].Message)
+	assert.Nil(t, entries[0].Data["suppressed"])
+	assert.Equal(t, "rate limited, waiting 3 seconds", entries[1].Message)
+	assert.Equal(t, int64(2), entries[1].Data["suppressed"])
+}
@@ -1,12 +1,18 @@
 package riot
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"io"
 
 	log "github.com/sirupsen/logrus"
+
+	"github.com/mjourard/golio/api"
 )
 
+const matchlistPageSize = 100
+
 type matchClient struct {
 	c *Client
 }
@@ -14,6 +20,10 @@ type matchClient struct {
 // Get returns a match specified by its ID
 func (m *matchClient) Get(id int) (*Match, error) {
 	logger := m.logger().WithField("method", "Get")
+	if err := validateMatchID(id); err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
 	var match *Match
 	if err := m.c.getInto(fmt.Sprintf(endpointGetMatch, id), &match); err != nil {
 		logger.Debug(err)
@@ -25,6 +35,10 @@ func (m *matchClient) Get(id int) (*Match, error) {
 // List returns a specified range of matches played on the account
 func (m *matchClient) List(accountID string, filter *MatchFilter) (*Matchlist, error) {
 	logger := m.logger().WithField("method", "List")
+	if err := validateMatchlist(accountID, filter); err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
 	var matches *Matchlist
 	queryParams := filter.GetQueryParams()
 	if queryParams != "" {
@@ -40,47 +54,126 @@ func (m *matchClient) List(accountID string, filter *MatchFilter) (*Matchlist, e
 	return matches, nil
 }
 
-// MatchStreamValue value returned by ListStream, containing either a reference to a match or an error
+// MatchStreamValue value returned by ListStream, containing either a reference to a match or an error.
+// Match and Timeline are only set if requested using ListStreamWithMatches or ListStreamWithTimelines
 type MatchStreamValue struct {
 	*MatchReference
-	Error error
+	Match    *Match
+	Timeline *MatchTimeline
+	Error    error
+}
+
+// ListStreamOption is used to alter the values returned by ListStream
+type ListStreamOption func(*listStreamConfig)
+
+type listStreamConfig struct {
+	matches   bool
+	timelines bool
+}
+
+// ListStreamWithMatches fetches the full match data for every match reference and sets it as Match on the values
+// returned by ListStream
+func ListStreamWithMatches() ListStreamOption {
+	return func(c *listStreamConfig) {
+		c.matches = true
+	}
+}
+
+// ListStreamWithTimelines fetches the full match data and the timeline for every match reference and sets them as
+// Match and Timeline on the values returned by ListStream. Timeline is nil for matches without an available timeline
+func ListStreamWithTimelines() ListStreamOption {
+	return func(c *listStreamConfig) {
+		c.matches = true
+		c.timelines = true
+	}
+}
+
+// ListPager returns a pager over all matches played on the account, requesting 100 matches per page.
+// Index values of the filter are ignored, the filter itself is not modified
+func (m *matchClient) ListPager(accountID string, filter *MatchFilter) *Pager[*MatchReference] {
+	pageFilter := NewMatchFilter()
+	if filter != nil {
+		filterCopy := *filter
+		pageFilter = &filterCopy
+	}
+	return newPager(0, matchlistPageSize, func(ctx context.Context, page int) ([]*MatchReference, error) {
+		begin := page * matchlistPageSize
+		end := begin + matchlistPageSize
+		pageFilter.BeginIndex = &begin
+		pageFilter.EndIndex = &end
+		matches, err := m.c.WithContext(ctx).Match.List(accountID, pageFilter)
+		if err != nil {
+			return nil, err
+		}
+		return matches.Matches, nil
+	})
 }
 
 // ListStream returns all matches played on this account as a stream, requesting new until there are no
-// more new games
-func (m *matchClient) ListStream(accountID string, filter *MatchFilter) <-chan MatchStreamValue {
+// more new games. Use ListStreamWithMatches or ListStreamWithTimelines to receive the full match data instead of only
+// references. The stream ends with the first error, after the last match io.EOF is sent as error. The stream of a
+// client returned by Client.WithContext ends with the error of the context once it is done
+func (m *matchClient) ListStream(accountID string, filter *MatchFilter,
+	options ...ListStreamOption) <-chan MatchStreamValue {
 	logger := m.logger().WithField("method", "ListStream")
+	cfg := &listStreamConfig{}
+	for _, opt := range options {
+		opt(cfg)
+	}
 	cMatches := make(chan MatchStreamValue, 100)
+	pager := m.ListPager(accountID, filter)
 	go func() {
-		start := 0
-		end := 100
-		filter.BeginIndex = &start
-		filter.EndIndex = &end
 		for {
-			matches, err := m.List(accountID, filter)
+			matches, err := pager.Next(m.c.context())
 			if err != nil {
-				logger.Debug(err)
+				if err != io.EOF {
+					logger.Debug(err)
+				}
 				cMatches <- MatchStreamValue{Error: err}
 				return
 			}
-			for _, match := range matches.Matches {
-				cMatches <- MatchStreamValue{MatchReference: match}
+			for _, match := range matches {
+				value, err := m.hydrate(match, cfg)
+				if err != nil {
+					logger.Debug(err)
+					cMatches <- MatchStreamValue{Error: err}
+					return
+				}
+				cMatches <- value
 			}
-			if len(matches.Matches) < 100 {
-				cMatches <- MatchStreamValue{Error: io.EOF}
-				return
-			}
-			start += 100
-			end += 100
 		}
 	}()
 	return cMatches
 }
 
+func (m *matchClient) hydrate(reference *MatchReference, cfg *listStreamConfig) (MatchStreamValue, error) {
+	value := MatchStreamValue{MatchReference: reference}
+	if !cfg.matches || reference == nil {
+		return value, nil
+	}
+	match, err := m.Get(reference.GameID)
+	if err != nil {
+		return MatchStreamValue{}, err
+	}
+	value.Match = match
+	if cfg.timelines {
+		timeline, err := m.GetTimeline(reference.GameID)
+		if err != nil && !errors.Is(err, api.ErrNotFound) {
+			return MatchStreamValue{}, err
+		}
+		value.Timeline = timeline
+	}
+	return value, nil
+}
+
 // GetTimeline returns the timeline for the given match
 // NOTE: timelines are not available for every match
 func (m *matchClient) GetTimeline(matchID int) (*MatchTimeline, error) {
 	logger := m.logger().WithField("method", "GetTimeline")
+	if err := validateMatchID(matchID); err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
 	var timeline MatchTimeline
 	if err := m.c.getInto(fmt.Sprintf(endpointGetMatchTimeline, matchID), &timeline); err != nil {
 		logger.Debug(err)
@@ -92,6 +185,10 @@ func (m *matchClient) GetTimeline(matchID int) (*MatchTimeline, error) {
 // ListIDsByTournamentCode returns all match ids for the given tournament
 func (m *matchClient) ListIDsByTournamentCode(tournamentCode string) ([]int, error) {
 	logger := m.logger().WithField("method", "ListIDsByTournamentCode")
+	if err := validateID("tournamentCode", tournamentCode); err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
 	var ids []int
 	if err := m.c.getInto(fmt.Sprintf(endpointGetMatchIDsByTournamentCode, tournamentCode), &ids); err != nil {
 		logger.Debug(err)
@@ -103,6 +200,10 @@ func (m *matchClient) ListIDsByTournamentCode(tournamentCode string) ([]int, err
 // GetForTournament returns the match data for the given match in the given tournament
 func (m *matchClient) GetForTournament(matchID int, tournamentCode string) (*Match, error) {
 	logger := m.logger().WithField("method", "GetForTournament")
+	if err := validateTournamentMatch(matchID, tournamentCode); err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
 	var match Match
 	if err := m.c.getInto(fmt.Sprintf(endpointGetMatchForTournament, matchID, tournamentCode), &match); err != nil {
 		logger.Debug(err)
@@ -112,5 +213,5 @@ func (m *matchClient) GetForTournament(matchID int, tournamentCode string) (*Mat
 }
 
 func (m *matchClient) logger() log.FieldLogger {
-	return m.c.logger().WithField("category", "match")
+	return m.c.categoryLogger(LogCategoryMatch)
 }
@@ -0,0 +1,56 @@
+package riot
+
+import "time"
+
+// Champions adds the given champions to the filter and returns the filter to allow chaining, e.g.
+//
+//	filter := riot.NewMatchFilter().
+//		Champions(riot.ChampionAhri).
+//		Queues(riot.QueueIDRankedSolo, riot.QueueIDRankedFlex).
+//		Between(time.Now().Add(-24*time.Hour), time.Now()).
+//		Range(0, 20)
+//	if err := filter.Validate(); err != nil {
+//		...
+//	}
+func (m *MatchFilter) Champions(champions ...Champion) *MatchFilter {
+	for _, champion := range champions {
+		m.ChampionIds = append(m.ChampionIds, int(champion))
+	}
+	return m
+}
+
+// Queues adds the given queues to the filter and returns the filter
+func (m *MatchFilter) Queues(queues ...QueueID) *MatchFilter {
+	for _, queue := range queues {
+		m.QueueIds = append(m.QueueIds, int(queue))
+	}
+	return m
+}
+
+// Season adds the given season IDs to the filter and returns the filter
+func (m *MatchFilter) Season(seasons ...int) *MatchFilter {
+	m.Seasons = append(m.Seasons, seasons...)
+	return m
+}
+
+// Between restricts the filter to matches played between begin and end and returns the filter. The time range must
+// not exceed one week
+func (m *MatchFilter) Between(begin, end time.Time) *MatchFilter {
+	m.BeginTime = &begin
+	m.EndTime = &end
+	return m
+}
+
+// Range restricts the filter to the matches with indices in [begin, end) and returns the filter. At most 100 matches
+// can be requested at once
+func (m *MatchFilter) Range(begin, end int) *MatchFilter {
+	m.BeginIndex = &begin
+	m.EndIndex = &end
+	return m
+}
+
+// Validate returns a *ValidationError if the filter exceeds the limits of the matchlist endpoint, e.g. an empty or
+// too long time range. Filters are validated before every request as well
+func (m *MatchFilter) Validate() error {
+	return m.validate()
+}
@@ -0,0 +1,68 @@
+package riot
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchFilter_builder(t *testing.T) {
+	t.Parallel()
+	begin := time.Unix(1585000000, 0)
+	end := begin.Add(24 * time.Hour)
+	tests := []struct {
+		name    string
+		filter  *MatchFilter
+		want    string
+		wantErr bool
+	}{
+		{
+			name:   "empty",
+			filter: NewMatchFilter(),
+			want:   "",
+		},
+		{
+			name: "all",
+			filter: NewMatchFilter().
+				Champions(ChampionAhri, ChampionWukong).
+				Queues(QueueIDRankedSolo).
+				Season(13).
+				Between(begin, end).
+				Range(10, 30),
+			want: "champion=103&champion=62&queue=420&season=13&endTime=1585086400000&beginTime=1585000000000&" +
+				"endIndex=30&beginIndex=10",
+		},
+		{
+			name:    "empty time range",
+			filter:  NewMatchFilter().Between(begin, begin),
+			want:    "endTime=1585000000000&beginTime=1585000000000",
+			wantErr: true,
+		},
+		{
+			name:    "time range too long",
+			filter:  NewMatchFilter().Between(begin, begin.Add(8*24*time.Hour)),
+			want:    "endTime=1585691200000&beginTime=1585000000000",
+			wantErr: true,
+		},
+		{
+			name:    "inverted range",
+			filter:  NewMatchFilter().Range(20, 10),
+			want:    "endIndex=10&beginIndex=20",
+			wantErr: true,
+		},
+		{
+			name:    "range too long",
+			filter:  NewMatchFilter().Range(0, 101),
+			want:    "endIndex=101&beginIndex=0",
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, test.filter.GetQueryParams())
+			err := test.filter.Validate()
+			assert.Equal(t, test.wantErr, err != nil, "got error %v", err)
+		})
+	}
+}
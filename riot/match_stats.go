@@ -0,0 +1,125 @@
+package riot
+
+import (
+	"time"
+)
+
+// teamWin is the value of TeamStats.Win for the team which won the game
+const teamWin = "Win"
+
+// WinningTeam returns the stats of the team which won the game or nil if no team won, e.g. for remakes
+func (m *Match) WinningTeam() *TeamStats {
+	for _, team := range m.Teams {
+		if team != nil && team.Win == teamWin {
+			return team
+		}
+	}
+	return nil
+}
+
+// Participant returns the participant with the given participant ID or nil if there is none
+func (m *Match) Participant(participantID int) *Participant {
+	for _, p := range m.Participants {
+		if p != nil && p.ParticipantID == participantID {
+			return p
+		}
+	}
+	return nil
+}
+
+// ParticipantByAccount returns the participant played by the account with the given ID, matching either the original
+// or the current account ID of the player, or nil if the account did not play in the match
+func (m *Match) ParticipantByAccount(accountID string) *Participant {
+	for _, identity := range m.ParticipantIdentities {
+		if identity == nil || identity.Player == nil {
+			continue
+		}
+		if identity.Player.AccountID == accountID || identity.Player.CurrentAccountID == accountID {
+			return m.Participant(identity.ParticipantID)
+		}
+	}
+	return nil
+}
+
+// KDA returns the ratio of kills and assists to deaths. Deaths are counted as at least one, so a game without deaths
+// returns kills plus assists
+func (p *Participant) KDA() float64 {
+	if p.Stats == nil {
+		return 0
+	}
+	deaths := p.Stats.Deaths
+	if deaths < 1 {
+		deaths = 1
+	}
+	return float64(p.Stats.Kills+p.Stats.Assists) / float64(deaths)
+}
+
+// CSPerMinute returns the minions and neutral monsters killed by the participant per minute of the game
+func (m *Match) CSPerMinute(p *Participant) float64 {
+	minutes := m.GameDuration.Minutes()
+	if p == nil || p.Stats == nil || minutes <= 0 {
+		return 0
+	}
+	return float64(p.Stats.TotalMinionsKilled+p.Stats.NeutralMinionsKilled) / minutes
+}
+
+// DamageShare returns the share in [0, 1] of the participant's damage dealt to champions of the damage dealt to
+// champions by the whole team of the participant
+func (m *Match) DamageShare(p *Participant) float64 {
+	if p == nil || p.Stats == nil {
+		return 0
+	}
+	total := 0
+	for _, teammate := range m.Participants {
+		if teammate != nil && teammate.Stats != nil && teammate.TeamID == p.TeamID {
+			total += teammate.Stats.TotalDamageDealtToChampions
+		}
+	}
+	if total == 0 {
+		return 0
+	}
+	return float64(p.Stats.TotalDamageDealtToChampions) / float64(total)
+}
+
+// GoldDiffAt returns the total gold of the team with the given ID minus the total gold of the opposing team at the
+// last frame of the timeline at or before the given time of the game. The returned bool is false if the timeline is
+// nil or has no such frame
+func (m *Match) GoldDiffAt(timeline *MatchTimeline, teamID int, at time.Duration) (int, bool) {
+	if timeline == nil {
+		return 0, false
+	}
+	var frame *MatchFrame
+	for _, f := range timeline.Frames {
+		if f != nil && f.Timestamp.Duration <= at {
+			frame = f
+		}
+	}
+	if frame == nil {
+		return 0, false
+	}
+	diff := 0
+	for _, pf := range frame.ParticipantFrames {
+		if pf == nil {
+			continue
+		}
+		p := m.Participant(pf.ParticipantID)
+		if p == nil {
+			continue
+		}
+		if p.TeamID == teamID {
+			diff += pf.TotalGold
+		} else {
+			diff -= pf.TotalGold
+		}
+	}
+	return diff, true
+}
+
+// GoldDiffAt returns Match.GoldDiffAt for the attached timeline. The returned bool is false if the match or the
+// timeline was not fetched, see ListStreamWithTimelines
+func (v MatchStreamValue) GoldDiffAt(teamID int, at time.Duration) (int, bool) {
+	if v.Match == nil {
+		return 0, false
+	}
+	return v.Match.GoldDiffAt(v.Timeline, teamID, at)
+}
@@ -0,0 +1,127 @@
+package riot
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func analyticsMatch() *Match {
+	stats := func(kills, deaths, assists, cs, damage int) *ParticipantStats {
+		return &ParticipantStats{
+			Kills:                       kills,
+			Deaths:                      deaths,
+			Assists:                     assists,
+			TotalMinionsKilled:          cs,
+			NeutralMinionsKilled:        cs / 10,
+			TotalDamageDealtToChampions: damage,
+		}
+	}
+	return &Match{
+		GameDuration: DurationSeconds{30 * time.Minute},
+		Teams:        []*TeamStats{{TeamID: 100, Win: "Fail"}, {TeamID: 200, Win: "Win"}},
+		Participants: []*Participant{
+			{ParticipantID: 1, TeamID: 100, Stats: stats(2, 4, 6, 200, 10000)},
+			{ParticipantID: 2, TeamID: 100, Stats: stats(3, 0, 1, 10, 30000)},
+			{ParticipantID: 3, TeamID: 200, Stats: stats(7, 1, 2, 250, 25000)},
+			{ParticipantID: 4, TeamID: 200},
+		},
+	}
+}
+
+func TestMatch_WinningTeam(t *testing.T) {
+	t.Parallel()
+	match := analyticsMatch()
+	assert.Equal(t, 200, match.WinningTeam().TeamID)
+	match.Teams[1].Win = "Fail"
+	assert.Nil(t, match.WinningTeam())
+}
+
+func TestMatch_ParticipantByAccount(t *testing.T) {
+	t.Parallel()
+	match := analyticsMatch()
+	match.ParticipantIdentities = []*ParticipantIdentity{
+		{ParticipantID: 1, Player: &Player{AccountID: "a"}},
+		{ParticipantID: 2},
+		{ParticipantID: 3, Player: &Player{AccountID: "old", CurrentAccountID: "b"}},
+	}
+	assert.Equal(t, 1, match.ParticipantByAccount("a").ParticipantID)
+	assert.Equal(t, 3, match.ParticipantByAccount("b").ParticipantID)
+	assert.Nil(t, match.ParticipantByAccount("c"))
+}
+
+func TestParticipant_KDA(t *testing.T) {
+	t.Parallel()
+	match := analyticsMatch()
+	assert.Equal(t, 2.0, match.Participant(1).KDA())
+	assert.Equal(t, 4.0, match.Participant(2).KDA())
+	assert.Equal(t, 0.0, match.Participant(4).KDA())
+	assert.Nil(t, match.Participant(5))
+}
+
+func TestMatch_CSPerMinute(t *testing.T) {
+	t.Parallel()
+	match := analyticsMatch()
+	assert.Equal(t, 7.333333333333333, match.CSPerMinute(match.Participant(1)))
+	assert.Equal(t, 0.0, match.CSPerMinute(match.Participant(4)))
+	assert.Equal(t, 0.0, match.CSPerMinute(nil))
+	match.GameDuration = DurationSeconds{}
+	assert.Equal(t, 0.0, match.CSPerMinute(match.Participant(1)))
+}
+
+func TestMatch_DamageShare(t *testing.T) {
+	t.Parallel()
+	match := analyticsMatch()
+	assert.Equal(t, 0.25, match.DamageShare(match.Participant(1)))
+	assert.Equal(t, 0.75, match.DamageShare(match.Participant(2)))
+	assert.Equal(t, 1.0, match.DamageShare(match.Participant(3)))
+	assert.Equal(t, 0.0, match.DamageShare(match.Participant(4)))
+}
+
+func TestMatch_GoldDiffAt(t *testing.T) {
+	t.Parallel()
+	match := analyticsMatch()
+	frame := func(at time.Duration, gold ...int) *MatchFrame {
+		frames := map[string]*ParticipantFrame{}
+		for i, g := range gold {
+			frames[string(rune('1'+i))] = &ParticipantFrame{ParticipantID: i + 1, TotalGold: g}
+		}
+		return &MatchFrame{Timestamp: DurationMillis{at}, ParticipantFrames: frames}
+	}
+	timeline := &MatchTimeline{
+		Interval: DurationMillis{time.Minute},
+		Frames: []*MatchFrame{
+			frame(0, 500, 500, 500, 500),
+			frame(time.Minute, 700, 600, 900, 650),
+			frame(2*time.Minute, 1000, 900, 1200, 1100),
+		},
+	}
+	tests := []struct {
+		name   string
+		team   int
+		at     time.Duration
+		want   int
+		wantOK bool
+	}{
+		{name: "start", team: 100, at: 0, want: 0, wantOK: true},
+		{name: "between frames", team: 100, at: 90 * time.Second, want: -250, wantOK: true},
+		{name: "other team", team: 200, at: time.Minute, want: 250, wantOK: true},
+		{name: "after last frame", team: 100, at: time.Hour, want: -400, wantOK: true},
+		{name: "before first frame", team: 100, at: -time.Second, wantOK: false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, ok := match.GoldDiffAt(timeline, test.team, test.at)
+			assert.Equal(t, test.wantOK, ok)
+			assert.Equal(t, test.want, got)
+		})
+	}
+	_, ok := match.GoldDiffAt(nil, 100, time.Minute)
+	assert.False(t, ok)
+	got, ok := MatchStreamValue{Match: match, Timeline: timeline}.GoldDiffAt(100, time.Minute)
+	assert.True(t, ok)
+	assert.Equal(t, -250, got)
+	_, ok = MatchStreamValue{Match: match}.GoldDiffAt(100, time.Minute)
+	assert.False(t, ok)
+}
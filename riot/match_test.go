@@ -1,6 +1,8 @@
 package riot
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -12,7 +14,7 @@ import (
 
 	"github.com/mjourard/golio/api"
 	"github.com/mjourard/golio/internal"
-	"github.com/mjourard/golio/internal/mock"
+	"github.com/mjourard/golio/mock"
 )
 
 func TestMatchClient_List(t *testing.T) {
@@ -44,12 +46,12 @@ func TestMatchClient_List(t *testing.T) {
 		{
 			name: "rate limited",
 			want: &Matchlist{},
-			doer: rateLimitDoer(Matchlist{}),
+			doer: mock.NewRateLimitDoer(Matchlist{}, 1, 1),
 		},
 		{
 			name: "unavailable once",
 			want: &Matchlist{},
-			doer: unavailableOnceDoer(Matchlist{}),
+			doer: mock.NewUnavailableDoer(Matchlist{}, 1),
 		},
 		{
 			name:    "unavailable twice",
@@ -66,7 +68,7 @@ func TestMatchClient_List(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			client := NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
 			got, err := client.Match.List("id", filter)
-			require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+			require.True(t, errors.Is(err, tt.wantErr), fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
 			if tt.wantErr == nil {
 				assert.Equal(t, got, tt.want)
 			}
@@ -74,9 +76,24 @@ func TestMatchClient_List(t *testing.T) {
 	}
 }
 
+func TestMatchClient_ListPager(t *testing.T) {
+	t.Parallel()
+	scenario := mock.NewScenario().
+		Expect(http.MethodGet, "/lol/match/v4/matchlists/by-account/id?beginIndex=0&endIndex=100").
+		RespondJSON(200, Matchlist{Matches: make([]*MatchReference, 100)}).
+		Expect(http.MethodGet, "/lol/match/v4/matchlists/by-account/id?beginIndex=100&endIndex=200").
+		RespondJSON(200, Matchlist{Matches: []*MatchReference{{GameID: 1}}})
+	client := NewClient(api.RegionEuropeWest, "API_KEY", scenario, logrus.StandardLogger())
+	filter := NewMatchFilter()
+	got, err := client.Match.ListPager("id", filter).All(context.Background())
+	require.Nil(t, err)
+	assert.Len(t, got, 101)
+	scenario.Verify(t)
+	assert.Nil(t, filter.BeginIndex)
+}
+
 func TestMatchClient_ListStream(t *testing.T) {
 	t.Parallel()
-	count := 0
 	tests := []struct {
 		name    string
 		doer    internal.Doer
@@ -84,17 +101,11 @@ func TestMatchClient_ListStream(t *testing.T) {
 	}{
 		{
 			name: "get response",
-			doer: &mock.Doer{
-				Custom: func(r *http.Request) (*http.Response, error) {
-					if count == 0 {
-						count++
-						return mock.NewJSONMockDoer(Matchlist{
-							Matches: make([]*MatchReference, 100),
-						}, 200).Do(r)
-					}
-					return mock.NewJSONMockDoer(Matchlist{}, 200).Do(r)
-				},
-			},
+			doer: mock.NewScenario().
+				Expect(http.MethodGet, "/lol/match/v4/matchlists/by-account/id?beginIndex=0").
+				RespondJSON(200, Matchlist{Matches: make([]*MatchReference, 100)}).
+				Expect(http.MethodGet, "/lol/match/v4/matchlists/by-account/id?beginIndex=100").
+				RespondJSON(200, Matchlist{}),
 		},
 		{
 			name: "unknown error status",
@@ -111,11 +122,11 @@ func TestMatchClient_ListStream(t *testing.T) {
 		},
 		{
 			name: "rate limited",
-			doer: rateLimitDoer(Matchlist{}),
+			doer: mock.NewRateLimitDoer(Matchlist{}, 1, 1),
 		},
 		{
 			name: "unavailable once",
-			doer: unavailableOnceDoer(Matchlist{}),
+			doer: mock.NewUnavailableDoer(Matchlist{}, 1),
 		},
 		{
 			name:    "unavailable twice",
@@ -130,7 +141,7 @@ func TestMatchClient_ListStream(t *testing.T) {
 			got := client.Match.ListStream("id", filter)
 			for res := range got {
 				if res.Error != nil && tt.wantErr != nil {
-					require.Equal(t, res.Error, tt.wantErr)
+					require.True(t, errors.Is(res.Error, tt.wantErr))
 					break
 				} else if res.Error != nil {
 					require.Equal(t, res.Error, io.EOF)
@@ -141,6 +152,78 @@ func TestMatchClient_ListStream(t *testing.T) {
 	}
 }
 
+func TestMatchClient_ListStreamHydrated(t *testing.T) {
+	t.Parallel()
+	routes := map[string]interface{}{
+		"/lol/match/v4/matchlists/by-account/id": Matchlist{Matches: []*MatchReference{{GameID: 1}, {GameID: 2}}},
+		"/lol/match/v4/matches/1":                Match{GameID: 1},
+		"/lol/match/v4/matches/2":                Match{GameID: 2},
+		"/lol/match/v4/timelines/by-match/1":     MatchTimeline{Interval: ms(1)},
+	}
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			object, ok := routes[r.URL.Path]
+			if !ok {
+				return mock.NewStatusMockDoer(http.StatusNotFound).Do(r)
+			}
+			return mock.NewJSONMockDoer(object, 200).Do(r)
+		},
+	}
+	tests := []struct {
+		name    string
+		options []ListStreamOption
+		want    []MatchStreamValue
+	}{
+		{
+			name: "references",
+			want: []MatchStreamValue{
+				{MatchReference: &MatchReference{GameID: 1}},
+				{MatchReference: &MatchReference{GameID: 2}},
+			},
+		},
+		{
+			name:    "matches",
+			options: []ListStreamOption{ListStreamWithMatches()},
+			want: []MatchStreamValue{
+				{MatchReference: &MatchReference{GameID: 1}, Match: &Match{GameID: 1}},
+				{MatchReference: &MatchReference{GameID: 2}, Match: &Match{GameID: 2}},
+			},
+		},
+		{
+			name:    "timelines",
+			options: []ListStreamOption{ListStreamWithTimelines()},
+			want: []MatchStreamValue{
+				{MatchReference: &MatchReference{GameID: 1}, Match: &Match{GameID: 1}, Timeline: &MatchTimeline{Interval: ms(1)}},
+				{MatchReference: &MatchReference{GameID: 2}, Match: &Match{GameID: 2}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger())
+			var got []MatchStreamValue
+			for value := range client.Match.ListStream("id", nil, tt.options...) {
+				if value.Error != nil {
+					require.Equal(t, io.EOF, value.Error)
+					break
+				}
+				got = append(got, value)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+	delete(routes, "/lol/match/v4/matches/2")
+	client := NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger())
+	var gotErr error
+	for value := range client.Match.ListStream("id", nil, ListStreamWithMatches()) {
+		if value.Error != nil {
+			gotErr = value.Error
+			break
+		}
+	}
+	assert.True(t, errors.Is(gotErr, api.ErrNotFound))
+}
+
 func TestMatchClient_Get(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -170,12 +253,12 @@ func TestMatchClient_Get(t *testing.T) {
 		{
 			name: "rate limited",
 			want: &Match{},
-			doer: rateLimitDoer(Match{}),
+			doer: mock.NewRateLimitDoer(Match{}, 1, 1),
 		},
 		{
 			name: "unavailable once",
 			want: &Match{},
-			doer: unavailableOnceDoer(Match{}),
+			doer: mock.NewUnavailableDoer(Match{}, 1),
 		},
 		{
 			name:    "unavailable twice",
@@ -187,7 +270,7 @@ func TestMatchClient_Get(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			client := NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
 			got, err := client.Match.Get(1)
-			require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+			require.True(t, errors.Is(err, tt.wantErr), fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
 			if tt.wantErr == nil {
 				assert.Equal(t, got, tt.want)
 			}
@@ -224,12 +307,12 @@ func TestMatchClient_GetTimeline(t *testing.T) {
 		{
 			name: "rate limited",
 			want: &MatchTimeline{},
-			doer: rateLimitDoer(MatchTimeline{}),
+			doer: mock.NewRateLimitDoer(MatchTimeline{}, 1, 1),
 		},
 		{
 			name: "unavailable once",
 			want: &MatchTimeline{},
-			doer: unavailableOnceDoer(MatchTimeline{}),
+			doer: mock.NewUnavailableDoer(MatchTimeline{}, 1),
 		},
 		{
 			name:    "unavailable twice",
@@ -241,7 +324,7 @@ func TestMatchClient_GetTimeline(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			client := NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
 			got, err := client.Match.GetTimeline(0)
-			require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+			require.True(t, errors.Is(err, tt.wantErr), fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
 			if tt.wantErr == nil {
 				assert.Equal(t, got, tt.want)
 			}
@@ -278,12 +361,12 @@ func TestMatchClient_ListIDsByTournamentCode(t *testing.T) {
 		{
 			name: "rate limited",
 			want: []int{},
-			doer: rateLimitDoer([]int{}),
+			doer: mock.NewRateLimitDoer([]int{}, 1, 1),
 		},
 		{
 			name: "unavailable once",
 			want: []int{},
-			doer: unavailableOnceDoer([]int{}),
+			doer: mock.NewUnavailableDoer([]int{}, 1),
 		},
 		{
 			name:    "unavailable twice",
@@ -295,7 +378,7 @@ func TestMatchClient_ListIDsByTournamentCode(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			client := NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
 			got, err := client.Match.ListIDsByTournamentCode("tournamentCode")
-			require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+			require.True(t, errors.Is(err, tt.wantErr), fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
 			if tt.wantErr == nil {
 				assert.Equal(t, got, tt.want)
 			}
@@ -332,12 +415,12 @@ func TestMatchClient_GetForTournament(t *testing.T) {
 		{
 			name: "rate limited",
 			want: &Match{},
-			doer: rateLimitDoer(Match{}),
+			doer: mock.NewRateLimitDoer(Match{}, 1, 1),
 		},
 		{
 			name: "unavailable once",
 			want: &Match{},
-			doer: unavailableOnceDoer(Match{}),
+			doer: mock.NewUnavailableDoer(Match{}, 1),
 		},
 		{
 			name:    "unavailable twice",
@@ -349,7 +432,7 @@ func TestMatchClient_GetForTournament(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			client := NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
 			got, err := client.Match.GetForTournament(0, "tournamentCode")
-			require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+			require.True(t, errors.Is(err, tt.wantErr), fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
 			if tt.wantErr == nil {
 				assert.Equal(t, got, tt.want)
 			}
@@ -0,0 +1,38 @@
+package riot
+
+import (
+	"net/http"
+)
+
+// Doer sends HTTP requests, e.g. an *http.Client. It is the same interface as the Doer passed to NewClient
+type Doer interface {
+	Do(r *http.Request) (*http.Response, error)
+}
+
+// DoerFunc is a function implementing Doer
+type DoerFunc func(r *http.Request) (*http.Response, error)
+
+// Do calls f
+func (f DoerFunc) Do(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+// Middleware wraps the Doer sending the requests of a client, e.g. to add headers like correlation IDs or to log
+// requests. Middleware sees every request sent to the Riot API, including retries
+type Middleware func(next Doer) Doer
+
+// WithMiddleware wraps the Doer of the client with the middleware. The first middleware receives the requests first,
+// calling WithMiddleware several times appends to the chain
+func WithMiddleware(middleware ...Middleware) Option {
+	return func(c *Client) {
+		c.middleware = append(c.middleware, middleware...)
+	}
+}
+
+// chain wraps the doer with the middleware
+func chain(doer Doer, middleware []Middleware) Doer {
+	for i := len(middleware) - 1; i >= 0; i-- {
+		doer = middleware[i](doer)
+	}
+	return doer
+}
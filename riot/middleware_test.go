@@ -0,0 +1,35 @@
+package riot
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mjourard/golio/api"
+	"github.com/mjourard/golio/mock"
+)
+
+func TestWithMiddleware(t *testing.T) {
+	t.Parallel()
+	var order []string
+	header := func(name string) Middleware {
+		return func(next Doer) Doer {
+			return DoerFunc(func(r *http.Request) (*http.Response, error) {
+				order = append(order, name)
+				r.Header.Set("X-Correlation-Id", name)
+				return next.Do(r)
+			})
+		}
+	}
+	doer := mock.NewSequenceDoer(mock.Unavailable(1), mock.OK(Summoner{ID: "id"}))
+	c := NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger(),
+		WithMiddleware(header("first"), header("second")), WithMiddleware(header("third")))
+	summoner, err := c.Summoner.GetByID("id")
+	require.Nil(t, err)
+	assert.Equal(t, "id", summoner.ID)
+	assert.Equal(t, []string{"first", "second", "third", "first", "second", "third"}, order)
+	doer.AssertHeader(t, "X-Correlation-Id", "third")
+}
@@ -0,0 +1,34 @@
+package mocks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mjourard/golio/riot"
+)
+
+func summonerLevel(api riot.SummonerAPI, name string) (int, error) {
+	summoner, err := api.GetByName(name)
+	if err != nil {
+		return 0, err
+	}
+	return summoner.SummonerLevel, nil
+}
+
+func TestSummonerAPI(t *testing.T) {
+	t.Parallel()
+	api := NewSummonerAPI(t)
+	api.On("GetByName", "name").Return(&riot.Summoner{Name: "name", SummonerLevel: 30}, nil).Once()
+	level, err := summonerLevel(api, "name")
+	require.Nil(t, err)
+	assert.Equal(t, 30, level)
+}
+
+func TestMatchAPI_ListStream(t *testing.T) {
+	t.Parallel()
+	api := NewMatchAPI(t)
+	api.On("ListStream", "account", (*riot.MatchFilter)(nil)).Return((<-chan riot.MatchStreamValue)(nil)).Once()
+	assert.Nil(t, api.ListStream("account", nil))
+}
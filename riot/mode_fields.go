@@ -0,0 +1,53 @@
+package riot
+
+import "encoding/json"
+
+// The accessors below read fields which are only sent for some game modes. They can be called on nil stats, e.g.
+// participant.Stats.ArenaPlacement(), and return false as second value if the field was not sent
+
+// ArenaPlacement returns the final placement of the team of the participant in an Arena game, from 1 to 8
+func (s *ParticipantStats) ArenaPlacement() (int, bool) {
+	if s == nil || s.Placement < 1 {
+		return 0, false
+	}
+	return s.Placement, true
+}
+
+// ArenaTeam returns the team of the participant in an Arena game
+func (s *ParticipantStats) ArenaTeam() (int, bool) {
+	if s == nil || s.PlayerSubteamID < 1 {
+		return 0, false
+	}
+	return s.PlayerSubteamID, true
+}
+
+// Augments returns the IDs of the augments chosen in an Arena game in the order they were chosen
+func (s *ParticipantStats) Augments() ([]int, bool) {
+	if s == nil {
+		return nil, false
+	}
+	var augments []int
+	for _, augment := range []int{s.PlayerAugment1, s.PlayerAugment2, s.PlayerAugment3, s.PlayerAugment4} {
+		if augment != 0 {
+			augments = append(augments, augment)
+		}
+	}
+	return augments, len(augments) > 0
+}
+
+// Challenge returns the value of the challenge statistic with the given name, e.g. "soloKills". The second return
+// value is false if the statistic was not sent or is not a number
+func (s *ParticipantStats) Challenge(name string) (float64, bool) {
+	if s == nil {
+		return 0, false
+	}
+	raw, ok := s.Challenges[name]
+	if !ok {
+		return 0, false
+	}
+	var value float64
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return 0, false
+	}
+	return value, true
+}
@@ -0,0 +1,43 @@
+package riot
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParticipantStats_modeFields(t *testing.T) {
+	t.Parallel()
+	var arena ParticipantStats
+	require.Nil(t, json.Unmarshal([]byte(`{"placement":2,"playerSubteamId":5,"playerAugment1":101,"playerAugment2":7,
+		"challenges":{"kda":3.5,"soloKills":2,"legendaryItemUsed":[3031,3072]}}`), &arena))
+	placement, ok := arena.ArenaPlacement()
+	assert.True(t, ok)
+	assert.Equal(t, 2, placement)
+	team, ok := arena.ArenaTeam()
+	assert.True(t, ok)
+	assert.Equal(t, 5, team)
+	augments, ok := arena.Augments()
+	assert.True(t, ok)
+	assert.Equal(t, []int{101, 7}, augments)
+	kda, ok := arena.Challenge("kda")
+	assert.True(t, ok)
+	assert.Equal(t, 3.5, kda)
+	_, ok = arena.Challenge("legendaryItemUsed")
+	assert.False(t, ok)
+	_, ok = arena.Challenge("unknown")
+	assert.False(t, ok)
+
+	for name, stats := range map[string]*ParticipantStats{"classic": {Kills: 3}, "nil": nil} {
+		_, ok = stats.ArenaPlacement()
+		assert.False(t, ok, name)
+		_, ok = stats.ArenaTeam()
+		assert.False(t, ok, name)
+		_, ok = stats.Augments()
+		assert.False(t, ok, name)
+		_, ok = stats.Challenge("kda")
+		assert.False(t, ok, name)
+	}
+}
@@ -1,6 +1,7 @@
 package riot
 
 import (
+	"encoding/json"
 	"fmt"
 	"sort"
 	"strconv"
@@ -13,8 +14,8 @@ import (
 
 // ChampionInfo contains information about the free champion rotation
 type ChampionInfo struct {
-	FreeChampionIDsForNewPlayers []int `json:"freeChampionIDsForNewPlayers"`
-	FreeChampionIDs              []int `json:"freeChampionIDs"`
+	FreeChampionIDsForNewPlayers []int `json:"freeChampionIdsForNewPlayers"`
+	FreeChampionIDs              []int `json:"freeChampionIds"`
 	MaxNewPlayerLevel            int   `json:"maxNewPlayerLevel"`
 }
 
@@ -46,15 +47,15 @@ func (i *ChampionInfo) GetChampions(client *datadragon.Client) ([]datadragon.Cha
 
 // ChampionMastery represents the mastery of a champion in the mastery system for a summoner
 type ChampionMastery struct {
-	ChestGranted                 bool   `json:"chestGranted"`
-	ChampionLevel                int    `json:"championLevel"`
-	ChampionPoints               int    `json:"championPoints"`
-	ChampionID                   int    `json:"championId"`
-	ChampionPointsUntilNextLevel int    `json:"championPointsUntilNextLevel"`
-	LastPlayTime                 int    `json:"lastPlayTime"`
-	TokensEarned                 int    `json:"tokensEarned"`
-	ChampionPointsSinceLastLevel int    `json:"championPointsSinceLastLevel"`
-	SummonerID                   string `json:"summonerId"`
+	ChestGranted                 bool      `json:"chestGranted"`
+	ChampionLevel                int       `json:"championLevel"`
+	ChampionPoints               int       `json:"championPoints"`
+	ChampionID                   int       `json:"championId"`
+	ChampionPointsUntilNextLevel int       `json:"championPointsUntilNextLevel"`
+	LastPlayTime                 Timestamp `json:"lastPlayTime"`
+	TokensEarned                 int       `json:"tokensEarned"`
+	ChampionPointsSinceLastLevel int       `json:"championPointsSinceLastLevel"`
+	SummonerID                   string    `json:"summonerId"`
 }
 
 // GetSummoner returns the summoner of this mastery
@@ -91,6 +92,7 @@ func (l *LeagueList) GetRank(i int) *LeagueItem {
 
 // LeagueItem represents a summoners ranked position in a league
 type LeagueItem struct {
+	LeagueID     string      `json:"leagueId"`
 	QueueType    string      `json:"queueType"`
 	SummonerName string      `json:"summonerName"`
 	HotStreak    bool        `json:"hotStreak"`
@@ -142,11 +144,11 @@ type Match struct {
 	Teams []*TeamStats `json:"teams"`
 	// Participant information.
 	Participants []*Participant `json:"participants"`
-	// Match duration in seconds.
-	GameDuration int `json:"gameDuration"`
+	// Match duration, sent in seconds.
+	GameDuration DurationSeconds `json:"gameDuration"`
 	// Designates the timestamp when champion select ended and the loading screen appeared, NOT when the game timer was
 	// at 0:00.
-	GameCreation int `json:"gameCreation"`
+	GameCreation Timestamp `json:"gameCreation"`
 }
 
 // GetSeason returns the season this match was played in
@@ -288,21 +290,23 @@ func (p *Participant) GetChampion(client *datadragon.Client) (datadragon.Champio
 
 // GetSpell1 returns the first summoner spell of this participant
 func (p *Participant) GetSpell1(client *datadragon.Client) (datadragon.SummonerSpell, error) {
-	return client.GetSummonerSpell(strconv.Itoa(p.Spell1ID))
+	return client.GetSummonerSpell(SummonerSpell(p.Spell1ID).dataDragonID())
 }
 
 // GetSpell2 returns the second summoner spell of this participant
 func (p *Participant) GetSpell2(client *datadragon.Client) (datadragon.SummonerSpell, error) {
-	return client.GetSummonerSpell(strconv.Itoa(p.Spell2ID))
+	return client.GetSummonerSpell(SummonerSpell(p.Spell2ID).dataDragonID())
 }
 
 // ParticipantStats contains stats of a participant in a game
 type ParticipantStats struct {
-	FirstBloodAssist                bool `json:"firstBloodAssist"`
-	VisionScore                     int  `json:"visionScore"`
-	MagicDamageDealtToChampions     int  `json:"magicDamageDealtToChampions"`
-	DamageDealtToObjectives         int  `json:"damageDealtToObjectives"`
-	TotalTimeCrowdControlDealt      int  `json:"totalTimeCrowdControlDealt"`
+	FirstBloodAssist            bool `json:"firstBloodAssist"`
+	VisionScore                 int  `json:"visionScore"`
+	MagicDamageDealtToChampions int  `json:"magicDamageDealtToChampions"`
+	DamageDealtToObjectives     int  `json:"damageDealtToObjectives"`
+	TotalTimeCrowdControlDealt  int  `json:"totalTimeCrowdControlDealt"`
+	LongestTimeSpentLiving      int  `json:"longestTimeSpentLiving"`
+	// Deprecated: misspelled and never set by the Riot API, use LongestTimeSpentLiving
 	IntestTimeSpentLiving           int  `json:"intestTimeSpentLiving"`
 	TotalScoreRank                  int  `json:"totalScoreRank"`
 	NeutralMinionsKilled            int  `json:"neutralMinionsKilled"`
@@ -357,6 +361,11 @@ type ParticipantStats struct {
 	TotalMinionsKilled              int  `json:"totalMinionsKilled"`
 	TimeCCingOthers                 int  `json:"timeCCingOthers"`
 
+	// Stat runes (offense, flex and defense)
+	StatPerk0 int `json:"statPerk0"`
+	StatPerk1 int `json:"statPerk1"`
+	StatPerk2 int `json:"statPerk2"`
+
 	// Primary rune path
 	PerkPrimaryStyle int `json:"perkPrimaryStyle"`
 	// Secondary rune path
@@ -418,6 +427,19 @@ type ParticipantStats struct {
 	TripleKills int `json:"tripleKills"`
 	QuadraKills int `json:"quadraKills"`
 	PentaKills  int `json:"pentaKills"`
+
+	// Fields only sent for some game modes, use the accessors like ArenaPlacement to read them.
+	// Final placement of the team of the participant in Arena, from 1 to 8.
+	Placement int `json:"placement"`
+	// Augments chosen in Arena.
+	PlayerAugment1 int `json:"playerAugment1"`
+	PlayerAugment2 int `json:"playerAugment2"`
+	PlayerAugment3 int `json:"playerAugment3"`
+	PlayerAugment4 int `json:"playerAugment4"`
+	// Team of the participant in Arena.
+	PlayerSubteamID int `json:"playerSubteamId"`
+	// Statistics of the challenges system by challenge name, e.g. kda or soloKills.
+	Challenges map[string]json.RawMessage `json:"challenges"`
 }
 
 // GetItem0 returns the item in slot 0
@@ -553,14 +575,14 @@ func (m *MatchFilter) GetQueryParams() string {
 
 // MatchReference contains information about a game by a single summoner
 type MatchReference struct {
-	Lane       string `json:"lane"`
-	GameID     int    `json:"gameId"`
-	Champion   int    `json:"champion"`
-	PlatformID string `json:"platformId"`
-	Season     int    `json:"season"`
-	Queue      int    `json:"queue"`
-	Role       string `json:"role"`
-	Timestamp  int    `json:"timestamp"`
+	Lane       string    `json:"lane"`
+	GameID     int       `json:"gameId"`
+	Champion   int       `json:"champion"`
+	PlatformID string    `json:"platformId"`
+	Season     int       `json:"season"`
+	Queue      int       `json:"queue"`
+	Role       string    `json:"role"`
+	Timestamp  Timestamp `json:"timestamp"`
 }
 
 // GetChampion returns the champion played in this match
@@ -585,13 +607,15 @@ func (r *MatchReference) GetGame(client *Client) (*Match, error) {
 
 // MatchTimeline contains timeline frames for a match
 type MatchTimeline struct {
-	Frames   []*MatchFrame `json:"frames"`
-	Interval int           `json:"interval"`
+	Frames []*MatchFrame `json:"frames"`
+	// Interval between two frames, sent in milliseconds
+	Interval DurationMillis `json:"frameInterval"`
 }
 
 // MatchFrame is a single frame in the timeline of a game
 type MatchFrame struct {
-	Timestamp         int                          `json:"timestamp"`
+	// Time since the start of the game, sent in milliseconds
+	Timestamp         DurationMillis               `json:"timestamp"`
 	ParticipantFrames map[string]*ParticipantFrame `json:"participantFrames"`
 	Events            []*MatchEvent                `json:"events"`
 }
@@ -612,29 +636,30 @@ type ParticipantFrame struct {
 
 // MatchEvent is an event in a match at a certain timestamp
 type MatchEvent struct {
-	EventType               string          `json:"eventType"`
-	TowerType               string          `json:"towerType"`
-	TeamID                  int             `json:"teamId"`
-	AscendedType            string          `json:"ascendedType"`
-	KillerID                int             `json:"killerId"`
-	LevelUpType             string          `json:"levelUpType"`
-	PointCaptured           string          `json:"pointCaptured"`
-	AssistingParticipantIDs []int           `json:"assistingParticipantIds"`
-	WardType                string          `json:"wardType"`
-	MonsterType             string          `json:"monsterType"`
-	Type                    *MatchEventType `json:"type"`
-	SkillSlot               int             `json:"skillSlot"`
-	VictimID                int             `json:"victimId"`
-	Timestamp               int             `json:"timestamp"`
-	AfterID                 int             `json:"afterId"`
-	MonsterSubType          string          `json:"monsterSubType"`
-	LaneType                string          `json:"laneType"`
-	ItemID                  int             `json:"itemId"`
-	ParticipantID           int             `json:"participantId"`
-	BuildingType            string          `json:"buildingType"`
-	CreatorID               int             `json:"creatorId"`
-	Position                *MatchPosition  `json:"position"`
-	BeforeID                int             `json:"beforeId"`
+	EventType               string         `json:"eventType"`
+	TowerType               string         `json:"towerType"`
+	TeamID                  int            `json:"teamId"`
+	AscendedType            string         `json:"ascendedType"`
+	KillerID                int            `json:"killerId"`
+	LevelUpType             string         `json:"levelUpType"`
+	PointCaptured           string         `json:"pointCaptured"`
+	AssistingParticipantIDs []int          `json:"assistingParticipantIds"`
+	WardType                string         `json:"wardType"`
+	MonsterType             string         `json:"monsterType"`
+	Type                    MatchEventType `json:"type"`
+	SkillSlot               int            `json:"skillSlot"`
+	VictimID                int            `json:"victimId"`
+	// Time since the start of the game, sent in milliseconds
+	Timestamp      DurationMillis `json:"timestamp"`
+	AfterID        int            `json:"afterId"`
+	MonsterSubType string         `json:"monsterSubType"`
+	LaneType       string         `json:"laneType"`
+	ItemID         int            `json:"itemId"`
+	ParticipantID  int            `json:"participantId"`
+	BuildingType   string         `json:"buildingType"`
+	CreatorID      int            `json:"creatorId"`
+	Position       *MatchPosition `json:"position"`
+	BeforeID       int            `json:"beforeId"`
 }
 
 // GetItem returns the item for this event
@@ -650,17 +675,18 @@ type MatchPosition struct {
 
 // GameInfo contains information about an ongoing game
 type GameInfo struct {
-	GameID            int                       `json:"gameId"`
-	GameStartTime     int                       `json:"gameStartTime"`
-	PlatformID        string                    `json:"platformId"`
-	GameMode          string                    `json:"gameMode"`
-	MapID             int                       `json:"mapId"`
-	GameType          string                    `json:"gameType"`
-	BannedChampions   []*BannedChampion         `json:"bannedChampions"`
-	Observers         *Observer                 `json:"observers"`
-	Participants      []*CurrentGameParticipant `json:"participants"`
-	GameLength        int                       `json:"gameLength"`
-	GameQueueConfigID int                       `json:"gameQueueConfigId"`
+	GameID          int                       `json:"gameId"`
+	GameStartTime   Timestamp                 `json:"gameStartTime"`
+	PlatformID      string                    `json:"platformId"`
+	GameMode        string                    `json:"gameMode"`
+	MapID           int                       `json:"mapId"`
+	GameType        string                    `json:"gameType"`
+	BannedChampions []*BannedChampion         `json:"bannedChampions"`
+	Observers       *Observer                 `json:"observers"`
+	Participants    []*CurrentGameParticipant `json:"participants"`
+	// Time since the start of the game, sent in seconds
+	GameLength        DurationSeconds `json:"gameLength"`
+	GameQueueConfigID int             `json:"gameQueueConfigId"`
 }
 
 // GetMatch returns information about the finished match
@@ -706,12 +732,12 @@ func (p *CurrentGameParticipant) GetChampion(client *datadragon.Client) (datadra
 
 // GetSpell1 returns the first summoner spell of this participant
 func (p *CurrentGameParticipant) GetSpell1(client *datadragon.Client) (datadragon.SummonerSpell, error) {
-	return client.GetSummonerSpell(strconv.Itoa(p.Spell1ID))
+	return client.GetSummonerSpell(SummonerSpell(p.Spell1ID).dataDragonID())
 }
 
 // GetSpell2 returns the second summoner spell of this participant
 func (p *CurrentGameParticipant) GetSpell2(client *datadragon.Client) (datadragon.SummonerSpell, error) {
-	return client.GetSummonerSpell(strconv.Itoa(p.Spell2ID))
+	return client.GetSummonerSpell(SummonerSpell(p.Spell2ID).dataDragonID())
 }
 
 // GameCustomizationObject contains information specific to an ongoing game
@@ -723,14 +749,15 @@ type GameCustomizationObject struct {
 // Perks represents the runes for a player in an ongoing game
 type Perks struct {
 	PerkStyle    int   `json:"perkStyle"`
-	PerksIDs     []int `json:"perksIDs"`
+	PerksIDs     []int `json:"perkIds"`
 	PerkSubStyle int   `json:"perkSubStyle"`
 }
 
 // FeaturedGames represents a list of featured games
 type FeaturedGames struct {
-	ClientRefreshInterval int         `json:"clientRefreshInterval"`
-	GameList              []*GameInfo `json:"gameList"`
+	// Suggested interval to refresh the featured games, sent in seconds
+	ClientRefreshInterval DurationSeconds `json:"clientRefreshInterval"`
+	GameList              []*GameInfo     `json:"gameList"`
 }
 
 // Status contains information about all services in a certain region
@@ -777,15 +804,61 @@ type StatusTranslation struct {
 	UpdatedAt string `json:"updated_at"`
 }
 
+// PlatformData contains the maintenances and incidents of a platform as returned by version 4 of the status API
+type PlatformData struct {
+	ID           string         `json:"id"`
+	Name         string         `json:"name"`
+	Locales      []string       `json:"locales"`
+	Maintenances []*StatusEntry `json:"maintenances"`
+	Incidents    []*StatusEntry `json:"incidents"`
+}
+
+// StatusEntry is a maintenance or an incident of a platform
+type StatusEntry struct {
+	ID                int              `json:"id"`
+	MaintenanceStatus string           `json:"maintenance_status"`
+	IncidentSeverity  string           `json:"incident_severity"`
+	Titles            []*StatusContent `json:"titles"`
+	Updates           []*StatusUpdate  `json:"updates"`
+	CreatedAt         string           `json:"created_at"`
+	ArchiveAt         string           `json:"archive_at"`
+	UpdatedAt         string           `json:"updated_at"`
+	Platforms         []string         `json:"platforms"`
+}
+
+// StatusUpdate is an update of a maintenance or an incident
+type StatusUpdate struct {
+	ID               int              `json:"id"`
+	Author           string           `json:"author"`
+	Publish          bool             `json:"publish"`
+	PublishLocations []string         `json:"publish_locations"`
+	Translations     []*StatusContent `json:"translations"`
+	CreatedAt        string           `json:"created_at"`
+	UpdatedAt        string           `json:"updated_at"`
+}
+
+// StatusContent is a text of a maintenance or an incident in a certain language
+type StatusContent struct {
+	Locale  string `json:"locale"`
+	Content string `json:"content"`
+}
+
 // Summoner represents a summoner with several related IDs
 type Summoner struct {
-	ProfileIconID int    `json:"profileIconId"`
-	Name          string `json:"name"`
-	PUUID         string `json:"puuid"`
-	SummonerLevel int    `json:"summonerLevel"`
-	RevisionDate  int    `json:"revisionDate"`
-	ID            string `json:"id"`
-	AccountID     string `json:"accountId"`
+	ProfileIconID int       `json:"profileIconId"`
+	Name          string    `json:"name"`
+	PUUID         string    `json:"puuid"`
+	SummonerLevel int       `json:"summonerLevel"`
+	RevisionDate  Timestamp `json:"revisionDate"`
+	ID            string    `json:"id"`
+	AccountID     string    `json:"accountId"`
+}
+
+// Account represents a Riot account, which is shared by all games of Riot
+type Account struct {
+	PUUID    string `json:"puuid"`
+	GameName string `json:"gameName"`
+	TagLine  string `json:"tagLine"`
 }
 
 // LobbyEventList is a wrapper for a list of lobby events in a tournament
@@ -800,6 +873,13 @@ type LobbyEvent struct {
 	Timestamp  string `json:"timestamp"`
 }
 
+// Time returns the time of the event or the zero Timestamp if the timestamp sent by the API is invalid
+func (e *LobbyEvent) Time() Timestamp {
+	var t Timestamp
+	_ = t.UnmarshalJSON([]byte(e.Timestamp))
+	return t
+}
+
 // Tournament contains the settings of a previously created tournament
 type Tournament struct {
 	Map          string   `json:"map"`
@@ -828,12 +908,12 @@ type TournamentCodeParameters struct {
 	// Optional list of encrypted summonerIds in order to validate the players eligible to join the lobby.
 	// NOTE: We currently do not enforce participants at the team level, but rather the aggregate of teamOne and
 	// teamTwo. We may add the ability to enforce at the team level in the future.
-	AllowedSummonerIDs []string `json:"allowedSummonerIds"`
+	AllowedSummonerIDs []string `json:"allowedSummonerIds,omitempty"`
 	// The map type of the game. (Legal values: SUMMONERS_RIFT, TWISTED_TREELINE, HOWLING_ABYSS)
 	MapType string `json:"mapType"`
 	// Optional string that may contain any data in any format, if specified at all. Used to denote any custom
 	// information about the game.
-	Metadata string `json:"metadata"`
+	Metadata string `json:"metadata,omitempty"`
 }
 
 // TournamentUpdateParameters parameters needed to update an existing tournament
@@ -844,7 +924,7 @@ type TournamentUpdateParameters struct {
 	PickType string `json:"pickType"`
 	// Optional list of encrypted summonerIds in order to validate the players eligible to join the lobby.
 	// NOTE: Participants are not enforced at the team level, but rather the aggregate of teamOne and teamTwo.
-	AllowedSummonerIDs []string `json:"allowedSummonerIds"`
+	AllowedSummonerIDs []string `json:"allowedSummonerIds,omitempty"`
 	// The map type (Legal values: SUMMONERS_RIFT, TWISTED_TREELINE, HOWLING_ABYSS)
 	MapType string `json:"mapType"`
 }
@@ -854,7 +934,7 @@ type TournamentRegistrationParameters struct {
 	// The provider ID to specify the regional registered provider data to associate this tournament.
 	ProviderID int `json:"providerId"`
 	// The optional name of the tournament.
-	Name string `json:"name"`
+	Name string `json:"name,omitempty"`
 }
 
 // ProviderRegistrationParameters parameters required for registering a provider with tournaments for a region
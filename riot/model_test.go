@@ -1,6 +1,7 @@
 package riot
 
 import (
+	"encoding/json"
 	"testing"
 
 	log "github.com/sirupsen/logrus"
@@ -10,7 +11,7 @@ import (
 	"github.com/mjourard/golio/api"
 	"github.com/mjourard/golio/datadragon"
 	"github.com/mjourard/golio/internal"
-	"github.com/mjourard/golio/internal/mock"
+	"github.com/mjourard/golio/mock"
 	"github.com/mjourard/golio/static"
 )
 
@@ -469,10 +470,10 @@ func TestParticipant_GetSpell1(t *testing.T) {
 		{
 			name: "valid",
 			doer: dataDragonResponseDoer(map[string]datadragon.SummonerSpell{
-				"champion": {ID: "1"},
+				"champion": {ID: "SummonerBoost"},
 			}),
 			model: Participant{Spell1ID: 1},
-			want:  datadragon.SummonerSpell{ID: "1"},
+			want:  datadragon.SummonerSpell{ID: "SummonerBoost"},
 		},
 	}
 	for _, test := range tests {
@@ -777,10 +778,10 @@ func TestCurrentGameParticipant_GetSpell1(t *testing.T) {
 		{
 			name: "valid",
 			doer: dataDragonResponseDoer(map[string]datadragon.SummonerSpell{
-				"champion": {ID: "1"},
+				"champion": {ID: "SummonerBoost"},
 			}),
 			model: CurrentGameParticipant{Spell1ID: 1},
-			want:  datadragon.SummonerSpell{ID: "1"},
+			want:  datadragon.SummonerSpell{ID: "SummonerBoost"},
 		},
 	}
 	for _, test := range tests {
@@ -993,3 +994,49 @@ func dataDragonResponseDoer(object interface{}) internal.Doer {
 		Data: object,
 	}, 200)
 }
+
+func TestRequestBodies_OmitEmpty(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		body interface{}
+		want string
+	}{
+		{
+			name: "code parameters",
+			body: TournamentCodeParameters{SpectatorType: "ALL", TeamSize: 5, PickType: "BLIND_PICK",
+				MapType: "SUMMONERS_RIFT"},
+			want: `{"spectatorType":"ALL","teamSize":5,"pickType":"BLIND_PICK","mapType":"SUMMONERS_RIFT"}`,
+		},
+		{
+			name: "update parameters",
+			body: TournamentUpdateParameters{SpectatorType: "ALL", PickType: "BLIND_PICK", MapType: "SUMMONERS_RIFT"},
+			want: `{"spectatorType":"ALL","pickType":"BLIND_PICK","mapType":"SUMMONERS_RIFT"}`,
+		},
+		{
+			name: "registration parameters",
+			body: TournamentRegistrationParameters{ProviderID: 1},
+			want: `{"providerId":1}`,
+		},
+		{
+			name: "set optional fields",
+			body: TournamentRegistrationParameters{ProviderID: 1, Name: "cup"},
+			want: `{"providerId":1,"name":"cup"}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := json.Marshal(test.body)
+			require.Nil(t, err)
+			assert.JSONEq(t, test.want, string(got))
+		})
+	}
+}
+
+func TestMatchEvent_Type(t *testing.T) {
+	t.Parallel()
+	var event MatchEvent
+	require.Nil(t, json.Unmarshal([]byte(`{"type":"WARD_KILL"}`), &event))
+	assert.Equal(t, MatchEventType(MatchEventTypeWardKill), eventType(&event))
+	assert.Equal(t, MatchEventType(MatchEventTypeItemPurchased), eventType(&MatchEvent{EventType: "ITEM_PURCHASED"}))
+}
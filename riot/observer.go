@@ -0,0 +1,75 @@
+package riot
+
+import (
+	"strings"
+	"time"
+
+	"github.com/mjourard/golio/api"
+)
+
+// RequestStats describes a single request sent to the Riot API. Retried requests are reported once per attempt
+type RequestStats struct {
+	// Method of the request, e.g. GET
+	Method   string
+	Endpoint string
+	// Family is the API of the endpoint, e.g. summoner-v4, see EndpointFamily
+	Family string
+	Region api.Region
+	// StatusCode of the response or 0 if no response was received
+	StatusCode int
+	Duration   time.Duration
+	// Err is the error of the http client, e.g. a timeout. Error responses of the Riot API are no errors here
+	Err error
+}
+
+// RetryStats describes a wait of the client before it sends a request again
+type RetryStats struct {
+	Method   string
+	Endpoint string
+	Family   string
+	Region   api.Region
+	// StatusCode of the response causing the retry, either 429 or 503
+	StatusCode int
+	// Wait is the time the client waits before sending the request again
+	Wait time.Duration
+}
+
+// RequestObserver is notified about every request sent by the client and every retry, e.g. to collect metrics. The methods
+// are called synchronously from the goroutine sending the request, so they must be fast and safe for concurrent use
+type RequestObserver interface {
+	ObserveRequest(RequestStats)
+	ObserveRetry(RetryStats)
+}
+
+// WithRequestObserver adds an observer to the client. Multiple observers are called in the order they were added
+func WithRequestObserver(o RequestObserver) Option {
+	return func(c *Client) {
+		c.observers = append(c.observers, o)
+	}
+}
+
+// EndpointFamily returns the API of the endpoint consisting of its name and version, e.g. summoner-v4 for
+// /lol/summoner/v4/summoners/by-name/name or account-v1 for /riot/account/v1/accounts/by-puuid/puuid. Endpoints
+// without a version return "unknown"
+func EndpointFamily(endpoint string) string {
+	if i := strings.IndexByte(endpoint, '?'); i >= 0 {
+		endpoint = endpoint[:i]
+	}
+	parts := strings.Split(strings.Trim(endpoint, "/"), "/")
+	if len(parts) < 3 || !strings.HasPrefix(parts[2], "v") {
+		return "unknown"
+	}
+	return parts[1] + "-" + parts[2]
+}
+
+func (c *Client) observeRequest(stats RequestStats) {
+	for _, o := range c.observers {
+		o.ObserveRequest(stats)
+	}
+}
+
+func (c *Client) observeRetry(stats RetryStats) {
+	for _, o := range c.observers {
+		o.ObserveRetry(stats)
+	}
+}
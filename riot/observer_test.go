@@ -0,0 +1,84 @@
+package riot
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mjourard/golio/api"
+	"github.com/mjourard/golio/mock"
+)
+
+type recordingObserver struct {
+	mu       sync.Mutex
+	requests []RequestStats
+	retries  []RetryStats
+}
+
+func (o *recordingObserver) ObserveRequest(stats RequestStats) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	stats.Duration = 0
+	o.requests = append(o.requests, stats)
+}
+
+func (o *recordingObserver) ObserveRetry(stats RetryStats) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.retries = append(o.retries, stats)
+}
+
+func TestWithRequestObserver(t *testing.T) {
+	t.Parallel()
+	doer := mock.NewSequenceDoer(mock.Unavailable(1), mock.RateLimited(1, 0), mock.OK(Summoner{Name: "name"}),
+		mock.Status(http.StatusNotFound, 1), mock.Step{Err: errors.New("connection refused")})
+	observer := &recordingObserver{}
+	client := NewClient(api.RegionKorea, "API_KEY", doer, logrus.StandardLogger(), WithRequestObserver(observer),
+		WithBackoff(Backoff{InitialDelay: time.Second, MaxAttempts: 3}))
+	_, err := client.Summoner.GetByName("name")
+	require.Nil(t, err)
+	_, err = client.Summoner.GetByName("name")
+	require.True(t, errors.Is(err, api.ErrNotFound))
+	_, err = client.Summoner.GetByName("name")
+	require.NotNil(t, err)
+
+	endpoint := "/lol/summoner/v4/summoners/by-name/name"
+	request := func(status int, err error) RequestStats {
+		return RequestStats{Method: http.MethodGet, Endpoint: endpoint, Family: "summoner-v4",
+			Region: api.RegionKorea, StatusCode: status, Err: err}
+	}
+	assert.Equal(t, []RequestStats{
+		request(http.StatusServiceUnavailable, nil),
+		request(http.StatusTooManyRequests, nil),
+		request(http.StatusOK, nil),
+		request(http.StatusNotFound, nil),
+		request(0, errors.New("connection refused")),
+	}, observer.requests)
+	assert.Equal(t, []RetryStats{
+		{Method: http.MethodGet, Endpoint: endpoint, Family: "summoner-v4", Region: api.RegionKorea,
+			StatusCode: http.StatusServiceUnavailable, Wait: time.Second},
+		{Method: http.MethodGet, Endpoint: endpoint, Family: "summoner-v4", Region: api.RegionKorea,
+			StatusCode: http.StatusTooManyRequests},
+	}, observer.retries)
+}
+
+func TestEndpointFamily(t *testing.T) {
+	t.Parallel()
+	tests := map[string]string{
+		"/lol/summoner/v4/summoners/by-name/name":              "summoner-v4",
+		"/riot/account/v1/accounts/by-puuid/puuid":             "account-v1",
+		"/lol/tournament-stub/v4/codes?count=1&tournamentId=1": "tournament-stub-v4",
+		"/lol/status/v3/shard-data":                            "status-v3",
+		"/lol/match":                                           "unknown",
+		"/custom/path/without/version":                         "unknown",
+	}
+	for endpoint, want := range tests {
+		assert.Equal(t, want, EndpointFamily(endpoint), endpoint)
+	}
+}
@@ -0,0 +1,66 @@
+package riot
+
+import (
+	"context"
+	"io"
+)
+
+// Pager iterates over the pages of a paginated endpoint. Pages are requested lazily, one page per call to Next.
+// The requests of a page are retried by the client like all other requests, see WithRetryPolicy. A page which failed
+// anyway can be requested again by calling Next.
+// A Pager is not safe for concurrent use.
+type Pager[T any] struct {
+	fetch    func(ctx context.Context, page int) ([]T, error)
+	pageSize int
+	page     int
+	done     bool
+}
+
+// newPager returns a pager requesting pages using fetch, starting with page firstPage. Paging stops once a page
+// contains less than pageSize items or, if pageSize is 0, once an empty page is returned
+func newPager[T any](firstPage, pageSize int, fetch func(ctx context.Context, page int) ([]T, error)) *Pager[T] {
+	return &Pager[T]{
+		fetch:    fetch,
+		pageSize: pageSize,
+		page:     firstPage,
+	}
+}
+
+// Next returns the items of the next page. io.EOF is returned once all pages have been returned.
+// If the page could not be fetched, e.g. because ctx is done, the error is returned and the page can be requested
+// again by calling Next
+func (p *Pager[T]) Next(ctx context.Context) ([]T, error) {
+	if p.done {
+		return nil, io.EOF
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	items, err := p.fetch(ctx, p.page)
+	if err != nil {
+		return nil, err
+	}
+	p.page++
+	if len(items) == 0 || len(items) < p.pageSize {
+		p.done = true
+		if len(items) == 0 {
+			return nil, io.EOF
+		}
+	}
+	return items, nil
+}
+
+// All returns the items of all remaining pages
+func (p *Pager[T]) All(ctx context.Context) ([]T, error) {
+	var res []T
+	for {
+		items, err := p.Next(ctx)
+		if err == io.EOF {
+			return res, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, items...)
+	}
+}
@@ -0,0 +1,154 @@
+package riot
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mjourard/golio/api"
+)
+
+func TestPager_Next(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		firstPage int
+		pageSize  int
+		pages     map[int][]int
+		errors    map[int][]error
+		want      [][]int
+		wantErr   error
+	}{
+		{
+			name:     "short last page",
+			pageSize: 2,
+			pages:    map[int][]int{0: {1, 2}, 1: {3}},
+			want:     [][]int{{1, 2}, {3}},
+		},
+		{
+			name:     "empty last page",
+			pageSize: 2,
+			pages:    map[int][]int{0: {1, 2}, 1: {3, 4}},
+			want:     [][]int{{1, 2}, {3, 4}},
+		},
+		{
+			name:      "no page size",
+			firstPage: 1,
+			pages:     map[int][]int{1: {1}, 2: {2, 3}},
+			want:      [][]int{{1}, {2, 3}},
+		},
+		{
+			name:     "server error",
+			pageSize: 2,
+			pages:    map[int][]int{0: {1}},
+			errors:   map[int][]error{0: {api.ErrBadGateway}},
+			wantErr:  api.ErrBadGateway,
+		},
+		{
+			name:     "no retry for client errors",
+			pageSize: 2,
+			pages:    map[int][]int{0: {1}},
+			errors:   map[int][]error{0: {api.ErrNotFound}},
+			wantErr:  api.ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pager := newPager(tt.firstPage, tt.pageSize, func(ctx context.Context, page int) ([]int, error) {
+				if errs := tt.errors[page]; len(errs) > 0 {
+					tt.errors[page] = errs[1:]
+					return nil, errs[0]
+				}
+				return tt.pages[page], nil
+			})
+			var got [][]int
+			for {
+				items, err := pager.Next(context.Background())
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					require.Equal(t, tt.wantErr, err)
+					return
+				}
+				got = append(got, items)
+			}
+			assert.Nil(t, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+			_, err := pager.Next(context.Background())
+			assert.Equal(t, io.EOF, err)
+		})
+	}
+}
+
+func TestPager_NextCancelled(t *testing.T) {
+	t.Parallel()
+	calls := 0
+	pager := newPager(0, 1, func(ctx context.Context, page int) ([]int, error) {
+		calls++
+		return nil, api.ErrServiceUnavailable
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := pager.Next(ctx)
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, 0, calls)
+}
+
+func TestPager_NextAgain(t *testing.T) {
+	t.Parallel()
+	var pages []int
+	pager := newPager(0, 1, func(ctx context.Context, page int) ([]int, error) {
+		pages = append(pages, page)
+		if len(pages) == 1 {
+			return nil, api.ErrServiceUnavailable
+		}
+		return []int{page}, nil
+	})
+	// the pager does not retry on its own, the client does
+	_, err := pager.Next(context.Background())
+	assert.Equal(t, api.ErrServiceUnavailable, err)
+	items, err := pager.Next(context.Background())
+	require.Nil(t, err)
+	assert.Equal(t, []int{0}, items)
+	assert.Equal(t, []int{0, 0}, pages)
+}
+
+func TestPager_All(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		fetch   func(ctx context.Context, page int) ([]int, error)
+		want    []int
+		wantErr error
+	}{
+		{
+			name: "all pages",
+			fetch: func(ctx context.Context, page int) ([]int, error) {
+				if page > 2 {
+					return nil, nil
+				}
+				return []int{page}, nil
+			},
+			want: []int{0, 1, 2},
+		},
+		{
+			name: "error",
+			fetch: func(ctx context.Context, page int) ([]int, error) {
+				return nil, fmt.Errorf("error")
+			},
+			wantErr: fmt.Errorf("error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := newPager(0, 0, tt.fetch).All(context.Background())
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package riot
+
+import (
+	"context"
+)
+
+// Priority of a request. When the rate limits are nearly exhausted, requests with PriorityBulk are deferred so that
+// requests with PriorityInteractive can still be sent, see WithBulkReserve
+type Priority int
+
+// All priorities of requests
+const (
+	// PriorityInteractive is the priority of user-facing requests and the default of all requests
+	PriorityInteractive Priority = iota
+	// PriorityBulk is the priority of background requests, e.g. of crawls or history backfills
+	PriorityBulk
+)
+
+func (p Priority) String() string {
+	if p == PriorityBulk {
+		return "bulk"
+	}
+	return "interactive"
+}
+
+type priorityKey struct{}
+
+// WithPriority returns a context tagging the requests sent with it with the priority, e.g.
+//
+//	crawler := client.WithContext(riot.WithPriority(ctx, riot.PriorityBulk))
+func WithPriority(ctx context.Context, priority Priority) context.Context {
+	return context.WithValue(ctx, priorityKey{}, priority)
+}
+
+// RequestPriority returns the priority set by WithPriority or PriorityInteractive
+func RequestPriority(ctx context.Context) Priority {
+	priority, _ := ctx.Value(priorityKey{}).(Priority)
+	return priority
+}
+
+// WithBulkReserve enables the rate limiter, see WithRateLimiter, and reserves the fraction of the requests of every
+// rate limit window for requests with PriorityInteractive, e.g. 0.2 defers requests with PriorityBulk once 80% of a
+// window are used until the window resets, while user-facing requests proceed
+func WithBulkReserve(fraction float64) Option {
+	return func(c *Client) {
+		c.rateLimiter = true
+		c.bulkReserve = fraction
+	}
+}
@@ -0,0 +1,85 @@
+package riot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mjourard/golio/api"
+	"github.com/mjourard/golio/mock"
+)
+
+func TestRequestPriority(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, PriorityInteractive, RequestPriority(context.Background()))
+	ctx := WithPriority(context.Background(), PriorityBulk)
+	assert.Equal(t, PriorityBulk, RequestPriority(ctx))
+	assert.Equal(t, "bulk", PriorityBulk.String())
+	assert.Equal(t, "interactive", PriorityInteractive.String())
+}
+
+func TestRateLimitTracker_reserveBulk(t *testing.T) {
+	t.Parallel()
+	tracker := &rateLimitTracker{}
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	tracker.update("match-v5", http.Header{
+		headerAppRateLimit:      {"10:10"},
+		headerAppRateLimitCount: {"1:10"},
+	}, start)
+	for i := 0; i < 7; i++ {
+		assert.Equal(t, time.Duration(0), tracker.reserve("match-v5", 0.2, start))
+	}
+	assert.Equal(t, 10*time.Second, tracker.reserve("match-v5", 0.2, start))
+	assert.Equal(t, time.Duration(0), tracker.reserve("summoner-v4", 0, start))
+	assert.Equal(t, time.Duration(0), tracker.reserve("summoner-v4", 0, start))
+	assert.Equal(t, 10*time.Second, tracker.reserve("summoner-v4", 0, start))
+
+	// at least one token of a window is never reserved
+	tracker = &rateLimitTracker{}
+	tracker.update("match-v5", http.Header{headerAppRateLimit: {"1:10"}, headerAppRateLimitCount: {"0:10"}}, start)
+	assert.Equal(t, time.Duration(0), tracker.reserve("match-v5", 1, start))
+}
+
+func TestWithBulkReserve(t *testing.T) {
+	t.Parallel()
+	var mu sync.Mutex
+	calls := 0
+	doer := &mock.Doer{Custom: func(*http.Request) (*http.Response, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		calls++
+		header := http.Header{}
+		header.Set(headerAppRateLimit, "5:10")
+		header.Set(headerAppRateLimitCount, fmt.Sprintf("%d:10", calls))
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     header,
+			Body:       io.NopCloser(strings.NewReader(`{"id":"id"}`)),
+		}, nil
+	}}
+	c := NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger(), WithBulkReserve(0.4))
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	bulk := c.WithContext(WithPriority(ctx, PriorityBulk))
+	for i := 0; i < 3; i++ {
+		_, err := bulk.Summoner.GetByID("id")
+		require.Nil(t, err)
+	}
+	_, err := bulk.Summoner.GetByID("id")
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	for i := 0; i < 2; i++ {
+		_, err := c.Summoner.GetByID("id")
+		require.Nil(t, err)
+	}
+	assert.Equal(t, 5, calls)
+}
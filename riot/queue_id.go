@@ -0,0 +1,176 @@
+package riot
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// QueueID is the ID of the queue a game was played in, as used in matches, match lists and current games
+type QueueID int
+
+// All current queue IDs. The constants are typed individually so that their methods can be called directly,
+// e.g. QueueIDRankedSolo.String()
+const (
+	QueueIDCustom                  QueueID = 0
+	QueueIDDraftPick               QueueID = 400
+	QueueIDRankedSolo              QueueID = 420
+	QueueIDBlindPick               QueueID = 430
+	QueueIDRankedFlex              QueueID = 440
+	QueueIDARAM                    QueueID = 450
+	QueueIDTwistedTreelineBlind    QueueID = 460
+	QueueIDTwistedTreelineRanked   QueueID = 470
+	QueueIDBloodHunt               QueueID = 600
+	QueueIDDarkStar                QueueID = 610
+	QueueIDClash                   QueueID = 700
+	QueueIDTwistedTreelineCoopAI   QueueID = 800
+	QueueIDCoopAIIntro             QueueID = 830
+	QueueIDCoopAIBeginner          QueueID = 840
+	QueueIDCoopAIIntermediate      QueueID = 850
+	QueueIDURF                     QueueID = 900
+	QueueIDAscension               QueueID = 910
+	QueueIDPoroKing                QueueID = 920
+	QueueIDNexusSiege              QueueID = 940
+	QueueIDDoomBotsVoting          QueueID = 950
+	QueueIDDoomBots                QueueID = 960
+	QueueIDStarGuardianNormal      QueueID = 980
+	QueueIDStarGuardianOnslaught   QueueID = 990
+	QueueIDProjectHunters          QueueID = 1000
+	QueueIDSnowURF                 QueueID = 1010
+	QueueIDOneForAll               QueueID = 1020
+	QueueIDOdysseyIntro            QueueID = 1030
+	QueueIDOdysseyCadet            QueueID = 1040
+	QueueIDOdysseyCrewmember       QueueID = 1050
+	QueueIDOdysseyCaptain          QueueID = 1060
+	QueueIDOdysseyOnslaught        QueueID = 1070
+	QueueIDTeamfightTactics        QueueID = 1090
+	QueueIDRankedTeamfightTactics  QueueID = 1100
+	QueueIDTeamfightTacticsTutor   QueueID = 1110
+	QueueIDTeamfightTacticsTesting QueueID = 1111
+	QueueIDNexusBlitz              QueueID = 1300
+	QueueIDTutorial1               QueueID = 2000
+	QueueIDTutorial2               QueueID = 2010
+	QueueIDTutorial3               QueueID = 2020
+)
+
+const (
+	mapSummonersRift   = "Summoner's Rift"
+	mapHowlingAbyss    = "Howling Abyss"
+	mapTwistedTreeline = "Twisted Treeline"
+	mapConvergence     = "Convergence"
+)
+
+type queueInfo struct {
+	name    string
+	mapName string
+	ranked  bool
+}
+
+var queueInfos = map[QueueID]queueInfo{
+	QueueIDCustom:                  {"Custom", "", false},
+	QueueIDDraftPick:               {"Draft Pick", mapSummonersRift, false},
+	QueueIDRankedSolo:              {"Ranked Solo/Duo", mapSummonersRift, true},
+	QueueIDBlindPick:               {"Blind Pick", mapSummonersRift, false},
+	QueueIDRankedFlex:              {"Ranked Flex", mapSummonersRift, true},
+	QueueIDARAM:                    {"ARAM", mapHowlingAbyss, false},
+	QueueIDTwistedTreelineBlind:    {"Twisted Treeline Blind Pick", mapTwistedTreeline, false},
+	QueueIDTwistedTreelineRanked:   {"Twisted Treeline Ranked Flex", mapTwistedTreeline, true},
+	QueueIDBloodHunt:               {"Blood Hunt Assassin", mapSummonersRift, false},
+	QueueIDDarkStar:                {"Dark Star: Singularity", "Cosmic Ruins", false},
+	QueueIDClash:                   {"Clash", mapSummonersRift, false},
+	QueueIDTwistedTreelineCoopAI:   {"Twisted Treeline Co-op vs. AI", mapTwistedTreeline, false},
+	QueueIDCoopAIIntro:             {"Co-op vs. AI Intro", mapSummonersRift, false},
+	QueueIDCoopAIBeginner:          {"Co-op vs. AI Beginner", mapSummonersRift, false},
+	QueueIDCoopAIIntermediate:      {"Co-op vs. AI Intermediate", mapSummonersRift, false},
+	QueueIDURF:                     {"ARURF", mapSummonersRift, false},
+	QueueIDAscension:               {"Ascension", "Crystal Scar", false},
+	QueueIDPoroKing:                {"Legend of the Poro King", mapHowlingAbyss, false},
+	QueueIDNexusSiege:              {"Nexus Siege", mapSummonersRift, false},
+	QueueIDDoomBotsVoting:          {"Doom Bots Voting", mapSummonersRift, false},
+	QueueIDDoomBots:                {"Doom Bots Standard", mapSummonersRift, false},
+	QueueIDStarGuardianNormal:      {"Star Guardian Invasion: Normal", "Valoran City Park", false},
+	QueueIDStarGuardianOnslaught:   {"Star Guardian Invasion: Onslaught", "Valoran City Park", false},
+	QueueIDProjectHunters:          {"PROJECT: Hunters", "Overcharge", false},
+	QueueIDSnowURF:                 {"Snow ARURF", mapSummonersRift, false},
+	QueueIDOneForAll:               {"One for All", mapSummonersRift, false},
+	QueueIDOdysseyIntro:            {"Odyssey Extraction: Intro", "Crash Site", false},
+	QueueIDOdysseyCadet:            {"Odyssey Extraction: Cadet", "Crash Site", false},
+	QueueIDOdysseyCrewmember:       {"Odyssey Extraction: Crewmember", "Crash Site", false},
+	QueueIDOdysseyCaptain:          {"Odyssey Extraction: Captain", "Crash Site", false},
+	QueueIDOdysseyOnslaught:        {"Odyssey Extraction: Onslaught", "Crash Site", false},
+	QueueIDTeamfightTactics:        {"Teamfight Tactics", mapConvergence, false},
+	QueueIDRankedTeamfightTactics:  {"Ranked Teamfight Tactics", mapConvergence, true},
+	QueueIDTeamfightTacticsTutor:   {"Teamfight Tactics Tutorial", mapConvergence, false},
+	QueueIDTeamfightTacticsTesting: {"Teamfight Tactics Test", mapConvergence, false},
+	QueueIDNexusBlitz:              {"Nexus Blitz", "Nexus Blitz", false},
+	QueueIDTutorial1:               {"Tutorial 1", mapSummonersRift, false},
+	QueueIDTutorial2:               {"Tutorial 2", mapSummonersRift, false},
+	QueueIDTutorial3:               {"Tutorial 3", mapSummonersRift, false},
+}
+
+// QueueIDs is a list of all known queue IDs in ascending order
+var QueueIDs = []QueueID{
+	QueueIDCustom, QueueIDDraftPick, QueueIDRankedSolo, QueueIDBlindPick, QueueIDRankedFlex, QueueIDARAM,
+	QueueIDTwistedTreelineBlind, QueueIDTwistedTreelineRanked, QueueIDBloodHunt, QueueIDDarkStar, QueueIDClash,
+	QueueIDTwistedTreelineCoopAI, QueueIDCoopAIIntro, QueueIDCoopAIBeginner, QueueIDCoopAIIntermediate, QueueIDURF,
+	QueueIDAscension, QueueIDPoroKing, QueueIDNexusSiege, QueueIDDoomBotsVoting, QueueIDDoomBots,
+	QueueIDStarGuardianNormal, QueueIDStarGuardianOnslaught, QueueIDProjectHunters, QueueIDSnowURF,
+	QueueIDOneForAll, QueueIDOdysseyIntro, QueueIDOdysseyCadet, QueueIDOdysseyCrewmember, QueueIDOdysseyCaptain,
+	QueueIDOdysseyOnslaught, QueueIDTeamfightTactics, QueueIDRankedTeamfightTactics, QueueIDTeamfightTacticsTutor,
+	QueueIDTeamfightTacticsTesting, QueueIDNexusBlitz, QueueIDTutorial1, QueueIDTutorial2, QueueIDTutorial3,
+}
+
+// String returns the name of the queue as shown in the client, e.g. "Ranked Solo/Duo", or "Queue <id>" for unknown
+// queues
+func (q QueueID) String() string {
+	if info, ok := queueInfos[q]; ok {
+		return info.name
+	}
+	return "Queue " + strconv.Itoa(int(q))
+}
+
+// IsKnown returns whether the queue is one of the known queues in QueueIDs
+func (q QueueID) IsKnown() bool {
+	_, ok := queueInfos[q]
+	return ok
+}
+
+// IsRanked returns whether games in the queue affect the ranking of the players
+func (q QueueID) IsRanked() bool {
+	return queueInfos[q].ranked
+}
+
+// Map returns the name of the map the queue is played on, e.g. "Summoner's Rift", or an empty string for custom
+// games and unknown queues
+func (q QueueID) Map() string {
+	return queueInfos[q].mapName
+}
+
+// Queue returns the ranked queue of the league API matching the queue ID. The second return value is false for
+// queues without a league
+func (q QueueID) Queue() (Queue, bool) {
+	switch q {
+	case QueueIDRankedSolo:
+		return QueueRankedSolo, true
+	case QueueIDRankedFlex:
+		return QueueRankedFlex, true
+	case QueueIDTwistedTreelineRanked:
+		return QueueRankedTwistedTreeline, true
+	}
+	return "", false
+}
+
+// ParseQueueID returns the queue ID for either the numeric ID or the name of a known queue, ignoring case,
+// e.g. "420" or "ranked solo/duo"
+func ParseQueueID(s string) (QueueID, error) {
+	s = strings.TrimSpace(s)
+	if id, err := strconv.Atoi(s); err == nil {
+		return QueueID(id), nil
+	}
+	for id, info := range queueInfos {
+		if strings.EqualFold(info.name, s) {
+			return id, nil
+		}
+	}
+	return 0, fmt.Errorf("riot: unknown queue %q", s)
+}
@@ -0,0 +1,109 @@
+package riot
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueueID(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		id        QueueID
+		want      string
+		wantMap   string
+		ranked    bool
+		wantQueue Queue
+	}{
+		{
+			name:      "ranked solo",
+			id:        QueueIDRankedSolo,
+			want:      "Ranked Solo/Duo",
+			wantMap:   "Summoner's Rift",
+			ranked:    true,
+			wantQueue: QueueRankedSolo,
+		},
+		{
+			name:      "ranked flex",
+			id:        440,
+			want:      "Ranked Flex",
+			wantMap:   "Summoner's Rift",
+			ranked:    true,
+			wantQueue: QueueRankedFlex,
+		},
+		{
+			name:    "aram",
+			id:      450,
+			want:    "ARAM",
+			wantMap: "Howling Abyss",
+		},
+		{
+			name: "custom",
+			id:   QueueIDCustom,
+			want: "Custom",
+		},
+		{
+			name: "unknown",
+			id:   12345,
+			want: "Queue 12345",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.id.String())
+			assert.Equal(t, tt.wantMap, tt.id.Map())
+			assert.Equal(t, tt.ranked, tt.id.IsRanked())
+			queue, ok := tt.id.Queue()
+			assert.Equal(t, tt.wantQueue, queue)
+			assert.Equal(t, tt.wantQueue != "", ok)
+		})
+	}
+}
+
+func TestQueueIDs(t *testing.T) {
+	t.Parallel()
+	assert.Len(t, QueueIDs, len(queueInfos))
+	assert.True(t, sort.SliceIsSorted(QueueIDs, func(i, j int) bool { return QueueIDs[i] < QueueIDs[j] }))
+	names := map[string]bool{}
+	for _, id := range QueueIDs {
+		assert.True(t, id.IsKnown())
+		assert.False(t, names[id.String()], "duplicate name %s", id)
+		names[id.String()] = true
+	}
+	assert.False(t, QueueID(-1).IsKnown())
+}
+
+func TestParseQueueID(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		s       string
+		want    QueueID
+		wantErr bool
+	}{
+		{name: "id", s: "420", want: QueueIDRankedSolo},
+		{name: "unknown id", s: "12345", want: 12345},
+		{name: "name", s: "Ranked Solo/Duo", want: QueueIDRankedSolo},
+		{name: "name ignoring case", s: " aram ", want: QueueIDARAM},
+		{name: "unknown name", s: "Ranked Duo", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseQueueID(tt.s)
+			if tt.wantErr {
+				require.NotNil(t, err)
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+	for _, id := range QueueIDs {
+		got, err := ParseQueueID(id.String())
+		require.Nil(t, err)
+		assert.Equal(t, id, got)
+	}
+}
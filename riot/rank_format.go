@@ -0,0 +1,63 @@
+package riot
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// RankFormat contains the words used by Format, so that ranks can be formatted in other languages
+type RankFormat struct {
+	// TierName returns the name of the tier, e.g. "Gold" for TierGold
+	TierName func(Tier) string
+	// Unranked is used instead of the rank for entries without a tier
+	Unranked string
+	// LeaguePoints is the unit of the league points, e.g. "LP"
+	LeaguePoints string
+	// Wins and Losses are the abbreviations of won and lost games, e.g. "W" and "L"
+	Wins   string
+	Losses string
+}
+
+// DefaultRankFormat is the English RankFormat used by FormatRank
+var DefaultRankFormat = RankFormat{
+	TierName:     englishTierName,
+	Unranked:     "Unranked",
+	LeaguePoints: "LP",
+	Wins:         "W",
+	Losses:       "L",
+}
+
+// englishTierName returns the name of the tier in title case, e.g. "Grandmaster"
+func englishTierName(t Tier) string {
+	name := strings.ToLower(string(t))
+	if name == "" {
+		return ""
+	}
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+// FormatRank returns the rank of the entry using DefaultRankFormat, e.g. "Gold IV · 56 LP (W 120 / L 110, 52%)"
+func FormatRank(entry *LeagueItem) string {
+	return DefaultRankFormat.Format(entry)
+}
+
+// Format returns the tier, the division, the league points and the games of the entry, e.g.
+// "Gold IV · 56 LP (W 120 / L 110, 52%)". The division is omitted for the apex tiers, the games are omitted if no
+// game was played
+func (f RankFormat) Format(entry *LeagueItem) string {
+	if entry == nil || entry.Tier == "" {
+		return f.Unranked
+	}
+	tier := Tier(entry.Tier)
+	rank := f.TierName(tier)
+	if !tier.IsApex() && entry.Rank != "" {
+		rank += " " + entry.Rank
+	}
+	rank += fmt.Sprintf(" · %d %s", entry.LeaguePoints, f.LeaguePoints)
+	if entry.TotalGames() > 0 {
+		rank += fmt.Sprintf(" (%s %d / %s %d, %d%%)", f.Wins, entry.Wins, f.Losses, entry.Losses,
+			int(math.Round(entry.WinRate()*100)))
+	}
+	return rank
+}
@@ -0,0 +1,65 @@
+package riot
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatRank(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name  string
+		entry *LeagueItem
+		want  string
+	}{
+		{
+			name:  "division",
+			entry: &LeagueItem{Tier: TierGold, Rank: "IV", LeaguePoints: 56, Wins: 120, Losses: 110},
+			want:  "Gold IV · 56 LP (W 120 / L 110, 52%)",
+		},
+		{
+			name:  "apex tier",
+			entry: &LeagueItem{Tier: TierGrandmaster, Rank: "I", LeaguePoints: 412, Wins: 1, Losses: 2},
+			want:  "Grandmaster · 412 LP (W 1 / L 2, 33%)",
+		},
+		{
+			name:  "no games",
+			entry: &LeagueItem{Tier: string(TierIron), Rank: "II"},
+			want:  "Iron II · 0 LP",
+		},
+		{
+			name:  "unranked",
+			entry: &LeagueItem{},
+			want:  "Unranked",
+		},
+		{
+			name: "nil",
+			want: "Unranked",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, FormatRank(test.entry))
+		})
+	}
+}
+
+func TestRankFormat_Format(t *testing.T) {
+	t.Parallel()
+	german := RankFormat{
+		TierName: func(tier Tier) string {
+			if tier == TierGold {
+				return "Gold"
+			}
+			return "Eisen"
+		},
+		Unranked:     "Nicht gewertet",
+		LeaguePoints: "LP",
+		Wins:         "S",
+		Losses:       "N",
+	}
+	assert.Equal(t, "Eisen I · 99 LP (S 3 / N 1, 75%)",
+		german.Format(&LeagueItem{Tier: string(TierIron), Rank: "I", LeaguePoints: 99, Wins: 3, Losses: 1}))
+	assert.Equal(t, "Nicht gewertet", german.Format(nil))
+}
@@ -0,0 +1,248 @@
+package riot
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mjourard/golio/api"
+)
+
+// Headers of the Riot API describing the rate limits. A limit header contains comma separated pairs of the number of
+// allowed requests and the length of the window in seconds, e.g. "20:1,100:120". The count header has the same
+// format with the number of requests made in the current windows. The type header of a response with status 429
+// names the exceeded limit, i.e. application, method or service
+const (
+	headerAppRateLimit         = "X-App-Rate-Limit"
+	headerAppRateLimitCount    = "X-App-Rate-Limit-Count"
+	headerMethodRateLimit      = "X-Method-Rate-Limit"
+	headerMethodRateLimitCount = "X-Method-Rate-Limit-Count"
+	headerRateLimitType        = "X-Rate-Limit-Type"
+)
+
+// RateLimitWindow is the state of a single rate limit window as reported by the last response
+type RateLimitWindow struct {
+	// Window is the length of the window, e.g. two minutes
+	Window time.Duration
+	// Limit is the number of requests allowed in the window
+	Limit int
+	// Count is the number of requests made in the current window
+	Count int
+	// Reset is the estimated time at which the window resets. The Riot API does not send the start of the window, so
+	// it is estimated from the first response of the window seen by the client
+	Reset time.Time
+}
+
+// Remaining returns the number of requests left in the current window
+func (w RateLimitWindow) Remaining() int {
+	if w.Count >= w.Limit {
+		return 0
+	}
+	return w.Limit - w.Count
+}
+
+// RateLimitStatus is a snapshot of the rate limits of the API key as reported by the Riot API
+type RateLimitStatus struct {
+	// App contains the windows of the application rate limit, which applies to all requests
+	App []RateLimitWindow
+	// Methods contains the windows of the method rate limits by endpoint family, e.g. summoner-v4
+	Methods map[string][]RateLimitWindow
+	// ThrottledUntil is the time until which the client waits after the last response with status 429, or the zero
+	// time if it was never rate limited. Responses exceeding a method rate limit only throttle their endpoint family,
+	// see MethodsThrottledUntil
+	ThrottledUntil time.Time
+	// MethodsThrottledUntil contains the times until which the requests of an endpoint family wait after the last
+	// response with status 429 for exceeding the method rate limit of the family
+	MethodsThrottledUntil map[string]time.Time
+}
+
+// Throttled returns whether the client is currently waiting for a rate limit to reset
+func (s RateLimitStatus) Throttled() bool {
+	return time.Now().Before(s.ThrottledUntil)
+}
+
+// Remaining returns the number of requests left for the endpoint family, e.g. summoner-v4, until a window of either
+// the application or the method rate limit is exhausted, or -1 if no rate limit was reported yet
+func (s RateLimitStatus) Remaining(family string) int {
+	remaining := -1
+	for _, windows := range [][]RateLimitWindow{s.App, s.Methods[family]} {
+		for _, w := range windows {
+			if remaining < 0 || w.Remaining() < remaining {
+				remaining = w.Remaining()
+			}
+		}
+	}
+	return remaining
+}
+
+// ReadyAt returns the time at which the next request for the endpoint family can be sent without exceeding a rate
+// limit, i.e. the latest reset of the exhausted windows, ThrottledUntil or the throttling of the family. It returns a
+// time in the past or the zero time if a request can be sent now
+func (s RateLimitStatus) ReadyAt(family string) time.Time {
+	ready := s.ThrottledUntil
+	if until := s.MethodsThrottledUntil[family]; until.After(ready) {
+		ready = until
+	}
+	for _, windows := range [][]RateLimitWindow{s.App, s.Methods[family]} {
+		for _, w := range windows {
+			if w.Remaining() == 0 && w.Reset.After(ready) {
+				ready = w.Reset
+			}
+		}
+	}
+	return ready
+}
+
+// rateLimitTracker keeps the rate limit state of the last responses
+type rateLimitTracker struct {
+	mu             sync.Mutex
+	app            []RateLimitWindow
+	methods        map[string][]RateLimitWindow
+	throttledUntil time.Time
+	// methodsThrottledUntil are the times until which the endpoint families wait after exceeding their method limit
+	methodsThrottledUntil map[string]time.Time
+	// appBuckets and methodBuckets are the buckets of the application and method rate limits, see WithRateLimiter
+	appBuckets    []*rateLimitBucket
+	methodBuckets map[string][]*rateLimitBucket
+}
+
+// regionRateLimits are the rate limit trackers of the regions used by a client and its views
+type regionRateLimits struct {
+	mu       sync.Mutex
+	trackers map[api.Region]*rateLimitTracker
+}
+
+// tracker returns the tracker of the region
+func (r *regionRateLimits) tracker(region api.Region) *rateLimitTracker {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.trackers == nil {
+		r.trackers = map[api.Region]*rateLimitTracker{}
+	}
+	if r.trackers[region] == nil {
+		r.trackers[region] = &rateLimitTracker{}
+	}
+	return r.trackers[region]
+}
+
+// update records the rate limit headers of a response for the endpoint family
+func (t *rateLimitTracker) update(family string, header http.Header, now time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if windows := parseRateLimit(header.Get(headerAppRateLimit), header.Get(headerAppRateLimitCount)); windows != nil {
+		t.app = mergeWindows(t.app, windows, now)
+		t.appBuckets = syncBuckets(t.appBuckets, t.app, now)
+	}
+	windows := parseRateLimit(header.Get(headerMethodRateLimit), header.Get(headerMethodRateLimitCount))
+	if windows != nil {
+		if t.methods == nil {
+			t.methods = map[string][]RateLimitWindow{}
+		}
+		t.methods[family] = mergeWindows(t.methods[family], windows, now)
+		if t.methodBuckets == nil {
+			t.methodBuckets = map[string][]*rateLimitBucket{}
+		}
+		t.methodBuckets[family] = syncBuckets(t.methodBuckets[family], t.methods[family], now)
+	}
+}
+
+// throttle records that the client waits until the given time after a response with status 429 for the endpoint
+// family. If the method rate limit was exceeded, as told by limitType, only the requests of the family wait
+func (t *rateLimitTracker) throttle(family, limitType string, until time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if limitType == api.RateLimitTypeMethod {
+		if t.methodsThrottledUntil == nil {
+			t.methodsThrottledUntil = map[string]time.Time{}
+		}
+		if until.After(t.methodsThrottledUntil[family]) {
+			t.methodsThrottledUntil[family] = until
+		}
+		return
+	}
+	if until.After(t.throttledUntil) {
+		t.throttledUntil = until
+	}
+}
+
+func (t *rateLimitTracker) status() RateLimitStatus {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	status := RateLimitStatus{
+		App:                   append([]RateLimitWindow(nil), t.app...),
+		Methods:               make(map[string][]RateLimitWindow, len(t.methods)),
+		ThrottledUntil:        t.throttledUntil,
+		MethodsThrottledUntil: make(map[string]time.Time, len(t.methodsThrottledUntil)),
+	}
+	for family, windows := range t.methods {
+		status.Methods[family] = append([]RateLimitWindow(nil), windows...)
+	}
+	for family, until := range t.methodsThrottledUntil {
+		status.MethodsThrottledUntil[family] = until
+	}
+	return status
+}
+
+// mergeWindows sets the reset times of the new windows. A window keeps its reset time as long as its count grows and
+// the reset time has not passed, otherwise a new window started with the response
+func mergeWindows(old, windows []RateLimitWindow, now time.Time) []RateLimitWindow {
+	for i := range windows {
+		windows[i].Reset = now.Add(windows[i].Window)
+		for _, o := range old {
+			if o.Window == windows[i].Window && o.Count <= windows[i].Count && now.Before(o.Reset) {
+				windows[i].Reset = o.Reset
+			}
+		}
+	}
+	return windows
+}
+
+// parseRateLimit parses the limit and count headers of a rate limit. It returns nil if the limit header is missing or
+// malformed
+func parseRateLimit(limits, counts string) []RateLimitWindow {
+	if limits == "" {
+		return nil
+	}
+	countsByWindow := map[time.Duration]int{}
+	for _, pair := range strings.Split(counts, ",") {
+		count, window, ok := parseRateLimitPair(pair)
+		if ok {
+			countsByWindow[window] = count
+		}
+	}
+	var windows []RateLimitWindow
+	for _, pair := range strings.Split(limits, ",") {
+		limit, window, ok := parseRateLimitPair(pair)
+		if !ok {
+			return nil
+		}
+		windows = append(windows, RateLimitWindow{Window: window, Limit: limit, Count: countsByWindow[window]})
+	}
+	return windows
+}
+
+// parseRateLimitPair parses a pair like "100:120" into 100 and two minutes
+func parseRateLimitPair(pair string) (int, time.Duration, bool) {
+	parts := strings.Split(strings.TrimSpace(pair), ":")
+	if len(parts) != 2 {
+		return 0, 0, false
+	}
+	value, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, false
+	}
+	seconds, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, 0, false
+	}
+	return value, time.Duration(seconds) * time.Second, true
+}
+
+// RateLimitStatus returns the rate limits of the API key in the region of the client as reported by the last
+// responses, e.g. to plan background work around the remaining quota. The status is empty until the first response
+// was received
+func (c *Client) RateLimitStatus() RateLimitStatus {
+	return c.rateLimits.status()
+}
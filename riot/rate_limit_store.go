@@ -0,0 +1,165 @@
+package riot
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/mjourard/golio/api"
+)
+
+// RateLimit is a limit of requests in a window, e.g. 100 requests in two minutes
+type RateLimit struct {
+	Limit  int
+	Window time.Duration
+}
+
+// RateLimitStore stores request counters shared by all clients using the same API key, e.g. the clients of many
+// short-lived serverless invocations. Implementations must be safe for concurrent use
+type RateLimitStore interface {
+	// Increment atomically increments the counter with the key and returns the new count. The counter is not used
+	// after expires and may be deleted
+	Increment(ctx context.Context, key string, expires time.Time) (int64, error)
+}
+
+// WindowRateLimitStore is a RateLimitStore which starts the windows of the counters itself. A counter is created by
+// the first increment of its window and expires after the window as measured by the clock of the store, so the
+// windows of all clients agree even if their clocks are skewed. It is preferred over Increment if a store implements
+// it
+type WindowRateLimitStore interface {
+	RateLimitStore
+	// IncrementWindow atomically increments the counter with the key, starting a window of the given length if the
+	// counter does not exist, and returns the new count and the time left until the window ends
+	IncrementWindow(ctx context.Context, key string, window time.Duration) (int64, time.Duration, error)
+}
+
+// WithRateLimitStore coordinates the application rate limits of all clients using the API key through the store.
+// Before every request the counters of the current fixed windows of the limits are incremented, and the client waits
+// for the next window if a limit is exceeded. If no limits are given, the application limits reported by the last
+// response are used, so the first request is never delayed. Requests are sent without waiting if the store fails.
+// Stores implementing WindowRateLimitStore start the windows on their own clock instead of the clock of the client
+func WithRateLimitStore(store RateLimitStore, limits ...RateLimit) Option {
+	return func(c *Client) {
+		c.limitStore = &storeLimiter{store: store, limits: limits}
+	}
+}
+
+// storeLimiter waits for the rate limits using a RateLimitStore
+type storeLimiter struct {
+	store  RateLimitStore
+	limits []RateLimit
+}
+
+// wait blocks until a request may be sent according to the counters of the store
+func (l *storeLimiter) wait(ctx context.Context, c *Client, endpoint string, logger log.FieldLogger) error {
+	limits := l.limits
+	if len(limits) == 0 {
+		for _, window := range c.RateLimitStatus().App {
+			limits = append(limits, RateLimit{Limit: window.Limit, Window: window.Window})
+		}
+	}
+	keyID := apiKeyID(c.apiKey(endpoint))
+	for {
+		wait, err := l.take(ctx, keyID, c.Region, limits, time.Now())
+		if err != nil {
+			logger.Warnf("rate limit store failed: %v", err)
+			return nil
+		}
+		if wait <= 0 {
+			return nil
+		}
+		c.logRetry(logger, "rate limit of store reached, waiting %s", wait)
+		if err := sleep(ctx, wait); err != nil {
+			return err
+		}
+	}
+}
+
+// take increments the counters of the limits in order and returns the time until the window of an exceeded limit
+// ends. It stops at the first exceeded limit, so a request which is not sent does not count towards the later limits
+func (l *storeLimiter) take(ctx context.Context, keyID string, region api.Region, limits []RateLimit,
+	now time.Time) (time.Duration, error) {
+	windowStore, hasWindows := l.store.(WindowRateLimitStore)
+	for _, limit := range limits {
+		if limit.Window <= 0 || limit.Limit <= 0 {
+			continue
+		}
+		if hasWindows {
+			key := fmt.Sprintf("golio:%s:%s:%d", keyID, region, limit.Window/time.Second)
+			count, left, err := windowStore.IncrementWindow(ctx, key, limit.Window)
+			if err != nil {
+				return 0, err
+			}
+			if count > int64(limit.Limit) {
+				return left, nil
+			}
+			continue
+		}
+		start := now.Truncate(limit.Window)
+		key := fmt.Sprintf("golio:%s:%s:%d:%d", keyID, region, limit.Window/time.Second, start.Unix())
+		count, err := l.store.Increment(ctx, key, start.Add(limit.Window))
+		if err != nil {
+			return 0, err
+		}
+		if count > int64(limit.Limit) {
+			return start.Add(limit.Window).Sub(now), nil
+		}
+	}
+	return 0, nil
+}
+
+// apiKeyID identifies the API key in the keys of a RateLimitStore without storing the key itself
+func apiKeyID(apiKey string) string {
+	sum := sha256.Sum256([]byte(apiKey))
+	return hex.EncodeToString(sum[:8])
+}
+
+// memorySweepInterval is the interval in which a MemoryRateLimitStore removes the expired counters of all keys
+const memorySweepInterval = time.Minute
+
+// MemoryRateLimitStore is a RateLimitStore keeping the counters in memory, e.g. for clients of a single process or
+// tests. It is safe for concurrent use
+type MemoryRateLimitStore struct {
+	mu        sync.Mutex
+	counters  map[string]memoryCounter
+	nextSweep time.Time
+}
+
+type memoryCounter struct {
+	count   int64
+	expires time.Time
+}
+
+// NewMemoryRateLimitStore returns an empty MemoryRateLimitStore
+func NewMemoryRateLimitStore() *MemoryRateLimitStore {
+	return &MemoryRateLimitStore{counters: map[string]memoryCounter{}}
+}
+
+// Increment increments the counter with the key, starting a new counter if it expired. The expired counters of
+// other keys are removed once per minute
+func (s *MemoryRateLimitStore) Increment(_ context.Context, key string, expires time.Time) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	if now.After(s.nextSweep) {
+		for k, counter := range s.counters {
+			if now.After(counter.expires) {
+				delete(s.counters, k)
+			}
+		}
+		s.nextSweep = now.Add(memorySweepInterval)
+	}
+	counter, ok := s.counters[key]
+	if !ok || now.After(counter.expires) {
+		counter = memoryCounter{}
+	}
+	counter.count++
+	counter.expires = expires
+	s.counters[key] = counter
+	return counter.count, nil
+}
@@ -0,0 +1,147 @@
+package riot
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mjourard/golio/api"
+	"github.com/mjourard/golio/mock"
+)
+
+type countingStore map[string]int64
+
+func (s countingStore) Increment(_ context.Context, key string, _ time.Time) (int64, error) {
+	s[key]++
+	return s[key], nil
+}
+
+type failingStore struct{}
+
+func (failingStore) Increment(context.Context, string, time.Time) (int64, error) {
+	return 0, errors.New("store down")
+}
+
+// windowStore starts the windows at the time of its first increment
+type windowStore struct {
+	countingStore
+	now    time.Time
+	starts map[string]time.Time
+}
+
+func (s *windowStore) IncrementWindow(_ context.Context, key string, window time.Duration) (int64, time.Duration, error) {
+	if start, ok := s.starts[key]; !ok || !s.now.Before(start.Add(window)) {
+		s.starts[key] = s.now
+		s.countingStore[key] = 0
+	}
+	s.countingStore[key]++
+	return s.countingStore[key], s.starts[key].Add(window).Sub(s.now), nil
+}
+
+func TestStoreLimiter_take(t *testing.T) {
+	t.Parallel()
+	limiter := &storeLimiter{store: countingStore{}}
+	limits := []RateLimit{{Limit: 2, Window: time.Second}, {Limit: 3, Window: time.Hour}, {}}
+	now := time.Date(2020, 1, 1, 0, 0, 0, 500*int(time.Millisecond), time.UTC)
+	for i := 0; i < 2; i++ {
+		wait, err := limiter.take(context.Background(), "key", api.RegionKorea, limits, now)
+		require.Nil(t, err)
+		assert.Equal(t, time.Duration(0), wait)
+	}
+	wait, err := limiter.take(context.Background(), "key", api.RegionKorea, limits, now)
+	require.Nil(t, err)
+	assert.Equal(t, 500*time.Millisecond, wait)
+	// the request rejected by the first limit did not count towards the second one
+	assert.Equal(t, int64(2), limiter.store.(countingStore)["golio:key:kr:3600:1577836800"])
+	wait, err = limiter.take(context.Background(), "key", api.RegionKorea, limits, now.Add(time.Second))
+	require.Nil(t, err)
+	assert.Equal(t, time.Duration(0), wait)
+	wait, err = limiter.take(context.Background(), "key", api.RegionKorea, limits, now.Add(time.Second))
+	require.Nil(t, err)
+	assert.Equal(t, time.Hour-1500*time.Millisecond, wait)
+	wait, err = limiter.take(context.Background(), "key", api.RegionEuropeWest, limits, now)
+	require.Nil(t, err)
+	assert.Equal(t, time.Duration(0), wait)
+}
+
+func TestStoreLimiter_takeWindow(t *testing.T) {
+	t.Parallel()
+	store := &windowStore{countingStore: countingStore{}, starts: map[string]time.Time{}}
+	limiter := &storeLimiter{store: store}
+	limits := []RateLimit{{Limit: 2, Window: time.Second}}
+	// the time of the client is ignored, only the clock of the store starts and ends windows
+	skewed := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	store.now = time.Date(2020, 1, 1, 0, 0, 0, 500*int(time.Millisecond), time.UTC)
+	for i := 0; i < 2; i++ {
+		wait, err := limiter.take(context.Background(), "key", api.RegionKorea, limits, skewed)
+		require.Nil(t, err)
+		assert.Equal(t, time.Duration(0), wait)
+	}
+	store.now = store.now.Add(200 * time.Millisecond)
+	wait, err := limiter.take(context.Background(), "key", api.RegionKorea, limits, skewed)
+	require.Nil(t, err)
+	assert.Equal(t, 800*time.Millisecond, wait)
+	assert.Equal(t, int64(3), store.countingStore["golio:key:kr:1"])
+	store.now = store.now.Add(800 * time.Millisecond)
+	wait, err = limiter.take(context.Background(), "key", api.RegionKorea, limits, skewed)
+	require.Nil(t, err)
+	assert.Equal(t, time.Duration(0), wait)
+}
+
+func TestClient_rateLimitStore(t *testing.T) {
+	t.Parallel()
+	doer := mock.NewStatusMockDoer(http.StatusOK)
+	store := NewMemoryRateLimitStore()
+	c := NewClient(api.RegionKorea, "API_KEY", doer, logrus.StandardLogger(),
+		WithRateLimitStore(store, RateLimit{Limit: 1, Window: time.Hour}))
+	other := NewClient(api.RegionKorea, "API_KEY", doer, logrus.StandardLogger(),
+		WithRateLimitStore(store, RateLimit{Limit: 1, Window: time.Hour}))
+	_, err := c.doRequest(context.Background(), http.MethodGet, "/lol/status/v3/shard-data", nil)
+	require.Nil(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = other.doRequest(ctx, http.MethodGet, "/lol/status/v3/shard-data", nil)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+
+	failing := NewClient(api.RegionKorea, "API_KEY", doer, logrus.StandardLogger(),
+		WithRateLimitStore(failingStore{}, RateLimit{Limit: 1, Window: time.Hour}))
+	_, err = failing.doRequest(context.Background(), http.MethodGet, "/lol/status/v3/shard-data", nil)
+	assert.Nil(t, err)
+}
+
+func TestMemoryRateLimitStore(t *testing.T) {
+	t.Parallel()
+	store := NewMemoryRateLimitStore()
+	for i := int64(1); i <= 2; i++ {
+		count, err := store.Increment(context.Background(), "key", time.Now().Add(time.Hour))
+		require.Nil(t, err)
+		assert.Equal(t, i, count)
+	}
+	_, _ = store.Increment(context.Background(), "expired", time.Now().Add(-time.Second))
+	// an expired counter starts again when its key is incremented
+	count, err := store.Increment(context.Background(), "expired", time.Now().Add(-time.Second))
+	require.Nil(t, err)
+	assert.Equal(t, int64(1), count)
+	// the expired counters of other keys are only removed by the periodic sweep
+	count, err = store.Increment(context.Background(), "other", time.Now().Add(time.Hour))
+	require.Nil(t, err)
+	assert.Equal(t, int64(1), count)
+	assert.Len(t, store.counters, 3)
+	store.nextSweep = time.Time{}
+	_, err = store.Increment(context.Background(), "other", time.Now().Add(time.Hour))
+	require.Nil(t, err)
+	assert.Len(t, store.counters, 2)
+}
+
+func TestApiKeyID(t *testing.T) {
+	t.Parallel()
+	assert.Len(t, apiKeyID("API_KEY"), 16)
+	assert.NotContains(t, apiKeyID("API_KEY"), "API_KEY")
+	assert.NotEqual(t, apiKeyID("API_KEY"), apiKeyID("OTHER_KEY"))
+}
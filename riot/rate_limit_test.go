@@ -0,0 +1,130 @@
+package riot
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mjourard/golio/api"
+	"github.com/mjourard/golio/mock"
+)
+
+func TestParseRateLimit(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		limits string
+		counts string
+		want   []RateLimitWindow
+	}{
+		{
+			name: "missing",
+		},
+		{
+			name:   "app",
+			limits: "20:1,100:120",
+			counts: "3:1,42:120",
+			want: []RateLimitWindow{
+				{Window: time.Second, Limit: 20, Count: 3},
+				{Window: 2 * time.Minute, Limit: 100, Count: 42},
+			},
+		},
+		{
+			name:   "missing count",
+			limits: "2000:60",
+			want:   []RateLimitWindow{{Window: time.Minute, Limit: 2000}},
+		},
+		{
+			name:   "malformed",
+			limits: "20:1,abc",
+			counts: "1:1",
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.want, parseRateLimit(test.limits, test.counts))
+		})
+	}
+}
+
+func TestRateLimitWindow_Remaining(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, 17, RateLimitWindow{Limit: 20, Count: 3}.Remaining())
+	assert.Equal(t, 0, RateLimitWindow{Limit: 20, Count: 21}.Remaining())
+}
+
+func TestRateLimitStatus_Remaining(t *testing.T) {
+	t.Parallel()
+	reset := time.Date(2020, 1, 1, 0, 2, 0, 0, time.UTC)
+	status := RateLimitStatus{
+		App: []RateLimitWindow{
+			{Window: time.Second, Limit: 20, Count: 3},
+			{Window: 2 * time.Minute, Limit: 100, Count: 100, Reset: reset},
+		},
+		Methods: map[string][]RateLimitWindow{
+			"summoner-v4": {{Window: time.Minute, Limit: 2000, Count: 1990, Reset: reset.Add(time.Minute)}},
+		},
+		ThrottledUntil: reset.Add(-time.Minute),
+	}
+	assert.Equal(t, -1, RateLimitStatus{}.Remaining("summoner-v4"))
+	assert.Equal(t, 0, status.Remaining("summoner-v4"))
+	assert.Equal(t, reset, status.ReadyAt("summoner-v4"))
+	status.App = status.App[:1]
+	assert.Equal(t, 10, status.Remaining("summoner-v4"))
+	assert.Equal(t, 17, status.Remaining("match-v4"))
+	assert.Equal(t, reset.Add(-time.Minute), status.ReadyAt("summoner-v4"))
+	status.Methods["summoner-v4"][0].Count = 2000
+	assert.Equal(t, reset.Add(time.Minute), status.ReadyAt("summoner-v4"))
+	status.MethodsThrottledUntil = map[string]time.Time{"match-v4": reset.Add(time.Hour)}
+	assert.Equal(t, reset.Add(time.Hour), status.ReadyAt("match-v4"))
+	assert.Equal(t, reset.Add(time.Minute), status.ReadyAt("summoner-v4"))
+	assert.True(t, RateLimitStatus{}.ReadyAt("summoner-v4").IsZero())
+}
+
+func TestRateLimitTracker_reset(t *testing.T) {
+	t.Parallel()
+	tracker := &rateLimitTracker{}
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	header := func(count string) http.Header {
+		return http.Header{headerAppRateLimit: {"100:120"}, headerAppRateLimitCount: {count}}
+	}
+	tracker.update("summoner-v4", header("1:120"), start)
+	tracker.update("summoner-v4", header("2:120"), start.Add(time.Minute))
+	assert.Equal(t, start.Add(2*time.Minute), tracker.status().App[0].Reset)
+	tracker.update("summoner-v4", header("1:120"), start.Add(3*time.Minute))
+	assert.Equal(t, start.Add(5*time.Minute), tracker.status().App[0].Reset)
+}
+
+func TestClient_RateLimitStatus(t *testing.T) {
+	t.Parallel()
+	ok := mock.OK(Summoner{})
+	ok.Header = http.Header{
+		headerAppRateLimit:         {"20:1,100:120"},
+		headerAppRateLimitCount:    {"2:1,5:120"},
+		headerMethodRateLimit:      {"2000:60"},
+		headerMethodRateLimitCount: {"7:60"},
+	}
+	doer := mock.NewSequenceDoer(mock.RateLimited(1, 0), ok)
+	client := NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger())
+	assert.Equal(t, RateLimitStatus{
+		Methods:               map[string][]RateLimitWindow{},
+		MethodsThrottledUntil: map[string]time.Time{},
+	}, client.RateLimitStatus())
+	_, err := client.Summoner.GetByName("name")
+	require.Nil(t, err)
+	got := client.RateLimitStatus()
+	assert.False(t, got.Throttled())
+	assert.WithinDuration(t, time.Now(), got.ThrottledUntil, time.Minute)
+	require.Len(t, got.App, 2)
+	assert.Equal(t, 18, got.App[0].Remaining())
+	assert.Equal(t, 95, got.App[1].Remaining())
+	assert.WithinDuration(t, time.Now().Add(2*time.Minute), got.App[1].Reset, time.Minute)
+	require.Len(t, got.Methods["summoner-v4"], 1)
+	assert.Equal(t, 1993, got.Methods["summoner-v4"][0].Remaining())
+}
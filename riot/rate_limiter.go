@@ -0,0 +1,142 @@
+package riot
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// WithRateLimiter makes the client wait before sending a request which would exceed the application rate limit or the
+// method rate limit of its endpoint family, e.g. match-v5, instead of only waiting after the Riot API answered with
+// 429. The limits are learned from the rate limit headers of the responses, so the first request of a family is never
+// delayed. Each window of a limit is a bucket of tokens which is refilled when the window resets, like the fixed
+// windows of the Riot API. A family waiting for its method limit does not delay requests of other families. The
+// limits are tracked per region and shared by all views of the client
+func WithRateLimiter() Option {
+	return func(c *Client) {
+		c.rateLimiter = true
+	}
+}
+
+// rateLimitBucket holds the tokens of a single rate limit window
+type rateLimitBucket struct {
+	window time.Duration
+	limit  int
+	// used is the number of tokens taken in the current window, including requests without response yet
+	used  int
+	reset time.Time
+}
+
+// syncBuckets updates the buckets with the windows reported by a response. Within the current window the reported
+// count only increases the used tokens, as it does not include the requests sent after the reported one. Buckets of
+// windows which are no longer reported are dropped
+func syncBuckets(buckets []*rateLimitBucket, windows []RateLimitWindow, now time.Time) []*rateLimitBucket {
+	synced := make([]*rateLimitBucket, 0, len(windows))
+	for _, w := range windows {
+		var bucket *rateLimitBucket
+		for _, b := range buckets {
+			if b.window == w.Window {
+				bucket = b
+			}
+		}
+		switch {
+		case bucket == nil:
+			bucket = &rateLimitBucket{window: w.Window, used: w.Count, reset: w.Reset}
+		case !now.Before(bucket.reset):
+			bucket.used = w.Count
+			bucket.reset = w.Reset
+		case w.Count > bucket.used:
+			bucket.used = w.Count
+		}
+		bucket.limit = w.Limit
+		synced = append(synced, bucket)
+	}
+	return synced
+}
+
+// refill starts a new window for buckets whose window has passed
+func refill(buckets []*rateLimitBucket, now time.Time) {
+	for _, b := range buckets {
+		if !now.Before(b.reset) {
+			b.used = 0
+			b.reset = now.Add(b.window)
+		}
+	}
+}
+
+// bucketsWait returns the time until all buckets have a token left apart from the reserved fraction of their tokens.
+// At least one token of a bucket is never reserved
+func bucketsWait(buckets []*rateLimitBucket, reserve float64, now time.Time) time.Duration {
+	var wait time.Duration
+	for _, b := range buckets {
+		reserved := int(float64(b.limit) * reserve)
+		if reserved > b.limit-1 {
+			reserved = b.limit - 1
+		}
+		if reserved < 0 {
+			reserved = 0
+		}
+		if b.used >= b.limit-reserved {
+			if w := b.reset.Sub(now); w > wait {
+				wait = w
+			}
+		}
+	}
+	return wait
+}
+
+// reserve takes a token of the application limit and of the method limit of the endpoint family if both have one
+// left apart from the reserved fraction of their tokens. Otherwise it takes no token and returns the time until both
+// have a token left or the family is no longer throttled
+func (t *rateLimitTracker) reserve(family string, reserve float64, now time.Time) time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	until := t.throttledUntil
+	if methodUntil := t.methodsThrottledUntil[family]; methodUntil.After(until) {
+		until = methodUntil
+	}
+	if now.Before(until) {
+		return until.Sub(now)
+	}
+	methodBuckets := t.methodBuckets[family]
+	refill(t.appBuckets, now)
+	refill(methodBuckets, now)
+	wait := bucketsWait(t.appBuckets, reserve, now)
+	if methodWait := bucketsWait(methodBuckets, reserve, now); methodWait > wait {
+		wait = methodWait
+	}
+	if wait > 0 {
+		return wait
+	}
+	for _, b := range t.appBuckets {
+		b.used++
+	}
+	for _, b := range methodBuckets {
+		b.used++
+	}
+	return 0
+}
+
+// waitForToken blocks until the rate limiter of the client allows to send a request for the endpoint or ctx is done
+func (c *Client) waitForToken(ctx context.Context, endpoint string) error {
+	priority := RequestPriority(ctx)
+	var reserve float64
+	if priority == PriorityBulk {
+		reserve = c.bulkReserve
+	}
+	for {
+		wait := c.rateLimits.reserve(EndpointFamily(endpoint), reserve, time.Now())
+		if wait <= 0 {
+			return nil
+		}
+		logger := c.categoryLogger(LogCategoryTransport).WithFields(log.Fields{
+			"endpoint": endpoint,
+			"priority": priority,
+		})
+		c.logRetry(logger, "rate limit reached, waiting %s", wait)
+		if err := sleep(ctx, wait); err != nil {
+			return err
+		}
+	}
+}
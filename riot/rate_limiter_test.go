@@ -0,0 +1,131 @@
+package riot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mjourard/golio/api"
+	"github.com/mjourard/golio/mock"
+)
+
+func TestRateLimitTracker_reserve(t *testing.T) {
+	t.Parallel()
+	tracker := &rateLimitTracker{}
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Duration(0), tracker.reserve("summoner-v4", 0, start))
+	tracker.update("summoner-v4", http.Header{
+		headerAppRateLimit:      {"3:10,100:600"},
+		headerAppRateLimitCount: {"1:10,1:600"},
+	}, start)
+	assert.Equal(t, time.Duration(0), tracker.reserve("summoner-v4", 0, start))
+	assert.Equal(t, time.Duration(0), tracker.reserve("summoner-v4", 0, start.Add(time.Second)))
+	assert.Equal(t, 9*time.Second, tracker.reserve("summoner-v4", 0, start.Add(time.Second)))
+	assert.Equal(t, time.Duration(0), tracker.reserve("summoner-v4", 0, start.Add(10*time.Second)))
+
+	// a response reporting more requests than reserved, e.g. sent by another process, takes the remaining tokens
+	tracker.update("summoner-v4", http.Header{
+		headerAppRateLimit:      {"3:10,100:600"},
+		headerAppRateLimitCount: {"3:10,5:600"},
+	}, start.Add(11*time.Second))
+	assert.Equal(t, 9*time.Second, tracker.reserve("summoner-v4", 0, start.Add(11*time.Second)))
+
+	tracker.throttle("summoner-v4", api.RateLimitTypeApplication, start.Add(time.Minute))
+	assert.Equal(t, 40*time.Second, tracker.reserve("summoner-v4", 0, start.Add(20*time.Second)))
+}
+
+func TestRateLimitTracker_reserveMethod(t *testing.T) {
+	t.Parallel()
+	tracker := &rateLimitTracker{}
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	tracker.update("match-v4", http.Header{
+		headerAppRateLimit:         {"100:10"},
+		headerAppRateLimitCount:    {"1:10"},
+		headerMethodRateLimit:      {"2:10"},
+		headerMethodRateLimitCount: {"1:10"},
+	}, start)
+	assert.Equal(t, time.Duration(0), tracker.reserve("match-v4", 0, start))
+	assert.Equal(t, 10*time.Second, tracker.reserve("match-v4", 0, start))
+	// an exhausted method limit neither delays other families nor takes tokens of the application limit
+	for i := 0; i < 5; i++ {
+		assert.Equal(t, 10*time.Second, tracker.reserve("match-v4", 0, start))
+	}
+	assert.Equal(t, time.Duration(0), tracker.reserve("summoner-v4", 0, start))
+	assert.Equal(t, 3, tracker.appBuckets[0].used)
+	assert.Equal(t, time.Duration(0), tracker.reserve("match-v4", 0, start.Add(10*time.Second)))
+
+	tracker.throttle("match-v4", api.RateLimitTypeMethod, start.Add(time.Minute))
+	assert.Equal(t, 40*time.Second, tracker.reserve("match-v4", 0, start.Add(20*time.Second)))
+	assert.Equal(t, time.Duration(0), tracker.reserve("summoner-v4", 0, start.Add(20*time.Second)))
+	status := tracker.status()
+	assert.False(t, status.Throttled())
+	assert.Equal(t, start.Add(time.Minute), status.MethodsThrottledUntil["match-v4"])
+}
+
+func TestWithRateLimiter(t *testing.T) {
+	t.Parallel()
+	calls := 0
+	doer := &mock.Doer{Custom: func(*http.Request) (*http.Response, error) {
+		calls++
+		header := http.Header{}
+		header.Set(headerAppRateLimit, "2:1")
+		header.Set(headerAppRateLimitCount, fmt.Sprintf("%d:1", calls))
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     header,
+			Body:       io.NopCloser(strings.NewReader(`{"id":"id"}`)),
+		}, nil
+	}}
+	c := NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger(), WithRateLimiter())
+	start := time.Now()
+	for i := 0; i < 2; i++ {
+		_, err := c.Summoner.GetByID("id")
+		require.Nil(t, err)
+	}
+	assert.Less(t, int64(time.Since(start)), int64(500*time.Millisecond))
+	_, err := c.Summoner.GetByID("id")
+	require.Nil(t, err)
+	assert.GreaterOrEqual(t, int64(time.Since(start)), int64(900*time.Millisecond))
+	assert.Equal(t, 3, calls)
+}
+
+func TestWithRateLimiter_method(t *testing.T) {
+	t.Parallel()
+	var matches int
+	doer := &mock.Doer{Custom: func(r *http.Request) (*http.Response, error) {
+		header := http.Header{}
+		header.Set(headerAppRateLimit, "100:1")
+		header.Set(headerAppRateLimitCount, "1:1")
+		if strings.HasPrefix(r.URL.Path, "/lol/match/") {
+			matches++
+			header.Set(headerMethodRateLimit, "1:10")
+			header.Set(headerMethodRateLimitCount, fmt.Sprintf("%d:10", matches))
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     header,
+			Body:       io.NopCloser(strings.NewReader(`{}`)),
+		}, nil
+	}}
+	c := NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger(), WithRateLimiter())
+	_, err := c.Match.Get(1)
+	require.Nil(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err = c.WithContext(ctx).Match.Get(1)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	start := time.Now()
+	_, err = c.Summoner.GetByID("id")
+	require.Nil(t, err)
+	assert.Less(t, int64(time.Since(start)), int64(500*time.Millisecond))
+	assert.Equal(t, 1, matches)
+}
@@ -0,0 +1,82 @@
+package riot
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/mjourard/golio/api"
+)
+
+// RetryPolicy decides whether a failed request is sent again. It is asked after every attempt answered with an error
+// status or failing with an *api.TransportError, with the number of the attempt starting at 1 and either the
+// response or the error. It returns the delay before the next attempt and whether to retry. Responses with status 429
+// are retried after at least the time of their Retry-After header or, if it is missing or malformed, until the
+// exhausted rate limit windows reset. Implementations must be safe for concurrent use
+type RetryPolicy interface {
+	ShouldRetry(attempt int, response *http.Response, err error) (time.Duration, bool)
+}
+
+// RetryPolicyFunc is a function implementing RetryPolicy
+type RetryPolicyFunc func(attempt int, response *http.Response, err error) (time.Duration, bool)
+
+// ShouldRetry calls f
+func (f RetryPolicyFunc) ShouldRetry(attempt int, response *http.Response, err error) (time.Duration, bool) {
+	return f(attempt, response, err)
+}
+
+// WithRetryPolicy sets the policy deciding which failed requests are sent again, e.g. to retry server errors of
+// idempotent requests only. Defaults to DefaultBackoff
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *Client) {
+		c.retryPolicy = policy
+	}
+}
+
+// IdempotentOnly returns a policy retrying only the requests with the methods GET, HEAD, PUT and DELETE according
+// to policy, e.g. to never send a tournament registration twice
+func IdempotentOnly(policy RetryPolicy) RetryPolicy {
+	return idempotentOnly{policy: policy}
+}
+
+type idempotentOnly struct {
+	policy RetryPolicy
+}
+
+func (p idempotentOnly) ShouldRetry(attempt int, response *http.Response, err error) (time.Duration, bool) {
+	switch requestMethod(response, err) {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		return p.policy.ShouldRetry(attempt, response, err)
+	}
+	return 0, false
+}
+
+func (p idempotentOnly) retryTimeLimit() time.Duration {
+	return retryTimeLimit(p.policy)
+}
+
+// requestMethod returns the method of the request of the response or the failed request
+func requestMethod(response *http.Response, err error) string {
+	if response != nil && response.Request != nil {
+		return response.Request.Method
+	}
+	var transportErr *api.TransportError
+	if errors.As(err, &transportErr) {
+		return transportErr.Method
+	}
+	return ""
+}
+
+// retryTimeLimiter is implemented by policies limiting the time from the first attempt until a retry is sent, see
+// Backoff.MaxElapsedTime
+type retryTimeLimiter interface {
+	retryTimeLimit() time.Duration
+}
+
+// retryTimeLimit returns the maximum time from the first attempt until a retry is sent, or 0 for no limit
+func retryTimeLimit(policy RetryPolicy) time.Duration {
+	if limiter, ok := policy.(retryTimeLimiter); ok {
+		return limiter.retryTimeLimit()
+	}
+	return 0
+}
@@ -0,0 +1,62 @@
+package riot
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mjourard/golio/api"
+	"github.com/mjourard/golio/mock"
+)
+
+func TestWithRetryPolicy(t *testing.T) {
+	t.Parallel()
+	var attempts []int
+	policy := RetryPolicyFunc(func(attempt int, response *http.Response, err error) (time.Duration, bool) {
+		attempts = append(attempts, attempt)
+		if err != nil {
+			return time.Millisecond, true
+		}
+		return time.Millisecond, response.StatusCode >= http.StatusInternalServerError
+	})
+	doer := mock.NewSequenceDoer(mock.Status(http.StatusInternalServerError, 1), mock.Status(http.StatusBadGateway, 1),
+		mock.Step{Err: errors.New("connection reset")}, mock.Status(http.StatusGatewayTimeout, 1),
+		mock.OK(Summoner{Name: "name"}))
+	c := NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger(), WithRetryPolicy(policy))
+	summoner, err := c.Summoner.GetByID("id")
+	require.Nil(t, err)
+	assert.Equal(t, "name", summoner.Name)
+	assert.Equal(t, []int{1, 2, 3, 4}, attempts)
+
+	doer = mock.NewSequenceDoer(mock.Status(http.StatusNotFound, 1))
+	c = NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger(), WithRetryPolicy(policy))
+	_, err = c.Summoner.GetByID("id")
+	assert.True(t, errors.Is(err, api.ErrNotFound))
+	assert.Len(t, doer.Requests(), 1)
+}
+
+func TestIdempotentOnly(t *testing.T) {
+	t.Parallel()
+	policy := IdempotentOnly(Backoff{InitialDelay: time.Millisecond, MaxAttempts: 2,
+		StatusCodes: []int{http.StatusInternalServerError}})
+	doer := mock.NewSequenceDoer(mock.Status(http.StatusInternalServerError, 1), mock.OK(Summoner{}))
+	c := NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger(), WithRetryPolicy(policy))
+	_, err := c.Summoner.GetByID("id")
+	require.Nil(t, err)
+	assert.Len(t, doer.Requests(), 2)
+
+	doer = mock.NewSequenceDoer(mock.Status(http.StatusInternalServerError, 1), mock.OK(1))
+	c = NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger(), WithRetryPolicy(policy))
+	_, _, err = c.Do(http.MethodPost, "/lol/tournament/v4/providers", map[string]string{"region": "EUW"})
+	assert.True(t, errors.Is(err, api.ErrInternalServerError))
+	assert.Len(t, doer.Requests(), 1)
+
+	delay, retry := policy.ShouldRetry(1, nil, &api.TransportError{Method: http.MethodGet, Err: errors.New("reset")})
+	assert.False(t, retry)
+	assert.Equal(t, time.Duration(0), delay)
+}
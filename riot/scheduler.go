@@ -0,0 +1,190 @@
+package riot
+
+import (
+	"context"
+	"sync"
+)
+
+// Scheduler interleaves the requests of several lanes of a client, see WithScheduler. At most concurrency requests
+// are waiting for the rate limits or executed at the same time. Once that limit is reached, waiting requests are
+// admitted in weighted round-robin order across lanes before they take tokens of the rate limits, so a lane with a
+// high weight (e.g. a live watcher) is not starved by a lane with many queued requests (e.g. a history backfill) which
+// shares the quota of the client. Requests are assigned to lanes by their context, see WithLane, and requests without
+// a lane are scheduled in a default lane of weight 1
+type Scheduler struct {
+	mu          sync.Mutex
+	free        int
+	lanes       []*Lane
+	defaultLane *Lane
+}
+
+// Lane of a Scheduler, see Scheduler.Lane
+type Lane struct {
+	s             *Scheduler
+	weight        int
+	currentWeight int
+	queue         []*schedulerWaiter
+	removed       bool
+}
+
+type schedulerWaiter struct {
+	lane    *Lane
+	ready   chan struct{}
+	granted bool
+}
+
+// NewScheduler returns a scheduler admitting at most concurrency requests at the same time. A concurrency below 1 is
+// treated as 1
+func NewScheduler(concurrency int) *Scheduler {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	s := &Scheduler{free: concurrency}
+	s.defaultLane = s.Lane(1)
+	return s
+}
+
+// WithScheduler makes the client admit its requests through the scheduler before waiting for the rate limits. Views
+// and clones of the client share the scheduler
+func WithScheduler(s *Scheduler) Option {
+	return func(c *Client) {
+		c.scheduler = s
+	}
+}
+
+// Lane returns a new lane of the scheduler. Lanes with a higher weight get proportionally more requests admitted
+// when requests have to wait. A weight below 1 is treated as 1
+func (s *Scheduler) Lane(weight int) *Lane {
+	if weight < 1 {
+		weight = 1
+	}
+	l := &Lane{
+		s:      s,
+		weight: weight,
+	}
+	s.mu.Lock()
+	s.lanes = append(s.lanes, l)
+	s.mu.Unlock()
+	return l
+}
+
+// Remove removes the lane from its scheduler, e.g. once the watcher using it stopped. Its waiting requests and all
+// later requests tagged with it are scheduled in the default lane
+func (l *Lane) Remove() {
+	s := l.s
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if l.removed || l == s.defaultLane {
+		return
+	}
+	l.removed = true
+	for i, lane := range s.lanes {
+		if lane == l {
+			s.lanes = append(s.lanes[:i], s.lanes[i+1:]...)
+			break
+		}
+	}
+	for _, w := range l.queue {
+		w.lane = s.defaultLane
+	}
+	s.defaultLane.queue = append(s.defaultLane.queue, l.queue...)
+	l.queue = nil
+}
+
+type laneKey struct{}
+
+// WithLane returns a context whose requests are scheduled in the lane by the scheduler of the client, e.g.
+//
+//	watcher := client.WithContext(riot.WithLane(ctx, scheduler.Lane(3)))
+func WithLane(ctx context.Context, lane *Lane) context.Context {
+	return context.WithValue(ctx, laneKey{}, lane)
+}
+
+// lane returns the lane of the requests of ctx. Must be called with s.mu held
+func (s *Scheduler) lane(ctx context.Context) *Lane {
+	l, _ := ctx.Value(laneKey{}).(*Lane)
+	if l == nil || l.s != s || l.removed {
+		return s.defaultLane
+	}
+	return l
+}
+
+// acquire waits until the scheduler admits the request of ctx. If ctx is done while waiting, its error is returned.
+// Admitted requests have to call release once they are done
+func (s *Scheduler) acquire(ctx context.Context) error {
+	s.mu.Lock()
+	if s.free > 0 && s.pending() == 0 {
+		s.free--
+		s.mu.Unlock()
+		return nil
+	}
+	w := &schedulerWaiter{lane: s.lane(ctx), ready: make(chan struct{})}
+	w.lane.queue = append(w.lane.queue, w)
+	s.mu.Unlock()
+	select {
+	case <-w.ready:
+		return nil
+	case <-ctx.Done():
+		s.mu.Lock()
+		granted := w.granted
+		if !granted {
+			queue := w.lane.queue
+			for i, queued := range queue {
+				if queued == w {
+					w.lane.queue = append(queue[:i], queue[i+1:]...)
+					break
+				}
+			}
+		}
+		s.mu.Unlock()
+		if granted {
+			// the slot was handed over concurrently, pass it on to the next request
+			s.release()
+		}
+		return ctx.Err()
+	}
+}
+
+// release hands the slot of a finished request over to the next waiting request or frees it
+func (s *Scheduler) release() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	l := s.next()
+	if l == nil {
+		s.free++
+		return
+	}
+	w := l.queue[0]
+	l.queue = l.queue[1:]
+	w.granted = true
+	close(w.ready)
+}
+
+// next selects the lane of the next request using smooth weighted round-robin. Must be called with s.mu held
+func (s *Scheduler) next() *Lane {
+	var selected *Lane
+	total := 0
+	for _, l := range s.lanes {
+		if len(l.queue) == 0 {
+			continue
+		}
+		l.currentWeight += l.weight
+		total += l.weight
+		if selected == nil || l.currentWeight > selected.currentWeight {
+			selected = l
+		}
+	}
+	if selected != nil {
+		selected.currentWeight -= total
+	}
+	return selected
+}
+
+// pending returns the number of waiting requests. Must be called with s.mu held
+func (s *Scheduler) pending() int {
+	n := 0
+	for _, l := range s.lanes {
+		n += len(l.queue)
+	}
+	return n
+}
@@ -0,0 +1,125 @@
+package riot
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mjourard/golio/api"
+	"github.com/mjourard/golio/mock"
+)
+
+func TestScheduler_Lane(t *testing.T) {
+	t.Parallel()
+	gate := make(chan struct{})
+	mu := sync.Mutex{}
+	var order []string
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			<-gate
+			mu.Lock()
+			order = append(order, r.URL.Query().Get("lane"))
+			mu.Unlock()
+			return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("{}"))}, nil
+		},
+	}
+	s := NewScheduler(1)
+	// both lanes share the client and thereby its rate limits
+	c := NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger(), WithScheduler(s),
+		WithRateLimiter())
+	live := s.Lane(3)
+	bulk := s.Lane(1)
+	wg := sync.WaitGroup{}
+	do := func(lane *Lane, name string) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := c.WithContext(WithLane(context.Background(), lane)).GetRaw("/path?lane=" + name)
+			assert.Nil(t, err)
+		}()
+	}
+	// occupy the only slot, then queue requests on both lanes
+	do(bulk, "first")
+	waitForPending(t, s, 0, func() bool { return s.free == 0 })
+	for i := 0; i < 4; i++ {
+		do(bulk, "bulk")
+	}
+	waitForPending(t, s, 4, nil)
+	for i := 0; i < 3; i++ {
+		do(live, "live")
+	}
+	waitForPending(t, s, 7, nil)
+	close(gate)
+	wg.Wait()
+	require.Len(t, order, 8)
+	assert.Equal(t, "first", order[0])
+	assert.ElementsMatch(t, []string{"live", "live", "live", "bulk"}, order[1:5])
+	assert.Equal(t, []string{"bulk", "bulk", "bulk"}, order[5:])
+}
+
+func TestScheduler_LaneCancelled(t *testing.T) {
+	t.Parallel()
+	s := NewScheduler(0)
+	lane := s.Lane(0)
+	ctx := WithLane(context.Background(), lane)
+	require.Nil(t, s.acquire(ctx))
+	timeout, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, context.DeadlineExceeded, s.acquire(timeout))
+	s.mu.Lock()
+	assert.Equal(t, 0, s.pending())
+	s.mu.Unlock()
+	s.release()
+	assert.Equal(t, 1, s.free)
+}
+
+func TestLane_Remove(t *testing.T) {
+	t.Parallel()
+	s := NewScheduler(1)
+	lane := s.Lane(2)
+	ctx := WithLane(context.Background(), lane)
+	require.Nil(t, s.acquire(ctx))
+	done := make(chan error)
+	go func() {
+		done <- s.acquire(ctx)
+	}()
+	waitForPending(t, s, 1, nil)
+
+	lane.Remove()
+	lane.Remove()
+	s.mu.Lock()
+	assert.Len(t, s.lanes, 1)
+	assert.Len(t, s.defaultLane.queue, 1)
+	assert.Equal(t, s.defaultLane, s.lane(ctx))
+	s.mu.Unlock()
+	// the waiting request of the removed lane is still admitted
+	s.release()
+	require.Nil(t, <-done)
+	s.release()
+	assert.Equal(t, 1, s.free)
+
+	s.defaultLane.Remove()
+	assert.Len(t, s.lanes, 1)
+}
+
+func waitForPending(t *testing.T, s *Scheduler, n int, condition func() bool) {
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		s.mu.Lock()
+		done := s.pending() == n && (condition == nil || condition())
+		s.mu.Unlock()
+		if done {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatalf("scheduler did not reach %d pending requests", n)
+}
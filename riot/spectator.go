@@ -13,6 +13,10 @@ type spectatorClient struct {
 // GetCurrent returns a currently running game for a summoner
 func (s *spectatorClient) GetCurrent(summonerID string) (*GameInfo, error) {
 	logger := s.logger().WithField("method", "GetCurrent")
+	if err := validateID("summonerID", summonerID); err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
 	var games GameInfo
 	if err := s.c.getInto(fmt.Sprintf(endpointGetCurrentGame, summonerID), &games); err != nil {
 		logger.Debug(err)
@@ -33,5 +37,5 @@ func (s *spectatorClient) ListFeatured() (*FeaturedGames, error) {
 }
 
 func (s *spectatorClient) logger() log.FieldLogger {
-	return s.c.logger().WithField("category", "spectator")
+	return s.c.categoryLogger(LogCategorySpectator)
 }
@@ -1,6 +1,7 @@
 package riot
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
@@ -11,7 +12,7 @@ import (
 
 	"github.com/mjourard/golio/api"
 	"github.com/mjourard/golio/internal"
-	"github.com/mjourard/golio/internal/mock"
+	"github.com/mjourard/golio/mock"
 )
 
 func TestSpectatorClient_ListFeatured(t *testing.T) {
@@ -43,12 +44,12 @@ func TestSpectatorClient_ListFeatured(t *testing.T) {
 		{
 			name: "rate limited",
 			want: &FeaturedGames{},
-			doer: rateLimitDoer(FeaturedGames{}),
+			doer: mock.NewRateLimitDoer(FeaturedGames{}, 1, 1),
 		},
 		{
 			name: "unavailable once",
 			want: &FeaturedGames{},
-			doer: unavailableOnceDoer(FeaturedGames{}),
+			doer: mock.NewUnavailableDoer(FeaturedGames{}, 1),
 		},
 		{
 			name:    "unavailable twice",
@@ -60,7 +61,7 @@ func TestSpectatorClient_ListFeatured(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			client := NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
 			got, err := client.Spectator.ListFeatured()
-			require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+			require.True(t, errors.Is(err, tt.wantErr), fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
 			if tt.wantErr == nil {
 				assert.Equal(t, got, tt.want)
 			}
@@ -97,12 +98,12 @@ func TestSpectatorClient_GetCurrent(t *testing.T) {
 		{
 			name: "rate limited",
 			want: &GameInfo{},
-			doer: rateLimitDoer(GameInfo{}),
+			doer: mock.NewRateLimitDoer(GameInfo{}, 1, 1),
 		},
 		{
 			name: "unavailable once",
 			want: &GameInfo{},
-			doer: unavailableOnceDoer(GameInfo{}),
+			doer: mock.NewUnavailableDoer(GameInfo{}, 1),
 		},
 		{
 			name:    "unavailable twice",
@@ -114,7 +115,7 @@ func TestSpectatorClient_GetCurrent(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			client := NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
 			got, err := client.Spectator.GetCurrent("id")
-			require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+			require.True(t, errors.Is(err, tt.wantErr), fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
 			if tt.wantErr == nil {
 				assert.Equal(t, got, tt.want)
 			}
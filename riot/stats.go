@@ -0,0 +1,138 @@
+package riot
+
+import (
+	"expvar"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ClientStats is a snapshot of the counters of a client, see Client.StatsSnapshot
+type ClientStats struct {
+	// InFlight is the number of requests currently waiting for a response
+	InFlight int64
+	// Requests is the number of requests sent since the client was created, including retries
+	Requests int64
+	// Errors is the number of requests which failed with an error status code or without a response
+	Errors int64
+	// RateLimited is the number of responses with status 429
+	RateLimited int64
+	// LastRateLimited is the time of the last response with status 429 or the zero time
+	LastRateLimited time.Time
+	// Retries is the number of requests sent again after a response with status 429 or 503
+	Retries int64
+	// Backoff is the total time spent waiting before retries
+	Backoff time.Duration
+	// Families contains the counters by endpoint family, e.g. summoner-v4, see EndpointFamily
+	Families map[string]FamilyStats
+}
+
+// FamilyStats are the counters of the requests of an endpoint family
+type FamilyStats struct {
+	Requests int64
+	Retries  int64
+	Backoff  time.Duration
+	// RateLimited is the number of responses with status 429
+	RateLimited int64
+	// Unavailable is the number of responses with status 503
+	Unavailable int64
+}
+
+// clientStats are the counters of a client. The int64 fields are accessed atomically and must stay at the start of
+// the struct to be aligned on 32 bit platforms
+type clientStats struct {
+	inFlight        int64
+	requests        int64
+	errors          int64
+	rateLimited     int64
+	retries         int64
+	backoff         int64
+	mu              sync.Mutex
+	lastRateLimited time.Time
+	families        map[string]*FamilyStats
+}
+
+func (s *clientStats) begin() {
+	atomic.AddInt64(&s.inFlight, 1)
+}
+
+func (s *clientStats) end(family string, statusCode int, err error) {
+	atomic.AddInt64(&s.inFlight, -1)
+	atomic.AddInt64(&s.requests, 1)
+	if err != nil || statusCode >= 400 {
+		atomic.AddInt64(&s.errors, 1)
+	}
+	if statusCode == http.StatusTooManyRequests {
+		atomic.AddInt64(&s.rateLimited, 1)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stats := s.family(family)
+	stats.Requests++
+	switch statusCode {
+	case http.StatusTooManyRequests:
+		stats.RateLimited++
+		s.lastRateLimited = time.Now()
+	case http.StatusServiceUnavailable:
+		stats.Unavailable++
+	}
+}
+
+func (s *clientStats) retry(family string, wait time.Duration) {
+	atomic.AddInt64(&s.retries, 1)
+	atomic.AddInt64(&s.backoff, int64(wait))
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stats := s.family(family)
+	stats.Retries++
+	stats.Backoff += wait
+}
+
+// family returns the counters of the family, s.mu must be held
+func (s *clientStats) family(family string) *FamilyStats {
+	if s.families == nil {
+		s.families = map[string]*FamilyStats{}
+	}
+	stats, ok := s.families[family]
+	if !ok {
+		stats = &FamilyStats{}
+		s.families[family] = stats
+	}
+	return stats
+}
+
+func (s *clientStats) snapshot() ClientStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var families map[string]FamilyStats
+	if len(s.families) > 0 {
+		families = make(map[string]FamilyStats, len(s.families))
+		for family, stats := range s.families {
+			families[family] = *stats
+		}
+	}
+	return ClientStats{
+		InFlight:        atomic.LoadInt64(&s.inFlight),
+		Requests:        atomic.LoadInt64(&s.requests),
+		Errors:          atomic.LoadInt64(&s.errors),
+		RateLimited:     atomic.LoadInt64(&s.rateLimited),
+		LastRateLimited: s.lastRateLimited,
+		Retries:         atomic.LoadInt64(&s.retries),
+		Backoff:         time.Duration(atomic.LoadInt64(&s.backoff)),
+		Families:        families,
+	}
+}
+
+// StatsSnapshot returns the current counters of the client, e.g. to check the health of the client in production
+func (c *Client) StatsSnapshot() ClientStats {
+	return c.stats.snapshot()
+}
+
+// PublishExpvar publishes the counters of the client as expvar variable with the given name, so they are served by
+// the /debug/vars handler of package expvar. Like expvar.Publish, it panics if the name is already in use
+func (c *Client) PublishExpvar(name string) {
+	expvar.Publish(name, expvar.Func(func() interface{} {
+		return c.StatsSnapshot()
+	}))
+}
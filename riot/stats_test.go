@@ -0,0 +1,84 @@
+package riot
+
+import (
+	"encoding/json"
+	"expvar"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mjourard/golio/api"
+	"github.com/mjourard/golio/mock"
+)
+
+func TestClient_StatsSnapshot(t *testing.T) {
+	t.Parallel()
+	doer := mock.NewSequenceDoer(mock.RateLimited(1, 0), mock.OK(Summoner{}), mock.Status(http.StatusNotFound, 1))
+	client := NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger())
+	assert.Equal(t, ClientStats{}, client.StatsSnapshot())
+	_, _ = client.Summoner.GetByName("name")
+	_, _ = client.Summoner.GetByName("name")
+	got := client.StatsSnapshot()
+	assert.WithinDuration(t, time.Now(), got.LastRateLimited, time.Minute)
+	got.LastRateLimited = time.Time{}
+	assert.Equal(t, ClientStats{
+		Requests:    3,
+		Errors:      2,
+		RateLimited: 1,
+		Retries:     1,
+		Families: map[string]FamilyStats{
+			"summoner-v4": {Requests: 3, Retries: 1, RateLimited: 1},
+		},
+	}, got)
+}
+
+func TestClientStats_families(t *testing.T) {
+	t.Parallel()
+	stats := &clientStats{}
+	stats.begin()
+	stats.end("match-v4", http.StatusServiceUnavailable, nil)
+	stats.retry("match-v4", time.Second)
+	stats.begin()
+	stats.end("match-v4", http.StatusTooManyRequests, nil)
+	stats.retry("match-v4", 2*time.Second)
+	stats.begin()
+	stats.end("league-v4", http.StatusOK, nil)
+	got := stats.snapshot()
+	assert.Equal(t, int64(2), got.Retries)
+	assert.Equal(t, 3*time.Second, got.Backoff)
+	assert.Equal(t, map[string]FamilyStats{
+		"match-v4":  {Requests: 2, Retries: 2, Backoff: 3 * time.Second, RateLimited: 1, Unavailable: 1},
+		"league-v4": {Requests: 1},
+	}, got.Families)
+}
+
+func TestClient_StatsSnapshot_inFlight(t *testing.T) {
+	t.Parallel()
+	var client *Client
+	var inFlight int64
+	doer := &mock.Doer{Custom: func(r *http.Request) (*http.Response, error) {
+		inFlight = client.StatsSnapshot().InFlight
+		return mock.NewJSONMockDoer(Summoner{}, 200).Do(r)
+	}}
+	client = NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger())
+	_, err := client.Summoner.GetByName("name")
+	require.Nil(t, err)
+	assert.Equal(t, int64(1), inFlight)
+	assert.Equal(t, int64(0), client.StatsSnapshot().InFlight)
+}
+
+func TestClient_PublishExpvar(t *testing.T) {
+	t.Parallel()
+	client := NewClient(api.RegionEuropeWest, "API_KEY", mock.NewJSONMockDoer(Summoner{}, 200),
+		logrus.StandardLogger())
+	client.PublishExpvar("golio_test_client")
+	_, err := client.Summoner.GetByName("name")
+	require.Nil(t, err)
+	var got ClientStats
+	require.Nil(t, json.Unmarshal([]byte(expvar.Get("golio_test_client").String()), &got))
+	assert.Equal(t, int64(1), got.Requests)
+}
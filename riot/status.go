@@ -1,6 +1,13 @@
 package riot
 
-import log "github.com/sirupsen/logrus"
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
 
 type statusClient struct {
 	c *Client
@@ -16,6 +23,198 @@ func (s *statusClient) Get() (*Status, error) {
 	}
 	return status, nil
 }
+
+// GetPlatformData returns the current maintenances and incidents of the platform of the Region from version 4 of the
+// status API
+func (s *statusClient) GetPlatformData() (*PlatformData, error) {
+	logger := s.logger().WithField("method", "GetPlatformData")
+	var data *PlatformData
+	if err := s.c.getInto(endpointGetPlatformData, &data); err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
+	return data, nil
+}
+
+// StatusEventType is the kind of change reported by Watch
+type StatusEventType string
+
+// All possible status event types
+const (
+	StatusEventTypeIncidentPublished    StatusEventType = "INCIDENT_PUBLISHED"
+	StatusEventTypeIncidentUpdated      StatusEventType = "INCIDENT_UPDATED"
+	StatusEventTypeMaintenancePublished StatusEventType = "MAINTENANCE_PUBLISHED"
+	StatusEventTypeMaintenanceUpdated   StatusEventType = "MAINTENANCE_UPDATED"
+)
+
+// StatusEvent value returned by Watch, containing either a published or updated incident, a published or updated
+// maintenance or an error
+type StatusEvent struct {
+	*Incident
+	Type StatusEventType
+	// The service the incident was first found on
+	Service *Service
+	// The maintenance of a maintenance event
+	Maintenance *StatusEntry
+	Error       error
+}
+
+// Watch polls the status of the services for the Region in the given interval and emits an event every time an
+// incident or maintenance is published or updated. Incidents are read from the shard data and deduplicated by their
+// ID, so an incident listed for multiple services is only reported once. Maintenances are read from the platform data
+// of version 4 of the status API. Incidents and maintenances present on the first poll are used as baseline and are
+// not emitted.
+// Failed requests are emitted as errors and polling continues. The returned channel is closed once ctx is done.
+func (s *statusClient) Watch(ctx context.Context, interval time.Duration) <-chan StatusEvent {
+	logger := s.logger().WithField("method", "Watch")
+	cEvents := make(chan StatusEvent, 10)
+	go func() {
+		defer close(cEvents)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		var incidents, maintenances map[int]string
+		client := s.c.WithContext(ctx).Status
+		for {
+			var ok bool
+			if incidents, ok = watchIncidents(ctx, client, cEvents, incidents, logger); !ok {
+				return
+			}
+			if maintenances, ok = watchMaintenances(ctx, client, cEvents, maintenances, logger); !ok {
+				return
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return cEvents
+}
+
+// watchIncidents emits the incidents of the shard data which are not known or changed and returns the fingerprints of
+// the current incidents. A nil known is used as baseline. The returned bool is false if ctx is done
+func watchIncidents(ctx context.Context, client *statusClient, events chan<- StatusEvent, known map[int]string,
+	logger log.FieldLogger) (map[int]string, bool) {
+	status, err := client.Get()
+	if err != nil {
+		logger.Debug(err)
+		return known, sendStatusEvent(ctx, events, StatusEvent{Error: err})
+	}
+	if status == nil {
+		return known, true
+	}
+	current := map[int]string{}
+	for _, service := range status.Services {
+		for _, incident := range service.Incidents {
+			if incident == nil {
+				continue
+			}
+			if _, ok := current[incident.ID]; ok {
+				continue
+			}
+			fingerprint := incidentFingerprint(incident)
+			current[incident.ID] = fingerprint
+			if known == nil {
+				continue
+			}
+			event := StatusEvent{Incident: incident, Service: service}
+			previous, ok := known[incident.ID]
+			switch {
+			case !ok:
+				event.Type = StatusEventTypeIncidentPublished
+			case previous != fingerprint:
+				event.Type = StatusEventTypeIncidentUpdated
+			default:
+				continue
+			}
+			if !sendStatusEvent(ctx, events, event) {
+				return current, false
+			}
+		}
+	}
+	return current, true
+}
+
+// watchMaintenances emits the maintenances of the platform data which are not known or changed and returns the
+// fingerprints of the current maintenances. A nil known is used as baseline. The returned bool is false if ctx is done
+func watchMaintenances(ctx context.Context, client *statusClient, events chan<- StatusEvent, known map[int]string,
+	logger log.FieldLogger) (map[int]string, bool) {
+	data, err := client.GetPlatformData()
+	if err != nil {
+		logger.Debug(err)
+		return known, sendStatusEvent(ctx, events, StatusEvent{Error: err})
+	}
+	if data == nil {
+		return known, true
+	}
+	current := map[int]string{}
+	for _, maintenance := range data.Maintenances {
+		if maintenance == nil {
+			continue
+		}
+		if _, ok := current[maintenance.ID]; ok {
+			continue
+		}
+		fingerprint := maintenanceFingerprint(maintenance)
+		current[maintenance.ID] = fingerprint
+		if known == nil {
+			continue
+		}
+		event := StatusEvent{Maintenance: maintenance}
+		previous, ok := known[maintenance.ID]
+		switch {
+		case !ok:
+			event.Type = StatusEventTypeMaintenancePublished
+		case previous != fingerprint:
+			event.Type = StatusEventTypeMaintenanceUpdated
+		default:
+			continue
+		}
+		if !sendStatusEvent(ctx, events, event) {
+			return current, false
+		}
+	}
+	return current, true
+}
+
 func (s *statusClient) logger() log.FieldLogger {
-	return s.c.logger().WithField("category", "status")
+	return s.c.categoryLogger(LogCategoryStatus)
+}
+
+func sendStatusEvent(ctx context.Context, c chan<- StatusEvent, event StatusEvent) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case c <- event:
+		return true
+	}
+}
+
+// incidentFingerprint returns a value that changes whenever the incident or one of its updates changes
+func incidentFingerprint(incident *Incident) string {
+	parts := []string{"inactive"}
+	if incident.Active {
+		parts[0] = "active"
+	}
+	for _, update := range incident.Updates {
+		if update == nil {
+			continue
+		}
+		parts = append(parts, update.ID+"@"+update.UpdatedAt)
+	}
+	return strings.Join(parts, ";")
+}
+
+// maintenanceFingerprint returns a value that changes whenever the status of the maintenance or one of its updates
+// changes
+func maintenanceFingerprint(maintenance *StatusEntry) string {
+	parts := []string{maintenance.MaintenanceStatus, maintenance.UpdatedAt}
+	for _, update := range maintenance.Updates {
+		if update == nil {
+			continue
+		}
+		parts = append(parts, strconv.Itoa(update.ID)+"@"+update.UpdatedAt)
+	}
+	return strings.Join(parts, ";")
 }
@@ -1,9 +1,12 @@
 package riot
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
@@ -11,7 +14,7 @@ import (
 
 	"github.com/mjourard/golio/api"
 	"github.com/mjourard/golio/internal"
-	"github.com/mjourard/golio/internal/mock"
+	"github.com/mjourard/golio/mock"
 )
 
 func TestStatusClient_Get(t *testing.T) {
@@ -43,12 +46,12 @@ func TestStatusClient_Get(t *testing.T) {
 		{
 			name: "rate limited",
 			want: &Status{},
-			doer: rateLimitDoer(Status{}),
+			doer: mock.NewRateLimitDoer(Status{}, 1, 1),
 		},
 		{
 			name: "unavailable once",
 			want: &Status{},
-			doer: unavailableOnceDoer(Status{}),
+			doer: mock.NewUnavailableDoer(Status{}, 1),
 		},
 		{
 			name:    "unavailable twice",
@@ -60,10 +63,161 @@ func TestStatusClient_Get(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			client := NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
 			got, err := client.Status.Get()
-			require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+			require.True(t, errors.Is(err, tt.wantErr), fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
 			if tt.wantErr == nil {
 				assert.Equal(t, got, tt.want)
 			}
 		})
 	}
 }
+
+func TestStatusClient_GetPlatformData(t *testing.T) {
+	t.Parallel()
+	want := &PlatformData{ID: "EUW1", Maintenances: []*StatusEntry{{ID: 1, MaintenanceStatus: "scheduled"}}}
+	client := NewClient(api.RegionEuropeWest, "API_KEY", statusDoer(nil, []PlatformData{*want}),
+		logrus.StandardLogger())
+	got, err := client.Status.GetPlatformData()
+	require.Nil(t, err)
+	assert.Equal(t, want, got)
+
+	client = NewClient(api.RegionEuropeWest, "API_KEY", mock.NewStatusMockDoer(http.StatusNotFound),
+		logrus.StandardLogger())
+	_, err = client.Status.GetPlatformData()
+	assert.True(t, errors.Is(err, api.ErrNotFound))
+}
+
+func TestStatusClient_Watch(t *testing.T) {
+	t.Parallel()
+	incident := func(id int, updates ...string) *Incident {
+		res := &Incident{ID: id, Active: true}
+		for _, update := range updates {
+			res.Updates = append(res.Updates, &StatusMessage{ID: update, UpdatedAt: update})
+		}
+		return res
+	}
+	game := func(incidents ...*Incident) *Service {
+		return &Service{Slug: "game", Incidents: incidents}
+	}
+	store := func(incidents ...*Incident) *Service {
+		return &Service{Slug: "store", Incidents: incidents}
+	}
+	maintenance := func(id int, status string, updates ...int) *StatusEntry {
+		res := &StatusEntry{ID: id, MaintenanceStatus: status}
+		for _, update := range updates {
+			res.Updates = append(res.Updates, &StatusUpdate{ID: update, UpdatedAt: "2020-01-01T00:00:00Z"})
+		}
+		return res
+	}
+	tests := []struct {
+		name string
+		doer internal.Doer
+		want []StatusEvent
+	}{
+		{
+			name: "published",
+			doer: statusDoer([]Status{
+				{Services: []*Service{game(incident(1, "a"))}},
+				{Services: []*Service{game(incident(1, "a"), incident(2, "b")), store(incident(2, "b"))}},
+			}, nil),
+			want: []StatusEvent{
+				{
+					Incident: incident(2, "b"),
+					Type:     StatusEventTypeIncidentPublished,
+					Service:  game(incident(1, "a"), incident(2, "b")),
+				},
+			},
+		},
+		{
+			name: "updated",
+			doer: statusDoer([]Status{
+				{Services: []*Service{game(incident(1, "a"))}},
+				{Services: []*Service{game(incident(1, "a"))}},
+				{Services: []*Service{game(incident(1, "a", "b"))}},
+			}, nil),
+			want: []StatusEvent{
+				{
+					Incident: incident(1, "a", "b"),
+					Type:     StatusEventTypeIncidentUpdated,
+					Service:  game(incident(1, "a", "b")),
+				},
+			},
+		},
+		{
+			name: "maintenance published",
+			doer: statusDoer(nil, []PlatformData{
+				{Maintenances: []*StatusEntry{maintenance(1, "scheduled")}},
+				{Maintenances: []*StatusEntry{maintenance(1, "scheduled"), maintenance(2, "scheduled")}},
+			}),
+			want: []StatusEvent{
+				{Maintenance: maintenance(2, "scheduled"), Type: StatusEventTypeMaintenancePublished},
+			},
+		},
+		{
+			name: "maintenance updated",
+			doer: statusDoer(nil, []PlatformData{
+				{Maintenances: []*StatusEntry{maintenance(1, "scheduled")}},
+				{Maintenances: []*StatusEntry{maintenance(1, "scheduled")}},
+				{Maintenances: []*StatusEntry{maintenance(1, "in_progress", 7)}},
+			}),
+			want: []StatusEvent{
+				{Maintenance: maintenance(1, "in_progress", 7), Type: StatusEventTypeMaintenanceUpdated},
+			},
+		},
+		{
+			name: "request error",
+			doer: mock.NewStatusMockDoer(http.StatusNotFound),
+			want: []StatusEvent{{Error: api.ErrNotFound}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			client := NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
+			events := client.Status.Watch(ctx, time.Millisecond)
+			for _, want := range tt.want {
+				select {
+				case got := <-events:
+					if want.Error != nil {
+						assert.True(t, errors.Is(got.Error, want.Error), "got error %v", got.Error)
+						got.Error = want.Error
+					}
+					assert.Equal(t, want, got)
+				case <-time.After(time.Second):
+					t.Fatal("no event emitted")
+				}
+			}
+			cancel()
+			for range events {
+			}
+		})
+	}
+}
+
+// statusDoer answers the requests of the shard data and the platform data with their own sequence of responses. An
+// empty response is returned for an endpoint without responses
+func statusDoer(statuses []Status, platforms []PlatformData) internal.Doer {
+	shardData := []interface{}{Status{}}
+	if len(statuses) > 0 {
+		shardData = nil
+		for _, status := range statuses {
+			shardData = append(shardData, status)
+		}
+	}
+	platformData := []interface{}{PlatformData{}}
+	if len(platforms) > 0 {
+		platformData = nil
+		for _, platform := range platforms {
+			platformData = append(platformData, platform)
+		}
+	}
+	shardDataDoer, platformDataDoer := sequenceDoer(shardData...), sequenceDoer(platformData...)
+	return &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			if r.URL.Path == endpointGetPlatformData {
+				return platformDataDoer.Do(r)
+			}
+			return shardDataDoer.Do(r)
+		},
+	}
+}
@@ -31,6 +31,14 @@ func (s *summonerClient) GetByID(summonerID string) (*Summoner, error) {
 }
 
 func (s *summonerClient) getBy(by Identification, value string, logger log.FieldLogger) (*Summoner, error) {
+	err := validateID(string(by), value)
+	if by == identificationPUUID {
+		err = validatePUUID(value)
+	}
+	if err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
 	var endpoint string
 	switch by {
 	case identificationSummonerID:
@@ -47,5 +55,5 @@ func (s *summonerClient) getBy(by Identification, value string, logger log.Field
 }
 
 func (s *summonerClient) logger() log.FieldLogger {
-	return s.c.logger().WithField("category", "summoner")
+	return s.c.categoryLogger(LogCategorySummoner)
 }
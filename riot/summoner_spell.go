@@ -0,0 +1,108 @@
+package riot
+
+import (
+	"strconv"
+	"strings"
+)
+
+// SummonerSpell is the numeric ID of a summoner spell as used by the Riot API, e.g. Spell1ID of a participant
+type SummonerSpell int
+
+// All current summoner spells. The constants are typed individually so that their methods can be called directly,
+// e.g. SummonerSpellFlash.String()
+const (
+	SummonerSpellCleanse     SummonerSpell = 1
+	SummonerSpellExhaust     SummonerSpell = 3
+	SummonerSpellFlash       SummonerSpell = 4
+	SummonerSpellGhost       SummonerSpell = 6
+	SummonerSpellHeal        SummonerSpell = 7
+	SummonerSpellSmite       SummonerSpell = 11
+	SummonerSpellTeleport    SummonerSpell = 12
+	SummonerSpellClarity     SummonerSpell = 13
+	SummonerSpellIgnite      SummonerSpell = 14
+	SummonerSpellBarrier     SummonerSpell = 21
+	SummonerSpellToTheKing   SummonerSpell = 30
+	SummonerSpellPoroToss    SummonerSpell = 31
+	SummonerSpellMark        SummonerSpell = 32
+	SummonerSpellURFMark     SummonerSpell = 39
+	SummonerSpellPlaceholder SummonerSpell = 54
+)
+
+type summonerSpellInfo struct {
+	key  string
+	name string
+}
+
+var summonerSpellData = map[SummonerSpell]summonerSpellInfo{
+	SummonerSpellCleanse:     {"SummonerBoost", "Cleanse"},
+	SummonerSpellExhaust:     {"SummonerExhaust", "Exhaust"},
+	SummonerSpellFlash:       {"SummonerFlash", "Flash"},
+	SummonerSpellGhost:       {"SummonerHaste", "Ghost"},
+	SummonerSpellHeal:        {"SummonerHeal", "Heal"},
+	SummonerSpellSmite:       {"SummonerSmite", "Smite"},
+	SummonerSpellTeleport:    {"SummonerTeleport", "Teleport"},
+	SummonerSpellClarity:     {"SummonerMana", "Clarity"},
+	SummonerSpellIgnite:      {"SummonerDot", "Ignite"},
+	SummonerSpellBarrier:     {"SummonerBarrier", "Barrier"},
+	SummonerSpellToTheKing:   {"SummonerPoroRecall", "To the King!"},
+	SummonerSpellPoroToss:    {"SummonerPoroThrow", "Poro Toss"},
+	SummonerSpellMark:        {"SummonerSnowball", "Mark"},
+	SummonerSpellURFMark:     {"SummonerSnowURFSnowball_Mark", "Mark"},
+	SummonerSpellPlaceholder: {"Summoner_UltBookPlaceholder", "Placeholder"},
+}
+
+// SummonerSpells is a list of all known summoner spells in ascending order
+var SummonerSpells = []SummonerSpell{
+	SummonerSpellCleanse, SummonerSpellExhaust, SummonerSpellFlash, SummonerSpellGhost, SummonerSpellHeal,
+	SummonerSpellSmite, SummonerSpellTeleport, SummonerSpellClarity, SummonerSpellIgnite, SummonerSpellBarrier,
+	SummonerSpellToTheKing, SummonerSpellPoroToss, SummonerSpellMark, SummonerSpellURFMark,
+	SummonerSpellPlaceholder,
+}
+
+// String returns the name of the summoner spell, e.g. "Flash", or "Summoner Spell <id>" for unknown spells
+func (s SummonerSpell) String() string {
+	if info, ok := summonerSpellData[s]; ok {
+		return info.name
+	}
+	return "Summoner Spell " + strconv.Itoa(int(s))
+}
+
+// Name returns the name of the summoner spell, e.g. "Flash", or an empty string for unknown spells
+func (s SummonerSpell) Name() string {
+	return summonerSpellData[s].name
+}
+
+// Key returns the ID of the summoner spell used by Data Dragon, e.g. "SummonerFlash", or an empty string for unknown
+// spells
+func (s SummonerSpell) Key() string {
+	return summonerSpellData[s].key
+}
+
+// IsKnown returns whether the summoner spell is one of the spells in SummonerSpells
+func (s SummonerSpell) IsKnown() bool {
+	_, ok := summonerSpellData[s]
+	return ok
+}
+
+// dataDragonID returns the ID used to look up the spell in Data Dragon. Unknown spells are looked up by their
+// numeric ID
+func (s SummonerSpell) dataDragonID() string {
+	if key := s.Key(); key != "" {
+		return key
+	}
+	return strconv.Itoa(int(s))
+}
+
+// SummonerSpellByName returns the summoner spell with the given name or Data Dragon ID, ignoring case, e.g. "flash"
+// or "SummonerFlash". The second return value is false if no such spell is known. Names shared by several spells,
+// e.g. "Mark", return the spell with the lowest ID
+func SummonerSpellByName(name string) (SummonerSpell, bool) {
+	name = strings.TrimSpace(name)
+	for _, s := range SummonerSpells {
+		info := summonerSpellData[s]
+		if strings.EqualFold(info.name, name) || strings.EqualFold(info.key, name) {
+			return s, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,44 @@
+package riot
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSummonerSpell(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "Flash", SummonerSpellFlash.String())
+	assert.Equal(t, "Ignite", SummonerSpell(14).Name())
+	assert.Equal(t, "SummonerDot", SummonerSpellIgnite.Key())
+	assert.True(t, SummonerSpellSmite.IsKnown())
+	assert.Equal(t, "Summoner Spell 99", SummonerSpell(99).String())
+	assert.Equal(t, "", SummonerSpell(99).Key())
+	assert.False(t, SummonerSpell(99).IsKnown())
+	assert.Equal(t, "SummonerHaste", SummonerSpellGhost.dataDragonID())
+	assert.Equal(t, "99", SummonerSpell(99).dataDragonID())
+	for _, s := range SummonerSpells {
+		assert.True(t, s.IsKnown(), s)
+	}
+}
+
+func TestSummonerSpellByName(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		want   SummonerSpell
+		wantOK bool
+	}{
+		{name: "Flash", want: SummonerSpellFlash, wantOK: true},
+		{name: " teleport ", want: SummonerSpellTeleport, wantOK: true},
+		{name: "summonerdot", want: SummonerSpellIgnite, wantOK: true},
+		{name: "to the king!", want: SummonerSpellToTheKing, wantOK: true},
+		{name: "Mark", want: SummonerSpellMark, wantOK: true},
+		{name: "Unknown"},
+	}
+	for _, test := range tests {
+		got, ok := SummonerSpellByName(test.name)
+		assert.Equal(t, test.wantOK, ok, test.name)
+		assert.Equal(t, test.want, got, test.name)
+	}
+}
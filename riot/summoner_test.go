@@ -1,6 +1,7 @@
 package riot
 
 import (
+	"errors"
 	"net/http"
 	"testing"
 
@@ -9,7 +10,7 @@ import (
 
 	"github.com/mjourard/golio/api"
 	"github.com/mjourard/golio/internal"
-	"github.com/mjourard/golio/internal/mock"
+	"github.com/mjourard/golio/mock"
 )
 
 func TestSummonerClient_GetByName(t *testing.T) {
@@ -41,12 +42,12 @@ func TestSummonerClient_GetByName(t *testing.T) {
 		{
 			name: "rate limited",
 			want: &Summoner{},
-			doer: rateLimitDoer(&Summoner{}),
+			doer: mock.NewRateLimitDoer(&Summoner{}, 1, 1),
 		},
 		{
 			name: "unavailable once",
 			want: &Summoner{},
-			doer: unavailableOnceDoer(&Summoner{}),
+			doer: mock.NewUnavailableDoer(&Summoner{}, 1),
 		},
 		{
 			name:    "unavailable twice",
@@ -59,7 +60,7 @@ func TestSummonerClient_GetByName(t *testing.T) {
 			var err error
 			client := NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
 			got, err := client.Summoner.GetByName("name")
-			assert.Equal(t, err, tt.wantErr)
+			assert.True(t, errors.Is(err, tt.wantErr))
 			if tt.wantErr == nil {
 				assert.Equal(t, got, tt.want)
 			}
@@ -96,12 +97,12 @@ func TestSummonerClient_GetByAccountID(t *testing.T) {
 		{
 			name: "rate limited",
 			want: &Summoner{},
-			doer: rateLimitDoer(&Summoner{}),
+			doer: mock.NewRateLimitDoer(&Summoner{}, 1, 1),
 		},
 		{
 			name: "unavailable once",
 			want: &Summoner{},
-			doer: unavailableOnceDoer(&Summoner{}),
+			doer: mock.NewUnavailableDoer(&Summoner{}, 1),
 		},
 		{
 			name:    "unavailable twice",
@@ -114,7 +115,7 @@ func TestSummonerClient_GetByAccountID(t *testing.T) {
 			var err error
 			client := NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
 			got, err := client.Summoner.GetByAccountID("accountID")
-			assert.Equal(t, err, tt.wantErr)
+			assert.True(t, errors.Is(err, tt.wantErr))
 			if tt.wantErr == nil {
 				assert.Equal(t, got, tt.want)
 			}
@@ -122,6 +123,9 @@ func TestSummonerClient_GetByAccountID(t *testing.T) {
 	}
 }
 
+// testPUUID is a valid encrypted PUUID
+const testPUUID = "5L_HG95CwpWSVcC69ymTDBv3YAXXO2BxSWbogV-sR9CpBL0GrNl4ULhaMIG2nQD8WLmTjzYaOeGqW1"
+
 func TestSummonerClient_GetByPUUID(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -151,12 +155,12 @@ func TestSummonerClient_GetByPUUID(t *testing.T) {
 		{
 			name: "rate limited",
 			want: &Summoner{},
-			doer: rateLimitDoer(&Summoner{}),
+			doer: mock.NewRateLimitDoer(&Summoner{}, 1, 1),
 		},
 		{
 			name: "unavailable once",
 			want: &Summoner{},
-			doer: unavailableOnceDoer(&Summoner{}),
+			doer: mock.NewUnavailableDoer(&Summoner{}, 1),
 		},
 		{
 			name:    "unavailable twice",
@@ -168,8 +172,8 @@ func TestSummonerClient_GetByPUUID(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			var err error
 			client := NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
-			got, err := client.Summoner.GetByPUUID("puuid")
-			assert.Equal(t, err, tt.wantErr)
+			got, err := client.Summoner.GetByPUUID(testPUUID)
+			assert.True(t, errors.Is(err, tt.wantErr))
 			if tt.wantErr == nil {
 				assert.Equal(t, got, tt.want)
 			}
@@ -206,12 +210,12 @@ func TestSummonerClient_GetByID(t *testing.T) {
 		{
 			name: "rate limited",
 			want: &Summoner{},
-			doer: rateLimitDoer(&Summoner{}),
+			doer: mock.NewRateLimitDoer(&Summoner{}, 1, 1),
 		},
 		{
 			name: "unavailable once",
 			want: &Summoner{},
-			doer: unavailableOnceDoer(&Summoner{}),
+			doer: mock.NewUnavailableDoer(&Summoner{}, 1),
 		},
 		{
 			name:    "unavailable twice",
@@ -224,7 +228,7 @@ func TestSummonerClient_GetByID(t *testing.T) {
 			var err error
 			client := NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
 			got, err := client.Summoner.GetByID("id")
-			assert.Equal(t, err, tt.wantErr)
+			assert.True(t, errors.Is(err, tt.wantErr))
 			if tt.wantErr == nil {
 				assert.Equal(t, got, tt.want)
 			}
@@ -0,0 +1,117 @@
+package riot
+
+import (
+	"fmt"
+	"strings"
+)
+
+// The string types of this package implement encoding.TextMarshaler and encoding.TextUnmarshaler, so they can be
+// used in configuration files, flags and URLs. Unmarshaling ignores case and fails for unknown values, except for
+// MatchEventType, because the Riot API adds new event types regularly
+
+// ParseQueue returns the ranked queue with the given name, ignoring case, e.g. "ranked_solo_5x5"
+func ParseQueue(s string) (Queue, error) {
+	for _, queue := range Queues {
+		if strings.EqualFold(string(queue), strings.TrimSpace(s)) {
+			return queue, nil
+		}
+	}
+	return "", fmt.Errorf("riot: unknown queue %q", s)
+}
+
+// String returns the name of the queue as used by the Riot API, e.g. RANKED_SOLO_5x5
+func (q Queue) String() string {
+	return string(q)
+}
+
+// MarshalText returns the name of the queue
+func (q Queue) MarshalText() ([]byte, error) {
+	return []byte(q), nil
+}
+
+// UnmarshalText parses the name of a queue using ParseQueue
+func (q *Queue) UnmarshalText(text []byte) error {
+	queue, err := ParseQueue(string(text))
+	if err != nil {
+		return err
+	}
+	*q = queue
+	return nil
+}
+
+// ParseTier returns the tier with the given name, ignoring case, e.g. "gold"
+func ParseTier(s string) (Tier, error) {
+	for _, tier := range orderedTiers {
+		if strings.EqualFold(string(tier), strings.TrimSpace(s)) {
+			return tier, nil
+		}
+	}
+	return "", fmt.Errorf("riot: unknown tier %q", s)
+}
+
+// String returns the name of the tier as used by the Riot API, e.g. GOLD
+func (t Tier) String() string {
+	return string(t)
+}
+
+// MarshalText returns the name of the tier
+func (t Tier) MarshalText() ([]byte, error) {
+	return []byte(t), nil
+}
+
+// UnmarshalText parses the name of a tier using ParseTier
+func (t *Tier) UnmarshalText(text []byte) error {
+	tier, err := ParseTier(string(text))
+	if err != nil {
+		return err
+	}
+	*t = tier
+	return nil
+}
+
+// ParseDivision returns the division given as roman or arabic numeral, ignoring case, e.g. "iv" or "4"
+func ParseDivision(s string) (Division, error) {
+	s = strings.TrimSpace(s)
+	for i, division := range Divisions {
+		if strings.EqualFold(string(division), s) || fmt.Sprint(i+1) == s {
+			return division, nil
+		}
+	}
+	return "", fmt.Errorf("riot: unknown division %q", s)
+}
+
+// String returns the division as roman numeral, e.g. IV
+func (d Division) String() string {
+	return string(d)
+}
+
+// MarshalText returns the division as roman numeral
+func (d Division) MarshalText() ([]byte, error) {
+	return []byte(d), nil
+}
+
+// UnmarshalText parses a division using ParseDivision
+func (d *Division) UnmarshalText(text []byte) error {
+	division, err := ParseDivision(string(text))
+	if err != nil {
+		return err
+	}
+	*d = division
+	return nil
+}
+
+// String returns the name of the event type as used by the Riot API, e.g. CHAMPION_KILL
+func (e MatchEventType) String() string {
+	return string(e)
+}
+
+// MarshalText returns the name of the event type
+func (e MatchEventType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText sets the event type to the upper case text. Unknown event types are accepted
+func (e *MatchEventType) UnmarshalText(text []byte) error {
+	*e = MatchEventType(strings.ToUpper(strings.TrimSpace(string(text))))
+	return nil
+}
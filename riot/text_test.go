@@ -0,0 +1,81 @@
+package riot
+
+import (
+	"encoding"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestText_roundTrip(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		value  encoding.TextMarshaler
+		target encoding.TextUnmarshaler
+		text   string
+	}{
+		{name: "queue", value: QueueRankedSolo, target: new(Queue), text: "RANKED_SOLO_5x5"},
+		{name: "tier", value: Tier(TierGrandmaster), target: new(Tier), text: "GRANDMASTER"},
+		{name: "division", value: Division(DivisionThree), target: new(Division), text: "III"},
+		{name: "event type", value: MatchEventTypeWardKill, target: new(MatchEventType), text: "WARD_KILL"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			text, err := test.value.MarshalText()
+			require.Nil(t, err)
+			assert.Equal(t, test.text, string(text))
+			require.Nil(t, test.target.UnmarshalText(text))
+			assert.Equal(t, test.text, test.target.(interface{ String() string }).String())
+		})
+	}
+}
+
+func TestText_parse(t *testing.T) {
+	t.Parallel()
+	var queue Queue
+	assert.Nil(t, queue.UnmarshalText([]byte("ranked_flex_sr")))
+	assert.Equal(t, Queue(QueueRankedFlex), queue)
+	assert.NotNil(t, queue.UnmarshalText([]byte("RANKED_ARAM")))
+
+	var tier Tier
+	assert.Nil(t, tier.UnmarshalText([]byte(" gold ")))
+	assert.Equal(t, Tier(TierGold), tier)
+	assert.NotNil(t, tier.UnmarshalText([]byte("wood")))
+
+	var division Division
+	assert.Nil(t, division.UnmarshalText([]byte("iv")))
+	assert.Equal(t, Division(DivisionFour), division)
+	assert.Nil(t, division.UnmarshalText([]byte("2")))
+	assert.Equal(t, Division(DivisionTwo), division)
+	assert.NotNil(t, division.UnmarshalText([]byte("V")))
+
+	var eventType MatchEventType
+	assert.Nil(t, eventType.UnmarshalText([]byte("feat_update")))
+	assert.Equal(t, MatchEventType("FEAT_UPDATE"), eventType)
+}
+
+func TestText_json(t *testing.T) {
+	t.Parallel()
+	config := struct {
+		Queue    Queue    `json:"queue"`
+		Tiers    []Tier   `json:"tiers"`
+		Division Division `json:"division"`
+	}{}
+	require.Nil(t, json.Unmarshal([]byte(`{"queue":"RANKED_SOLO_5x5","tiers":["iron","master"],"division":"1"}`),
+		&config))
+	assert.Equal(t, Queue(QueueRankedSolo), config.Queue)
+	assert.Equal(t, []Tier{TierIron, TierMaster}, config.Tiers)
+	assert.Equal(t, DivisionOne, config.Division)
+	data, err := json.Marshal(config)
+	require.Nil(t, err)
+	assert.JSONEq(t, `{"queue":"RANKED_SOLO_5x5","tiers":["IRON","MASTER"],"division":"I"}`, string(data))
+
+	event := MatchEvent{}
+	require.Nil(t, json.Unmarshal([]byte(`{"type":null}`), &event))
+	assert.Equal(t, MatchEventType(""), event.Type)
+	require.Nil(t, json.Unmarshal([]byte(`{"type":"NEW_EVENT"}`), &event))
+	assert.Equal(t, MatchEventType("NEW_EVENT"), event.Type)
+}
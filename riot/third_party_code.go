@@ -15,6 +15,10 @@ func (t *thirdPartyCodeClient) Get(summonerID string) (string, error) {
 	logger := t.logger().WithFields(log.Fields{
 		"method": "Get",
 	})
+	if err := validateID("summonerID", summonerID); err != nil {
+		logger.Debug(err)
+		return "", err
+	}
 	var code string
 	if err := t.c.getInto(fmt.Sprintf(endpointGetThirdPartyCode, summonerID), &code); err != nil {
 		logger.Debug(err)
@@ -24,5 +28,5 @@ func (t *thirdPartyCodeClient) Get(summonerID string) (string, error) {
 }
 
 func (t *thirdPartyCodeClient) logger() log.FieldLogger {
-	return t.c.logger().WithField("category", "third party code")
+	return t.c.categoryLogger(LogCategoryThirdPartyCode)
 }
@@ -1,6 +1,7 @@
 package riot
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
@@ -11,7 +12,7 @@ import (
 
 	"github.com/mjourard/golio/api"
 	"github.com/mjourard/golio/internal"
-	"github.com/mjourard/golio/internal/mock"
+	"github.com/mjourard/golio/mock"
 )
 
 func TestThirdPartyCodeClient_Get(t *testing.T) {
@@ -43,12 +44,12 @@ func TestThirdPartyCodeClient_Get(t *testing.T) {
 		{
 			name: "rate limited",
 			want: "code",
-			doer: rateLimitDoer("code"),
+			doer: mock.NewRateLimitDoer("code", 1, 1),
 		},
 		{
 			name: "unavailable once",
 			want: "code",
-			doer: unavailableOnceDoer("code"),
+			doer: mock.NewUnavailableDoer("code", 1),
 		},
 		{
 			name:    "unavailable twice",
@@ -60,7 +61,7 @@ func TestThirdPartyCodeClient_Get(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			client := NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
 			got, err := client.ThirdPartyCode.Get("id")
-			require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+			require.True(t, errors.Is(err, tt.wantErr), fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
 			if tt.wantErr == nil {
 				assert.Equal(t, got, tt.want)
 			}
@@ -0,0 +1,66 @@
+package riot
+
+// orderedTiers are all tiers from the lowest to the highest
+var orderedTiers = append(append([]Tier{}, Tiers...), apexTiers...)
+
+// value returns the position of the tier in orderedTiers starting at 1, or 0 for unknown tiers
+func (t Tier) value() int {
+	for i, tier := range orderedTiers {
+		if tier == t {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// IsApex returns whether the tier is one of the tiers above TierDiamond, which only have division I
+func (t Tier) IsApex() bool {
+	for _, tier := range apexTiers {
+		if tier == t {
+			return true
+		}
+	}
+	return false
+}
+
+// Compare returns -1 if t is lower than other, 1 if t is higher than other and 0 if both are equal. Unknown tiers are
+// lower than all known tiers
+func (t Tier) Compare(other Tier) int {
+	return compareInts(t.value(), other.value())
+}
+
+// Less returns whether t is lower than other
+func (t Tier) Less(other Tier) bool {
+	return t.Compare(other) < 0
+}
+
+// value returns 4 for division I down to 1 for division IV, or 0 for unknown divisions
+func (d Division) value() int {
+	for i, division := range Divisions {
+		if division == d {
+			return len(Divisions) - i
+		}
+	}
+	return 0
+}
+
+// Compare returns -1 if d is lower than other, 1 if d is higher than other and 0 if both are equal. Division I is the
+// highest division of a tier, unknown divisions are lower than all known divisions
+func (d Division) Compare(other Division) int {
+	return compareInts(d.value(), other.value())
+}
+
+// Less returns whether d is lower than other
+func (d Division) Less(other Division) bool {
+	return d.Compare(other) < 0
+}
+
+func compareInts(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
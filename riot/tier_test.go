@@ -0,0 +1,31 @@
+package riot
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTier_Compare(t *testing.T) {
+	t.Parallel()
+	tiers := []Tier{TierChallenger, TierIron, "UNRANKED", TierMaster, TierGold, TierGrandmaster, TierDiamond}
+	sort.Slice(tiers, func(i, j int) bool {
+		return tiers[i].Less(tiers[j])
+	})
+	assert.Equal(t, []Tier{"UNRANKED", TierIron, TierGold, TierDiamond, TierMaster, TierGrandmaster, TierChallenger},
+		tiers)
+	assert.Equal(t, 0, Tier(TierGold).Compare(TierGold))
+	assert.Equal(t, 1, Tier(TierMaster).Compare(TierDiamond))
+	assert.Equal(t, -1, TierIron.Compare(TierBronze))
+	assert.True(t, Tier(TierGrandmaster).IsApex())
+	assert.False(t, Tier(TierDiamond).IsApex())
+}
+
+func TestDivision_Compare(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, 1, DivisionOne.Compare(DivisionTwo))
+	assert.Equal(t, -1, Division(DivisionFour).Compare(DivisionThree))
+	assert.Equal(t, 0, Division(DivisionTwo).Compare(DivisionTwo))
+	assert.True(t, Division("V").Less(DivisionFour))
+}
@@ -0,0 +1,257 @@
+package riot
+
+import (
+	"io"
+	"sort"
+	"time"
+)
+
+// TimelineEvent is a typed event of a match timeline. Use a type switch on the concrete types
+// (*ChampionKillEvent, *ObjectiveKillEvent, *TurretPlateEvent, *ItemPurchaseEvent, *WardEvent, *SkillLevelUpEvent and
+// *OtherEvent) to access the event specific fields
+type TimelineEvent interface {
+	// Type returns the type of the event. Elite monster kills have the type of the killed monster, e.g.
+	// MatchEventTypeDragonKill, if it is known
+	Type() MatchEventType
+	// Time returns the time since the start of the game at which the event happened
+	Time() time.Duration
+	// Raw returns the event as returned by the Riot API
+	Raw() *MatchEvent
+}
+
+type timelineEvent struct {
+	raw *MatchEvent
+}
+
+// monsterKillTypes are the event types for kills of elite monsters by the monster type
+var monsterKillTypes = map[string]MatchEventType{
+	"DRAGON":       MatchEventTypeDragonKill,
+	"BARON_NASHOR": MatchEventTypeBaronKill,
+	"RIFTHERALD":   MatchEventTypeRiftHeraldKill,
+}
+
+// Type returns the type of the event. Elite monster kills have the type of the killed monster, e.g.
+// MatchEventTypeDragonKill, if it is known
+func (e timelineEvent) Type() MatchEventType {
+	t := eventType(e.raw)
+	if t == MatchEventTypeEliteMonsterKill {
+		if monsterType, ok := monsterKillTypes[e.raw.MonsterType]; ok {
+			return monsterType
+		}
+	}
+	return t
+}
+
+// Time returns the time since the start of the game at which the event happened
+func (e timelineEvent) Time() time.Duration {
+	return e.raw.Timestamp.Duration
+}
+
+// Raw returns the event as returned by the Riot API
+func (e timelineEvent) Raw() *MatchEvent {
+	return e.raw
+}
+
+// ChampionKillEvent is emitted when a champion is killed
+type ChampionKillEvent struct {
+	timelineEvent
+	KillerID                int
+	VictimID                int
+	AssistingParticipantIDs []int
+	Position                *MatchPosition
+}
+
+// ObjectiveKillEvent is emitted when an elite monster (dragon, baron, rift herald) or a building (tower, inhibitor)
+// is killed
+type ObjectiveKillEvent struct {
+	timelineEvent
+	KillerID int
+	// Team owning the destroyed building, only set for buildings
+	TeamID int
+	// Either the monster type (e.g. DRAGON) or the building type (e.g. TOWER_BUILDING)
+	ObjectiveType string
+	// Either the monster sub type (e.g. FIRE_DRAGON) or the tower type (e.g. OUTER_TURRET)
+	ObjectiveSubType string
+	LaneType         string
+	Position         *MatchPosition
+}
+
+// IsBuilding returns whether the killed objective was a building
+func (e *ObjectiveKillEvent) IsBuilding() bool {
+	return eventType(e.raw) == MatchEventTypeBuildingKill
+}
+
+// TurretPlateEvent is emitted when a plate of an outer turret is destroyed
+type TurretPlateEvent struct {
+	timelineEvent
+	// The participant destroying the plate or 0 if it was destroyed by minions
+	KillerID int
+	// Team owning the turret
+	TeamID   int
+	LaneType string
+	Position *MatchPosition
+}
+
+// ItemPurchaseEvent is emitted when a participant buys an item
+type ItemPurchaseEvent struct {
+	timelineEvent
+	ParticipantID int
+	ItemID        int
+}
+
+// WardEvent is emitted when a ward is placed or destroyed
+type WardEvent struct {
+	timelineEvent
+	// Whether the ward was placed or destroyed
+	Placed bool
+	// The participant placing the ward, only set for placed wards
+	CreatorID int
+	// The participant destroying the ward, only set for destroyed wards
+	KillerID int
+	WardType string
+}
+
+// SkillLevelUpEvent is emitted when a participant levels up a skill
+type SkillLevelUpEvent struct {
+	timelineEvent
+	ParticipantID int
+	// The skill which was leveled up, from 1 (Q) to 4 (R)
+	SkillSlot int
+	// Either NORMAL or EVOLVE for skills evolved by some champions
+	LevelUpType string
+}
+
+// OtherEvent is emitted for all events which have no dedicated type
+type OtherEvent struct {
+	timelineEvent
+}
+
+// Events returns all events of the timeline as typed events in chronological order
+func (t *MatchTimeline) Events() []TimelineEvent {
+	var events []TimelineEvent
+	for _, frame := range t.Frames {
+		if frame == nil {
+			continue
+		}
+		for _, event := range frame.Events {
+			if event == nil {
+				continue
+			}
+			events = append(events, newTimelineEvent(event))
+		}
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Time() < events[j].Time()
+	})
+	return events
+}
+
+// TimelineEventStreamValue value returned by StreamTimelineEvents, containing either a timeline event or an error
+type TimelineEventStreamValue struct {
+	Event TimelineEvent
+	Error error
+}
+
+// StreamTimelineEvents returns all events of the timeline for the given match as a stream of typed events in
+// chronological order. After the last event io.EOF is sent as error and the channel is closed.
+// NOTE: timelines are not available for every match
+func (m *matchClient) StreamTimelineEvents(matchID int) <-chan TimelineEventStreamValue {
+	logger := m.logger().WithField("method", "StreamTimelineEvents")
+	cEvents := make(chan TimelineEventStreamValue, 100)
+	go func() {
+		defer close(cEvents)
+		timeline, err := m.GetTimeline(matchID)
+		if err != nil {
+			logger.Debug(err)
+			cEvents <- TimelineEventStreamValue{Error: err}
+			return
+		}
+		for _, event := range timeline.Events() {
+			cEvents <- TimelineEventStreamValue{Event: event}
+		}
+		cEvents <- TimelineEventStreamValue{Error: io.EOF}
+	}()
+	return cEvents
+}
+
+// Typed returns the event as a typed event, see TimelineEvent
+func (e *MatchEvent) Typed() TimelineEvent {
+	return newTimelineEvent(e)
+}
+
+func newTimelineEvent(e *MatchEvent) TimelineEvent {
+	base := timelineEvent{raw: e}
+	switch eventType(e) {
+	case MatchEventTypeChampionKill:
+		return &ChampionKillEvent{
+			timelineEvent:           base,
+			KillerID:                e.KillerID,
+			VictimID:                e.VictimID,
+			AssistingParticipantIDs: e.AssistingParticipantIDs,
+			Position:                e.Position,
+		}
+	case MatchEventTypeEliteMonsterKill:
+		return &ObjectiveKillEvent{
+			timelineEvent:    base,
+			KillerID:         e.KillerID,
+			ObjectiveType:    e.MonsterType,
+			ObjectiveSubType: e.MonsterSubType,
+			Position:         e.Position,
+		}
+	case MatchEventTypeBuildingKill:
+		return &ObjectiveKillEvent{
+			timelineEvent:    base,
+			KillerID:         e.KillerID,
+			TeamID:           e.TeamID,
+			ObjectiveType:    e.BuildingType,
+			ObjectiveSubType: e.TowerType,
+			LaneType:         e.LaneType,
+			Position:         e.Position,
+		}
+	case MatchEventTypeTurretPlateDestroyed:
+		return &TurretPlateEvent{
+			timelineEvent: base,
+			KillerID:      e.KillerID,
+			TeamID:        e.TeamID,
+			LaneType:      e.LaneType,
+			Position:      e.Position,
+		}
+	case MatchEventTypeItemPurchased:
+		return &ItemPurchaseEvent{
+			timelineEvent: base,
+			ParticipantID: e.ParticipantID,
+			ItemID:        e.ItemID,
+		}
+	case MatchEventTypeWardPlaced:
+		return &WardEvent{
+			timelineEvent: base,
+			Placed:        true,
+			CreatorID:     e.CreatorID,
+			WardType:      e.WardType,
+		}
+	case MatchEventTypeWardKill:
+		return &WardEvent{
+			timelineEvent: base,
+			KillerID:      e.KillerID,
+			WardType:      e.WardType,
+		}
+	case MatchEventTypeSkillLevelUp:
+		return &SkillLevelUpEvent{
+			timelineEvent: base,
+			ParticipantID: e.ParticipantID,
+			SkillSlot:     e.SkillSlot,
+			LevelUpType:   e.LevelUpType,
+		}
+	default:
+		return &OtherEvent{timelineEvent: base}
+	}
+}
+
+// eventType returns the type of the event. The Riot API sends the type as type, eventType is only set for events
+// created by hand
+func eventType(e *MatchEvent) MatchEventType {
+	if e.Type != "" {
+		return e.Type
+	}
+	return MatchEventType(e.EventType)
+}
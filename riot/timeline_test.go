@@ -0,0 +1,157 @@
+package riot
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mjourard/golio/api"
+	"github.com/mjourard/golio/internal"
+	"github.com/mjourard/golio/mock"
+)
+
+func TestMatchTimeline_Events(t *testing.T) {
+	kill := &MatchEvent{EventType: "CHAMPION_KILL", Timestamp: ms(3000), KillerID: 1, VictimID: 6,
+		AssistingParticipantIDs: []int{2}}
+	dragon := &MatchEvent{EventType: "ELITE_MONSTER_KILL", Timestamp: ms(2000), KillerID: 3, MonsterType: "DRAGON",
+		MonsterSubType: "FIRE_DRAGON"}
+	tower := &MatchEvent{EventType: "BUILDING_KILL", Timestamp: ms(4000), KillerID: 4, TeamID: 200,
+		BuildingType: "TOWER_BUILDING", TowerType: "OUTER_TURRET", LaneType: "MID_LANE"}
+	item := &MatchEvent{EventType: "ITEM_PURCHASED", Timestamp: ms(1000), ParticipantID: 5, ItemID: 1055}
+	wardPlaced := &MatchEvent{EventType: "WARD_PLACED", Timestamp: ms(1500), CreatorID: 5, WardType: "YELLOW_TRINKET"}
+	wardKilled := &MatchEvent{EventType: "WARD_KILL", Timestamp: ms(61000), KillerID: 7, WardType: "YELLOW_TRINKET"}
+	levelUp := &MatchEvent{EventType: "SKILL_LEVEL_UP", Timestamp: ms(1000), ParticipantID: 5, SkillSlot: 1,
+		LevelUpType: "NORMAL"}
+	plate := &MatchEvent{Type: "TURRET_PLATE_DESTROYED", Timestamp: ms(62000), KillerID: 2, TeamID: 100,
+		LaneType: "TOP_LANE"}
+	soul := &MatchEvent{Type: "DRAGON_SOUL_GIVEN", Timestamp: ms(63000)}
+	timeline := MatchTimeline{
+		Frames: []*MatchFrame{
+			{Events: []*MatchEvent{item, levelUp, wardPlaced, kill, dragon, tower}},
+			nil,
+			{Events: []*MatchEvent{nil, wardKilled, soul, plate}},
+		},
+	}
+	want := []TimelineEvent{
+		&ItemPurchaseEvent{timelineEvent: timelineEvent{raw: item}, ParticipantID: 5, ItemID: 1055},
+		&SkillLevelUpEvent{timelineEvent: timelineEvent{raw: levelUp}, ParticipantID: 5, SkillSlot: 1,
+			LevelUpType: "NORMAL"},
+		&WardEvent{timelineEvent: timelineEvent{raw: wardPlaced}, Placed: true, CreatorID: 5,
+			WardType: "YELLOW_TRINKET"},
+		&ObjectiveKillEvent{timelineEvent: timelineEvent{raw: dragon}, KillerID: 3, ObjectiveType: "DRAGON",
+			ObjectiveSubType: "FIRE_DRAGON"},
+		&ChampionKillEvent{timelineEvent: timelineEvent{raw: kill}, KillerID: 1, VictimID: 6,
+			AssistingParticipantIDs: []int{2}},
+		&ObjectiveKillEvent{timelineEvent: timelineEvent{raw: tower}, KillerID: 4, TeamID: 200,
+			ObjectiveType: "TOWER_BUILDING", ObjectiveSubType: "OUTER_TURRET", LaneType: "MID_LANE"},
+		&WardEvent{timelineEvent: timelineEvent{raw: wardKilled}, KillerID: 7, WardType: "YELLOW_TRINKET"},
+		&TurretPlateEvent{timelineEvent: timelineEvent{raw: plate}, KillerID: 2, TeamID: 100, LaneType: "TOP_LANE"},
+		&OtherEvent{timelineEvent: timelineEvent{raw: soul}},
+	}
+	got := timeline.Events()
+	require.Equal(t, want, got)
+	assert.Equal(t, time.Minute+time.Second, got[6].Time())
+	assert.Equal(t, wardKilled, got[6].Raw())
+	assert.False(t, got[3].(*ObjectiveKillEvent).IsBuilding())
+	assert.True(t, got[5].(*ObjectiveKillEvent).IsBuilding())
+	var types []MatchEventType
+	for _, event := range got {
+		types = append(types, event.Type())
+	}
+	assert.Equal(t, []MatchEventType{MatchEventTypeItemPurchased, MatchEventTypeSkillLevelUp,
+		MatchEventTypeWardPlaced, MatchEventTypeDragonKill, MatchEventTypeChampionKill, MatchEventTypeBuildingKill,
+		MatchEventTypeWardKill, MatchEventTypeTurretPlateDestroyed, MatchEventTypeDragonSoulGiven}, types)
+	assert.Equal(t, got[7], plate.Typed())
+}
+
+func TestTimelineEvent_Type(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		monster string
+		want    MatchEventType
+	}{
+		{monster: "DRAGON", want: MatchEventTypeDragonKill},
+		{monster: "BARON_NASHOR", want: MatchEventTypeBaronKill},
+		{monster: "RIFTHERALD", want: MatchEventTypeRiftHeraldKill},
+		{monster: "HORDE", want: MatchEventTypeEliteMonsterKill},
+	}
+	for _, test := range tests {
+		event := &MatchEvent{Type: MatchEventTypeEliteMonsterKill, MonsterType: test.monster}
+		assert.Equal(t, test.want, event.Typed().Type(), test.monster)
+	}
+}
+
+func TestMatchClient_StreamTimelineEvents(t *testing.T) {
+	t.Parallel()
+	kill := &MatchEvent{EventType: "CHAMPION_KILL", KillerID: 1}
+	tests := []struct {
+		name    string
+		doer    internal.Doer
+		want    []TimelineEvent
+		wantErr error
+	}{
+		{
+			name: "get response",
+			doer: mock.NewJSONMockDoer(MatchTimeline{Frames: []*MatchFrame{{Events: []*MatchEvent{kill}}}}, 200),
+			want: []TimelineEvent{
+				&ChampionKillEvent{timelineEvent: timelineEvent{raw: kill}, KillerID: 1},
+			},
+			wantErr: io.EOF,
+		},
+		{
+			name:    "not found",
+			doer:    mock.NewStatusMockDoer(http.StatusNotFound),
+			wantErr: api.ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
+			var got []TimelineEvent
+			var gotErr error
+			for value := range client.Match.StreamTimelineEvents(1) {
+				if value.Error != nil {
+					gotErr = value.Error
+					continue
+				}
+				got = append(got, value.Event)
+			}
+			assert.True(t, errors.Is(gotErr, tt.wantErr), "got error %v", gotErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMatchTimeline_EventsGolden(t *testing.T) {
+	t.Parallel()
+	content, err := os.ReadFile(filepath.Join(fixturesDir, "timeline.json"))
+	require.Nil(t, err)
+	timeline := &MatchTimeline{}
+	require.Nil(t, json.Unmarshal(content, timeline))
+	counts := map[string]int{}
+	for _, event := range timeline.Events() {
+		counts[fmt.Sprintf("%T", event)]++
+	}
+	assert.Equal(t, map[string]int{
+		"*riot.ItemPurchaseEvent":  40,
+		"*riot.ChampionKillEvent":  18,
+		"*riot.WardEvent":          39,
+		"*riot.SkillLevelUpEvent":  30,
+		"*riot.ObjectiveKillEvent": 9,
+	}, counts)
+}
+
+// ms returns the given milliseconds as DurationMillis
+func ms(n int) DurationMillis {
+	return DurationMillis{Duration: time.Duration(n) * time.Millisecond}
+}
@@ -0,0 +1,50 @@
+package riot
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Timestamp is a point in time sent by the Riot API as milliseconds since the Unix epoch. It embeds time.Time, so
+// all of its methods can be used directly, e.g. summoner.RevisionDate.Before(t). The raw value sent by the API is
+// available through Millis. A timestamp of 0 is decoded to the zero time.Time
+type Timestamp struct {
+	time.Time
+}
+
+// TimestampFromMillis returns the timestamp for the given milliseconds since the Unix epoch
+func TimestampFromMillis(millis int64) Timestamp {
+	if millis == 0 {
+		return Timestamp{}
+	}
+	return Timestamp{Time: time.Unix(0, millis*int64(time.Millisecond))}
+}
+
+// Millis returns the timestamp as milliseconds since the Unix epoch, as sent by the Riot API
+func (t Timestamp) Millis() int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixNano() / int64(time.Millisecond)
+}
+
+// MarshalJSON encodes the timestamp as milliseconds since the Unix epoch
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	return strconv.AppendInt(nil, t.Millis(), 10), nil
+}
+
+// UnmarshalJSON decodes milliseconds since the Unix epoch, given either as a number or as a string
+func (t *Timestamp) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	data = bytes.Trim(data, `"`)
+	millis, err := strconv.ParseInt(string(data), 10, 64)
+	if err != nil {
+		return fmt.Errorf("riot: invalid timestamp %s: %w", data, err)
+	}
+	*t = TimestampFromMillis(millis)
+	return nil
+}
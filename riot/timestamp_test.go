@@ -0,0 +1,79 @@
+package riot
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimestamp_UnmarshalJSON(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		data    string
+		want    Timestamp
+		wantErr bool
+	}{
+		{
+			name: "number",
+			data: "1585180823012",
+			want: TimestampFromMillis(1585180823012),
+		},
+		{
+			name: "string",
+			data: `"1585180823012"`,
+			want: TimestampFromMillis(1585180823012),
+		},
+		{
+			name: "zero",
+			data: "0",
+		},
+		{
+			name: "null",
+			data: "null",
+		},
+		{
+			name:    "invalid",
+			data:    `"yesterday"`,
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var got Timestamp
+			err := json.Unmarshal([]byte(test.data), &got)
+			if test.wantErr {
+				assert.NotNil(t, err)
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.want.Millis(), got.Millis())
+		})
+	}
+}
+
+func TestTimestamp_MarshalJSON(t *testing.T) {
+	t.Parallel()
+	summoner := Summoner{RevisionDate: TimestampFromMillis(1585180823012)}
+	assert.Equal(t, int64(1585180823012), summoner.RevisionDate.Millis())
+	assert.Equal(t, time.Date(2020, time.March, 26, 0, 0, 23, 12000000, time.UTC), summoner.RevisionDate.UTC())
+	data, err := json.Marshal(summoner)
+	require.Nil(t, err)
+	var decoded Summoner
+	require.Nil(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, summoner.RevisionDate.Millis(), decoded.RevisionDate.Millis())
+	assert.True(t, summoner.RevisionDate.Equal(decoded.RevisionDate.Time))
+	data, err = json.Marshal(Timestamp{})
+	require.Nil(t, err)
+	assert.Equal(t, "0", string(data))
+}
+
+func TestLobbyEvent_Time(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, int64(1585180823012), (&LobbyEvent{Timestamp: "1585180823012"}).Time().Millis())
+	assert.True(t, (&LobbyEvent{Timestamp: "invalid"}).Time().IsZero())
+}
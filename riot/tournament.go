@@ -18,6 +18,10 @@ func (t *tournamentClient) CreateCodes(id, count int, params *TournamentCodePara
 		"method": "CreateCodes",
 		"stub":   stub,
 	})
+	if err := validateCodes(count, params); err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
 	endpoint := endpointCreateTournamentCodes
 	if stub {
 		endpoint = endpointCreateStubTournamentCodes
@@ -37,6 +41,10 @@ func (t *tournamentClient) ListLobbyEvents(code string, useStub bool) (*LobbyEve
 		"method": "ListLobbyEvents",
 		"stub":   useStub,
 	})
+	if err := validateID("code", code); err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
 	endpoint := endpointGetLobbyEvents
 	if useStub {
 		endpoint = endpointGetStubLobbyEvents
@@ -94,6 +102,10 @@ func (t *tournamentClient) Get(code string) (*Tournament, error) {
 	logger := t.logger().WithFields(log.Fields{
 		"method": "Get",
 	})
+	if err := validateID("code", code); err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
 	var tournament Tournament
 	if err := t.c.getInto(fmt.Sprintf(endpointGetTournament, code), &tournament); err != nil {
 		logger.Debug(err)
@@ -107,6 +119,10 @@ func (t *tournamentClient) Update(code string, parameters TournamentUpdateParame
 	logger := t.logger().WithFields(log.Fields{
 		"method": "Update",
 	})
+	if err := validateID("code", code); err != nil {
+		logger.Debug(err)
+		return err
+	}
 	if err := t.c.put(fmt.Sprintf(endpointUpdateTournament, code), parameters); err != nil {
 		logger.Debug(err)
 		return err
@@ -115,5 +131,5 @@ func (t *tournamentClient) Update(code string, parameters TournamentUpdateParame
 }
 
 func (t *tournamentClient) logger() log.FieldLogger {
-	return t.c.logger().WithField("category", "tournament")
+	return t.c.categoryLogger(LogCategoryTournament)
 }
@@ -0,0 +1,56 @@
+package riot
+
+// NewTournamentCodeParameters returns parameters for tournament codes of games with the given team size on Summoner's
+// Rift with tournament draft, which can be spectated by everyone. The parameters can be changed by chaining, e.g.
+//
+//	params := riot.NewTournamentCodeParameters(5).
+//		Pick(riot.PickTypeBlindPick).
+//		Spectators(riot.SpectatorTypeLobbyOnly).
+//		Allow(summonerIDs...)
+//	if err := params.Validate(); err != nil {
+//		...
+//	}
+func NewTournamentCodeParameters(teamSize int) *TournamentCodeParameters {
+	return &TournamentCodeParameters{
+		TeamSize:      teamSize,
+		MapType:       string(MapTypeSummonersRift),
+		PickType:      string(PickTypeTournamentDraft),
+		SpectatorType: string(SpectatorTypeAll),
+	}
+}
+
+// Map sets the map of the games and returns the parameters
+func (p *TournamentCodeParameters) Map(mapType MapType) *TournamentCodeParameters {
+	p.MapType = string(mapType)
+	return p
+}
+
+// Pick sets the champion selection of the games and returns the parameters
+func (p *TournamentCodeParameters) Pick(pickType PickType) *TournamentCodeParameters {
+	p.PickType = string(pickType)
+	return p
+}
+
+// Spectators sets who can spectate the games and returns the parameters
+func (p *TournamentCodeParameters) Spectators(spectatorType SpectatorType) *TournamentCodeParameters {
+	p.SpectatorType = string(spectatorType)
+	return p
+}
+
+// Allow adds the given encrypted summoner IDs to the summoners eligible to join the lobby and returns the parameters
+func (p *TournamentCodeParameters) Allow(summonerIDs ...string) *TournamentCodeParameters {
+	p.AllowedSummonerIDs = append(p.AllowedSummonerIDs, summonerIDs...)
+	return p
+}
+
+// Meta sets the custom metadata of the games and returns the parameters
+func (p *TournamentCodeParameters) Meta(metadata string) *TournamentCodeParameters {
+	p.Metadata = metadata
+	return p
+}
+
+// Validate returns a *ValidationError if the parameters would be rejected by the Riot API, e.g. for a team size
+// above 5, an unknown map type or a summoner allowed twice. Parameters are validated before every request as well
+func (p *TournamentCodeParameters) Validate() error {
+	return p.validate()
+}
@@ -0,0 +1,85 @@
+package riot
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mjourard/golio/api"
+	"github.com/mjourard/golio/mock"
+)
+
+func TestTournamentCodeParameters_builder(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		params  *TournamentCodeParameters
+		want    TournamentCodeParameters
+		wantErr bool
+	}{
+		{
+			name:   "defaults",
+			params: NewTournamentCodeParameters(5),
+			want: TournamentCodeParameters{TeamSize: 5, MapType: "SUMMONERS_RIFT", PickType: "TOURNAMENT_DRAFT",
+				SpectatorType: "ALL"},
+		},
+		{
+			name: "all",
+			params: NewTournamentCodeParameters(3).
+				Map(MapTypeTwistedTreeline).
+				Pick(PickTypeBlindPick).
+				Spectators(SpectatorTypeLobbyOnly).
+				Allow("a", "b").
+				Allow("c").
+				Meta("metadata"),
+			want: TournamentCodeParameters{TeamSize: 3, MapType: "TWISTED_TREELINE", PickType: "BLIND_PICK",
+				SpectatorType: "LOBBYONLY", AllowedSummonerIDs: []string{"a", "b", "c"}, Metadata: "metadata"},
+		},
+		{
+			name:   "team size",
+			params: NewTournamentCodeParameters(6),
+			want: TournamentCodeParameters{TeamSize: 6, MapType: "SUMMONERS_RIFT", PickType: "TOURNAMENT_DRAFT",
+				SpectatorType: "ALL"},
+			wantErr: true,
+		},
+		{
+			name:   "unknown map",
+			params: NewTournamentCodeParameters(5).Map("CRYSTAL_SCAR"),
+			want: TournamentCodeParameters{TeamSize: 5, MapType: "CRYSTAL_SCAR", PickType: "TOURNAMENT_DRAFT",
+				SpectatorType: "ALL"},
+			wantErr: true,
+		},
+		{
+			name:   "duplicate summoner",
+			params: NewTournamentCodeParameters(5).Allow("a", "a"),
+			want: TournamentCodeParameters{TeamSize: 5, MapType: "SUMMONERS_RIFT", PickType: "TOURNAMENT_DRAFT",
+				SpectatorType: "ALL", AllowedSummonerIDs: []string{"a", "a"}},
+			wantErr: true,
+		},
+		{
+			name:    "empty summoner",
+			params:  &TournamentCodeParameters{TeamSize: 1, AllowedSummonerIDs: []string{" "}},
+			want:    TournamentCodeParameters{TeamSize: 1, AllowedSummonerIDs: []string{" "}},
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, *test.params)
+			err := test.params.Validate()
+			assert.Equal(t, test.wantErr, err != nil, "got error %v", err)
+		})
+	}
+}
+
+func TestTournamentClient_CreateCodes_invalidParameters(t *testing.T) {
+	t.Parallel()
+	doer := mock.NewJSONMockDoer([]string{"code"}, 200)
+	client := NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger())
+	_, err := client.Tournament.CreateCodes(1, 1, NewTournamentCodeParameters(5).Pick("RANDOM"), false)
+	var validationErr *ValidationError
+	assert.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, `riot: invalid params: unknown PickType "RANDOM"`, err.Error())
+}
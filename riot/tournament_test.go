@@ -1,6 +1,7 @@
 package riot
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
@@ -11,7 +12,7 @@ import (
 
 	"github.com/mjourard/golio/api"
 	"github.com/mjourard/golio/internal"
-	"github.com/mjourard/golio/internal/mock"
+	"github.com/mjourard/golio/mock"
 )
 
 func TestTournamentClient_CreateCodes(t *testing.T) {
@@ -43,12 +44,12 @@ func TestTournamentClient_CreateCodes(t *testing.T) {
 		{
 			name: "rate limited",
 			want: []string{},
-			doer: rateLimitDoer([]string{}),
+			doer: mock.NewRateLimitDoer([]string{}, 1, 1),
 		},
 		{
 			name: "unavailable once",
 			want: []string{},
-			doer: unavailableOnceDoer([]string{}),
+			doer: mock.NewUnavailableDoer([]string{}, 1),
 		},
 		{
 			name:    "unavailable twice",
@@ -59,8 +60,8 @@ func TestTournamentClient_CreateCodes(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			client := NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
-			got, err := client.Tournament.CreateCodes(0, 0, &TournamentCodeParameters{}, true)
-			require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+			got, err := client.Tournament.CreateCodes(0, 1, &TournamentCodeParameters{TeamSize: 5}, true)
+			require.True(t, errors.Is(err, tt.wantErr), fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
 			if tt.wantErr == nil {
 				assert.Equal(t, got, tt.want)
 			}
@@ -97,12 +98,12 @@ func TestTournamentClient_ListLobbyEvents(t *testing.T) {
 		{
 			name: "rate limited",
 			want: &LobbyEventList{},
-			doer: rateLimitDoer(LobbyEventList{}),
+			doer: mock.NewRateLimitDoer(LobbyEventList{}, 1, 1),
 		},
 		{
 			name: "unavailable once",
 			want: &LobbyEventList{},
-			doer: unavailableOnceDoer(LobbyEventList{}),
+			doer: mock.NewUnavailableDoer(LobbyEventList{}, 1),
 		},
 		{
 			name:    "unavailable twice",
@@ -114,7 +115,7 @@ func TestTournamentClient_ListLobbyEvents(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			client := NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
 			got, err := client.Tournament.ListLobbyEvents("code", true)
-			require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+			require.True(t, errors.Is(err, tt.wantErr), fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
 			if tt.wantErr == nil {
 				assert.Equal(t, got, tt.want)
 			}
@@ -151,12 +152,12 @@ func TestTournamentClient_CreateProvider(t *testing.T) {
 		{
 			name: "rate limited",
 			want: 1,
-			doer: rateLimitDoer(1),
+			doer: mock.NewRateLimitDoer(1, 1, 1),
 		},
 		{
 			name: "unavailable once",
 			want: 1,
-			doer: unavailableOnceDoer(1),
+			doer: mock.NewUnavailableDoer(1, 1),
 		},
 		{
 			name:    "unavailable twice",
@@ -168,7 +169,7 @@ func TestTournamentClient_CreateProvider(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			client := NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
 			got, err := client.Tournament.CreateProvider(&ProviderRegistrationParameters{}, true)
-			require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+			require.True(t, errors.Is(err, tt.wantErr), fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
 			if tt.wantErr == nil {
 				assert.Equal(t, got, tt.want)
 			}
@@ -205,12 +206,12 @@ func TestTournamentClient_Create(t *testing.T) {
 		{
 			name: "rate limited",
 			want: 1,
-			doer: rateLimitDoer(1),
+			doer: mock.NewRateLimitDoer(1, 1, 1),
 		},
 		{
 			name: "unavailable once",
 			want: 1,
-			doer: unavailableOnceDoer(1),
+			doer: mock.NewUnavailableDoer(1, 1),
 		},
 		{
 			name:    "unavailable twice",
@@ -222,7 +223,7 @@ func TestTournamentClient_Create(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			client := NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
 			got, err := client.Tournament.Create(&TournamentRegistrationParameters{}, true)
-			require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+			require.True(t, errors.Is(err, tt.wantErr), fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
 			if tt.wantErr == nil {
 				assert.Equal(t, got, tt.want)
 			}
@@ -259,12 +260,12 @@ func TestTournamentClient_Get(t *testing.T) {
 		{
 			name: "rate limited",
 			want: &Tournament{},
-			doer: rateLimitDoer(Tournament{}),
+			doer: mock.NewRateLimitDoer(Tournament{}, 1, 1),
 		},
 		{
 			name: "unavailable once",
 			want: &Tournament{},
-			doer: unavailableOnceDoer(Tournament{}),
+			doer: mock.NewUnavailableDoer(Tournament{}, 1),
 		},
 		{
 			name:    "unavailable twice",
@@ -276,7 +277,7 @@ func TestTournamentClient_Get(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			client := NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
 			got, err := client.Tournament.Get("code")
-			require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+			require.True(t, errors.Is(err, tt.wantErr), fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
 			if tt.wantErr == nil {
 				assert.Equal(t, got, tt.want)
 			}
@@ -310,11 +311,11 @@ func TestTournamentClient_Update(t *testing.T) {
 		},
 		{
 			name: "rate limited",
-			doer: rateLimitDoer(1),
+			doer: mock.NewRateLimitDoer(1, 1, 1),
 		},
 		{
 			name: "unavailable once",
-			doer: unavailableOnceDoer(1),
+			doer: mock.NewUnavailableDoer(1, 1),
 		},
 		{
 			name:    "unavailable twice",
@@ -326,7 +327,19 @@ func TestTournamentClient_Update(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			client := NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
 			err := client.Tournament.Update("code", TournamentUpdateParameters{})
-			require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+			require.True(t, errors.Is(err, tt.wantErr), fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
 		})
 	}
 }
+
+func TestTournamentClient_UpdateRequest(t *testing.T) {
+	t.Parallel()
+	doer := mock.NewStatusMockDoer(http.StatusOK)
+	client := NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger())
+	params := TournamentUpdateParameters{PickType: "BLIND_PICK", AllowedSummonerIDs: []string{"id"}}
+	require.Nil(t, client.Tournament.Update("code", params))
+	doer.AssertCalls(t, 1)
+	doer.AssertCalled(t, http.MethodPut, "/lol/tournament/v4/codes/code")
+	doer.AssertHeader(t, apiTokenHeaderKey, "API_KEY")
+	doer.AssertJSONBody(t, params)
+}
@@ -0,0 +1,129 @@
+package riot
+
+import (
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+var (
+	jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// unknownFields returns the paths of all fields in the json body which do not exist in the type t, e.g.
+// info.participants[].newField, in sorted order. Types decoding themselves are not inspected
+func unknownFields(body []byte, t reflect.Type) []string {
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		return nil
+	}
+	var fields []string
+	seen := map[string]bool{}
+	collectUnknownFields(value, t, "", func(path string) {
+		if !seen[path] {
+			seen[path] = true
+			fields = append(fields, path)
+		}
+	})
+	sort.Strings(fields)
+	return fields
+}
+
+// collectUnknownFields calls report for every field of value which does not exist in t
+func collectUnknownFields(value interface{}, t reflect.Type, path string, report func(string)) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if reflect.PtrTo(t).Implements(jsonUnmarshalerType) || reflect.PtrTo(t).Implements(textUnmarshalerType) {
+		return
+	}
+	switch value := value.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(value))
+		for key := range value {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		switch t.Kind() {
+		case reflect.Struct:
+			fields := jsonFields(t)
+			for _, key := range keys {
+				field, ok := lookupJSONField(fields, key)
+				if !ok {
+					report(joinFieldPath(path, key))
+					continue
+				}
+				collectUnknownFields(value[key], field.Type, joinFieldPath(path, key), report)
+			}
+		case reflect.Map:
+			for _, key := range keys {
+				collectUnknownFields(value[key], t.Elem(), joinFieldPath(path, key), report)
+			}
+		}
+	case []interface{}:
+		if t.Kind() != reflect.Slice && t.Kind() != reflect.Array {
+			return
+		}
+		for _, element := range value {
+			collectUnknownFields(element, t.Elem(), path+"[]", report)
+		}
+	}
+}
+
+// jsonFields returns the fields of the struct type t by their json names, including the fields of embedded structs
+func jsonFields(t reflect.Type) map[string]reflect.StructField {
+	fields := map[string]reflect.StructField{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				for key, promoted := range jsonFields(embedded) {
+					if _, ok := fields[key]; !ok {
+						fields[key] = promoted
+					}
+				}
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fields[name] = field
+	}
+	return fields
+}
+
+// lookupJSONField finds the field for a json key, ignoring case like encoding/json if there is no exact match
+func lookupJSONField(fields map[string]reflect.StructField, key string) (reflect.StructField, bool) {
+	if field, ok := fields[key]; ok {
+		return field, true
+	}
+	for name, field := range fields {
+		if strings.EqualFold(name, key) {
+			return field, true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+func joinFieldPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
@@ -0,0 +1,52 @@
+package riot
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnknownFields(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		body   string
+		target interface{}
+		want   []string
+	}{
+		{
+			name:   "known fields",
+			body:   `{"gameId":1,"participants":[{"teamId":100}]}`,
+			target: &Match{},
+		},
+		{
+			name: "nested fields",
+			body: `{"gameId":1,"newField":1,"participantIdentities":[{"player":{"newName":"a"}},` +
+				`{"player":{"newName":"b","newIcon":1}}],"gameDuration":100}`,
+			target: &Match{},
+			want:   []string{"newField", "participantIdentities[].player.newIcon", "participantIdentities[].player.newName"},
+		},
+		{
+			name:   "case insensitive",
+			body:   `[{"GAMEID":1}]`,
+			target: &[]*Match{},
+		},
+		{
+			name:   "map values",
+			body:   `{"a":{"gameId":1,"newField":1}}`,
+			target: &map[string]Match{},
+			want:   []string{"a.newField"},
+		},
+		{
+			name:   "invalid json",
+			body:   `{`,
+			target: &Match{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, unknownFields([]byte(tt.body), reflect.TypeOf(tt.target)))
+		})
+	}
+}
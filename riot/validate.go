@@ -0,0 +1,215 @@
+package riot
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mjourard/golio/api"
+)
+
+const (
+	puuidLength = 78
+	// maxMatchlistRange is the maximum number of matches returned for a single matchlist request
+	maxMatchlistRange = 100
+	// maxMatchlistTimeRange is the maximum time between BeginTime and EndTime of a matchlist request
+	maxMatchlistTimeRange = 7 * 24 * time.Hour
+	maxTournamentCodes    = 1000
+	maxTeamSize           = 5
+)
+
+// apexTiers are the tiers above TierDiamond, which only consist of a single division
+var apexTiers = []Tier{TierMaster, TierGrandmaster, TierChallenger}
+
+// ValidationError is returned for invalid arguments without sending a request. It matches api.ErrBadRequest using
+// errors.Is, which the Riot API would have returned for the request
+type ValidationError struct {
+	// Argument is the name of the invalid argument, e.g. puuid
+	Argument string
+	// Reason describes why the argument is invalid
+	Reason string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("riot: invalid %s: %s", e.Argument, e.Reason)
+}
+
+// Is returns true for api.ErrBadRequest
+func (e *ValidationError) Is(target error) bool {
+	return target == error(api.ErrBadRequest)
+}
+
+func invalid(argument, format string, args ...interface{}) error {
+	return &ValidationError{Argument: argument, Reason: fmt.Sprintf(format, args...)}
+}
+
+// validateID checks that an ID or name is given
+func validateID(argument, value string) error {
+	if strings.TrimSpace(value) == "" {
+		return invalid(argument, "must not be empty")
+	}
+	return nil
+}
+
+// validateIDs checks pairs of argument names and values using validateID
+func validateIDs(argumentsAndValues ...string) error {
+	for i := 0; i+1 < len(argumentsAndValues); i += 2 {
+		if err := validateID(argumentsAndValues[i], argumentsAndValues[i+1]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validatePUUID checks the length and the alphabet of an encrypted PUUID
+func validatePUUID(puuid string) error {
+	if len(puuid) != puuidLength {
+		return invalid("puuid", "got %d characters, want %d", len(puuid), puuidLength)
+	}
+	for _, r := range puuid {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_') {
+			return invalid("puuid", "unexpected character %q", r)
+		}
+	}
+	return nil
+}
+
+// validateMatchID checks that a match ID is not negative
+func validateMatchID(id int) error {
+	if id < 0 {
+		return invalid("matchID", "must not be negative, got %d", id)
+	}
+	return nil
+}
+
+// validateTournamentMatch checks the arguments of a request for a match of a tournament
+func validateTournamentMatch(matchID int, tournamentCode string) error {
+	if err := validateMatchID(matchID); err != nil {
+		return err
+	}
+	return validateID("tournamentCode", tournamentCode)
+}
+
+// validateLeague checks the queue, the tier and division pair and the page of a request for league entries
+func validateLeague(queue Queue, tier Tier, division Division, page int) error {
+	if err := validateID("queue", string(queue)); err != nil {
+		return err
+	}
+	known := false
+	for _, d := range Divisions {
+		known = known || d == division
+	}
+	if !known {
+		return invalid("division", "unknown division %q", division)
+	}
+	for _, t := range apexTiers {
+		if t == tier {
+			if division != DivisionOne {
+				return invalid("division", "tier %s only has division %s, got %s", tier, DivisionOne, division)
+			}
+			return validatePage(page)
+		}
+	}
+	for _, t := range Tiers {
+		if t == tier {
+			return validatePage(page)
+		}
+	}
+	return invalid("tier", "unknown tier %q", tier)
+}
+
+func validatePage(page int) error {
+	if page < 1 {
+		return invalid("page", "must be at least 1, got %d", page)
+	}
+	return nil
+}
+
+// validateMatchlist checks the arguments of a matchlist request
+func validateMatchlist(accountID string, filter *MatchFilter) error {
+	if err := validateID("accountID", accountID); err != nil {
+		return err
+	}
+	return filter.validate()
+}
+
+// validate checks the indices and times of the filter against the limits of the matchlist endpoint
+func (m *MatchFilter) validate() error {
+	if m == nil {
+		return nil
+	}
+	if m.BeginIndex != nil && *m.BeginIndex < 0 {
+		return invalid("filter", "BeginIndex must not be negative, got %d", *m.BeginIndex)
+	}
+	if m.EndIndex != nil {
+		begin := 0
+		if m.BeginIndex != nil {
+			begin = *m.BeginIndex
+		}
+		if *m.EndIndex <= begin || *m.EndIndex-begin > maxMatchlistRange {
+			return invalid("filter", "EndIndex must be in (%d, %d], got %d", begin, begin+maxMatchlistRange,
+				*m.EndIndex)
+		}
+	}
+	if m.BeginTime != nil && m.EndTime != nil {
+		if !m.EndTime.After(*m.BeginTime) {
+			return invalid("filter", "EndTime %s is not after BeginTime %s", m.EndTime, m.BeginTime)
+		}
+		if m.EndTime.Sub(*m.BeginTime) > maxMatchlistTimeRange {
+			return invalid("filter", "time range %s exceeds %s", m.EndTime.Sub(*m.BeginTime), maxMatchlistTimeRange)
+		}
+	}
+	return nil
+}
+
+// validateCodes checks the count and the parameters of a request for tournament codes
+func validateCodes(count int, params *TournamentCodeParameters) error {
+	if count < 1 || count > maxTournamentCodes {
+		return invalid("count", "must be in [1, %d], got %d", maxTournamentCodes, count)
+	}
+	if params == nil {
+		return invalid("params", "must not be nil")
+	}
+	return params.validate()
+}
+
+// validate checks the team size, the map, pick and spectator types if they are set and the allowed summoners of the
+// parameters
+func (p *TournamentCodeParameters) validate() error {
+	if p.TeamSize < 1 || p.TeamSize > maxTeamSize {
+		return invalid("params", "TeamSize must be in [1, %d], got %d", maxTeamSize, p.TeamSize)
+	}
+	if err := validateEnum("MapType", p.MapType, MapTypes); err != nil {
+		return err
+	}
+	if err := validateEnum("PickType", p.PickType, PickTypes); err != nil {
+		return err
+	}
+	if err := validateEnum("SpectatorType", p.SpectatorType, SpectatorTypes); err != nil {
+		return err
+	}
+	allowed := map[string]bool{}
+	for _, id := range p.AllowedSummonerIDs {
+		if strings.TrimSpace(id) == "" {
+			return invalid("params", "AllowedSummonerIDs must not contain empty IDs")
+		}
+		if allowed[id] {
+			return invalid("params", "AllowedSummonerIDs contains %s twice", id)
+		}
+		allowed[id] = true
+	}
+	return nil
+}
+
+// validateEnum checks that value is either empty or one of the legal values
+func validateEnum[T ~string](field, value string, legal []T) error {
+	if value == "" {
+		return nil
+	}
+	for _, l := range legal {
+		if string(l) == value {
+			return nil
+		}
+	}
+	return invalid("params", "unknown %s %q", field, value)
+}
@@ -0,0 +1,149 @@
+package riot
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mjourard/golio/api"
+	"github.com/mjourard/golio/mock"
+)
+
+func TestClient_validation(t *testing.T) {
+	t.Parallel()
+	begin := time.Date(2020, time.March, 1, 0, 0, 0, 0, time.UTC)
+	end := begin.Add(8 * 24 * time.Hour)
+	beginIndex, endIndex := 10, 111
+	tests := []struct {
+		name     string
+		call     func(c *Client) error
+		argument string
+	}{
+		{
+			name: "empty summoner name",
+			call: func(c *Client) error {
+				_, err := c.Summoner.GetByName(" ")
+				return err
+			},
+			argument: "name",
+		},
+		{
+			name: "short puuid",
+			call: func(c *Client) error {
+				_, err := c.Summoner.GetByPUUID("puuid")
+				return err
+			},
+			argument: "puuid",
+		},
+		{
+			name: "puuid with invalid character",
+			call: func(c *Client) error {
+				_, err := c.Summoner.GetByPUUID(strings.Repeat("a", puuidLength-1) + "/")
+				return err
+			},
+			argument: "puuid",
+		},
+		{
+			name: "empty champion ID",
+			call: func(c *Client) error {
+				_, err := c.ChampionMastery.Get("summoner", "")
+				return err
+			},
+			argument: "championID",
+		},
+		{
+			name: "apex tier with division",
+			call: func(c *Client) error {
+				_, err := c.League.ListPlayers(QueueRankedSolo, "MASTER", DivisionTwo, 1)
+				return err
+			},
+			argument: "division",
+		},
+		{
+			name: "unknown tier",
+			call: func(c *Client) error {
+				_, err := c.League.ListPlayers(QueueRankedSolo, "WOOD", DivisionOne, 1)
+				return err
+			},
+			argument: "tier",
+		},
+		{
+			name: "page zero",
+			call: func(c *Client) error {
+				_, err := c.League.ListPlayers(QueueRankedSolo, TierGold, DivisionOne, 0)
+				return err
+			},
+			argument: "page",
+		},
+		{
+			name: "negative match ID",
+			call: func(c *Client) error {
+				_, err := c.Match.GetTimeline(-1)
+				return err
+			},
+			argument: "matchID",
+		},
+		{
+			name: "too many matches",
+			call: func(c *Client) error {
+				_, err := c.Match.List("account", &MatchFilter{BeginIndex: &beginIndex, EndIndex: &endIndex})
+				return err
+			},
+			argument: "filter",
+		},
+		{
+			name: "time range too long",
+			call: func(c *Client) error {
+				_, err := c.Match.List("account", &MatchFilter{BeginTime: &begin, EndTime: &end})
+				return err
+			},
+			argument: "filter",
+		},
+		{
+			name: "too many codes",
+			call: func(c *Client) error {
+				_, err := c.Tournament.CreateCodes(1, 1001, &TournamentCodeParameters{TeamSize: 5}, true)
+				return err
+			},
+			argument: "count",
+		},
+		{
+			name: "empty code",
+			call: func(c *Client) error {
+				return c.Tournament.Update("", TournamentUpdateParameters{})
+			},
+			argument: "code",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			doer := mock.NewStatusMockDoer(http.StatusOK)
+			client := NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger())
+			err := test.call(client)
+			var validationErr *ValidationError
+			if assert.True(t, errors.As(err, &validationErr), "got %v", err) {
+				assert.Equal(t, test.argument, validationErr.Argument)
+			}
+			assert.True(t, errors.Is(err, api.ErrBadRequest))
+			doer.AssertCalls(t, 0)
+		})
+	}
+}
+
+func TestMatchFilter_validate(t *testing.T) {
+	t.Parallel()
+	begin := time.Date(2020, time.March, 1, 0, 0, 0, 0, time.UTC)
+	end := begin.Add(24 * time.Hour)
+	beginIndex, endIndex := 100, 200
+	assert.Nil(t, (*MatchFilter)(nil).validate())
+	assert.Nil(t, NewMatchFilter().validate())
+	assert.Nil(t, (&MatchFilter{BeginTime: &begin, EndTime: &end}).validate())
+	assert.Nil(t, (&MatchFilter{BeginIndex: &beginIndex, EndIndex: &endIndex}).validate())
+	assert.NotNil(t, (&MatchFilter{BeginTime: &end, EndTime: &begin}).validate())
+	assert.NotNil(t, (&MatchFilter{EndIndex: &beginIndex, BeginIndex: &endIndex}).validate())
+}
@@ -0,0 +1,343 @@
+package sandbox
+
+import (
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mjourard/golio/riot"
+)
+
+const (
+	fieldName      = "name"
+	fieldID        = "id"
+	fieldAccountID = "account"
+	fieldPUUID     = "puuid"
+
+	masteriesCount   = 30
+	apexLeagueSize   = 50
+	leaguePageSize   = 50
+	leaguePages      = 5
+	leagueSize       = 20
+	minMatchlistSize = 20
+	maxMatchlistSize = 100
+	defaultEndIndex  = 100
+	maxMatchlistPage = 100
+)
+
+type route struct {
+	method  string
+	pattern *regexp.Regexp
+	// handler returns the payload and status code for the request given the submatches of pattern
+	handler func(d *Doer, r *request, params []string) (interface{}, int)
+}
+
+var routes = []route{
+	{http.MethodGet, regexp.MustCompile(`^/lol/summoner/v4/summoners/by-name/([^/]+)$`), summonerBy(fieldName)},
+	{http.MethodGet, regexp.MustCompile(`^/lol/summoner/v4/summoners/by-account/([^/]+)$`), summonerBy(fieldAccountID)},
+	{http.MethodGet, regexp.MustCompile(`^/lol/summoner/v4/summoners/by-puuid/([^/]+)$`), summonerBy(fieldPUUID)},
+	{http.MethodGet, regexp.MustCompile(`^/lol/summoner/v4/summoners/([^/]+)$`), summonerBy(fieldID)},
+	{http.MethodGet, regexp.MustCompile(`^/lol/champion-mastery/v4/champion-masteries/by-summoner/([^/]+)$`),
+		masteries},
+	{http.MethodGet,
+		regexp.MustCompile(`^/lol/champion-mastery/v4/champion-masteries/by-summoner/([^/]+)/by-champion/(\d+)$`),
+		mastery},
+	{http.MethodGet, regexp.MustCompile(`^/lol/champion-mastery/v4/scores/by-summoner/([^/]+)$`), masteryScore},
+	{http.MethodGet, regexp.MustCompile(`^/lol/platform/v3/champion-rotations$`), rotation},
+	{http.MethodGet, regexp.MustCompile(`^/lol/league/v4/(challenger|grandmaster|master)leagues/by-queue/([^/]+)$`),
+		apexLeague},
+	{http.MethodGet, regexp.MustCompile(`^/lol/league/v4/leagues/([^/]+)$`), league},
+	{http.MethodGet, regexp.MustCompile(`^/lol/league/v4/entries/by-summoner/([^/]+)$`), leagueEntriesBySummoner},
+	{http.MethodGet, regexp.MustCompile(`^/lol/league/v4/entries/([^/]+)/([^/]+)/([^/]+)$`), leagueEntries},
+	{http.MethodGet, regexp.MustCompile(`^/lol/status/v3/shard-data$`), status},
+	{http.MethodGet, regexp.MustCompile(`^/lol/status/v4/platform-data$`), platformData},
+	{http.MethodGet, regexp.MustCompile(`^/lol/match/v4/matches/(\d+)$`), match},
+	{http.MethodGet, regexp.MustCompile(`^/lol/match/v4/matchlists/by-account/([^/]+)$`), matchlist},
+	{http.MethodGet, regexp.MustCompile(`^/lol/match/v4/timelines/by-match/(\d+)$`), timeline},
+	{http.MethodGet, regexp.MustCompile(`^/lol/spectator/v4/featured-games$`), featuredGames},
+	{http.MethodPost, regexp.MustCompile(`^/lol/tournament-stub/v4/providers$`), createID("provider")},
+	{http.MethodPost, regexp.MustCompile(`^/lol/tournament-stub/v4/tournaments$`), createID("tournament")},
+	{http.MethodPost, regexp.MustCompile(`^/lol/tournament-stub/v4/codes$`), tournamentCodes},
+	{http.MethodGet, regexp.MustCompile(`^/lol/tournament-stub/v4/lobby-events/by-code/([^/]+)$`), lobbyEvents},
+}
+
+// handle returns the payload and status code for the given request
+func (d *Doer) handle(r *request) (interface{}, int) {
+	for _, route := range routes {
+		params := route.pattern.FindStringSubmatch(r.URL.Path)
+		if params == nil {
+			continue
+		}
+		if r.Method != route.method {
+			return nil, http.StatusMethodNotAllowed
+		}
+		return route.handler(d, r, params[1:])
+	}
+	return nil, http.StatusNotFound
+}
+
+func summonerBy(field string) func(*Doer, *request, []string) (interface{}, int) {
+	return func(d *Doer, r *request, params []string) (interface{}, int) {
+		return d.summoner(r, field, params[0]), http.StatusOK
+	}
+}
+
+func (d *Doer) masteries(r *request, summonerID string) []*riot.ChampionMastery {
+	summoner := d.summoner(r, fieldID, summonerID)
+	return d.generator(r, "masteries/"+summonerID).ChampionMasteries(summoner, masteriesCount)
+}
+
+func masteries(d *Doer, r *request, params []string) (interface{}, int) {
+	return d.masteries(r, params[0]), http.StatusOK
+}
+
+func mastery(d *Doer, r *request, params []string) (interface{}, int) {
+	championID, _ := strconv.Atoi(params[1])
+	for _, m := range d.masteries(r, params[0]) {
+		if m.ChampionID == championID {
+			return m, http.StatusOK
+		}
+	}
+	return nil, http.StatusNotFound
+}
+
+func masteryScore(d *Doer, r *request, params []string) (interface{}, int) {
+	score := 0
+	for _, m := range d.masteries(r, params[0]) {
+		score += m.ChampionLevel
+	}
+	return score, http.StatusOK
+}
+
+func rotation(d *Doer, r *request, _ []string) (interface{}, int) {
+	return d.generator(r, "rotation").ChampionInfo(), http.StatusOK
+}
+
+// addLeague registers the league and returns it
+func (d *Doer) addLeague(list *riot.LeagueList) *riot.LeagueList {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if known, ok := d.leagues[list.LeagueID]; ok {
+		return known
+	}
+	d.leagues[list.LeagueID] = list
+	return list
+}
+
+func apexLeague(d *Doer, r *request, params []string) (interface{}, int) {
+	tier := riot.Tier(strings.ToUpper(params[0]))
+	list := d.generator(r, "league/"+params[0]+"/"+params[1]).LeagueList(riot.Queue(params[1]), tier, apexLeagueSize)
+	return d.addLeague(list), http.StatusOK
+}
+
+func league(d *Doer, r *request, params []string) (interface{}, int) {
+	d.mu.Lock()
+	list, ok := d.leagues[params[0]]
+	d.mu.Unlock()
+	if ok {
+		return list, http.StatusOK
+	}
+	list = d.generator(r, "league/"+params[0]).LeagueList(riot.QueueRankedSolo, riot.TierGold, leagueSize)
+	list.LeagueID = params[0]
+	for _, entry := range list.Entries {
+		entry.LeagueID = params[0]
+	}
+	return d.addLeague(list), http.StatusOK
+}
+
+func leagueEntriesBySummoner(d *Doer, r *request, params []string) (interface{}, int) {
+	summoner := d.summoner(r, fieldID, params[0])
+	g := d.generator(r, "entries/"+params[0])
+	item := g.LeagueItem(summoner)
+	list := g.LeagueList(riot.QueueRankedSolo, riot.Tier(item.Tier), leagueSize-1)
+	item.LeagueID = list.LeagueID
+	list.Entries = append(list.Entries, item)
+	d.addLeague(list)
+	return []*riot.LeagueItem{item}, http.StatusOK
+}
+
+func leagueEntries(d *Doer, r *request, params []string) (interface{}, int) {
+	page, err := strconv.Atoi(r.URL.Query().Get("page"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+	if page > leaguePages {
+		return []*riot.LeagueItem{}, http.StatusOK
+	}
+	key := fmt.Sprintf("entries/%s/%s/%s/%d", params[0], params[1], params[2], page)
+	list := d.generator(r, key).LeagueList(riot.Queue(params[0]), riot.Tier(params[1]), leaguePageSize)
+	for _, entry := range list.Entries {
+		entry.Rank = params[2]
+		if entry.MiniSeries != nil && entry.Rank != string(riot.DivisionOne) {
+			entry.MiniSeries = nil
+			entry.LeaguePoints = 99
+		}
+	}
+	return list.Entries, http.StatusOK
+}
+
+func status(_ *Doer, r *request, _ []string) (interface{}, int) {
+	res := &riot.Status{
+		Name:      r.platform,
+		RegionTag: strings.ToLower(r.platform),
+		Hostname:  r.URL.Host,
+		Slug:      strings.ToLower(r.platform),
+		Locales:   []string{"en_US"},
+	}
+	for _, service := range []string{"Game", "Store", "Website", "Client"} {
+		res.Services = append(res.Services, &riot.Service{
+			Name:      service,
+			Slug:      strings.ToLower(service),
+			Status:    "online",
+			Incidents: []*riot.Incident{},
+		})
+	}
+	return res, http.StatusOK
+}
+
+func platformData(_ *Doer, r *request, _ []string) (interface{}, int) {
+	return &riot.PlatformData{
+		ID:           r.platform,
+		Name:         r.platform,
+		Locales:      []string{"en_US"},
+		Maintenances: []*riot.StatusEntry{},
+		Incidents:    []*riot.StatusEntry{},
+	}, http.StatusOK
+}
+
+// match returns the match with the given ID. If the match was listed in a match list, the summoner of the list is
+// one of the participants. The other participants are registered as known summoners
+func (d *Doer) match(r *request, gameID int) *riot.Match {
+	key := strconv.Itoa(gameID)
+	d.mu.Lock()
+	owner := d.matchOwners[r.platform+"/"+key]
+	d.mu.Unlock()
+	g := d.generator(r, "match/"+key)
+	summoners := make([]*riot.Summoner, 10)
+	for i := range summoners {
+		summoners[i] = g.Summoner()
+	}
+	if owner != nil {
+		summoners[uint64(d.seedOf(r, "owner/"+key))%uint64(len(summoners))] = owner
+	}
+	d.mu.Lock()
+	for _, s := range summoners {
+		d.register(r, s)
+	}
+	d.mu.Unlock()
+	m := g.Match(summoners...)
+	m.GameID = gameID
+	return m
+}
+
+func match(d *Doer, r *request, params []string) (interface{}, int) {
+	gameID, _ := strconv.Atoi(params[0])
+	return d.match(r, gameID), http.StatusOK
+}
+
+func timeline(d *Doer, r *request, params []string) (interface{}, int) {
+	gameID, _ := strconv.Atoi(params[0])
+	return d.generator(r, "timeline/"+params[0]).Timeline(d.match(r, gameID)), http.StatusOK
+}
+
+// matchlist returns the matches of the summoner, which all are registered with the summoner as their owner.
+// The beginIndex and endIndex query parameters are applied, all other filters are ignored
+func matchlist(d *Doer, r *request, params []string) (interface{}, int) {
+	summoner := d.summoner(r, fieldAccountID, params[0])
+	random := rand.New(rand.NewSource(d.seedOf(r, "matchlist/"+params[0])))
+	total := minMatchlistSize + random.Intn(maxMatchlistSize-minMatchlistSize+1)
+	gameIDs := make([]int, total)
+	gameID := 4400000000 + random.Intn(100000000)
+	for i := range gameIDs {
+		gameIDs[i] = gameID
+		gameID -= 1000 + random.Intn(50000)
+	}
+	query := r.URL.Query()
+	begin, err := strconv.Atoi(query.Get("beginIndex"))
+	if err != nil || begin < 0 {
+		begin = 0
+	}
+	end, err := strconv.Atoi(query.Get("endIndex"))
+	if err != nil || end > begin+maxMatchlistPage {
+		end = begin + defaultEndIndex
+	}
+	res := &riot.Matchlist{
+		Matches:    []*riot.MatchReference{},
+		TotalGames: total,
+		StartIndex: begin,
+	}
+	for i := begin; i < end && i < total; i++ {
+		d.mu.Lock()
+		d.matchOwners[r.platform+"/"+strconv.Itoa(gameIDs[i])] = summoner
+		d.mu.Unlock()
+		m := d.match(r, gameIDs[i])
+		p := m.Participants[0]
+		for j, identity := range m.ParticipantIdentities {
+			if identity.Player.SummonerID == summoner.ID {
+				p = m.Participants[j]
+			}
+		}
+		res.Matches = append(res.Matches, &riot.MatchReference{
+			GameID:     m.GameID,
+			PlatformID: m.PlatformID,
+			Champion:   p.ChampionID,
+			Queue:      m.QueueID,
+			Season:     m.SeasonID,
+			Timestamp:  m.GameCreation,
+			Lane:       p.Timeline.Lane,
+			Role:       p.Timeline.Role,
+		})
+	}
+	res.EndIndex = begin + len(res.Matches)
+	return res, http.StatusOK
+}
+
+func featuredGames(_ *Doer, _ *request, _ []string) (interface{}, int) {
+	return &riot.FeaturedGames{
+		ClientRefreshInterval: riot.DurationSeconds{Duration: 5 * time.Minute},
+		GameList:              []*riot.GameInfo{},
+	}, http.StatusOK
+}
+
+// createID returns an ID for the created provider or tournament based on the request body
+func createID(kind string) func(*Doer, *request, []string) (interface{}, int) {
+	return func(d *Doer, r *request, _ []string) (interface{}, int) {
+		body, err := readBody(r)
+		if err != nil {
+			return nil, http.StatusBadRequest
+		}
+		return int(uint32(d.seedOf(r, kind+"/"+body)) % 1000000), http.StatusOK
+	}
+}
+
+func tournamentCodes(d *Doer, r *request, _ []string) (interface{}, int) {
+	count, err := strconv.Atoi(r.URL.Query().Get("count"))
+	if err != nil || count < 1 || count > 1000 {
+		return nil, http.StatusBadRequest
+	}
+	tournamentID := r.URL.Query().Get("tournamentId")
+	random := rand.New(rand.NewSource(d.seedOf(r, "codes/"+tournamentID)))
+	codes := make([]string, count)
+	for i := range codes {
+		codes[i] = fmt.Sprintf("%s%s-%08x-%04x", r.platform, tournamentID, random.Uint32(), random.Intn(1<<16))
+	}
+	return codes, http.StatusOK
+}
+
+func lobbyEvents(_ *Doer, _ *request, _ []string) (interface{}, int) {
+	return &riot.LobbyEventList{EventList: []*riot.LobbyEvent{}}, http.StatusOK
+}
+
+func readBody(r *request) (string, error) {
+	if r.Body == nil {
+		return "", nil
+	}
+	defer r.Body.Close()
+	body, err := io.ReadAll(r.Body)
+	return string(body), err
+}
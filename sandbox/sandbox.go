@@ -0,0 +1,141 @@
+// Package sandbox provides a Doer answering Riot API requests with generated data without accessing the network.
+// Responses are deterministic and keyed by the input of the request, e.g. requesting the same summoner name always
+// returns the same summoner, and reference each other consistently, e.g. the matches of a summoner contain the
+// summoner. This makes the sandbox useful for demos, local development and the CI of applications using golio.
+//
+//	client := golio.NewClient("", golio.WithSandbox())
+//	summoner, _ := client.Riot.Summoner.GetByName("Any Name")
+//
+// Endpoints without data in the sandbox answer with 404 as the Riot API does, e.g. summoners are never in a game.
+// Requests to other services like Data Dragon are answered with 404 as well.
+package sandbox
+
+import (
+	"encoding/json"
+	"hash/fnv"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/mjourard/golio/fake"
+	"github.com/mjourard/golio/internal/fakeriot"
+	"github.com/mjourard/golio/riot"
+)
+
+const riotHostSuffix = ".api.riotgames.com"
+
+// Doer is an implementation of the Doer interface answering requests to the Riot API with generated data.
+// It is safe for concurrent use
+type Doer struct {
+	seed int64
+	mu   sync.Mutex
+	// summoners contains all summoners returned so far by their platform and ID, account ID and PUUID,
+	// so that looking up a summoner by any of its IDs returns the same summoner
+	summoners map[string]*riot.Summoner
+	// matchOwners contains the summoner of the match list a match was listed in by platform and game ID
+	matchOwners map[string]*riot.Summoner
+	// leagues contains all leagues returned so far by their ID
+	leagues map[string]*riot.LeagueList
+}
+
+// Option is used to configure the Doer
+type Option func(*Doer)
+
+// WithSeed sets the seed all generated data is based on. Doers with different seeds return different data for the
+// same requests
+func WithSeed(seed int64) Option {
+	return func(d *Doer) {
+		d.seed = seed
+	}
+}
+
+// New returns a new sandbox Doer
+func New(options ...Option) *Doer {
+	d := &Doer{
+		summoners:   map[string]*riot.Summoner{},
+		matchOwners: map[string]*riot.Summoner{},
+		leagues:     map[string]*riot.LeagueList{},
+	}
+	for _, opt := range options {
+		opt(d)
+	}
+	return d
+}
+
+// Do answers the request with generated data
+func (d *Doer) Do(r *http.Request) (*http.Response, error) {
+	if !strings.HasSuffix(r.URL.Host, riotHostSuffix) {
+		return fakeriot.ErrorResponse(http.StatusNotFound), nil
+	}
+	platform := strings.ToUpper(strings.TrimSuffix(r.URL.Host, riotHostSuffix))
+	payload, code := d.handle(&request{Request: r, platform: platform})
+	if code != http.StatusOK {
+		return fakeriot.ErrorResponse(code), nil
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	return fakeriot.Response(http.StatusOK, body), nil
+}
+
+// request is a request to the sandbox
+type request struct {
+	*http.Request
+	platform string
+}
+
+// generator returns a generator for the given key of the request, which always generates the same values for the
+// same key and platform
+func (d *Doer) generator(r *request, key string) *fake.Generator {
+	g := fake.New(d.seedOf(r, key))
+	g.SetPlatform(r.platform)
+	return g
+}
+
+func (d *Doer) seedOf(r *request, key string) int64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(r.platform + "/" + key))
+	return int64(h.Sum64()) ^ d.seed
+}
+
+// summoner returns the summoner with the given value of the given field, which is generated if it is not known yet
+func (d *Doer) summoner(r *request, field, value string) *riot.Summoner {
+	key := value
+	if field == fieldName {
+		key = fakeriot.NormalizeName(value)
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if s, ok := d.summoners[r.platform+"/"+field+"/"+key]; ok {
+		return s
+	}
+	s := d.generator(r, "summoner/"+field+"/"+key).Summoner()
+	switch field {
+	case fieldName:
+		s.Name = value
+	case fieldID:
+		s.ID = value
+	case fieldAccountID:
+		s.AccountID = value
+	case fieldPUUID:
+		s.PUUID = value
+	}
+	d.register(r, s)
+	return s
+}
+
+// register adds the summoner to the known summoners. The caller must hold d.mu
+func (d *Doer) register(r *request, s *riot.Summoner) {
+	for field, value := range map[string]string{
+		fieldName:      fakeriot.NormalizeName(s.Name),
+		fieldID:        s.ID,
+		fieldAccountID: s.AccountID,
+		fieldPUUID:     s.PUUID,
+	} {
+		key := r.platform + "/" + field + "/" + value
+		if _, ok := d.summoners[key]; !ok {
+			d.summoners[key] = s
+		}
+	}
+}
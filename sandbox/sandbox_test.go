@@ -0,0 +1,179 @@
+package sandbox
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mjourard/golio/api"
+	"github.com/mjourard/golio/riot"
+)
+
+func newClient(region api.Region, options ...Option) *riot.Client {
+	return riot.NewClient(region, "", New(options...), log.StandardLogger())
+}
+
+func TestDoer_Summoner(t *testing.T) {
+	t.Parallel()
+	client := newClient(api.RegionEuropeWest)
+	summoner, err := client.Summoner.GetByName("Silent Fox")
+	require.Nil(t, err)
+	assert.Equal(t, "Silent Fox", summoner.Name)
+	same, err := client.Summoner.GetByName("silentfox")
+	require.Nil(t, err)
+	assert.Equal(t, summoner, same)
+	for _, get := range []func() (*riot.Summoner, error){
+		func() (*riot.Summoner, error) { return client.Summoner.GetByID(summoner.ID) },
+		func() (*riot.Summoner, error) { return client.Summoner.GetByAccountID(summoner.AccountID) },
+		func() (*riot.Summoner, error) { return client.Summoner.GetByPUUID(summoner.PUUID) },
+	} {
+		got, err := get()
+		require.Nil(t, err)
+		assert.Equal(t, summoner, got)
+	}
+
+	fresh, err := newClient(api.RegionEuropeWest).Summoner.GetByName("Silent Fox")
+	require.Nil(t, err)
+	assert.Equal(t, summoner, fresh)
+	other, err := client.Summoner.GetByName("Crimson Owl")
+	require.Nil(t, err)
+	assert.NotEqual(t, summoner.ID, other.ID)
+	seeded, err := newClient(api.RegionEuropeWest, WithSeed(42)).Summoner.GetByName("Silent Fox")
+	require.Nil(t, err)
+	assert.NotEqual(t, summoner.ID, seeded.ID)
+	otherRegion, err := newClient(api.RegionKorea).Summoner.GetByName("Silent Fox")
+	require.Nil(t, err)
+	assert.NotEqual(t, summoner.ID, otherRegion.ID)
+
+	unknown, err := newClient(api.RegionEuropeWest).Summoner.GetByID("some-id")
+	require.Nil(t, err)
+	assert.Equal(t, "some-id", unknown.ID)
+}
+
+func TestDoer_ChampionMastery(t *testing.T) {
+	t.Parallel()
+	client := newClient(api.RegionEuropeWest)
+	masteries, err := client.ChampionMastery.List("summoner")
+	require.Nil(t, err)
+	require.Len(t, masteries, masteriesCount)
+	mastery, err := client.ChampionMastery.Get("summoner", strconv.Itoa(masteries[3].ChampionID))
+	require.Nil(t, err)
+	assert.Equal(t, masteries[3], mastery)
+	_, err = client.ChampionMastery.Get("summoner", "99999")
+	assert.True(t, errors.Is(err, api.ErrNotFound))
+	score, err := client.ChampionMastery.GetTotal("summoner")
+	require.Nil(t, err)
+	assert.True(t, score >= masteriesCount)
+	rotation, err := client.Champion.GetFreeRotation()
+	require.Nil(t, err)
+	assert.NotEmpty(t, rotation.FreeChampionIDs)
+}
+
+func TestDoer_League(t *testing.T) {
+	t.Parallel()
+	client := newClient(api.RegionEuropeWest)
+	challenger, err := client.League.GetChallenger(riot.QueueRankedSolo)
+	require.Nil(t, err)
+	assert.Equal(t, "CHALLENGER", challenger.Tier)
+	assert.Len(t, challenger.Entries, apexLeagueSize)
+	league, err := client.League.Get(challenger.LeagueID)
+	require.Nil(t, err)
+	assert.Equal(t, challenger.Entries, league.Entries)
+
+	entries, err := client.League.ListBySummoner("summoner")
+	require.Nil(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "summoner", entries[0].SummonerID)
+	league, err = client.League.Get(entries[0].LeagueID)
+	require.Nil(t, err)
+	assert.Contains(t, league.Entries, entries[0])
+
+	players, err := client.League.ListPlayers(riot.QueueRankedSolo, riot.TierGold, riot.DivisionTwo, 1)
+	require.Nil(t, err)
+	require.Len(t, players, leaguePageSize)
+	for _, player := range players {
+		assert.Equal(t, string(riot.TierGold), player.Tier)
+		assert.Equal(t, string(riot.DivisionTwo), player.Rank)
+	}
+	players, err = client.League.ListPlayers(riot.QueueRankedSolo, riot.TierGold, riot.DivisionTwo, leaguePages+1)
+	require.Nil(t, err)
+	assert.Empty(t, players)
+}
+
+func TestDoer_Match(t *testing.T) {
+	t.Parallel()
+	client := newClient(api.RegionNorthAmerica)
+	summoner, err := client.Summoner.GetByName("Lucky Tide")
+	require.Nil(t, err)
+	begin, end := 2, 7
+	matches, err := client.Match.List(summoner.AccountID, &riot.MatchFilter{BeginIndex: &begin, EndIndex: &end})
+	require.Nil(t, err)
+	require.Len(t, matches.Matches, 5)
+	assert.Equal(t, begin, matches.StartIndex)
+	assert.Equal(t, end, matches.EndIndex)
+	for _, reference := range matches.Matches {
+		assert.Equal(t, "NA1", reference.PlatformID)
+	}
+	match, err := client.Match.Get(matches.Matches[0].GameID)
+	require.Nil(t, err)
+	assert.Equal(t, matches.Matches[0].GameID, match.GameID)
+	found := false
+	for i, identity := range match.ParticipantIdentities {
+		if identity.Player.SummonerID == summoner.ID {
+			found = true
+			assert.Equal(t, matches.Matches[0].Champion, match.Participants[i].ChampionID)
+		}
+	}
+	assert.True(t, found, "summoner is no participant")
+	participant, err := client.Summoner.GetByID(match.ParticipantIdentities[9].Player.SummonerID)
+	require.Nil(t, err)
+	assert.Equal(t, match.ParticipantIdentities[9].Player.SummonerName, participant.Name)
+	timeline, err := client.Match.GetTimeline(match.GameID)
+	require.Nil(t, err)
+	assert.Equal(t, match.GameDuration.Duration, timeline.Frames[len(timeline.Frames)-1].Timestamp.Duration)
+}
+
+func TestDoer_Other(t *testing.T) {
+	t.Parallel()
+	client := newClient(api.RegionEuropeWest)
+	status, err := client.Status.Get()
+	require.Nil(t, err)
+	assert.Equal(t, "EUW1", status.Name)
+	platform, err := client.Status.GetPlatformData()
+	require.Nil(t, err)
+	assert.Equal(t, "EUW1", platform.ID)
+	_, err = client.Spectator.ListFeatured()
+	require.Nil(t, err)
+	_, err = client.Spectator.GetCurrent("summoner")
+	assert.True(t, errors.Is(err, api.ErrNotFound))
+	_, err = client.ThirdPartyCode.Get("summoner")
+	assert.True(t, errors.Is(err, api.ErrNotFound))
+
+	providerID, err := client.Tournament.CreateProvider(&riot.ProviderRegistrationParameters{
+		Region: "EUW",
+		URL:    "https://example.com",
+	}, true)
+	require.Nil(t, err)
+	tournamentID, err := client.Tournament.Create(&riot.TournamentRegistrationParameters{ProviderID: providerID}, true)
+	require.Nil(t, err)
+	codes, err := client.Tournament.CreateCodes(tournamentID, 3, &riot.TournamentCodeParameters{TeamSize: 5}, true)
+	require.Nil(t, err)
+	assert.Len(t, codes, 3)
+	events, err := client.Tournament.ListLobbyEvents(codes[0], true)
+	require.Nil(t, err)
+	assert.Empty(t, events.EventList)
+}
+
+func TestDoer_DataDragon(t *testing.T) {
+	t.Parallel()
+	request, err := http.NewRequest(http.MethodGet, "https://ddragon.leagueoflegends.com/api/versions.json", nil)
+	require.Nil(t, err)
+	response, err := New().Do(request)
+	require.Nil(t, err)
+	assert.Equal(t, http.StatusNotFound, response.StatusCode)
+}
@@ -0,0 +1,52 @@
+package server
+
+import (
+	"regexp"
+	"time"
+)
+
+type route struct {
+	pattern *regexp.Regexp
+	// ttl is the time a response is cached, overriding the default of the server if not 0
+	ttl time.Duration
+}
+
+// immutable is the cache time of responses which never change, e.g. finished matches
+const immutable = 24 * time.Hour
+
+// routes are the GET endpoints of the Riot API supported by golio
+var routes = []route{
+	{pattern: regexp.MustCompile(`^/lol/summoner/v4/summoners/by-(name|account|puuid)/[^/]+$`)},
+	{pattern: regexp.MustCompile(`^/lol/summoner/v4/summoners/[^/]+$`)},
+	{pattern: regexp.MustCompile(`^/lol/champion-mastery/v4/champion-masteries/by-summoner/[^/]+$`)},
+	{pattern: regexp.MustCompile(`^/lol/champion-mastery/v4/champion-masteries/by-summoner/[^/]+/by-champion/\d+$`)},
+	{pattern: regexp.MustCompile(`^/lol/champion-mastery/v4/scores/by-summoner/[^/]+$`)},
+	{pattern: regexp.MustCompile(`^/lol/platform/v3/champion-rotations$`)},
+	{pattern: regexp.MustCompile(`^/lol/platform/v3/third-party-code/by-summoner/[^/]+$`)},
+	{pattern: regexp.MustCompile(`^/lol/league/v4/(challenger|grandmaster|master)leagues/by-queue/[^/]+$`)},
+	{pattern: regexp.MustCompile(`^/lol/league/v4/leagues/[^/]+$`)},
+	{pattern: regexp.MustCompile(`^/lol/league/v4/entries/by-summoner/[^/]+$`)},
+	{pattern: regexp.MustCompile(`^/lol/league/v4/entries/[^/]+/[^/]+/[^/]+$`)},
+	{pattern: regexp.MustCompile(`^/lol/status/v3/shard-data$`)},
+	{pattern: regexp.MustCompile(`^/lol/match/v4/matches/\d+$`), ttl: immutable},
+	{pattern: regexp.MustCompile(`^/lol/match/v4/matches/\d+/by-tournament-code/[^/]+$`), ttl: immutable},
+	{pattern: regexp.MustCompile(`^/lol/match/v4/matches/by-tournament-code/[^/]+/ids$`)},
+	{pattern: regexp.MustCompile(`^/lol/match/v4/matchlists/by-account/[^/]+$`)},
+	{pattern: regexp.MustCompile(`^/lol/match/v4/timelines/by-match/\d+$`), ttl: immutable},
+	{pattern: regexp.MustCompile(`^/lol/spectator/v4/active-games/by-summoner/[^/]+$`)},
+	{pattern: regexp.MustCompile(`^/lol/spectator/v4/featured-games$`)},
+	{pattern: regexp.MustCompile(`^/lol/tournament(-stub)?/v4/lobby-events/by-code/[^/]+$`)},
+	{pattern: regexp.MustCompile(`^/lol/tournament/v4/codes/[^/]+$`)},
+	{pattern: regexp.MustCompile(`^/riot/account/v1/accounts/by-puuid/[^/]+$`)},
+	{pattern: regexp.MustCompile(`^/riot/account/v1/accounts/by-riot-id/[^/]+/[^/]+$`)},
+}
+
+// findRoute returns the route matching the path or false if the endpoint is not supported
+func findRoute(path string) (route, bool) {
+	for _, r := range routes {
+		if r.pattern.MatchString(path) {
+			return r, true
+		}
+	}
+	return route{}, false
+}
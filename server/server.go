@@ -0,0 +1,329 @@
+// Package server provides an HTTP server exposing the routes of the Riot API, so that applications written in any
+// language can send their requests through a single golio client. The server adds the API key, handles the rate
+// limits of the key and caches responses, e.g.
+//
+//	proxy := server.New("API KEY", server.WithCacheTTL(time.Minute))
+//	log.Fatal(http.ListenAndServe(":8080", proxy))
+//
+// Requests are sent to the server with the platform ID of the region as first path segment, e.g.
+// GET http://localhost:8080/euw1/lol/summoner/v4/summoners/by-name/name. The responses and errors are the same as
+// the ones of the Riot API. API keys sent by the applications, either as X-Riot-Token header or as api_key query
+// parameter, are removed and replaced by the key of the server.
+//
+// By default only the GET endpoints supported by golio are served. Using WithPassthrough all other requests are
+// forwarded to the Riot API unchanged, so that the server can front endpoints golio does not support yet.
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/mjourard/golio/api"
+	"github.com/mjourard/golio/cache"
+	"github.com/mjourard/golio/internal"
+	"github.com/mjourard/golio/logging"
+	"github.com/mjourard/golio/riot"
+)
+
+// Headers set on the responses of the server
+const (
+	// HeaderCache is HIT for responses served from the cache, BYPASS for forwarded requests of endpoints which are not
+	// cached and MISS otherwise
+	HeaderCache = "X-Golio-Cache"
+	cacheHit    = "HIT"
+	cacheMiss   = "MISS"
+	cacheBypass = "BYPASS"
+)
+
+// apiKeyParameter is the query parameter used to send the API key instead of the X-Riot-Token header
+const apiKeyParameter = "api_key"
+
+// hopHeaders are not forwarded by proxies, see RFC 7230 section 6.1. Accept-Encoding is left to the http client and
+// the API key header is set by the client of the server
+var hopHeaders = []string{
+	"Connection", "Keep-Alive", "Proxy-Authenticate", "Proxy-Authorization", "Te", "Trailer", "Transfer-Encoding",
+	"Upgrade", "Accept-Encoding", "X-Riot-Token",
+}
+
+const (
+	// defaultCacheTTL is the time responses are cached if not configured otherwise
+	defaultCacheTTL = time.Minute
+	// defaultCacheSize is the number of responses kept by the default cache
+	defaultCacheSize = 10000
+)
+
+// Server is an http.Handler forwarding requests to the Riot API. It is safe for concurrent use
+type Server struct {
+	apiKey   string
+	client   internal.Doer
+	logger   log.FieldLogger
+	options  []riot.Option
+	cacheTTL time.Duration
+	// passthrough forwards requests of endpoints which are not supported by golio
+	passthrough bool
+	cache       riot.Cache
+	mu          sync.Mutex
+	clients     map[api.Region]*riot.Client
+}
+
+// Option is used to configure the Server
+type Option func(*Server)
+
+// WithClient sets the http client used to send requests to the Riot API
+func WithClient(c internal.Doer) Option {
+	return func(s *Server) {
+		s.client = c
+	}
+}
+
+// WithLogger sets the logger of the server and its clients
+func WithLogger(l log.FieldLogger) Option {
+	return func(s *Server) {
+		s.logger = l
+	}
+}
+
+// WithLogging sets a logger of the server and its clients which does not depend on logrus, e.g.
+// logging.Slog(slog.Default())
+func WithLogging(l logging.Logger) Option {
+	return func(s *Server) {
+		s.logger = logging.FieldLogger(l)
+	}
+}
+
+// WithCacheTTL sets the time successful responses are cached. Finished matches and timelines never change and are
+// cached for a day if the TTL is shorter. A TTL of 0 disables the cache. Defaults to a minute
+func WithCacheTTL(ttl time.Duration) Option {
+	return func(s *Server) {
+		s.cacheTTL = ttl
+	}
+}
+
+// WithCache sets the cache of the responses, e.g. redis.NewCache to share it between the instances of the server.
+// Defaults to an in-memory cache of the 10000 most recently used responses
+func WithCache(c riot.Cache) Option {
+	return func(s *Server) {
+		s.cache = c
+	}
+}
+
+// WithPassthrough forwards all requests which are not served by the server otherwise, e.g. of endpoints golio does
+// not support yet or with methods other than GET, to the Riot API. The responses are returned unchanged and are not
+// cached
+func WithPassthrough() Option {
+	return func(s *Server) {
+		s.passthrough = true
+	}
+}
+
+// WithRiotOptions sets the options of the clients sending the requests, e.g. riot.WithRequestObserver
+func WithRiotOptions(options ...riot.Option) Option {
+	return func(s *Server) {
+		s.options = append(s.options, options...)
+	}
+}
+
+// New returns a new server sending requests to the Riot API using the API key. The requests of the clients wait for
+// the rate limits of the key, see riot.WithRateLimiter
+func New(apiKey string, options ...Option) *Server {
+	s := &Server{
+		apiKey:   apiKey,
+		client:   http.DefaultClient,
+		logger:   log.StandardLogger(),
+		cacheTTL: defaultCacheTTL,
+		clients:  map[api.Region]*riot.Client{},
+	}
+	for _, opt := range options {
+		opt(s)
+	}
+	if s.cache == nil {
+		s.cache = cache.NewLRU(defaultCacheSize)
+	}
+	return s
+}
+
+// ServeHTTP forwards the request to the Riot API or answers it from the cache
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	region, endpoint, err := splitPath(r.URL.EscapedPath())
+	if err != nil {
+		writeError(w, http.StatusNotFound, err.Error())
+		return
+	}
+	_, ok := findRoute(endpoint)
+	query := r.URL.Query()
+	query.Del(apiKeyParameter)
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+	if !ok || r.Method != http.MethodGet {
+		switch {
+		case s.passthrough:
+			s.forward(w, r, region, endpoint)
+		case !ok:
+			writeError(w, http.StatusNotFound, "unsupported endpoint")
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		}
+		return
+	}
+	hit := new(bool)
+	ctx := context.WithValue(r.Context(), cacheHitKey{}, hit)
+	var body json.RawMessage
+	if err := s.riotClient(region).GetInto(ctx, endpoint, &body); err != nil {
+		s.logger.WithFields(log.Fields{"region": region, "endpoint": endpoint}).Debug(err)
+		writeRiotError(w, err)
+		return
+	}
+	status := cacheMiss
+	if *hit {
+		status = cacheHit
+	}
+	writeBody(w, status, body)
+}
+
+// forward sends the request to the Riot API and copies the response unchanged
+func (s *Server) forward(w http.ResponseWriter, r *http.Request, region api.Region, endpoint string) {
+	header := r.Header.Clone()
+	for _, h := range hopHeaders {
+		header.Del(h)
+	}
+	response, err := s.riotClient(region).Forward(r.Context(), r.Method, endpoint, header, r.Body)
+	if err != nil {
+		s.logger.WithFields(log.Fields{"region": region, "endpoint": endpoint}).Debug(err)
+		writeRiotError(w, err)
+		return
+	}
+	defer response.Body.Close()
+	for key, values := range response.Header {
+		w.Header()[key] = values
+	}
+	for _, h := range hopHeaders {
+		w.Header().Del(h)
+	}
+	w.Header().Set(HeaderCache, cacheBypass)
+	w.WriteHeader(response.StatusCode)
+	_, _ = io.Copy(w, response.Body)
+}
+
+// splitPath returns the region given as the first segment of the escaped path and the remaining path. The segments are
+// split before they are unescaped, so that path parameters may contain escaped slashes, e.g. the game names of Riot
+// IDs, and are escaped again for the request to the Riot API
+func splitPath(escapedPath string) (api.Region, string, error) {
+	segments := strings.Split(strings.TrimPrefix(escapedPath, "/"), "/")
+	if len(segments) < 2 {
+		return "", "", errors.New("missing region")
+	}
+	for i, segment := range segments {
+		unescaped, err := url.PathUnescape(segment)
+		if err != nil {
+			return "", "", err
+		}
+		segments[i] = unescaped
+	}
+	region := api.Region(segments[0])
+	if err := region.Validate(); err != nil {
+		return "", "", err
+	}
+	endpoint := ""
+	for _, segment := range segments[1:] {
+		endpoint += "/" + url.PathEscape(segment)
+	}
+	return region, endpoint, nil
+}
+
+// riotClient returns the client of the region, creating it on first use
+func (s *Server) riotClient(region api.Region) *riot.Client {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	client, ok := s.clients[region]
+	if !ok {
+		options := []riot.Option{riot.WithRateLimiter()}
+		if s.cacheTTL > 0 {
+			options = append(options, riot.WithCache(&routeCache{Cache: s.cache}, s.cacheTTL))
+		}
+		client = riot.NewClient(region, s.apiKey, s.client, s.logger, append(options, s.options...)...)
+		s.clients[region] = client
+	}
+	return client
+}
+
+type cacheHitKey struct{}
+
+// routeCache extends the time to live of the responses of routes with a longer ttl, e.g. of finished matches, and
+// reports hits to the request, see cacheHitKey
+type routeCache struct {
+	riot.Cache
+}
+
+func (c *routeCache) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	value, ok, err := c.Cache.Get(ctx, key)
+	if hit, isHit := ctx.Value(cacheHitKey{}).(*bool); isHit && ok {
+		*hit = true
+	}
+	return value, ok, err
+}
+
+func (c *routeCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	// the keys are the region followed by the endpoint, see riot.CacheKey
+	_, endpoint, _ := strings.Cut(key, "/")
+	endpoint, _, _ = strings.Cut(endpoint, "?")
+	if route, ok := findRoute("/" + endpoint); ok && route.ttl > ttl {
+		ttl = route.ttl
+	}
+	return c.Cache.Set(ctx, key, value, ttl)
+}
+
+func writeBody(w http.ResponseWriter, cache string, body json.RawMessage) {
+	w.Header().Set("Content-Type", "application/json;charset=utf-8")
+	w.Header().Set(HeaderCache, cache)
+	_, _ = w.Write(body)
+}
+
+// writeRiotError writes the error response of the Riot API or a 502 if the Riot API could not be reached. The
+// Retry-After and rate limit headers of responses with status 429 are forwarded
+func writeRiotError(w http.ResponseWriter, err error) {
+	var responseErr *api.ResponseError
+	if errors.As(err, &responseErr) {
+		if responseErr.StatusCode == http.StatusTooManyRequests {
+			for key, values := range responseErr.Header {
+				w.Header()[key] = values
+			}
+			var rateLimitErr *api.RateLimitError
+			if w.Header().Get("Retry-After") == "" && errors.As(err, &rateLimitErr) && rateLimitErr.RetryAfter > 0 {
+				seconds := int64((rateLimitErr.RetryAfter + time.Second - 1) / time.Second)
+				w.Header().Set("Retry-After", strconv.FormatInt(seconds, 10))
+			}
+		}
+		writeError(w, responseErr.StatusCode, responseErr.Err.Message)
+		return
+	}
+	var validationErr *riot.ValidationError
+	if errors.As(err, &validationErr) {
+		writeError(w, http.StatusBadRequest, validationErr.Error())
+		return
+	}
+	// the error is not sent as it may contain details of the server, it is logged by the caller
+	writeError(w, http.StatusBadGateway, "riot api unavailable")
+}
+
+// writeError writes an error response in the format used by the Riot API
+func writeError(w http.ResponseWriter, code int, message string) {
+	w.Header().Set("Content-Type", "application/json;charset=utf-8")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"status": map[string]interface{}{
+			"message":     message,
+			"status_code": code,
+		},
+	})
+}
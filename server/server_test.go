@@ -0,0 +1,236 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mjourard/golio/api"
+	"github.com/mjourard/golio/cache"
+	"github.com/mjourard/golio/logging"
+	"github.com/mjourard/golio/mock"
+	"github.com/mjourard/golio/mockserver"
+	"github.com/mjourard/golio/riot"
+)
+
+func TestServer(t *testing.T) {
+	t.Parallel()
+	riotServer := mockserver.New()
+	defer riotServer.Close()
+	proxy := httptest.NewServer(New("API_KEY", WithClient(riotServer.Client()), WithLogger(logrus.StandardLogger())))
+	defer proxy.Close()
+
+	tests := []struct {
+		name   string
+		path   string
+		method string
+		want   int
+		cache  string
+	}{
+		{name: "miss", path: "/euw1/lol/summoner/v4/summoners/by-name/name", want: http.StatusOK, cache: cacheMiss},
+		{name: "hit", path: "/euw1/lol/summoner/v4/summoners/by-name/name", want: http.StatusOK, cache: cacheHit},
+		{name: "other region", path: "/kr/lol/summoner/v4/summoners/by-name/name", want: http.StatusOK,
+			cache: cacheMiss},
+		{name: "unknown region", path: "/xx1/lol/summoner/v4/summoners/by-name/name", want: http.StatusNotFound},
+		{name: "missing region", path: "/", want: http.StatusNotFound},
+		{name: "unsupported", path: "/euw1/lol/unknown/v1/path", want: http.StatusNotFound},
+		{name: "method", path: "/euw1/lol/status/v3/shard-data", method: http.MethodPost,
+			want: http.StatusMethodNotAllowed},
+	}
+	for _, test := range tests {
+		method := test.method
+		if method == "" {
+			method = http.MethodGet
+		}
+		request, err := http.NewRequest(method, proxy.URL+test.path, nil)
+		require.Nil(t, err)
+		response, err := http.DefaultClient.Do(request)
+		require.Nil(t, err, test.name)
+		body, err := io.ReadAll(response.Body)
+		require.Nil(t, err)
+		_ = response.Body.Close()
+		assert.Equal(t, test.want, response.StatusCode, test.name)
+		assert.Equal(t, test.cache, response.Header.Get(HeaderCache), test.name)
+		assert.True(t, json.Valid(body), test.name)
+	}
+}
+
+func TestServer_RiotError(t *testing.T) {
+	t.Parallel()
+	lru := cache.NewLRU(0)
+	proxy := New("API_KEY", WithClient(mock.NewStatusMockDoer(http.StatusNotFound)), WithCache(lru))
+	recorder := httptest.NewRecorder()
+	proxy.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/euw1/lol/match/v4/matches/1", nil))
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	var got struct {
+		Status struct {
+			StatusCode int `json:"status_code"`
+		} `json:"status"`
+	}
+	require.Nil(t, json.Unmarshal(recorder.Body.Bytes(), &got))
+	assert.Equal(t, http.StatusNotFound, got.Status.StatusCode)
+	assert.Equal(t, 0, lru.Len())
+}
+
+func TestServer_RateLimitError(t *testing.T) {
+	t.Parallel()
+	header := http.Header{
+		"Retry-After":            {"7"},
+		"X-Rate-Limit-Type":      {api.RateLimitTypeApplication},
+		"X-App-Rate-Limit":       {"20:1"},
+		"X-App-Rate-Limit-Count": {"21:1"},
+	}
+	proxy := New("API_KEY", WithClient(mock.NewHeaderMockDoer(http.StatusTooManyRequests, header)),
+		WithRiotOptions(riot.WithRateLimitErrors()))
+	recorder := httptest.NewRecorder()
+	proxy.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/euw1/lol/status/v3/shard-data", nil))
+	assert.Equal(t, http.StatusTooManyRequests, recorder.Code)
+	for key, values := range header {
+		assert.Equal(t, values, recorder.Header().Values(key), key)
+	}
+}
+
+func TestServer_TransportError(t *testing.T) {
+	t.Parallel()
+	proxy := New("API_KEY", WithRiotOptions(riot.WithBaseURL("http://127.0.0.1:1/{route}"),
+		riot.WithKeyPlacement(riot.KeyInQuery)))
+	recorder := httptest.NewRecorder()
+	proxy.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/euw1/lol/status/v3/shard-data", nil))
+	assert.Equal(t, http.StatusBadGateway, recorder.Code)
+	assert.NotContains(t, recorder.Body.String(), "API_KEY")
+	assert.NotContains(t, recorder.Body.String(), "127.0.0.1")
+}
+
+func TestServer_Cache(t *testing.T) {
+	t.Parallel()
+	doer := mock.NewSequenceDoer(mock.OK(map[string]int{"gameId": 1}))
+	lru := cache.NewLRU(2)
+	proxy := New("API_KEY", WithClient(doer), WithCache(lru), WithCacheTTL(time.Second))
+	for _, path := range []string{"/euw1/lol/match/v4/matches/1", "/euw1/lol/match/v4/matches/2",
+		"/euw1/lol/match/v4/matches/3", "/euw1/lol/match/v4/matches/3"} {
+		proxy.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+	// the least recently used response was evicted for new ones
+	assert.Equal(t, 2, lru.Len())
+	assert.Equal(t, 3, doer.Calls())
+	recorder := httptest.NewRecorder()
+	proxy.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/euw1/lol/match/v4/matches/2", nil))
+	assert.Equal(t, cacheHit, recorder.Header().Get(HeaderCache))
+
+	// finished matches are cached longer than the ttl of the server
+	c := &ttlCache{Cache: lru}
+	proxy = New("API_KEY", WithClient(doer), WithCache(c), WithCacheTTL(time.Second))
+	proxy.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/kr/lol/match/v4/matches/1", nil))
+	proxy.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/kr/lol/status/v3/shard-data", nil))
+	assert.Equal(t, []time.Duration{immutable, time.Second}, c.ttls)
+}
+
+// ttlCache records the ttl of the values stored in the cache
+type ttlCache struct {
+	riot.Cache
+	ttls []time.Duration
+}
+
+func (c *ttlCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	c.ttls = append(c.ttls, ttl)
+	return c.Cache.Set(ctx, key, value, ttl)
+}
+
+func TestServer_CacheTTL(t *testing.T) {
+	t.Parallel()
+	doer := mock.NewSequenceDoer(mock.OK(map[string]int{"gameId": 1}))
+	proxy := New("API_KEY", WithClient(doer), WithCacheTTL(0))
+	for i := 0; i < 2; i++ {
+		recorder := httptest.NewRecorder()
+		proxy.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/euw1/lol/status/v3/shard-data", nil))
+		assert.Equal(t, cacheMiss, recorder.Header().Get(HeaderCache))
+	}
+	assert.Equal(t, 2, doer.Calls())
+}
+
+func TestServer_Passthrough(t *testing.T) {
+	t.Parallel()
+	var got *http.Request
+	var gotBody string
+	doer := &mock.Doer{Custom: func(r *http.Request) (*http.Response, error) {
+		got = r
+		if r.Body != nil {
+			body, _ := io.ReadAll(r.Body)
+			gotBody = string(body)
+		}
+		header := http.Header{}
+		header.Set("Content-Type", "text/plain")
+		header.Set("Connection", "close")
+		return &http.Response{
+			StatusCode: http.StatusCreated,
+			Header:     header,
+			Body:       io.NopCloser(strings.NewReader("created")),
+		}, nil
+	}}
+	proxy := New("API_KEY", WithClient(doer), WithPassthrough())
+
+	request := httptest.NewRequest(http.MethodPost, "/euw1/lol/new/v1/path?api_key=CLIENT_KEY&a=b",
+		strings.NewReader("body"))
+	request.Header.Set("X-Riot-Token", "CLIENT_KEY")
+	request.Header.Set("X-Custom", "value")
+	recorder := httptest.NewRecorder()
+	proxy.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusCreated, recorder.Code)
+	assert.Equal(t, "created", recorder.Body.String())
+	assert.Equal(t, "text/plain", recorder.Header().Get("Content-Type"))
+	assert.Empty(t, recorder.Header().Get("Connection"))
+	assert.Equal(t, cacheBypass, recorder.Header().Get(HeaderCache))
+	assert.Equal(t, "https://euw1.api.riotgames.com/lol/new/v1/path?a=b", got.URL.String())
+	assert.Equal(t, []string{"API_KEY"}, got.Header.Values("X-Riot-Token"))
+	assert.Equal(t, "value", got.Header.Get("X-Custom"))
+	assert.Equal(t, "body", gotBody)
+
+	recorder = httptest.NewRecorder()
+	proxy.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/euw1/lol/status/v3/shard-data", nil))
+	assert.Equal(t, http.StatusCreated, recorder.Code)
+	assert.Equal(t, "https://euw1.api.riotgames.com/lol/status/v3/shard-data", got.URL.String())
+}
+
+func TestServer_stripsKey(t *testing.T) {
+	t.Parallel()
+	var got *http.Request
+	doer := &mock.Doer{Custom: func(r *http.Request) (*http.Response, error) {
+		got = r
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("[]"))}, nil
+	}}
+	proxy := New("API_KEY", WithClient(doer), WithLogging(logging.Discard()))
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet,
+		"/euw1/lol/match/v4/matchlists/by-account/id?api_key=CLIENT_KEY&endIndex=10&beginIndex=0", nil)
+	request.Header.Set("X-Riot-Token", "CLIENT_KEY")
+	proxy.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "https://euw1.api.riotgames.com/lol/match/v4/matchlists/by-account/id?beginIndex=0&endIndex=10",
+		got.URL.String())
+	assert.Equal(t, []string{"API_KEY"}, got.Header.Values("X-Riot-Token"))
+}
+
+func TestServer_escapedPath(t *testing.T) {
+	t.Parallel()
+	var got *http.Request
+	doer := &mock.Doer{Custom: func(r *http.Request) (*http.Response, error) {
+		got = r
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("{}"))}, nil
+	}}
+	proxy := New("API_KEY", WithClient(doer), WithLogging(logging.Discard()))
+	recorder := httptest.NewRecorder()
+	proxy.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet,
+		"/euw1/riot/account/v1/accounts/by-riot-id/a%2Fb%3Fc%23d%20e/EUW", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "/riot/account/v1/accounts/by-riot-id/a%2Fb%3Fc%23d%20e/EUW", got.URL.EscapedPath())
+	assert.Empty(t, got.URL.RawQuery)
+}
@@ -0,0 +1,145 @@
+package serverless
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mjourard/golio/internal"
+	"github.com/mjourard/golio/internal/cloud"
+)
+
+const (
+	dynamoDBTarget      = "DynamoDB_20120810.UpdateItem"
+	dynamoDBContentType = "application/x-amz-json-1.0"
+)
+
+// AWSCredentials are used to sign the requests to DynamoDB
+type AWSCredentials = cloud.AWSCredentials
+
+// DynamoDB is a riot.RateLimitStore keeping the counters in a DynamoDB table. The table needs the string partition
+// key "id" and should have time to live enabled on the number attribute "expires". It is safe for concurrent use
+type DynamoDB struct {
+	table       string
+	region      string
+	endpoint    string
+	credentials AWSCredentials
+	client      internal.Doer
+}
+
+// DynamoDBOption is used to configure the DynamoDB store
+type DynamoDBOption func(*DynamoDB)
+
+// WithAWSCredentials sets the credentials. Defaults to the environment variables AWS_ACCESS_KEY_ID,
+// AWS_SECRET_ACCESS_KEY and AWS_SESSION_TOKEN, which are set in Lambda functions
+func WithAWSCredentials(credentials AWSCredentials) DynamoDBOption {
+	return func(d *DynamoDB) {
+		d.credentials = credentials
+	}
+}
+
+// WithAWSRegion sets the region of the table. Defaults to the environment variable AWS_REGION
+func WithAWSRegion(region string) DynamoDBOption {
+	return func(d *DynamoDB) {
+		d.region = region
+	}
+}
+
+// WithDynamoDBEndpoint sets the URL of DynamoDB, e.g. of DynamoDB local. Defaults to the endpoint of the region
+func WithDynamoDBEndpoint(endpoint string) DynamoDBOption {
+	return func(d *DynamoDB) {
+		d.endpoint = endpoint
+	}
+}
+
+// WithDynamoDBClient sets the http client used to send the requests
+func WithDynamoDBClient(c internal.Doer) DynamoDBOption {
+	return func(d *DynamoDB) {
+		d.client = c
+	}
+}
+
+// NewDynamoDB returns a store using the table
+func NewDynamoDB(table string, options ...DynamoDBOption) (*DynamoDB, error) {
+	d := &DynamoDB{
+		table:       table,
+		region:      os.Getenv("AWS_REGION"),
+		credentials: cloud.AWSCredentialsFromEnv(),
+		client:      http.DefaultClient,
+	}
+	for _, opt := range options {
+		opt(d)
+	}
+	if d.region == "" {
+		return nil, errors.New("serverless: missing aws region")
+	}
+	if d.credentials.AccessKeyID == "" || d.credentials.SecretAccessKey == "" {
+		return nil, errors.New("serverless: missing aws credentials")
+	}
+	if d.endpoint == "" {
+		d.endpoint = fmt.Sprintf("https://dynamodb.%s.amazonaws.com/", d.region)
+	}
+	return d, nil
+}
+
+type dynamoDBValue struct {
+	S string `json:"S,omitempty"`
+	N string `json:"N,omitempty"`
+}
+
+// Increment increments the counter with the key using an atomic update of its item
+func (d *DynamoDB) Increment(ctx context.Context, key string, expires time.Time) (int64, error) {
+	body, err := json.Marshal(map[string]interface{}{
+		"TableName":        d.table,
+		"Key":              map[string]dynamoDBValue{"id": {S: key}},
+		"UpdateExpression": "ADD #count :one SET #expires = :expires",
+		"ExpressionAttributeNames": map[string]string{
+			"#count":   "count",
+			"#expires": "expires",
+		},
+		"ExpressionAttributeValues": map[string]dynamoDBValue{
+			":one":     {N: "1"},
+			":expires": {N: strconv.FormatInt(expires.Unix(), 10)},
+		},
+		"ReturnValues": "UPDATED_NEW",
+	})
+	if err != nil {
+		return 0, err
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, d.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	request.Header.Set("Content-Type", dynamoDBContentType)
+	request.Header.Set("X-Amz-Target", dynamoDBTarget)
+	cloud.SignAWS(request, body, d.credentials, d.region, "dynamodb", time.Now())
+	response, err := d.client.Do(request)
+	if err != nil {
+		return 0, err
+	}
+	defer response.Body.Close()
+	var result struct {
+		Attributes map[string]dynamoDBValue `json:"Attributes"`
+		Type       string                   `json:"__type"`
+		Message    string                   `json:"message"`
+	}
+	decodeErr := json.NewDecoder(response.Body).Decode(&result)
+	if response.StatusCode != http.StatusOK {
+		code := result.Type
+		if i := strings.LastIndex(code, "#"); i >= 0 {
+			code = code[i+1:]
+		}
+		return 0, &Error{StatusCode: response.StatusCode, Code: code, Message: result.Message}
+	}
+	if decodeErr != nil {
+		return 0, decodeErr
+	}
+	return strconv.ParseInt(result.Attributes["count"].N, 10, 64)
+}
@@ -0,0 +1,64 @@
+package serverless
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDynamoDB_Increment(t *testing.T) {
+	t.Parallel()
+	var got map[string]interface{}
+	var header http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Clone()
+		body, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(body, &got)
+		if strings.Contains(string(body), "missing") {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"__type":"com.amazonaws.dynamodb.v20120810#ResourceNotFoundException",` +
+				`"message":"Requested resource not found"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"Attributes":{"count":{"N":"3"},"expires":{"N":"1577836860"}}}`))
+	}))
+	defer server.Close()
+	store, err := NewDynamoDB("limits", WithAWSRegion("eu-west-1"), WithDynamoDBEndpoint(server.URL),
+		WithAWSCredentials(AWSCredentials{AccessKeyID: "id", SecretAccessKey: "secret", SessionToken: "token"}))
+	require.Nil(t, err)
+
+	count, err := store.Increment(context.Background(), "key", time.Unix(1577836860, 0))
+	require.Nil(t, err)
+	assert.Equal(t, int64(3), count)
+	assert.Equal(t, dynamoDBTarget, header.Get("X-Amz-Target"))
+	assert.Equal(t, "token", header.Get("X-Amz-Security-Token"))
+	assert.Contains(t, header.Get("Authorization"), "Credential=id/")
+	assert.Equal(t, "limits", got["TableName"])
+	assert.Equal(t, map[string]interface{}{"id": map[string]interface{}{"S": "key"}}, got["Key"])
+	assert.Equal(t, map[string]interface{}{"N": "1577836860"},
+		got["ExpressionAttributeValues"].(map[string]interface{})[":expires"])
+
+	_, err = store.Increment(context.Background(), "missing", time.Now())
+	assert.Equal(t, &Error{StatusCode: http.StatusBadRequest, Code: "ResourceNotFoundException",
+		Message: "Requested resource not found"}, err)
+}
+
+func TestNewDynamoDB(t *testing.T) {
+	t.Parallel()
+	_, err := NewDynamoDB("limits", WithAWSRegion(""), WithAWSCredentials(AWSCredentials{AccessKeyID: "id"}))
+	assert.NotNil(t, err)
+	_, err = NewDynamoDB("limits", WithAWSRegion("eu-west-1"), WithAWSCredentials(AWSCredentials{AccessKeyID: "id"}))
+	assert.NotNil(t, err)
+	store, err := NewDynamoDB("limits", WithAWSRegion("eu-west-1"),
+		WithAWSCredentials(AWSCredentials{AccessKeyID: "id", SecretAccessKey: "secret"}))
+	require.Nil(t, err)
+	assert.Equal(t, "https://dynamodb.eu-west-1.amazonaws.com/", store.endpoint)
+}
@@ -0,0 +1,141 @@
+package serverless
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mjourard/golio/internal"
+	"github.com/mjourard/golio/internal/cloud"
+)
+
+const firestoreBaseURL = "https://firestore.googleapis.com/v1"
+
+// TokenSource returns an OAuth 2 access token used to authenticate the requests to Firestore
+type TokenSource func(ctx context.Context) (string, error)
+
+// Firestore is a riot.RateLimitStore keeping the counters as documents of a Firestore collection. The documents
+// should be deleted by a time to live policy on the timestamp field "expires". It is safe for concurrent use
+type Firestore struct {
+	project    string
+	collection string
+	baseURL    string
+	token      TokenSource
+	client     internal.Doer
+}
+
+// FirestoreOption is used to configure the Firestore store
+type FirestoreOption func(*Firestore)
+
+// WithTokenSource sets the source of the access tokens. Defaults to the tokens of the default service account
+// provided by the metadata server, which is available in Cloud Functions and Cloud Run
+func WithTokenSource(token TokenSource) FirestoreOption {
+	return func(f *Firestore) {
+		f.token = token
+	}
+}
+
+// WithFirestoreURL sets the base URL of the Firestore API, e.g. of the emulator. Defaults to
+// https://firestore.googleapis.com/v1
+func WithFirestoreURL(baseURL string) FirestoreOption {
+	return func(f *Firestore) {
+		f.baseURL = strings.TrimRight(baseURL, "/")
+	}
+}
+
+// WithFirestoreClient sets the http client used to send the requests, including the requests for tokens
+func WithFirestoreClient(c internal.Doer) FirestoreOption {
+	return func(f *Firestore) {
+		f.client = c
+	}
+}
+
+// NewFirestore returns a store using the collection of the default database of the project
+func NewFirestore(project, collection string, options ...FirestoreOption) *Firestore {
+	f := &Firestore{
+		project:    project,
+		collection: collection,
+		baseURL:    firestoreBaseURL,
+		client:     http.DefaultClient,
+	}
+	for _, opt := range options {
+		opt(f)
+	}
+	if f.token == nil {
+		f.token = (&cloud.MetadataToken{Client: f.client}).Get
+	}
+	return f
+}
+
+type firestoreWrite struct {
+	Update struct {
+		Name   string                            `json:"name"`
+		Fields map[string]map[string]interface{} `json:"fields"`
+	} `json:"update"`
+	UpdateMask struct {
+		FieldPaths []string `json:"fieldPaths"`
+	} `json:"updateMask"`
+	UpdateTransforms []map[string]interface{} `json:"updateTransforms"`
+}
+
+// Increment increments the counter with the key using a write with an increment transform
+func (f *Firestore) Increment(ctx context.Context, key string, expires time.Time) (int64, error) {
+	database := fmt.Sprintf("projects/%s/databases/(default)", f.project)
+	var write firestoreWrite
+	write.Update.Name = fmt.Sprintf("%s/documents/%s/%s", database, f.collection, strings.ReplaceAll(key, "/", "_"))
+	write.Update.Fields = map[string]map[string]interface{}{
+		"expires": {"timestampValue": expires.UTC().Format(time.RFC3339)},
+	}
+	write.UpdateMask.FieldPaths = []string{"expires"}
+	write.UpdateTransforms = []map[string]interface{}{
+		{"fieldPath": "count", "increment": map[string]string{"integerValue": "1"}},
+	}
+	body, err := json.Marshal(map[string][]firestoreWrite{"writes": {write}})
+	if err != nil {
+		return 0, err
+	}
+	token, err := f.token(ctx)
+	if err != nil {
+		return 0, err
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost,
+		fmt.Sprintf("%s/%s/documents:commit", f.baseURL, database), bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("Authorization", "Bearer "+token)
+	response, err := f.client.Do(request)
+	if err != nil {
+		return 0, err
+	}
+	defer response.Body.Close()
+	var result struct {
+		WriteResults []struct {
+			TransformResults []struct {
+				IntegerValue string `json:"integerValue"`
+			} `json:"transformResults"`
+		} `json:"writeResults"`
+		Error struct {
+			Status  string `json:"status"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	decodeErr := json.NewDecoder(response.Body).Decode(&result)
+	if response.StatusCode != http.StatusOK {
+		return 0, &Error{StatusCode: response.StatusCode, Code: result.Error.Status, Message: result.Error.Message}
+	}
+	if decodeErr != nil {
+		return 0, decodeErr
+	}
+	if len(result.WriteResults) == 0 || len(result.WriteResults[0].TransformResults) == 0 {
+		return 0, errors.New("serverless: firestore returned no transform result")
+	}
+	return strconv.ParseInt(result.WriteResults[0].TransformResults[0].IntegerValue, 10, 64)
+}
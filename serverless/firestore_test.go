@@ -0,0 +1,60 @@
+package serverless
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFirestore_Increment(t *testing.T) {
+	t.Parallel()
+	var path, authorization string
+	var got map[string][]firestoreWrite
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path, authorization = r.URL.Path, r.Header.Get("Authorization")
+		body, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(body, &got)
+		w.WriteHeader(status)
+		if status != http.StatusOK {
+			_, _ = w.Write([]byte(`{"error":{"code":403,"message":"denied","status":"PERMISSION_DENIED"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"writeResults":[{"transformResults":[{"integerValue":"7"}]}]}`))
+	}))
+	defer server.Close()
+	token := func(context.Context) (string, error) {
+		return "token", nil
+	}
+	store := NewFirestore("project", "limits", WithFirestoreURL(server.URL+"/v1/"), WithTokenSource(token))
+
+	count, err := store.Increment(context.Background(), "golio:key", time.Unix(1577836800, 0))
+	require.Nil(t, err)
+	assert.Equal(t, int64(7), count)
+	assert.Equal(t, "/v1/projects/project/databases/(default)/documents:commit", path)
+	assert.Equal(t, "Bearer token", authorization)
+	require.Len(t, got["writes"], 1)
+	write := got["writes"][0]
+	assert.Equal(t, "projects/project/databases/(default)/documents/limits/golio:key", write.Update.Name)
+	assert.Equal(t, "2020-01-01T00:00:00Z", write.Update.Fields["expires"]["timestampValue"])
+	assert.Equal(t, []string{"expires"}, write.UpdateMask.FieldPaths)
+
+	status = http.StatusForbidden
+	_, err = store.Increment(context.Background(), "golio:key", time.Now())
+	assert.Equal(t, &Error{StatusCode: http.StatusForbidden, Code: "PERMISSION_DENIED", Message: "denied"}, err)
+
+	tokenErr := errors.New("no token")
+	store = NewFirestore("project", "limits", WithTokenSource(func(context.Context) (string, error) {
+		return "", tokenErr
+	}))
+	_, err = store.Increment(context.Background(), "golio:key", time.Now())
+	assert.Equal(t, tokenErr, err)
+}
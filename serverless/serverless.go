@@ -0,0 +1,25 @@
+// Package serverless provides stores for the rate limit counters of golio clients running in short-lived serverless
+// invocations, e.g. AWS Lambda or Google Cloud Functions, so that all invocations sharing an API key coordinate its
+// quota, e.g.
+//
+//	store, err := serverless.NewDynamoDB("golio-rate-limits")
+//	client := golio.NewClient("API KEY", golio.WithServerless(store))
+//
+// The stores talk to the HTTP APIs of the databases directly, so that no SDK has to be initialized on cold starts.
+package serverless
+
+import (
+	"fmt"
+)
+
+// Error is returned if a database rejected a request
+type Error struct {
+	StatusCode int
+	// Code is the error code of the database, e.g. ResourceNotFoundException or PERMISSION_DENIED
+	Code    string
+	Message string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("serverless: request failed with status %d: %s: %s", e.StatusCode, e.Code, e.Message)
+}
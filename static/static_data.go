@@ -14,49 +14,62 @@ import (
 )
 
 // Client provides access to static data provided by Riot
-// data is fetched on the first call to each method and cached for further calls
+// data is fetched on the first call to each method and cached for further calls. A client is safe for concurrent use
 type Client struct {
-	logger  logrus.FieldLogger
-	client  internal.Doer
-	mutexes map[string]*sync.RWMutex
-	cache   map[string]interface{}
+	logger logrus.FieldLogger
+	client internal.Doer
+	// cache contains an entry per endpoint. The map is not modified after NewClient, only the entries are
+	cache map[string]*cacheEntry
+}
+
+// cacheEntry is the cached response of a single endpoint
+type cacheEntry struct {
+	mu    sync.Mutex
+	value interface{}
 }
 
 // NewClient returns a new client
 func NewClient(doer internal.Doer, logger logrus.FieldLogger) *Client {
-	mutexes := map[string]*sync.RWMutex{
-		"seasons":   {},
-		"queues":    {},
-		"maps":      {},
-		"gameModes": {},
-		"gameTypes": {},
+	cache := map[string]*cacheEntry{}
+	for _, endpoint := range []string{
+		staticDataEndpointSeasons,
+		staticDataEndpointQueues,
+		staticDataEndpointMaps,
+		staticDataEndpointGameModes,
+		staticDataEndpointGameTypes,
+	} {
+		cache[endpoint] = &cacheEntry{}
 	}
 	return &Client{
-		logger:  logger,
-		client:  doer,
-		mutexes: mutexes,
-		cache:   map[string]interface{}{},
+		logger: logger,
+		client: doer,
+		cache:  cache,
 	}
 }
 
-// GetSeasons returns static data for seasons
-func (c *Client) GetSeasons() ([]Season, error) {
-	mu := c.mutexes["seasons"]
-	unlock, toggle := internal.RWLockToggle(mu)
-	defer unlock()
-	seasons, ok := c.cache["seasons"].([]Season)
+// cached returns a copy of the cached response of the endpoint, requesting it if it is not cached yet. Concurrent
+// callers wait for a single request
+func cached[T any](c *Client, endpoint string) ([]T, error) {
+	entry := c.cache[endpoint]
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+	values, ok := entry.value.([]T)
 	if !ok {
-		toggle()
-		if err := c.getInto(staticDataEndpointSeasons, &seasons); err != nil {
+		if err := c.getInto(endpoint, &values); err != nil {
 			return nil, err
 		}
-		c.cache["seasons"] = seasons
+		entry.value = values
 	}
-	res := make([]Season, len(seasons))
-	copy(res, seasons)
+	res := make([]T, len(values))
+	copy(res, values)
 	return res, nil
 }
 
+// GetSeasons returns static data for seasons
+func (c *Client) GetSeasons() ([]Season, error) {
+	return cached[Season](c, staticDataEndpointSeasons)
+}
+
 // GetSeason returns the season for the specified id or an error if no season for the id exists
 func (c *Client) GetSeason(id int) (Season, error) {
 	seasons, err := c.GetSeasons()
@@ -73,20 +86,7 @@ func (c *Client) GetSeason(id int) (Season, error) {
 
 // GetQueues returns static data for queues
 func (c *Client) GetQueues() ([]Queue, error) {
-	mu := c.mutexes["queues"]
-	unlock, toggle := internal.RWLockToggle(mu)
-	defer unlock()
-	queues, ok := c.cache["queues"].([]Queue)
-	if !ok {
-		toggle()
-		if err := c.getInto(staticDataEndpointQueues, &queues); err != nil {
-			return nil, err
-		}
-		c.cache["queues"] = queues
-	}
-	res := make([]Queue, len(queues))
-	copy(res, queues)
-	return res, nil
+	return cached[Queue](c, staticDataEndpointQueues)
 }
 
 // GetQueue returns the queue for the specified id or an error if no queue for the id exists
@@ -105,20 +105,7 @@ func (c *Client) GetQueue(id int) (Queue, error) {
 
 // GetMaps returns static data for maps
 func (c *Client) GetMaps() ([]Map, error) {
-	mu := c.mutexes["maps"]
-	unlock, toggle := internal.RWLockToggle(mu)
-	defer unlock()
-	maps, ok := c.cache["maps"].([]Map)
-	if !ok {
-		toggle()
-		if err := c.getInto(staticDataEndpointMaps, &maps); err != nil {
-			return nil, err
-		}
-		c.cache["maps"] = maps
-	}
-	res := make([]Map, len(maps))
-	copy(res, maps)
-	return res, nil
+	return cached[Map](c, staticDataEndpointMaps)
 }
 
 // GetMap returns the map for the specified id or an error if no map for the id exists
@@ -137,20 +124,7 @@ func (c *Client) GetMap(id int) (Map, error) {
 
 // GetGameModes returns static data for game modes
 func (c *Client) GetGameModes() ([]GameMode, error) {
-	mu := c.mutexes["gameModes"]
-	unlock, toggle := internal.RWLockToggle(mu)
-	defer unlock()
-	gameModes, ok := c.cache["gameModes"].([]GameMode)
-	if !ok {
-		toggle()
-		if err := c.getInto(staticDataEndpointGameModes, &gameModes); err != nil {
-			return nil, err
-		}
-		c.cache["gameModes"] = gameModes
-	}
-	res := make([]GameMode, len(gameModes))
-	copy(res, gameModes)
-	return res, nil
+	return cached[GameMode](c, staticDataEndpointGameModes)
 }
 
 // GetGameMode returns the game mode for the specified id or an error if no mode for the id exists
@@ -169,20 +143,7 @@ func (c *Client) GetGameMode(mode string) (GameMode, error) {
 
 // GetGameTypes returns static data for game types
 func (c *Client) GetGameTypes() ([]GameType, error) {
-	mu := c.mutexes["gameTypes"]
-	unlock, toggle := internal.RWLockToggle(mu)
-	defer unlock()
-	gameTypes, ok := c.cache["gameTypes"].([]GameType)
-	if !ok {
-		toggle()
-		if err := c.getInto(staticDataEndpointGameTypes, &gameTypes); err != nil {
-			return nil, err
-		}
-		c.cache["gameTypes"] = gameTypes
-	}
-	res := make([]GameType, len(gameTypes))
-	copy(res, gameTypes)
-	return res, nil
+	return cached[GameType](c, staticDataEndpointGameTypes)
 }
 
 // GetGameType returns the game type for the specified id or an error if no type for the id exists
@@ -201,7 +162,11 @@ func (c *Client) GetGameType(typ string) (GameType, error) {
 
 // ClearCaches clears caches for all methods
 func (c *Client) ClearCaches() {
-	c.cache = map[string]interface{}{}
+	for _, entry := range c.cache {
+		entry.mu.Lock()
+		entry.value = nil
+		entry.mu.Unlock()
+	}
 }
 
 func (c *Client) getInto(endpoint string, target interface{}) error {
@@ -210,6 +175,9 @@ func (c *Client) getInto(endpoint string, target interface{}) error {
 	if err != nil {
 		return err
 	}
+	if resp.Body != nil {
+		defer resp.Body.Close()
+	}
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
 		err, ok := api.StatusToError[resp.StatusCode]
 		if !ok {
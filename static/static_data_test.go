@@ -2,7 +2,11 @@ package static
 
 import (
 	"fmt"
+	"io"
 	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 
 	log "github.com/sirupsen/logrus"
@@ -10,7 +14,7 @@ import (
 
 	"github.com/mjourard/golio/api"
 	"github.com/mjourard/golio/internal"
-	"github.com/mjourard/golio/internal/mock"
+	"github.com/mjourard/golio/mock"
 )
 
 func TestClient_GetSeasons(t *testing.T) {
@@ -455,3 +459,35 @@ func TestClient_ClearCaches(t *testing.T) {
 	client := NewClient(http.DefaultClient, log.StandardLogger())
 	client.ClearCaches()
 }
+
+func TestClient_concurrent(t *testing.T) {
+	t.Parallel()
+	var calls int32
+	doer := &mock.Doer{Custom: func(r *http.Request) (*http.Response, error) {
+		atomic.AddInt32(&calls, 1)
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("[{}]"))}, nil
+	}}
+	c := NewClient(doer, log.StandardLogger())
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if i%10 == 0 {
+				c.ClearCaches()
+			}
+			_, err := c.GetSeasons()
+			assert.Nil(t, err)
+			_, err = c.GetQueues()
+			assert.Nil(t, err)
+			_, err = c.GetMaps()
+			assert.Nil(t, err)
+			_, err = c.GetGameModes()
+			assert.Nil(t, err)
+			_, err = c.GetGameTypes()
+			assert.Nil(t, err)
+		}(i)
+	}
+	wg.Wait()
+	assert.GreaterOrEqual(t, atomic.LoadInt32(&calls), int32(5))
+}
@@ -0,0 +1,126 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
+)
+
+// fakeDB is a database/sql driver recording the executed statements and answering queries with a handler
+type fakeDB struct {
+	mu    sync.Mutex
+	execs []fakeStatement
+	// query returns the columns and rows of the result of a query
+	query func(query string, args []driver.Value) ([]string, [][]driver.Value)
+}
+
+type fakeStatement struct {
+	query string
+	args  []driver.Value
+}
+
+var fakeDBs sync.Map
+var fakeDBCount int64
+
+func init() {
+	sql.Register("golio-fake", fakeDriver{})
+}
+
+// openFake returns a database backed by the fake
+func openFake(fake *fakeDB) *sql.DB {
+	name := fmt.Sprint(atomic.AddInt64(&fakeDBCount, 1))
+	fakeDBs.Store(name, fake)
+	db, err := sql.Open("golio-fake", name)
+	if err != nil {
+		panic(err)
+	}
+	return db
+}
+
+func (f *fakeDB) statements() []fakeStatement {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]fakeStatement(nil), f.execs...)
+}
+
+type fakeDriver struct{}
+
+func (fakeDriver) Open(name string) (driver.Conn, error) {
+	fake, ok := fakeDBs.Load(name)
+	if !ok {
+		return nil, fmt.Errorf("unknown fake %s", name)
+	}
+	return &fakeConn{db: fake.(*fakeDB)}, nil
+}
+
+type fakeConn struct {
+	db *fakeDB
+}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return nil, fmt.Errorf("prepare is not supported")
+}
+
+func (c *fakeConn) Close() error {
+	return nil
+}
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return fakeTx{}, nil
+}
+
+func (c *fakeConn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	c.db.mu.Lock()
+	defer c.db.mu.Unlock()
+	c.db.execs = append(c.db.execs, fakeStatement{query: query, args: values(args)})
+	return driver.RowsAffected(1), nil
+}
+
+func (c *fakeConn) QueryContext(_ context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	columns, rows := c.db.query(query, values(args))
+	return &fakeRows{columns: columns, rows: rows}, nil
+}
+
+func values(args []driver.NamedValue) []driver.Value {
+	var values []driver.Value
+	for _, arg := range args {
+		values = append(values, arg.Value)
+	}
+	return values
+}
+
+type fakeTx struct{}
+
+func (fakeTx) Commit() error {
+	return nil
+}
+
+func (fakeTx) Rollback() error {
+	return nil
+}
+
+type fakeRows struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+func (r *fakeRows) Columns() []string {
+	return r.columns
+}
+
+func (r *fakeRows) Close() error {
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+	return nil
+}
@@ -0,0 +1,168 @@
+//go:build cgo
+
+package store
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mjourard/golio/api"
+	"github.com/mjourard/golio/riot"
+)
+
+// openSQLite returns a database stored in the file of the test, which is shared by every database opened with the
+// same path
+func openSQLite(t *testing.T, path string) *sql.DB {
+	db, err := sql.Open("sqlite3", path+"?_busy_timeout=10000")
+	require.Nil(t, err)
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+	return db
+}
+
+// newSQLiteStore returns a migrated store using a new SQLite database
+func newSQLiteStore(t *testing.T) *Store {
+	s := New(openSQLite(t, filepath.Join(t.TempDir(), "golio.db")), SQLite)
+	require.Nil(t, s.Migrate(context.Background()))
+	return s
+}
+
+func TestSQLite_Migrate(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "golio.db")
+	// instances of an application starting at the same time migrate the same database concurrently
+	errs := make(chan error, 4)
+	wg := sync.WaitGroup{}
+	for i := 0; i < cap(errs); i++ {
+		db := openSQLite(t, path)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- New(db, SQLite).Migrate(context.Background())
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		assert.Nil(t, err)
+	}
+	db := openSQLite(t, path)
+	require.Nil(t, New(db, SQLite).Migrate(context.Background()))
+	var versions, latest int
+	require.Nil(t, db.QueryRow(`SELECT COUNT(*), MAX(version) FROM golio_schema_migrations`).Scan(&versions, &latest))
+	assert.Equal(t, len(migrations), versions)
+	assert.Equal(t, len(migrations)-1, latest)
+}
+
+func TestSQLite_Save(t *testing.T) {
+	t.Parallel()
+	s := newSQLiteStore(t)
+	ctx := context.Background()
+
+	_, err := s.Summoner(ctx, api.RegionEuropeWest, "puuid")
+	assert.Equal(t, ErrNotFound, err)
+	require.Nil(t, s.SaveSummoner(ctx, api.RegionEuropeWest, &riot.Summoner{PUUID: "puuid", Name: "old"}))
+	require.Nil(t, s.SaveSummoner(ctx, api.RegionEuropeWest, &riot.Summoner{PUUID: "puuid", Name: "new",
+		RevisionDate: riot.TimestampFromMillis(1600000000000)}))
+	summoner, err := s.Summoner(ctx, api.RegionEuropeWest, "puuid")
+	require.Nil(t, err)
+	assert.Equal(t, "new", summoner.Name)
+	assert.Equal(t, int64(1600000000000), summoner.RevisionDate.Millis())
+	_, err = s.Summoner(ctx, api.RegionKorea, "puuid")
+	assert.Equal(t, ErrNotFound, err)
+
+	require.Nil(t, s.SaveMatch(ctx, api.RegionEuropeWest, &riot.Match{GameID: 1, QueueID: 400}))
+	require.Nil(t, s.SaveMatch(ctx, api.RegionEuropeWest, &riot.Match{GameID: 1, QueueID: 420}))
+	match, err := s.Match(ctx, api.RegionEuropeWest, 1)
+	require.Nil(t, err)
+	assert.Equal(t, 420, match.QueueID)
+	_, err = s.Match(ctx, api.RegionEuropeWest, 2)
+	assert.Equal(t, ErrNotFound, err)
+
+	require.Nil(t, s.SaveTimeline(ctx, api.RegionEuropeWest, 1, &riot.MatchTimeline{
+		Frames: []*riot.MatchFrame{{}, {}},
+	}))
+	timeline, err := s.Timeline(ctx, api.RegionEuropeWest, 1)
+	require.Nil(t, err)
+	assert.Len(t, timeline.Frames, 2)
+	_, err = s.Timeline(ctx, api.RegionEuropeWest, 2)
+	assert.Equal(t, ErrNotFound, err)
+}
+
+func TestSQLite_MatchIDs(t *testing.T) {
+	t.Parallel()
+	s := newSQLiteStore(t)
+	ctx := context.Background()
+	since := time.Unix(1600000000, 0)
+	for id, match := range map[int]struct {
+		queue   int
+		created time.Time
+	}{
+		1: {queue: 420, created: since.Add(-time.Hour)},
+		2: {queue: 420, created: since},
+		3: {queue: 420, created: since.Add(2 * time.Hour)},
+		4: {queue: 450, created: since.Add(time.Hour)},
+		5: {queue: 420, created: since.Add(time.Hour)},
+	} {
+		require.Nil(t, s.SaveMatch(ctx, api.RegionEuropeWest, &riot.Match{GameID: id, QueueID: match.queue,
+			GameCreation: riot.Timestamp{Time: match.created}}))
+	}
+	require.Nil(t, s.SaveMatch(ctx, api.RegionKorea, &riot.Match{GameID: 6, QueueID: 420,
+		GameCreation: riot.Timestamp{Time: since}}))
+
+	ids, err := s.MatchIDs(ctx, api.RegionEuropeWest, MatchQuery{})
+	require.Nil(t, err)
+	assert.Equal(t, []int{3, 4, 5, 2, 1}, ids)
+	ids, err = s.MatchIDs(ctx, api.RegionEuropeWest, MatchQuery{
+		QueueID: riot.QueueIDRankedSolo,
+		Since:   since,
+		Until:   since.Add(2 * time.Hour),
+	})
+	require.Nil(t, err)
+	assert.Equal(t, []int{5, 2}, ids)
+	ids, err = s.MatchIDs(ctx, api.RegionEuropeWest, MatchQuery{QueueID: riot.QueueIDRankedSolo, Limit: 2})
+	require.Nil(t, err)
+	assert.Equal(t, []int{3, 5}, ids)
+}
+
+func TestSQLite_LeagueHistory(t *testing.T) {
+	t.Parallel()
+	s := newSQLiteStore(t)
+	ctx := context.Background()
+	taken := time.Unix(1600000000, 0)
+	for i, points := range []int{30, 10, 20} {
+		require.Nil(t, s.SaveLeagueSnapshot(ctx, api.RegionEuropeWest, taken.Add(-time.Duration(i)*time.Hour),
+			[]*riot.LeagueItem{
+				{SummonerID: "id", QueueType: string(riot.QueueRankedSolo), LeaguePoints: points},
+				{SummonerID: "id", QueueType: string(riot.QueueRankedFlex), LeaguePoints: 99},
+				nil,
+				{SummonerID: "other", QueueType: string(riot.QueueRankedSolo), LeaguePoints: 99},
+			}))
+	}
+	// saving the entries for the same time again updates them
+	require.Nil(t, s.SaveLeagueSnapshot(ctx, api.RegionEuropeWest, taken, []*riot.LeagueItem{
+		{SummonerID: "id", QueueType: string(riot.QueueRankedSolo), LeaguePoints: 40},
+	}))
+
+	history, err := s.LeagueHistory(ctx, api.RegionEuropeWest, "id", riot.QueueRankedSolo)
+	require.Nil(t, err)
+	require.Len(t, history, 3)
+	var points []int
+	for i, snapshot := range history {
+		assert.True(t, snapshot.TakenAt.Equal(taken.Add(time.Duration(i-2)*time.Hour)), snapshot.TakenAt)
+		points = append(points, snapshot.Entry.LeaguePoints)
+	}
+	assert.Equal(t, []int{20, 10, 40}, points)
+	history, err = s.LeagueHistory(ctx, api.RegionKorea, "id", riot.QueueRankedSolo)
+	require.Nil(t, err)
+	assert.Empty(t, history)
+}
@@ -0,0 +1,366 @@
+// Package store persists data of the Riot API in an SQL database, so that collectors built on golio share a schema.
+// Matches, timelines, summoners and snapshots of league entries are stored by region. The documents are stored as
+// JSON next to the columns used by the queries, so new fields of the Riot API do not require a migration.
+//
+// The store works with any database/sql driver for SQLite or Postgres, e.g.
+//
+//	db, err := sql.Open("sqlite", "golio.db")
+//	...
+//	s := store.New(db, store.SQLite)
+//	if err := s.Migrate(ctx); err != nil {
+//		...
+//	}
+//	err = s.SaveMatch(ctx, api.RegionEuropeWest, match)
+package store
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mjourard/golio/api"
+	"github.com/mjourard/golio/riot"
+)
+
+// ErrNotFound is returned by the query helpers if the requested document is not stored
+var ErrNotFound = errors.New("store: not found")
+
+// Dialect is the SQL dialect of the database
+type Dialect int
+
+// All supported dialects
+const (
+	SQLite Dialect = iota
+	Postgres
+)
+
+// Store saves and queries documents of the Riot API in an SQL database. It is safe for concurrent use
+type Store struct {
+	db      *sql.DB
+	dialect Dialect
+}
+
+// New returns a store using the database. Migrate must be called before the store is used for the first time
+func New(db *sql.DB, dialect Dialect) *Store {
+	return &Store{db: db, dialect: dialect}
+}
+
+// migrations create the schema, new migrations are appended and never changed once released
+var migrations = []string{
+	`CREATE TABLE golio_summoners (
+	region TEXT NOT NULL,
+	puuid TEXT NOT NULL,
+	id TEXT NOT NULL,
+	account_id TEXT NOT NULL,
+	name TEXT NOT NULL,
+	revision_date BIGINT NOT NULL,
+	data TEXT NOT NULL,
+	PRIMARY KEY (region, puuid)
+)`,
+	`CREATE INDEX golio_summoners_name ON golio_summoners (region, name)`,
+	`CREATE TABLE golio_matches (
+	region TEXT NOT NULL,
+	game_id BIGINT NOT NULL,
+	queue_id INTEGER NOT NULL,
+	game_version TEXT NOT NULL,
+	game_creation BIGINT NOT NULL,
+	data TEXT NOT NULL,
+	PRIMARY KEY (region, game_id)
+)`,
+	`CREATE INDEX golio_matches_creation ON golio_matches (region, queue_id, game_creation)`,
+	`CREATE TABLE golio_timelines (
+	region TEXT NOT NULL,
+	game_id BIGINT NOT NULL,
+	data TEXT NOT NULL,
+	PRIMARY KEY (region, game_id)
+)`,
+	`CREATE TABLE golio_league_snapshots (
+	region TEXT NOT NULL,
+	summoner_id TEXT NOT NULL,
+	queue TEXT NOT NULL,
+	taken_at BIGINT NOT NULL,
+	tier TEXT NOT NULL,
+	rank TEXT NOT NULL,
+	league_points INTEGER NOT NULL,
+	wins INTEGER NOT NULL,
+	losses INTEGER NOT NULL,
+	data TEXT NOT NULL,
+	PRIMARY KEY (region, summoner_id, queue, taken_at)
+)`,
+}
+
+// Migrate creates or updates the schema of the store. Applied migrations are recorded in the table
+// golio_schema_migrations, so Migrate can be called on every start of an application. Concurrent calls, e.g. by
+// instances of an application starting at the same time, are serialized by locking the table while a migration is
+// applied
+func (s *Store) Migrate(ctx context.Context) error {
+	if _, err := s.db.ExecContext(ctx,
+		`CREATE TABLE IF NOT EXISTS golio_schema_migrations (version INTEGER PRIMARY KEY)`); err != nil {
+		return fmt.Errorf("store: create migrations table: %w", err)
+	}
+	for {
+		done, err := s.migrateNext(ctx)
+		if err != nil || done {
+			return err
+		}
+	}
+}
+
+// migrateNext applies the next migration which was not applied yet. It returns true if all migrations were applied
+func (s *Store) migrateNext(ctx context.Context) (bool, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("store: begin migration: %w", err)
+	}
+	// the version has to be read while holding the lock, as a concurrent call may have applied the migration since
+	if _, err := tx.ExecContext(ctx, s.lockMigrations()); err != nil {
+		_ = tx.Rollback()
+		return false, fmt.Errorf("store: lock migrations: %w", err)
+	}
+	applied := -1
+	row := tx.QueryRowContext(ctx, `SELECT COALESCE(MAX(version), -1) FROM golio_schema_migrations`)
+	if err := row.Scan(&applied); err != nil {
+		_ = tx.Rollback()
+		return false, fmt.Errorf("store: read migrations: %w", err)
+	}
+	version := applied + 1
+	if version >= len(migrations) {
+		return true, tx.Rollback()
+	}
+	if _, err := tx.ExecContext(ctx, migrations[version]); err != nil {
+		_ = tx.Rollback()
+		return false, fmt.Errorf("store: migration %d: %w", version, err)
+	}
+	if _, err := tx.ExecContext(ctx, s.rebind(`INSERT INTO golio_schema_migrations (version) VALUES (?)`),
+		version); err != nil {
+		_ = tx.Rollback()
+		return false, fmt.Errorf("store: migration %d: %w", version, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("store: migration %d: %w", version, err)
+	}
+	return false, nil
+}
+
+// lockMigrations returns the statement locking the migrations table until the end of the transaction
+func (s *Store) lockMigrations() string {
+	if s.dialect == Postgres {
+		return `LOCK TABLE golio_schema_migrations IN SHARE ROW EXCLUSIVE MODE`
+	}
+	// SQLite locks the database for writing with the first write of a transaction, even if it changes no rows, and
+	// concurrent writers wait for the lock up to the busy timeout of the connection
+	return `DELETE FROM golio_schema_migrations WHERE version < 0`
+}
+
+// SaveSummoner inserts or updates the summoner
+func (s *Store) SaveSummoner(ctx context.Context, region api.Region, summoner *riot.Summoner) error {
+	data, err := json.Marshal(summoner)
+	if err != nil {
+		return err
+	}
+	return s.exec(ctx, `INSERT INTO golio_summoners (region, puuid, id, account_id, name, revision_date, data)
+VALUES (?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT (region, puuid) DO UPDATE SET id = excluded.id, account_id = excluded.account_id, name = excluded.name,
+revision_date = excluded.revision_date, data = excluded.data`,
+		string(region), summoner.PUUID, summoner.ID, summoner.AccountID, summoner.Name, summoner.RevisionDate.Millis(),
+		string(data))
+}
+
+// SaveMatch inserts or updates the match
+func (s *Store) SaveMatch(ctx context.Context, region api.Region, match *riot.Match) error {
+	data, err := json.Marshal(match)
+	if err != nil {
+		return err
+	}
+	return s.exec(ctx, `INSERT INTO golio_matches (region, game_id, queue_id, game_version, game_creation, data)
+VALUES (?, ?, ?, ?, ?, ?)
+ON CONFLICT (region, game_id) DO UPDATE SET queue_id = excluded.queue_id, game_version = excluded.game_version,
+game_creation = excluded.game_creation, data = excluded.data`,
+		string(region), match.GameID, match.QueueID, match.GameVersion, match.GameCreation.Millis(), string(data))
+}
+
+// SaveTimeline inserts or updates the timeline of the match with the given ID
+func (s *Store) SaveTimeline(ctx context.Context, region api.Region, gameID int, timeline *riot.MatchTimeline) error {
+	data, err := json.Marshal(timeline)
+	if err != nil {
+		return err
+	}
+	return s.exec(ctx, `INSERT INTO golio_timelines (region, game_id, data) VALUES (?, ?, ?)
+ON CONFLICT (region, game_id) DO UPDATE SET data = excluded.data`, string(region), gameID, string(data))
+}
+
+// SaveLeagueSnapshot stores the league entries as they were at the given time, e.g. the result of
+// League.ListBySummoner or the entries of an apex league. Saving the same entries for the same time again updates
+// them
+func (s *Store) SaveLeagueSnapshot(ctx context.Context, region api.Region, takenAt time.Time,
+	entries []*riot.LeagueItem) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	query := s.rebind(`INSERT INTO golio_league_snapshots
+(region, summoner_id, queue, taken_at, tier, rank, league_points, wins, losses, data)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT (region, summoner_id, queue, taken_at) DO UPDATE SET tier = excluded.tier, rank = excluded.rank,
+league_points = excluded.league_points, wins = excluded.wins, losses = excluded.losses, data = excluded.data`)
+	for _, entry := range entries {
+		if entry == nil {
+			continue
+		}
+		data, err := json.Marshal(entry)
+		if err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, query, string(region), entry.SummonerID, entry.QueueType,
+			takenAt.UnixNano()/int64(time.Millisecond), entry.Tier, entry.Rank, entry.LeaguePoints, entry.Wins,
+			entry.Losses, string(data)); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// Summoner returns the summoner with the PUUID or ErrNotFound
+func (s *Store) Summoner(ctx context.Context, region api.Region, puuid string) (*riot.Summoner, error) {
+	var summoner *riot.Summoner
+	err := s.get(ctx, &summoner, `SELECT data FROM golio_summoners WHERE region = ? AND puuid = ?`,
+		string(region), puuid)
+	return summoner, err
+}
+
+// Match returns the match with the ID or ErrNotFound
+func (s *Store) Match(ctx context.Context, region api.Region, gameID int) (*riot.Match, error) {
+	var match *riot.Match
+	err := s.get(ctx, &match, `SELECT data FROM golio_matches WHERE region = ? AND game_id = ?`,
+		string(region), gameID)
+	return match, err
+}
+
+// Timeline returns the timeline of the match with the ID or ErrNotFound
+func (s *Store) Timeline(ctx context.Context, region api.Region, gameID int) (*riot.MatchTimeline, error) {
+	var timeline *riot.MatchTimeline
+	err := s.get(ctx, &timeline, `SELECT data FROM golio_timelines WHERE region = ? AND game_id = ?`,
+		string(region), gameID)
+	return timeline, err
+}
+
+// MatchQuery restricts the matches returned by MatchIDs. Zero fields are ignored
+type MatchQuery struct {
+	QueueID riot.QueueID
+	// Since and Until restrict the creation time of the matches to [Since, Until)
+	Since time.Time
+	Until time.Time
+	// Limit is the maximum number of returned IDs
+	Limit int
+}
+
+// MatchIDs returns the IDs of the stored matches of the region matching the query, newest first
+func (s *Store) MatchIDs(ctx context.Context, region api.Region, query MatchQuery) ([]int, error) {
+	conditions := []string{"region = ?"}
+	args := []interface{}{string(region)}
+	if query.QueueID != 0 {
+		conditions = append(conditions, "queue_id = ?")
+		args = append(args, int(query.QueueID))
+	}
+	if !query.Since.IsZero() {
+		conditions = append(conditions, "game_creation >= ?")
+		args = append(args, riot.Timestamp{Time: query.Since}.Millis())
+	}
+	if !query.Until.IsZero() {
+		conditions = append(conditions, "game_creation < ?")
+		args = append(args, riot.Timestamp{Time: query.Until}.Millis())
+	}
+	statement := "SELECT game_id FROM golio_matches WHERE " + strings.Join(conditions, " AND ") +
+		" ORDER BY game_creation DESC"
+	if query.Limit > 0 {
+		statement += " LIMIT " + strconv.Itoa(query.Limit)
+	}
+	rows, err := s.db.QueryContext(ctx, s.rebind(statement), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var ids []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// LeagueSnapshot is a league entry of a summoner at a point in time
+type LeagueSnapshot struct {
+	TakenAt time.Time
+	Entry   *riot.LeagueItem
+}
+
+// LeagueHistory returns the snapshots of the league entry of the summoner in the queue, oldest first
+func (s *Store) LeagueHistory(ctx context.Context, region api.Region, summonerID string,
+	queue riot.Queue) ([]LeagueSnapshot, error) {
+	rows, err := s.db.QueryContext(ctx, s.rebind(`SELECT taken_at, data FROM golio_league_snapshots
+WHERE region = ? AND summoner_id = ? AND queue = ? ORDER BY taken_at`), string(region), summonerID, string(queue))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var snapshots []LeagueSnapshot
+	for rows.Next() {
+		var takenAt int64
+		var data string
+		if err := rows.Scan(&takenAt, &data); err != nil {
+			return nil, err
+		}
+		snapshot := LeagueSnapshot{TakenAt: riot.TimestampFromMillis(takenAt).Time}
+		if err := json.Unmarshal([]byte(data), &snapshot.Entry); err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	return snapshots, rows.Err()
+}
+
+func (s *Store) exec(ctx context.Context, query string, args ...interface{}) error {
+	_, err := s.db.ExecContext(ctx, s.rebind(query), args...)
+	return err
+}
+
+// get decodes the JSON document of the single row returned by the query into target
+func (s *Store) get(ctx context.Context, target interface{}, query string, args ...interface{}) error {
+	var data string
+	err := s.db.QueryRowContext(ctx, s.rebind(query), args...).Scan(&data)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrNotFound
+	}
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal([]byte(data), target)
+}
+
+// rebind replaces the ? placeholders of the query with the placeholders of the dialect
+func (s *Store) rebind(query string) string {
+	if s.dialect != Postgres {
+		return query
+	}
+	var b strings.Builder
+	n := 0
+	for _, r := range query {
+		if r == '?' {
+			n++
+			b.WriteString("$" + strconv.Itoa(n))
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,128 @@
+package store
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mjourard/golio/api"
+	"github.com/mjourard/golio/riot"
+)
+
+func noRows(string, []driver.Value) ([]string, [][]driver.Value) {
+	return []string{"data"}, nil
+}
+
+func TestStore_Migrate(t *testing.T) {
+	t.Parallel()
+	fake := &fakeDB{}
+	fake.query = func(string, []driver.Value) ([]string, [][]driver.Value) {
+		// the first two migrations were applied before
+		version := int64(1)
+		for _, statement := range fake.statements() {
+			if strings.HasPrefix(statement.query, "INSERT INTO golio_schema_migrations") {
+				version = statement.args[0].(int64)
+			}
+		}
+		return []string{"version"}, [][]driver.Value{{version}}
+	}
+	s := New(openFake(fake), Postgres)
+	require.Nil(t, s.Migrate(context.Background()))
+	statements := fake.statements()
+	require.Len(t, statements, 1+3*(len(migrations)-2)+1)
+	assert.Contains(t, statements[0].query, "CREATE TABLE IF NOT EXISTS golio_schema_migrations")
+	assert.Equal(t, "LOCK TABLE golio_schema_migrations IN SHARE ROW EXCLUSIVE MODE", statements[1].query)
+	assert.Equal(t, migrations[2], statements[2].query)
+	assert.Equal(t, "INSERT INTO golio_schema_migrations (version) VALUES ($1)", statements[3].query)
+	assert.Equal(t, []driver.Value{int64(2)}, statements[3].args)
+	assert.Equal(t, statements[1], statements[len(statements)-1])
+}
+
+func TestStore_Save(t *testing.T) {
+	t.Parallel()
+	fake := &fakeDB{query: noRows}
+	s := New(openFake(fake), SQLite)
+	ctx := context.Background()
+	created := riot.TimestampFromMillis(1600000000000)
+	require.Nil(t, s.SaveMatch(ctx, api.RegionEuropeWest, &riot.Match{GameID: 1, QueueID: 420, GameCreation: created}))
+	require.Nil(t, s.SaveSummoner(ctx, api.RegionEuropeWest, &riot.Summoner{PUUID: "puuid", Name: "name"}))
+	require.Nil(t, s.SaveTimeline(ctx, api.RegionEuropeWest, 1, &riot.MatchTimeline{}))
+	require.Nil(t, s.SaveLeagueSnapshot(ctx, api.RegionEuropeWest, created.Time, []*riot.LeagueItem{
+		{SummonerID: "a", QueueType: string(riot.QueueRankedSolo), LeaguePoints: 10}, nil,
+		{SummonerID: "b", QueueType: string(riot.QueueRankedSolo)},
+	}))
+	statements := fake.statements()
+	require.Len(t, statements, 5)
+	for i, table := range []string{"golio_matches", "golio_summoners", "golio_timelines", "golio_league_snapshots"} {
+		assert.True(t, strings.HasPrefix(statements[i].query, "INSERT INTO "+table), statements[i].query)
+		assert.Contains(t, statements[i].query, "ON CONFLICT")
+		assert.NotContains(t, statements[i].query, "$1")
+	}
+	assert.Equal(t, []driver.Value{"euw1", int64(1), int64(420), "", int64(1600000000000)},
+		statements[0].args[:5])
+	assert.Equal(t, int64(1600000000000), statements[3].args[3])
+	assert.Equal(t, int64(10), statements[3].args[6])
+}
+
+func TestStore_Get(t *testing.T) {
+	t.Parallel()
+	match, err := json.Marshal(riot.Match{GameID: 1})
+	require.Nil(t, err)
+	fake := &fakeDB{query: func(query string, args []driver.Value) ([]string, [][]driver.Value) {
+		if args[1] == int64(1) {
+			return []string{"data"}, [][]driver.Value{{string(match)}}
+		}
+		return []string{"data"}, nil
+	}}
+	s := New(openFake(fake), SQLite)
+	got, err := s.Match(context.Background(), api.RegionEuropeWest, 1)
+	require.Nil(t, err)
+	assert.Equal(t, 1, got.GameID)
+	_, err = s.Match(context.Background(), api.RegionEuropeWest, 2)
+	assert.Equal(t, ErrNotFound, err)
+	_, err = s.Summoner(context.Background(), api.RegionEuropeWest, "puuid")
+	assert.Equal(t, ErrNotFound, err)
+}
+
+func TestStore_MatchIDs(t *testing.T) {
+	t.Parallel()
+	var gotQuery string
+	var gotArgs []driver.Value
+	fake := &fakeDB{query: func(query string, args []driver.Value) ([]string, [][]driver.Value) {
+		gotQuery, gotArgs = query, args
+		return []string{"game_id"}, [][]driver.Value{{int64(3)}, {int64(2)}}
+	}}
+	s := New(openFake(fake), Postgres)
+	since := time.Unix(1600000000, 0)
+	ids, err := s.MatchIDs(context.Background(), api.RegionKorea, MatchQuery{
+		QueueID: riot.QueueIDRankedSolo,
+		Since:   since,
+		Limit:   10,
+	})
+	require.Nil(t, err)
+	assert.Equal(t, []int{3, 2}, ids)
+	assert.Equal(t, "SELECT game_id FROM golio_matches WHERE region = $1 AND queue_id = $2 AND game_creation >= $3 "+
+		"ORDER BY game_creation DESC LIMIT 10", gotQuery)
+	assert.Equal(t, []driver.Value{"kr", int64(420), int64(1600000000000)}, gotArgs)
+}
+
+func TestStore_LeagueHistory(t *testing.T) {
+	t.Parallel()
+	entry, err := json.Marshal(riot.LeagueItem{SummonerID: "id", LeaguePoints: 42})
+	require.Nil(t, err)
+	fake := &fakeDB{query: func(string, []driver.Value) ([]string, [][]driver.Value) {
+		return []string{"taken_at", "data"}, [][]driver.Value{{int64(1600000000000), string(entry)}}
+	}}
+	s := New(openFake(fake), SQLite)
+	got, err := s.LeagueHistory(context.Background(), api.RegionKorea, "id", riot.QueueRankedSolo)
+	require.Nil(t, err)
+	require.Len(t, got, 1)
+	assert.True(t, got[0].TakenAt.Equal(time.Unix(1600000000, 0)))
+	assert.Equal(t, 42, got[0].Entry.LeaguePoints)
+}
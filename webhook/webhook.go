@@ -0,0 +1,257 @@
+// Package webhook sends the events of the watchers of golio as signed JSON to webhook URLs, turning golio into a
+// small notification pipeline, e.g.
+//
+//	emitter := webhook.New([]webhook.Endpoint{{URL: "https://example.com/hook", Secret: "secret"}})
+//	go emitter.ForwardStatus(ctx, client.Riot.Status.Watch(ctx, time.Minute))
+//
+// Every request contains the JSON encoded Event as body and the headers X-Golio-Event, X-Golio-Timestamp and
+// X-Golio-Signature. The signature is the hex encoded HMAC-SHA256 of the timestamp, a dot and the body using the
+// secret of the endpoint, receivers can check it using Verify.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/mjourard/golio/internal"
+	"github.com/mjourard/golio/riot"
+)
+
+// Headers of the requests sent to the webhooks
+const (
+	HeaderEvent     = "X-Golio-Event"
+	HeaderTimestamp = "X-Golio-Timestamp"
+	HeaderSignature = "X-Golio-Signature"
+)
+
+// Types of the events forwarded from the watchers
+const (
+	EventStatusIncident    = "status.incident"
+	EventStatusMaintenance = "status.maintenance"
+	EventFreeRotation      = "champion.free_rotation"
+)
+
+// Event is the body of a request to a webhook
+type Event struct {
+	// Type of the event, e.g. status.incident
+	Type string      `json:"type"`
+	Time time.Time   `json:"time"`
+	Data interface{} `json:"data"`
+}
+
+// Endpoint is a webhook receiving events
+type Endpoint struct {
+	URL string
+	// Secret is used to sign the requests, requests are not signed if it is empty
+	Secret string
+}
+
+// DeliveryError is returned if an event could not be delivered to an endpoint
+type DeliveryError struct {
+	URL string
+	// StatusCode of the last response or 0 if no response was received
+	StatusCode int
+	Err        error
+}
+
+func (e *DeliveryError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("webhook: delivery to %s failed: %v", e.URL, e.Err)
+	}
+	return fmt.Sprintf("webhook: delivery to %s failed with status %d", e.URL, e.StatusCode)
+}
+
+func (e *DeliveryError) Unwrap() error {
+	return e.Err
+}
+
+// Emitter sends events to webhooks. It is safe for concurrent use
+type Emitter struct {
+	endpoints []Endpoint
+	client    internal.Doer
+	logger    log.FieldLogger
+	attempts  int
+	backoff   time.Duration
+}
+
+// Option is used to configure the Emitter
+type Option func(*Emitter)
+
+// WithClient sets the http client used to send the events
+func WithClient(c internal.Doer) Option {
+	return func(e *Emitter) {
+		e.client = c
+	}
+}
+
+// WithLogger sets the logger of the emitter
+func WithLogger(l log.FieldLogger) Option {
+	return func(e *Emitter) {
+		e.logger = l
+	}
+}
+
+// WithRetries sets the number of attempts to deliver an event and the wait before the first retry, which doubles
+// with every further retry. Defaults to 3 attempts and one second
+func WithRetries(attempts int, backoff time.Duration) Option {
+	return func(e *Emitter) {
+		e.attempts = attempts
+		e.backoff = backoff
+	}
+}
+
+// New returns an emitter sending events to the endpoints
+func New(endpoints []Endpoint, options ...Option) *Emitter {
+	e := &Emitter{
+		endpoints: endpoints,
+		client:    http.DefaultClient,
+		logger:    log.StandardLogger(),
+		attempts:  3,
+		backoff:   time.Second,
+	}
+	for _, opt := range options {
+		opt(e)
+	}
+	if e.attempts < 1 {
+		e.attempts = 1
+	}
+	e.logger = e.logger.WithField("client", "webhook")
+	return e
+}
+
+// Send delivers the event to all endpoints. Requests failing without a response or with status 429 or 5xx are
+// retried. The error of the first endpoint the event could not be delivered to is returned as *DeliveryError, the
+// event is sent to the remaining endpoints regardless
+func (e *Emitter) Send(ctx context.Context, event Event) error {
+	if event.Time.IsZero() {
+		event.Time = time.Now()
+	}
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	var firstErr error
+	for _, endpoint := range e.endpoints {
+		if err := e.deliver(ctx, endpoint, event.Type, body); err != nil {
+			e.logger.WithFields(log.Fields{"url": endpoint.URL, "event": event.Type}).Warn(err)
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+	return firstErr
+}
+
+func (e *Emitter) deliver(ctx context.Context, endpoint Endpoint, eventType string, body []byte) error {
+	wait := e.backoff
+	deliveryErr := &DeliveryError{URL: endpoint.URL}
+	for attempt := 0; attempt < e.attempts; attempt++ {
+		if attempt > 0 {
+			if err := sleep(ctx, wait); err != nil {
+				deliveryErr.Err = err
+				return deliveryErr
+			}
+			wait *= 2
+		}
+		statusCode, err := e.post(ctx, endpoint, eventType, body)
+		if err == nil && statusCode >= 200 && statusCode <= 299 {
+			return nil
+		}
+		deliveryErr.StatusCode, deliveryErr.Err = statusCode, err
+		if err == nil && statusCode != http.StatusTooManyRequests && statusCode < 500 {
+			return deliveryErr
+		}
+		if ctx.Err() != nil {
+			return deliveryErr
+		}
+	}
+	return deliveryErr
+}
+
+func (e *Emitter) post(ctx context.Context, endpoint Endpoint, eventType string, body []byte) (int, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(HeaderEvent, eventType)
+	request.Header.Set(HeaderTimestamp, timestamp)
+	if endpoint.Secret != "" {
+		request.Header.Set(HeaderSignature, Sign(endpoint.Secret, timestamp, body))
+	}
+	response, err := e.client.Do(request)
+	if err != nil {
+		return 0, err
+	}
+	if response.Body != nil {
+		_ = response.Body.Close()
+	}
+	return response.StatusCode, nil
+}
+
+// Sign returns the signature of a request with the timestamp and the body
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify returns whether the signature of a request with the timestamp and the body was created using the secret.
+// Receivers should additionally reject old timestamps to prevent replays
+func Verify(secret, timestamp string, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(signature))
+}
+
+// ForwardStatus sends every incident and maintenance of the channel returned by riot.Client.Status.Watch as event of
+// the type EventStatusIncident or EventStatusMaintenance until the channel is closed. Errors of the watcher are logged
+// and skipped
+func (e *Emitter) ForwardStatus(ctx context.Context, events <-chan riot.StatusEvent) {
+	for event := range events {
+		if event.Error != nil {
+			e.logger.Debug(event.Error)
+			continue
+		}
+		eventType := EventStatusIncident
+		if event.Maintenance != nil {
+			eventType = EventStatusMaintenance
+		}
+		_ = e.Send(ctx, Event{Type: eventType, Data: event})
+	}
+}
+
+// ForwardFreeRotation sends every change of the channel returned by riot.Client.Champion.WatchFreeRotation as event
+// of the type EventFreeRotation until the channel is closed. Failed requests of the watcher are logged and skipped
+func (e *Emitter) ForwardFreeRotation(ctx context.Context, changes <-chan riot.FreeRotationChange) {
+	for change := range changes {
+		if change.Error != nil && change.ChampionInfo == nil {
+			e.logger.Debug(change.Error)
+			continue
+		}
+		_ = e.Send(ctx, Event{Type: EventFreeRotation, Data: change})
+	}
+}
+
+// sleep waits for the duration d or until ctx is done, returning the error of ctx in the latter case
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
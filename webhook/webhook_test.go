@@ -0,0 +1,109 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mjourard/golio/mock"
+	"github.com/mjourard/golio/riot"
+)
+
+type receiver struct {
+	mu       sync.Mutex
+	statuses []int
+	bodies   [][]byte
+	headers  []http.Header
+}
+
+func (r *receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	body, _ := io.ReadAll(req.Body)
+	r.bodies = append(r.bodies, body)
+	r.headers = append(r.headers, req.Header.Clone())
+	status := http.StatusNoContent
+	if len(r.statuses) > 0 {
+		status, r.statuses = r.statuses[0], r.statuses[1:]
+	}
+	w.WriteHeader(status)
+}
+
+func TestEmitter_Send(t *testing.T) {
+	t.Parallel()
+	hook := &receiver{statuses: []int{http.StatusServiceUnavailable}}
+	server := httptest.NewServer(hook)
+	defer server.Close()
+	emitter := New([]Endpoint{{URL: server.URL, Secret: "secret"}}, WithRetries(2, time.Millisecond))
+	at := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	require.Nil(t, emitter.Send(context.Background(), Event{Type: "game.start", Time: at, Data: map[string]int{"id": 1}}))
+	require.Len(t, hook.bodies, 2)
+	assert.JSONEq(t, `{"type": "game.start", "time": "2020-01-01T00:00:00Z", "data": {"id": 1}}`,
+		string(hook.bodies[1]))
+	header := hook.headers[1]
+	assert.Equal(t, "game.start", header.Get(HeaderEvent))
+	assert.True(t, Verify("secret", header.Get(HeaderTimestamp), hook.bodies[1], header.Get(HeaderSignature)))
+	assert.False(t, Verify("other", header.Get(HeaderTimestamp), hook.bodies[1], header.Get(HeaderSignature)))
+}
+
+func TestEmitter_Send_errors(t *testing.T) {
+	t.Parallel()
+	hook := &receiver{statuses: []int{http.StatusBadRequest}}
+	server := httptest.NewServer(hook)
+	defer server.Close()
+	emitter := New([]Endpoint{{URL: server.URL}}, WithRetries(3, time.Millisecond))
+	err := emitter.Send(context.Background(), Event{Type: "game.end"})
+	var deliveryErr *DeliveryError
+	require.True(t, errors.As(err, &deliveryErr))
+	assert.Equal(t, http.StatusBadRequest, deliveryErr.StatusCode)
+	assert.Len(t, hook.bodies, 1)
+	assert.Empty(t, hook.headers[0].Get(HeaderSignature))
+
+	dropped := errors.New("connection refused")
+	calls := 0
+	emitter = New([]Endpoint{{URL: "http://localhost"}}, WithRetries(3, time.Millisecond),
+		WithClient(&mock.Doer{Custom: func(*http.Request) (*http.Response, error) {
+			calls++
+			return nil, dropped
+		}}))
+	err = emitter.Send(context.Background(), Event{Type: "game.end"})
+	assert.True(t, errors.Is(err, dropped))
+	assert.Equal(t, 3, calls)
+}
+
+func TestEmitter_ForwardStatus(t *testing.T) {
+	t.Parallel()
+	hook := &receiver{}
+	server := httptest.NewServer(hook)
+	defer server.Close()
+	emitter := New([]Endpoint{{URL: server.URL}})
+	events := make(chan riot.StatusEvent, 3)
+	events <- riot.StatusEvent{Error: errors.New("failed")}
+	events <- riot.StatusEvent{Type: riot.StatusEventTypeIncidentPublished, Incident: &riot.Incident{ID: 1}}
+	events <- riot.StatusEvent{Type: riot.StatusEventTypeMaintenancePublished, Maintenance: &riot.StatusEntry{ID: 2}}
+	close(events)
+	emitter.ForwardStatus(context.Background(), events)
+	require.Len(t, hook.bodies, 2)
+	var got struct {
+		Type string
+		Data struct {
+			ID   int `json:"id"`
+			Type riot.StatusEventType
+		}
+	}
+	require.Nil(t, json.Unmarshal(hook.bodies[0], &got))
+	assert.Equal(t, EventStatusIncident, got.Type)
+	assert.Equal(t, 1, got.Data.ID)
+	assert.Equal(t, riot.StatusEventTypeIncidentPublished, got.Data.Type)
+	require.Nil(t, json.Unmarshal(hook.bodies[1], &got))
+	assert.Equal(t, EventStatusMaintenance, got.Type)
+}